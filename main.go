@@ -3,6 +3,8 @@
 package main
 
 import (
+	"os"
+
 	"github.com/hashicorp/terraform/plugin"
 	"github.com/hashicorp/terraform/terraform"
 
@@ -10,6 +12,13 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == provider.CleanupCommandName {
+		os.Exit(provider.RunCleanupCommand(os.Args[2:], os.Stdin, os.Stdout))
+	}
+	if len(os.Args) > 1 && os.Args[1] == provider.DiscoverCommandName {
+		os.Exit(provider.RunDiscoverCommand(os.Args[2:], os.Stdout))
+	}
+
 	provider.PrintVersion()
 
 	plugin.Serve(&plugin.ServeOpts{
@@ -147,7 +147,7 @@ func readAuditAuditEvents(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).auditClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type AuditAuditEventsDataSourceCrud struct {
@@ -160,7 +160,7 @@ func (s *AuditAuditEventsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *AuditAuditEventsDataSourceCrud) Get() error {
+func (s *AuditAuditEventsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_audit.ListEventsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -186,7 +186,7 @@ func (s *AuditAuditEventsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "audit")
 
-	response, err := s.Client.ListEvents(context.Background(), request)
+	response, err := s.Client.ListEvents(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -197,7 +197,7 @@ func (s *AuditAuditEventsDataSourceCrud) Get() error {
 	limit := s.D.Get("limit").(int)
 	limit--
 	for request.Page != nil && limit > 0 {
-		listResponse, err := s.Client.ListEvents(context.Background(), request)
+		listResponse, err := s.Client.ListEvents(ctx, request)
 		if err != nil {
 			return err
 		}
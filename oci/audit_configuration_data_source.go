@@ -31,7 +31,7 @@ func readSingularAuditConfiguration(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).auditClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type AuditConfigurationDataSourceCrud struct {
@@ -44,7 +44,7 @@ func (s *AuditConfigurationDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *AuditConfigurationDataSourceCrud) Get() error {
+func (s *AuditConfigurationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_audit.GetConfigurationRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -54,7 +54,7 @@ func (s *AuditConfigurationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "audit")
 
-	response, err := s.Client.GetConfiguration(context.Background(), request)
+	response, err := s.Client.GetConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
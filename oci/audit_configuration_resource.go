@@ -13,6 +13,9 @@ import (
 
 func AuditConfigurationResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importAuditConfiguration,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createAuditConfiguration,
 		Read:     readAuditConfiguration,
@@ -40,7 +43,7 @@ func createAuditConfiguration(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).auditClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readAuditConfiguration(d *schema.ResourceData, m interface{}) error {
@@ -48,7 +51,7 @@ func readAuditConfiguration(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).auditClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateAuditConfiguration(d *schema.ResourceData, m interface{}) error {
@@ -56,13 +59,19 @@ func updateAuditConfiguration(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).auditClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteAuditConfiguration(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+// importAuditConfiguration imports the audit configuration of a tenancy by the OCID of the tenancy
+func importAuditConfiguration(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	d.Set("compartment_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
 type AuditConfigurationResourceCrud struct {
 	BaseCrud
 	Client                 *oci_audit.AuditClient
@@ -74,12 +83,12 @@ func (s *AuditConfigurationResourceCrud) ID() string {
 	return s.D.Get("compartment_id").(string)
 }
 
-func (s *AuditConfigurationResourceCrud) Create() error {
+func (s *AuditConfigurationResourceCrud) Create(ctx context.Context) error {
 	// This resource can't actually be created. So treat it as an update instead.
-	return s.Update()
+	return s.Update(ctx)
 }
 
-func (s *AuditConfigurationResourceCrud) Get() error {
+func (s *AuditConfigurationResourceCrud) Get(ctx context.Context) error {
 	request := oci_audit.GetConfigurationRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -89,7 +98,7 @@ func (s *AuditConfigurationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "audit")
 
-	response, err := s.Client.GetConfiguration(context.Background(), request)
+	response, err := s.Client.GetConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -98,7 +107,7 @@ func (s *AuditConfigurationResourceCrud) Get() error {
 	return nil
 }
 
-func (s *AuditConfigurationResourceCrud) Update() error {
+func (s *AuditConfigurationResourceCrud) Update(ctx context.Context) error {
 	request := oci_audit.UpdateConfigurationRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -113,7 +122,7 @@ func (s *AuditConfigurationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "audit")
 
-	_, err := s.Client.UpdateConfiguration(context.Background(), request)
+	_, err := s.Client.UpdateConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -125,7 +134,7 @@ func (s *AuditConfigurationResourceCrud) Update() error {
 	// Requests to update the retention policy may succeed instantly but may not see the actual update take effect
 	// until minutes later. Add polling here to return only when the change has taken effect.
 	retentionPolicyFunc := func() bool { return *s.Res.RetentionPeriodDays == *request.RetentionPeriodDays }
-	return WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *AuditConfigurationResourceCrud) SetData() error {
@@ -81,6 +81,14 @@ func TestAuditConfigurationResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(singularDatasourceName, "retention_period_days", "91"),
 				),
 			},
+			// verify resource import
+			{
+				Config:                  config,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+				ResourceName:            resourceName,
+			},
 		},
 	})
 }
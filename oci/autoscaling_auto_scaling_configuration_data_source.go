@@ -245,7 +245,7 @@ func readSingularAutoscalingAutoScalingConfiguration(d *schema.ResourceData, m i
 	sync.D = d
 	sync.Client = m.(*OracleClients).autoScalingClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type AutoscalingAutoScalingConfigurationDataSourceCrud struct {
@@ -258,7 +258,7 @@ func (s *AutoscalingAutoScalingConfigurationDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *AutoscalingAutoScalingConfigurationDataSourceCrud) Get() error {
+func (s *AutoscalingAutoScalingConfigurationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_autoscaling.GetAutoScalingConfigurationRequest{}
 
 	if autoScalingConfigurationId, ok := s.D.GetOkExists("auto_scaling_configuration_id"); ok {
@@ -268,7 +268,7 @@ func (s *AutoscalingAutoScalingConfigurationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "autoscaling")
 
-	response, err := s.Client.GetAutoScalingConfiguration(context.Background(), request)
+	response, err := s.Client.GetAutoScalingConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
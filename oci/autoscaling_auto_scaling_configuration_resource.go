@@ -269,7 +269,7 @@ func createAutoscalingAutoScalingConfiguration(d *schema.ResourceData, m interfa
 	sync.D = d
 	sync.Client = m.(*OracleClients).autoScalingClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readAutoscalingAutoScalingConfiguration(d *schema.ResourceData, m interface{}) error {
@@ -277,7 +277,7 @@ func readAutoscalingAutoScalingConfiguration(d *schema.ResourceData, m interface
 	sync.D = d
 	sync.Client = m.(*OracleClients).autoScalingClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateAutoscalingAutoScalingConfiguration(d *schema.ResourceData, m interface{}) error {
@@ -285,7 +285,7 @@ func updateAutoscalingAutoScalingConfiguration(d *schema.ResourceData, m interfa
 	sync.D = d
 	sync.Client = m.(*OracleClients).autoScalingClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteAutoscalingAutoScalingConfiguration(d *schema.ResourceData, m interface{}) error {
@@ -294,7 +294,7 @@ func deleteAutoscalingAutoScalingConfiguration(d *schema.ResourceData, m interfa
 	sync.Client = m.(*OracleClients).autoScalingClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type AutoscalingAutoScalingConfigurationResourceCrud struct {
@@ -308,7 +308,7 @@ func (s *AutoscalingAutoScalingConfigurationResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *AutoscalingAutoScalingConfigurationResourceCrud) Create() error {
+func (s *AutoscalingAutoScalingConfigurationResourceCrud) Create(ctx context.Context) error {
 	request := oci_autoscaling.CreateAutoScalingConfigurationRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -372,7 +372,7 @@ func (s *AutoscalingAutoScalingConfigurationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "autoscaling")
 
-	response, err := s.Client.CreateAutoScalingConfiguration(context.Background(), request)
+	response, err := s.Client.CreateAutoScalingConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -381,7 +381,7 @@ func (s *AutoscalingAutoScalingConfigurationResourceCrud) Create() error {
 	return nil
 }
 
-func (s *AutoscalingAutoScalingConfigurationResourceCrud) Get() error {
+func (s *AutoscalingAutoScalingConfigurationResourceCrud) Get(ctx context.Context) error {
 	request := oci_autoscaling.GetAutoScalingConfigurationRequest{}
 
 	tmp := s.D.Id()
@@ -389,7 +389,7 @@ func (s *AutoscalingAutoScalingConfigurationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "autoscaling")
 
-	response, err := s.Client.GetAutoScalingConfiguration(context.Background(), request)
+	response, err := s.Client.GetAutoScalingConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -398,7 +398,7 @@ func (s *AutoscalingAutoScalingConfigurationResourceCrud) Get() error {
 	return nil
 }
 
-func (s *AutoscalingAutoScalingConfigurationResourceCrud) Update() error {
+func (s *AutoscalingAutoScalingConfigurationResourceCrud) Update(ctx context.Context) error {
 	request := oci_autoscaling.UpdateAutoScalingConfigurationRequest{}
 
 	tmp := s.D.Id()
@@ -433,7 +433,7 @@ func (s *AutoscalingAutoScalingConfigurationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "autoscaling")
 
-	response, err := s.Client.UpdateAutoScalingConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateAutoScalingConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -442,7 +442,7 @@ func (s *AutoscalingAutoScalingConfigurationResourceCrud) Update() error {
 	return nil
 }
 
-func (s *AutoscalingAutoScalingConfigurationResourceCrud) Delete() error {
+func (s *AutoscalingAutoScalingConfigurationResourceCrud) Delete(ctx context.Context) error {
 	request := oci_autoscaling.DeleteAutoScalingConfigurationRequest{}
 
 	tmp := s.D.Id()
@@ -450,7 +450,7 @@ func (s *AutoscalingAutoScalingConfigurationResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "autoscaling")
 
-	_, err := s.Client.DeleteAutoScalingConfiguration(context.Background(), request)
+	_, err := s.Client.DeleteAutoScalingConfiguration(ctx, request)
 	return err
 }
 
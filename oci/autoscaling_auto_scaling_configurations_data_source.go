@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_autoscaling "github.com/oracle/oci-go-sdk/autoscaling"
 )
 
@@ -13,7 +14,8 @@ func AutoscalingAutoScalingConfigurationsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readAutoscalingAutoScalingConfigurations,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -27,6 +29,22 @@ func AutoscalingAutoScalingConfigurationsDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(AutoscalingAutoScalingConfigurationResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_autoscaling.ListAutoScalingConfigurationsSortByTimecreated),
+					string(oci_autoscaling.ListAutoScalingConfigurationsSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_autoscaling.ListAutoScalingConfigurationsSortOrderAsc),
+					string(oci_autoscaling.ListAutoScalingConfigurationsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -36,7 +54,7 @@ func readAutoscalingAutoScalingConfigurations(d *schema.ResourceData, m interfac
 	sync.D = d
 	sync.Client = m.(*OracleClients).autoScalingClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type AutoscalingAutoScalingConfigurationsDataSourceCrud struct {
@@ -49,7 +67,7 @@ func (s *AutoscalingAutoScalingConfigurationsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *AutoscalingAutoScalingConfigurationsDataSourceCrud) Get() error {
+func (s *AutoscalingAutoScalingConfigurationsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_autoscaling.ListAutoScalingConfigurationsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -62,9 +80,18 @@ func (s *AutoscalingAutoScalingConfigurationsDataSourceCrud) Get() error {
 		request.DisplayName = &tmp
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_autoscaling.ListAutoScalingConfigurationsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_autoscaling.ListAutoScalingConfigurationsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "autoscaling")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListAutoScalingConfigurations(context.Background(), request)
+	response, err := s.Client.ListAutoScalingConfigurations(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -72,8 +99,8 @@ func (s *AutoscalingAutoScalingConfigurationsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListAutoScalingConfigurations(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListAutoScalingConfigurations(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -135,6 +162,8 @@ func (s *AutoscalingAutoScalingConfigurationsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, AutoscalingAutoScalingConfigurationsDataSource().Schema["auto_scaling_configurations"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("auto_scaling_configurations", resources); err != nil {
 		return err
 	}
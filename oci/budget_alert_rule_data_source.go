@@ -85,7 +85,7 @@ func readSingularBudgetAlertRule(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).budgetClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type BudgetAlertRuleDataSourceCrud struct {
@@ -98,7 +98,7 @@ func (s *BudgetAlertRuleDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *BudgetAlertRuleDataSourceCrud) Get() error {
+func (s *BudgetAlertRuleDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_budget.GetAlertRuleRequest{}
 
 	if alertRuleId, ok := s.D.GetOkExists("alert_rule_id"); ok {
@@ -113,7 +113,7 @@ func (s *BudgetAlertRuleDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "budget")
 
-	response, err := s.Client.GetAlertRule(context.Background(), request)
+	response, err := s.Client.GetAlertRule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -101,7 +101,7 @@ func createBudgetAlertRule(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).budgetClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readBudgetAlertRule(d *schema.ResourceData, m interface{}) error {
@@ -109,7 +109,7 @@ func readBudgetAlertRule(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).budgetClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateBudgetAlertRule(d *schema.ResourceData, m interface{}) error {
@@ -117,7 +117,7 @@ func updateBudgetAlertRule(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).budgetClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteBudgetAlertRule(d *schema.ResourceData, m interface{}) error {
@@ -126,7 +126,7 @@ func deleteBudgetAlertRule(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).budgetClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type BudgetAlertRuleResourceCrud struct {
@@ -158,7 +158,7 @@ func (s *BudgetAlertRuleResourceCrud) DeletedTarget() []string {
 	return []string{}
 }
 
-func (s *BudgetAlertRuleResourceCrud) Create() error {
+func (s *BudgetAlertRuleResourceCrud) Create(ctx context.Context) error {
 	request := oci_budget.CreateAlertRuleRequest{}
 
 	if budgetId, ok := s.D.GetOkExists("budget_id"); ok {
@@ -213,7 +213,7 @@ func (s *BudgetAlertRuleResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "budget")
 
-	response, err := s.Client.CreateAlertRule(context.Background(), request)
+	response, err := s.Client.CreateAlertRule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -222,7 +222,7 @@ func (s *BudgetAlertRuleResourceCrud) Create() error {
 	return nil
 }
 
-func (s *BudgetAlertRuleResourceCrud) Get() error {
+func (s *BudgetAlertRuleResourceCrud) Get(ctx context.Context) error {
 	request := oci_budget.GetAlertRuleRequest{}
 
 	tmp := s.D.Id()
@@ -235,7 +235,7 @@ func (s *BudgetAlertRuleResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "budget")
 
-	response, err := s.Client.GetAlertRule(context.Background(), request)
+	response, err := s.Client.GetAlertRule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -244,7 +244,7 @@ func (s *BudgetAlertRuleResourceCrud) Get() error {
 	return nil
 }
 
-func (s *BudgetAlertRuleResourceCrud) Update() error {
+func (s *BudgetAlertRuleResourceCrud) Update(ctx context.Context) error {
 	request := oci_budget.UpdateAlertRuleRequest{}
 
 	tmp := s.D.Id()
@@ -302,7 +302,7 @@ func (s *BudgetAlertRuleResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "budget")
 
-	response, err := s.Client.UpdateAlertRule(context.Background(), request)
+	response, err := s.Client.UpdateAlertRule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -311,7 +311,7 @@ func (s *BudgetAlertRuleResourceCrud) Update() error {
 	return nil
 }
 
-func (s *BudgetAlertRuleResourceCrud) Delete() error {
+func (s *BudgetAlertRuleResourceCrud) Delete(ctx context.Context) error {
 	request := oci_budget.DeleteAlertRuleRequest{}
 
 	tmp := s.D.Id()
@@ -324,7 +324,7 @@ func (s *BudgetAlertRuleResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "budget")
 
-	_, err := s.Client.DeleteAlertRule(context.Background(), request)
+	_, err := s.Client.DeleteAlertRule(ctx, request)
 	return err
 }
 
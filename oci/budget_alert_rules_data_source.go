@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_budget "github.com/oracle/oci-go-sdk/budget"
 )
 
@@ -13,7 +14,8 @@ func BudgetAlertRulesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readBudgetAlertRules,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"budget_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -31,6 +33,14 @@ func BudgetAlertRulesDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(BudgetAlertRuleResource()),
 			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_budget.ListAlertRulesSortOrderAsc),
+					string(oci_budget.ListAlertRulesSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -40,7 +50,7 @@ func readBudgetAlertRules(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).budgetClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type BudgetAlertRulesDataSourceCrud struct {
@@ -53,7 +63,7 @@ func (s *BudgetAlertRulesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *BudgetAlertRulesDataSourceCrud) Get() error {
+func (s *BudgetAlertRulesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_budget.ListAlertRulesRequest{}
 
 	if budgetId, ok := s.D.GetOkExists("budget_id"); ok {
@@ -71,9 +81,14 @@ func (s *BudgetAlertRulesDataSourceCrud) Get() error {
 		request.LifecycleState = &tmp
 	}
 
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_budget.ListAlertRulesSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "budget")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListAlertRules(context.Background(), request)
+	response, err := s.Client.ListAlertRules(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -81,8 +96,8 @@ func (s *BudgetAlertRulesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListAlertRules(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListAlertRules(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -162,6 +177,8 @@ func (s *BudgetAlertRulesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, BudgetAlertRulesDataSource().Schema["alert_rules"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("alert_rules", resources); err != nil {
 		return err
 	}
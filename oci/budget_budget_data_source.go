@@ -93,7 +93,7 @@ func readSingularBudgetBudget(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).budgetClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type BudgetBudgetDataSourceCrud struct {
@@ -106,7 +106,7 @@ func (s *BudgetBudgetDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *BudgetBudgetDataSourceCrud) Get() error {
+func (s *BudgetBudgetDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_budget.GetBudgetRequest{}
 
 	if budgetId, ok := s.D.GetOkExists("budget_id"); ok {
@@ -116,7 +116,7 @@ func (s *BudgetBudgetDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "budget")
 
-	response, err := s.Client.GetBudget(context.Background(), request)
+	response, err := s.Client.GetBudget(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -108,7 +108,7 @@ func createBudgetBudget(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).budgetClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readBudgetBudget(d *schema.ResourceData, m interface{}) error {
@@ -116,7 +116,7 @@ func readBudgetBudget(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).budgetClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateBudgetBudget(d *schema.ResourceData, m interface{}) error {
@@ -124,7 +124,7 @@ func updateBudgetBudget(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).budgetClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteBudgetBudget(d *schema.ResourceData, m interface{}) error {
@@ -133,7 +133,7 @@ func deleteBudgetBudget(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).budgetClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type BudgetBudgetResourceCrud struct {
@@ -165,7 +165,7 @@ func (s *BudgetBudgetResourceCrud) DeletedTarget() []string {
 	return []string{}
 }
 
-func (s *BudgetBudgetResourceCrud) Create() error {
+func (s *BudgetBudgetResourceCrud) Create(ctx context.Context) error {
 	request := oci_budget.CreateBudgetRequest{}
 
 	if amount, ok := s.D.GetOkExists("amount"); ok {
@@ -211,7 +211,7 @@ func (s *BudgetBudgetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "budget")
 
-	response, err := s.Client.CreateBudget(context.Background(), request)
+	response, err := s.Client.CreateBudget(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -220,7 +220,7 @@ func (s *BudgetBudgetResourceCrud) Create() error {
 	return nil
 }
 
-func (s *BudgetBudgetResourceCrud) Get() error {
+func (s *BudgetBudgetResourceCrud) Get(ctx context.Context) error {
 	request := oci_budget.GetBudgetRequest{}
 
 	tmp := s.D.Id()
@@ -228,7 +228,7 @@ func (s *BudgetBudgetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "budget")
 
-	response, err := s.Client.GetBudget(context.Background(), request)
+	response, err := s.Client.GetBudget(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -237,7 +237,7 @@ func (s *BudgetBudgetResourceCrud) Get() error {
 	return nil
 }
 
-func (s *BudgetBudgetResourceCrud) Update() error {
+func (s *BudgetBudgetResourceCrud) Update(ctx context.Context) error {
 	request := oci_budget.UpdateBudgetRequest{}
 
 	if amount, ok := s.D.GetOkExists("amount"); ok {
@@ -276,7 +276,7 @@ func (s *BudgetBudgetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "budget")
 
-	response, err := s.Client.UpdateBudget(context.Background(), request)
+	response, err := s.Client.UpdateBudget(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -285,7 +285,7 @@ func (s *BudgetBudgetResourceCrud) Update() error {
 	return nil
 }
 
-func (s *BudgetBudgetResourceCrud) Delete() error {
+func (s *BudgetBudgetResourceCrud) Delete(ctx context.Context) error {
 	request := oci_budget.DeleteBudgetRequest{}
 
 	tmp := s.D.Id()
@@ -293,7 +293,7 @@ func (s *BudgetBudgetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "budget")
 
-	_, err := s.Client.DeleteBudget(context.Background(), request)
+	_, err := s.Client.DeleteBudget(ctx, request)
 	return err
 }
 
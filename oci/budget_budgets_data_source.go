@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_budget "github.com/oracle/oci-go-sdk/budget"
 )
 
@@ -13,7 +14,8 @@ func BudgetBudgetsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readBudgetBudgets,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -31,6 +33,14 @@ func BudgetBudgetsDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(BudgetBudgetResource()),
 			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_budget.ListBudgetsSortOrderAsc),
+					string(oci_budget.ListBudgetsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -40,7 +50,7 @@ func readBudgetBudgets(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).budgetClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type BudgetBudgetsDataSourceCrud struct {
@@ -53,7 +63,7 @@ func (s *BudgetBudgetsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *BudgetBudgetsDataSourceCrud) Get() error {
+func (s *BudgetBudgetsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_budget.ListBudgetsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -71,9 +81,14 @@ func (s *BudgetBudgetsDataSourceCrud) Get() error {
 		request.LifecycleState = &tmp
 	}
 
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_budget.ListBudgetsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "budget")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListBudgets(context.Background(), request)
+	response, err := s.Client.ListBudgets(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -81,8 +96,8 @@ func (s *BudgetBudgetsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListBudgets(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListBudgets(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -172,6 +187,8 @@ func (s *BudgetBudgetsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, BudgetBudgetsDataSource().Schema["budgets"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("budgets", resources); err != nil {
 		return err
 	}
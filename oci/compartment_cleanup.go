@@ -0,0 +1,266 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	CleanupCommandName        = "cleanup"
+	defaultCleanupParallelism = 4
+)
+
+// Types that must be deleted first, in addition to the ones of the DependencyGraph. Volumes can only be deleted once
+// they are detached, which happens when their instance is terminated.
+var cleanupExtraDependents = map[string][]string{
+	"CoreVolume": {"CoreInstance"},
+}
+
+// cleanupItem is a resource deleted by the cleanup command. The default route tables of the VCNs can not be deleted,
+// but their rules are removed so that the gateways they reference can be deleted before the VCNs.
+type cleanupItem struct {
+	compartmentResource
+}
+
+func (i cleanupItem) String() string {
+	action := "delete"
+	if i.defaultResourceType != "" {
+		action = "reset"
+	}
+	return fmt.Sprintf("%-6s %-24s %s (%s)", action, i.resourceType.name, i.id, i.displayName)
+}
+
+// cleanupOptions select the resources deleted by the cleanup command
+type cleanupOptions struct {
+	compartmentResourceFilter
+	parallelism int
+	dryRun      bool
+	autoApprove bool
+}
+
+// RunCleanupCommand runs the cleanup subcommand of the provider binary, which deletes the resources left over in a
+// compartment, e.g. by failed test pipelines. The resources are listed first, and only deleted once confirmed. They
+// are deleted in the order of the DependencyGraph, with the resources that do not depend on each other deleted in
+// parallel. The provider is configured with the same environment variables and config file as in terraform.
+func RunCleanupCommand(args []string, stdin io.Reader, stdout io.Writer) int {
+	flags := flag.NewFlagSet(CleanupCommandName, flag.ContinueOnError)
+	flags.SetOutput(stdout)
+	resourceFlags := addCompartmentResourceFlags(flags, "delete")
+	dryRun := flags.Bool("dry-run", false, "only list the resources that would be deleted")
+	autoApprove := flags.Bool("auto-approve", false, "delete the resources without asking for confirmation")
+	parallelism := flags.Int("parallelism", defaultCleanupParallelism, "number of resources deleted in parallel")
+	flags.Usage = func() {
+		fmt.Fprintf(stdout, "Usage: terraform-provider-oci %s -compartment-id <ocid> [options]\n\n", CleanupCommandName)
+		fmt.Fprintf(stdout, "Deletes the resources left over in a compartment. Supported types: %s\n\n", strings.Join(compartmentResourceTypeNames(), ", "))
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	filter, err := resourceFlags.filter()
+	if err != nil {
+		fmt.Fprintln(stdout, err)
+		flags.Usage()
+		return 2
+	}
+	options, err := newCleanupOptions(filter, *parallelism)
+	if err != nil {
+		fmt.Fprintln(stdout, err)
+		return 2
+	}
+	options.dryRun = *dryRun
+	options.autoApprove = *autoApprove
+
+	clients, err := resourceFlags.clients()
+	if err != nil {
+		fmt.Fprintln(stdout, err)
+		return 1
+	}
+	return cleanupCompartment(clients, options, stdin, stdout)
+}
+
+func newCleanupOptions(filter *compartmentResourceFilter, parallelism int) (*cleanupOptions, error) {
+	if parallelism < 1 {
+		return nil, fmt.Errorf("-parallelism must be at least 1")
+	}
+	return &cleanupOptions{compartmentResourceFilter: *filter, parallelism: parallelism}, nil
+}
+
+// cleanupCompartment lists the resources selected by the options, and deletes them once confirmed
+func cleanupCompartment(clients *OracleClients, options *cleanupOptions, stdin io.Reader, stdout io.Writer) int {
+	items, err := listCleanupItems(clients, options)
+	if err != nil {
+		fmt.Fprintf(stdout, "could not list the resources of compartment %s: %v\n", options.compartmentId, err)
+		return 1
+	}
+	if len(items) == 0 {
+		fmt.Fprintf(stdout, "No resources to delete in compartment %s\n", options.compartmentId)
+		return 0
+	}
+
+	fmt.Fprintf(stdout, "%d resources of compartment %s will be cleaned up:\n", len(items), options.compartmentId)
+	for _, item := range items {
+		fmt.Fprintf(stdout, "  %s\n", item)
+	}
+	if options.dryRun {
+		return 0
+	}
+	if !options.autoApprove {
+		fmt.Fprint(stdout, "\nOnly 'yes' will be accepted to delete them: ")
+		answer, _ := bufio.NewReader(stdin).ReadString('\n')
+		if strings.TrimSpace(answer) != "yes" {
+			fmt.Fprintln(stdout, "Cleanup cancelled")
+			return 1
+		}
+	}
+
+	errs := deleteCleanupItems(clients, items, options.parallelism, stdout)
+	if len(errs) > 0 {
+		fmt.Fprintf(stdout, "%d of %d resources could not be cleaned up\n", len(errs), len(items))
+		return 1
+	}
+	fmt.Fprintf(stdout, "%d resources were cleaned up\n", len(items))
+	return 0
+}
+
+// listCleanupItems lists the resources to clean up. The default resources of the VCNs are deleted with the VCNs, except
+// for the default route tables, which are reset first.
+func listCleanupItems(clients *OracleClients, options *cleanupOptions) ([]cleanupItem, error) {
+	resources, err := listCompartmentResources(clients, &options.compartmentResourceFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []cleanupItem
+	for _, resource := range resources {
+		if resource.defaultResourceType != "" && resource.defaultResourceType != "oci_core_default_route_table" {
+			continue
+		}
+		items = append(items, cleanupItem{resource})
+	}
+	return items, nil
+}
+
+// cleanupLevels groups the resource types in the order they are deleted. The types of a level only depend on the types
+// of the previous levels, so their resources can be deleted in parallel.
+func cleanupLevels(types []*compartmentResourceType) ([][]*compartmentResourceType, error) {
+	included := map[string]*compartmentResourceType{}
+	for _, resourceType := range types {
+		included[resourceType.name] = resourceType
+	}
+
+	levels := map[string]int{}
+	visiting := map[string]bool{}
+	var levelOf func(resourceType *compartmentResourceType) (int, error)
+	levelOf = func(resourceType *compartmentResourceType) (int, error) {
+		if level, ok := levels[resourceType.name]; ok {
+			return level, nil
+		}
+		if visiting[resourceType.name] {
+			return 0, fmt.Errorf("the deletion of %s depends on itself", resourceType.name)
+		}
+		visiting[resourceType.name] = true
+
+		level := 0
+		for _, dependent := range append(append([]string{}, getDependencyGraphDependents(resourceType.name)...), cleanupExtraDependents[resourceType.name]...) {
+			if dependentType, ok := included[dependent]; ok && dependent != resourceType.name {
+				dependentLevel, err := levelOf(dependentType)
+				if err != nil {
+					return 0, err
+				}
+				if dependentLevel+1 > level {
+					level = dependentLevel + 1
+				}
+			}
+		}
+		levels[resourceType.name] = level
+		return level, nil
+	}
+
+	var result [][]*compartmentResourceType
+	for _, resourceType := range types {
+		level, err := levelOf(resourceType)
+		if err != nil {
+			return nil, err
+		}
+		for len(result) <= level {
+			result = append(result, nil)
+		}
+		result[level] = append(result[level], resourceType)
+	}
+	return result, nil
+}
+
+// deleteCleanupItems deletes the resources level by level, and returns the errors of the ones that could not be deleted.
+// The resources of a level are deleted even if some resources of the previous levels could not be.
+func deleteCleanupItems(clients *OracleClients, items []cleanupItem, parallelism int, stdout io.Writer) []error {
+	itemsByType := map[string][]cleanupItem{}
+	var types []*compartmentResourceType
+	for _, item := range items {
+		if _, ok := itemsByType[item.resourceType.name]; !ok {
+			types = append(types, item.resourceType)
+		}
+		itemsByType[item.resourceType.name] = append(itemsByType[item.resourceType.name], item)
+	}
+
+	levels, err := cleanupLevels(types)
+	if err != nil {
+		return []error{err}
+	}
+
+	resources := resourcesMap()
+	var errs []error
+	var mutex sync.Mutex
+	for _, level := range levels {
+		var wait sync.WaitGroup
+		semaphore := make(chan bool, parallelism)
+		for _, resourceType := range level {
+			for _, item := range itemsByType[resourceType.name] {
+				wait.Add(1)
+				semaphore <- true
+				go func(item cleanupItem) {
+					defer func() {
+						<-semaphore
+						wait.Done()
+					}()
+
+					err := deleteCleanupItem(clients, resources, item)
+
+					mutex.Lock()
+					defer mutex.Unlock()
+					if err != nil {
+						errs = append(errs, err)
+						fmt.Fprintf(stdout, "Failed: %s: %v\n", item, err)
+						return
+					}
+					fmt.Fprintf(stdout, "Done:   %s\n", item)
+				}(item)
+			}
+		}
+		wait.Wait()
+	}
+	return errs
+}
+
+// deleteCleanupItem deletes a resource with the Delete of its terraform resource, which waits for it to be terminated
+func deleteCleanupItem(clients *OracleClients, resources map[string]*schema.Resource, item cleanupItem) error {
+	resource := resources[item.terraformType()]
+	d := resource.Data(nil)
+	d.SetId(item.id)
+	if item.defaultResourceType != "" {
+		d.Set("manage_default_resource_id", item.id)
+	}
+	if err := resource.Delete(d, clients); err != nil {
+		return fmt.Errorf("could not delete %s %s: %v", item.resourceType.name, item.id, err)
+	}
+	return nil
+}
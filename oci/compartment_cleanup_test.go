@@ -0,0 +1,299 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_core "github.com/oracle/oci-go-sdk/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func createCleanupTestVcn(t *testing.T, client *oci_core.VirtualNetworkClient, name string, cidrBlock string, tags map[string]string) oci_core.Vcn {
+	response, err := client.CreateVcn(context.Background(), oci_core.CreateVcnRequest{CreateVcnDetails: oci_core.CreateVcnDetails{
+		CidrBlock:     oci_common.String(cidrBlock),
+		CompartmentId: oci_common.String(testTenancyOCID),
+		DisplayName:   oci_common.String(name),
+		FreeformTags:  tags,
+	}})
+	assert.NoError(t, err)
+	return response.Vcn
+}
+
+func TestCleanupCompartment_basic(t *testing.T) {
+	clients := newFakeVirtualNetworkClients(t, newFakeVirtualNetwork(), "")
+	client := clients.virtualNetworkClient
+	ctx := context.Background()
+
+	vcn := createCleanupTestVcn(t, client, "ci-vcn", "10.0.0.0/16", map[string]string{"pipeline": "ci"})
+	keptVcn := createCleanupTestVcn(t, client, "kept-vcn", "10.1.0.0/16", nil)
+
+	internetGateway, err := client.CreateInternetGateway(ctx, oci_core.CreateInternetGatewayRequest{CreateInternetGatewayDetails: oci_core.CreateInternetGatewayDetails{
+		CompartmentId: oci_common.String(testTenancyOCID),
+		VcnId:         vcn.Id,
+		IsEnabled:     oci_common.Bool(true),
+	}})
+	assert.NoError(t, err)
+	natGateway, err := client.CreateNatGateway(ctx, oci_core.CreateNatGatewayRequest{CreateNatGatewayDetails: oci_core.CreateNatGatewayDetails{
+		CompartmentId: oci_common.String(testTenancyOCID),
+		VcnId:         vcn.Id,
+	}})
+	assert.NoError(t, err)
+	_, err = client.UpdateRouteTable(ctx, oci_core.UpdateRouteTableRequest{RtId: vcn.DefaultRouteTableId, UpdateRouteTableDetails: oci_core.UpdateRouteTableDetails{
+		RouteRules: []oci_core.RouteRule{{Destination: oci_common.String("0.0.0.0/0"), NetworkEntityId: internetGateway.Id}},
+	}})
+	assert.NoError(t, err)
+	routeTable, err := client.CreateRouteTable(ctx, oci_core.CreateRouteTableRequest{CreateRouteTableDetails: oci_core.CreateRouteTableDetails{
+		CompartmentId: oci_common.String(testTenancyOCID),
+		VcnId:         vcn.Id,
+		RouteRules:    []oci_core.RouteRule{{Destination: oci_common.String("0.0.0.0/0"), NetworkEntityId: natGateway.Id}},
+	}})
+	assert.NoError(t, err)
+	subnet, err := client.CreateSubnet(ctx, oci_core.CreateSubnetRequest{CreateSubnetDetails: oci_core.CreateSubnetDetails{
+		CidrBlock:     oci_common.String("10.0.1.0/24"),
+		CompartmentId: oci_common.String(testTenancyOCID),
+		VcnId:         vcn.Id,
+		RouteTableId:  routeTable.Id,
+	}})
+	assert.NoError(t, err)
+
+	networkTypes := "CoreVcn,CoreSubnet,CoreRouteTable,CoreSecurityList,CoreDhcpOptions,CoreInternetGateway,CoreNatGateway"
+	options := newTestCleanupOptions(t, "", tagFiltersFlag{"pipeline": "ci"}, networkTypes)
+	options.dryRun = true
+
+	// The resources of the VCN are listed whether they match the filters or not
+	var output bytes.Buffer
+	assert.Equal(t, 0, cleanupCompartment(clients, options, nil, &output))
+	for _, id := range []*string{vcn.Id, internetGateway.Id, natGateway.Id, routeTable.Id, subnet.Id} {
+		assert.Equal(t, "delete", cleanupTestAction(output.String(), *id), *id)
+	}
+	assert.Equal(t, "reset", cleanupTestAction(output.String(), *vcn.DefaultRouteTableId))
+	assert.NotContains(t, output.String(), *vcn.DefaultSecurityListId, "the other default resources are deleted with the VCN")
+	assert.NotContains(t, output.String(), *keptVcn.Id)
+
+	// Nothing is deleted unless confirmed
+	options.dryRun = false
+	output.Reset()
+	assert.Equal(t, 1, cleanupCompartment(clients, options, strings.NewReader("no\n"), &output))
+	assert.Contains(t, output.String(), "Cleanup cancelled")
+
+	output.Reset()
+	assert.Equal(t, 0, cleanupCompartment(clients, options, strings.NewReader("yes\n"), &output), output.String())
+	assert.Contains(t, output.String(), "6 resources were cleaned up")
+
+	deletedVcn, err := client.GetVcn(ctx, oci_core.GetVcnRequest{VcnId: vcn.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, "TERMINATED", string(deletedVcn.LifecycleState))
+	deletedGateway, err := client.GetNatGateway(ctx, oci_core.GetNatGatewayRequest{NatGatewayId: natGateway.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, "TERMINATED", string(deletedGateway.LifecycleState))
+	kept, err := client.GetVcn(ctx, oci_core.GetVcnRequest{VcnId: keptVcn.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, "AVAILABLE", string(kept.LifecycleState))
+
+	// Resources that are terminated are not listed again
+	output.Reset()
+	assert.Equal(t, 0, cleanupCompartment(clients, options, nil, &output))
+	assert.Contains(t, output.String(), "No resources to delete")
+
+	// The name filter selects the other VCN
+	options = newTestCleanupOptions(t, "^kept-", tagFiltersFlag{}, networkTypes)
+	options.autoApprove = true
+	output.Reset()
+	assert.Equal(t, 0, cleanupCompartment(clients, options, nil, &output), output.String())
+	assert.Contains(t, output.String(), *keptVcn.Id)
+}
+
+// cleanupTestCompute lists the instances, VNIC attachments and VNICs added by a test, and sends the other requests to
+// the fake VirtualNetwork
+type cleanupTestCompute struct {
+	network     *fakeVirtualNetwork
+	instances   []oci_core.Instance
+	attachments []oci_core.VnicAttachment
+	vnics       map[string]oci_core.Vnic
+}
+
+// launch adds an instance whose primary VNIC is in a subnet
+func (c *cleanupTestCompute) launch(name string, subnetId *string) oci_core.Instance {
+	instance := oci_core.Instance{
+		AvailabilityDomain: oci_common.String("ad1"),
+		CompartmentId:      oci_common.String(testTenancyOCID),
+		DisplayName:        oci_common.String(name),
+		Id:                 oci_common.String(fmt.Sprintf("ocid1.instance.oc1..%d", len(c.instances))),
+		LifecycleState:     oci_core.InstanceLifecycleStateRunning,
+		Shape:              oci_common.String("VM.Standard2.1"),
+	}
+	c.instances = append(c.instances, instance)
+	c.attach(instance.Id, subnetId, true)
+	return instance
+}
+
+// attach adds the attachment of a VNIC in a subnet to an instance
+func (c *cleanupTestCompute) attach(instanceId *string, subnetId *string, primary bool) oci_core.VnicAttachment {
+	vnic := oci_core.Vnic{
+		Id:        oci_common.String(fmt.Sprintf("ocid1.vnic.oc1..%d", len(c.vnics))),
+		IsPrimary: oci_common.Bool(primary),
+		SubnetId:  subnetId,
+	}
+	c.vnics[*vnic.Id] = vnic
+	attachment := oci_core.VnicAttachment{
+		AvailabilityDomain: oci_common.String("ad1"),
+		CompartmentId:      oci_common.String(testTenancyOCID),
+		Id:                 oci_common.String(fmt.Sprintf("ocid1.vnicattachment.oc1..%d", len(c.attachments))),
+		InstanceId:         instanceId,
+		LifecycleState:     oci_core.VnicAttachmentLifecycleStateAttached,
+		SubnetId:           subnetId,
+		VnicId:             vnic.Id,
+	}
+	c.attachments = append(c.attachments, attachment)
+	return attachment
+}
+
+func (c *cleanupTestCompute) Do(request *http.Request) (*http.Response, error) {
+	var items interface{}
+	path := strings.Split(strings.Trim(request.URL.Path, "/"), "/")
+	switch path[1] {
+	case "instances":
+		items = c.instances
+	case "vnicAttachments":
+		items = c.attachments
+	case "vnics":
+		items = c.vnics[path[2]]
+	default:
+		return c.network.Do(request)
+	}
+	body, err := json.Marshal(items)
+	if err != nil {
+		return nil, err
+	}
+	return newHttpResponse(request, http.StatusOK, http.Header{"Content-Type": {"application/json"}}, body), nil
+}
+
+func TestCleanupCompartment_instances(t *testing.T) {
+	compute := &cleanupTestCompute{network: newFakeVirtualNetwork(), vnics: map[string]oci_core.Vnic{}}
+	clients := newFakeVirtualNetworkClients(t, compute, "")
+	computeClient, err := oci_core.NewComputeClientWithConfigurationProvider(*clients.virtualNetworkClient.ConfigurationProvider())
+	assert.NoError(t, err)
+	computeClient.HTTPClient = compute
+	clients.computeClient = &computeClient
+	client := clients.virtualNetworkClient
+	ctx := context.Background()
+
+	vcn := createCleanupTestVcn(t, client, "ci-vcn", "10.0.0.0/16", map[string]string{"pipeline": "ci"})
+	keptVcn := createCleanupTestVcn(t, client, "kept-vcn", "10.1.0.0/16", nil)
+	var subnetIds []*string
+	for _, subnetVcn := range []oci_core.Vcn{vcn, keptVcn} {
+		subnet, err := client.CreateSubnet(ctx, oci_core.CreateSubnetRequest{CreateSubnetDetails: oci_core.CreateSubnetDetails{
+			CidrBlock:     oci_common.String(strings.Replace(*subnetVcn.CidrBlock, ".0.0/16", ".1.0/24", 1)),
+			CompartmentId: oci_common.String(testTenancyOCID),
+			VcnId:         subnetVcn.Id,
+		}})
+		assert.NoError(t, err)
+		subnetIds = append(subnetIds, subnet.Id)
+	}
+
+	// The instances are deleted with the VCN of their primary VNIC, and the secondary VNICs of the other instances are
+	// detached from the VCN
+	instance := compute.launch("instance", subnetIds[0])
+	secondaryAttachment := compute.attach(instance.Id, subnetIds[1], false)
+	keptInstance := compute.launch("kept-instance", subnetIds[1])
+	attachment := compute.attach(keptInstance.Id, subnetIds[0], false)
+
+	options := newTestCleanupOptions(t, "", tagFiltersFlag{"pipeline": "ci"}, "CoreVcn,CoreSubnet,CoreInstance,CoreVnicAttachment")
+	options.dryRun = true
+	var output bytes.Buffer
+	assert.Equal(t, 0, cleanupCompartment(clients, options, nil, &output), output.String())
+	assert.Equal(t, "delete", cleanupTestAction(output.String(), *instance.Id))
+	assert.Equal(t, "delete", cleanupTestAction(output.String(), *attachment.Id))
+	for _, id := range []*string{keptInstance.Id, secondaryAttachment.Id, compute.attachments[0].Id, compute.attachments[2].Id} {
+		assert.NotContains(t, output.String(), *id)
+	}
+
+	// Without a VCN, neither instances nor VNIC attachments are selected by the filter
+	options = newTestCleanupOptions(t, "", tagFiltersFlag{}, "CoreInstance,CoreVnicAttachment")
+	options.dryRun = true
+	output.Reset()
+	assert.Equal(t, 0, cleanupCompartment(clients, options, nil, &output), output.String())
+	assert.Equal(t, "delete", cleanupTestAction(output.String(), *keptInstance.Id))
+	assert.NotContains(t, output.String(), "ocid1.vnicattachment")
+}
+
+func newTestCleanupOptions(t *testing.T, nameRegex string, tags map[string]string, types string) *cleanupOptions {
+	filter, err := newCompartmentResourceFilter(testTenancyOCID, nameRegex, tags, types)
+	assert.NoError(t, err)
+	options, err := newCleanupOptions(filter, 2)
+	assert.NoError(t, err)
+	return options
+}
+
+// cleanupTestAction returns the action listed for a resource by the cleanup command
+func cleanupTestAction(output string, id string) string {
+	for _, line := range strings.Split(output, "\n") {
+		if strings.Contains(line, id) {
+			return strings.Fields(line)[0]
+		}
+	}
+	return ""
+}
+
+func TestCleanupLevels_dependencyOrder(t *testing.T) {
+	var types []*compartmentResourceType
+	for i := range compartmentResourceTypes {
+		types = append(types, &compartmentResourceTypes[i])
+	}
+	levels, err := cleanupLevels(types)
+	assert.NoError(t, err)
+
+	levelOf := map[string]int{}
+	for level, levelTypes := range levels {
+		for _, resourceType := range levelTypes {
+			levelOf[resourceType.name] = level
+		}
+	}
+	assert.Len(t, levelOf, len(compartmentResourceTypes))
+
+	for _, dependency := range [][2]string{
+		{"CoreInstance", "CoreSubnet"},
+		{"CoreInstance", "CoreVolume"},
+		{"CoreVnicAttachment", "CoreSubnet"},
+		{"CoreVolumeBackup", "CoreVolume"},
+		{"CoreSubnet", "CoreRouteTable"},
+		{"CoreSubnet", "CoreSecurityList"},
+		{"CoreSubnet", "CoreDhcpOptions"},
+		{"CoreRouteTable", "CoreInternetGateway"},
+		{"CoreRouteTable", "CoreNatGateway"},
+		{"CoreRouteTable", "CoreServiceGateway"},
+		{"CoreLocalPeeringGateway", "CoreRouteTable"},
+		{"CoreInternetGateway", "CoreVcn"},
+		{"CoreNatGateway", "CoreVcn"},
+	} {
+		assert.True(t, levelOf[dependency[0]] < levelOf[dependency[1]], "%s should be deleted before %s", dependency[0], dependency[1])
+	}
+}
+
+func TestNewCleanupOptions_invalid(t *testing.T) {
+	_, err := newCompartmentResourceFilter(testTenancyOCID, "(", nil, "")
+	assert.Error(t, err)
+	_, err = newCompartmentResourceFilter(testTenancyOCID, "", nil, "CoreVcn,CoreMissing")
+	assert.Error(t, err)
+	filter, err := newCompartmentResourceFilter(testTenancyOCID, "", nil, "")
+	assert.NoError(t, err)
+	_, err = newCleanupOptions(filter, 0)
+	assert.Error(t, err)
+
+	assert.Error(t, tagFiltersFlag{}.Set("pipeline"))
+	tags := tagFiltersFlag{}
+	assert.NoError(t, tags.Set("Operations.CostCenter=42"))
+	assert.Equal(t, "Operations.CostCenter=42", tags.String())
+
+	var output bytes.Buffer
+	assert.Equal(t, 2, RunCleanupCommand([]string{"-dry-run"}, nil, &output))
+	assert.Contains(t, output.String(), "-compartment-id is required")
+}
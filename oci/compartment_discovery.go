@@ -0,0 +1,485 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const (
+	DiscoverCommandName      = "discover"
+	discoveredConfigFileName = "discovered.tf"
+	discoveredStateFileName  = "terraform.tfstate"
+	discoveredProviderName   = "provider.oci"
+)
+
+// undiscoveredResourceTypes returns the terraform types of the provider's resources that are not listed in a
+// compartment, and are therefore never discovered
+func undiscoveredResourceTypes() []string {
+	discovered := map[string]bool{}
+	for _, resourceType := range compartmentResourceTypes {
+		discovered[resourceType.resourceType] = true
+	}
+	for _, defaultResourceType := range vcnDefaultResourceTypes {
+		discovered[defaultResourceType] = true
+	}
+
+	var result []string
+	for resourceType := range resourcesMap() {
+		if !discovered[resourceType] {
+			result = append(result, resourceType)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// discoveredResource is a resource of the compartment, read with its terraform resource
+type discoveredResource struct {
+	compartmentResource
+	// name of the resource in the generated configuration, unique for its terraform type
+	name     string
+	resource *schema.Resource
+	data     *schema.ResourceData
+}
+
+func (r *discoveredResource) address() string {
+	return r.terraformType() + "." + r.name
+}
+
+func (r *discoveredResource) vcnId() string {
+	vcnId, _ := r.attributes["vcn_id"].(string)
+	return vcnId
+}
+
+// RunDiscoverCommand runs the discover subcommand of the provider binary, which brings the resources of an existing
+// compartment under terraform. The resources are listed with the data sources of their types and read with their
+// resources, which are used to write a configuration and a matching state file. Running terraform plan in the output
+// directory should then report no changes.
+func RunDiscoverCommand(args []string, stdout io.Writer) int {
+	flags := flag.NewFlagSet(DiscoverCommandName, flag.ContinueOnError)
+	flags.SetOutput(stdout)
+	resourceFlags := addCompartmentResourceFlags(flags, "discover")
+	outputDir := flags.String("output-dir", ".", "directory where "+discoveredConfigFileName+" and "+discoveredStateFileName+" are written")
+	flags.Usage = func() {
+		fmt.Fprintf(stdout, "Usage: terraform-provider-oci %s -compartment-id <ocid> [options]\n\n", DiscoverCommandName)
+		fmt.Fprintf(stdout, "Writes the configuration and state of the resources of a compartment. Supported types: %s\n\n", strings.Join(compartmentResourceTypeNames(), ", "))
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	filter, err := resourceFlags.filter()
+	if err != nil {
+		fmt.Fprintln(stdout, err)
+		flags.Usage()
+		return 2
+	}
+	clients, err := resourceFlags.clients()
+	if err != nil {
+		fmt.Fprintln(stdout, err)
+		return 1
+	}
+	return discoverCompartment(clients, filter, *outputDir, stdout)
+}
+
+// discoverCompartment reads the resources selected by the filter, and writes their configuration and state to the
+// output directory. An existing state file is never overwritten.
+func discoverCompartment(clients *OracleClients, filter *compartmentResourceFilter, outputDir string, stdout io.Writer) int {
+	configPath := filepath.Join(outputDir, discoveredConfigFileName)
+	statePath := filepath.Join(outputDir, discoveredStateFileName)
+	for _, path := range []string{configPath, statePath} {
+		if _, err := os.Stat(path); err == nil {
+			fmt.Fprintf(stdout, "%s already exists, remove it or choose another -output-dir\n", path)
+			return 1
+		}
+	}
+
+	listed, err := listCompartmentResources(clients, filter)
+	if err != nil {
+		fmt.Fprintf(stdout, "could not list the resources of compartment %s: %v\n", filter.compartmentId, err)
+		return 1
+	}
+
+	resources, errs := readDiscoveredResources(clients, listed)
+	for _, err := range errs {
+		fmt.Fprintf(stdout, "Skipped: %v\n", err)
+	}
+	fmt.Fprintf(stdout, "Not discovered: the resources of the other types, import them with terraform import: %s\n", strings.Join(undiscoveredResourceTypes(), ", "))
+	if len(resources) == 0 {
+		fmt.Fprintf(stdout, "No resources to discover in compartment %s\n", filter.compartmentId)
+		return 0
+	}
+
+	var config bytes.Buffer
+	variables := writeDiscoveredConfig(&config, resources)
+	state, err := discoveredState(resources)
+	if err != nil {
+		fmt.Fprintln(stdout, err)
+		return 1
+	}
+
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		fmt.Fprintln(stdout, err)
+		return 1
+	}
+	if err := writeDiscoveredFiles(configPath, config.Bytes(), statePath, state); err != nil {
+		fmt.Fprintln(stdout, err)
+		return 1
+	}
+
+	for _, resource := range resources {
+		fmt.Fprintf(stdout, "Discovered: %s (%s)\n", resource.address(), resource.id)
+	}
+	fmt.Fprintf(stdout, "%d resources were written to %s and %s\n", len(resources), configPath, statePath)
+	if len(variables) > 0 {
+		fmt.Fprintf(stdout, "The sensitive attributes are read from variables, set them with TF_VAR_%s\n", strings.Join(variables, ", TF_VAR_"))
+	}
+	return 0
+}
+
+// readDiscoveredResources imports the listed resources the way terraform import does, and names them after their
+// display names. The resources that can not be imported are returned as errors.
+func readDiscoveredResources(clients *OracleClients, listed []compartmentResource) ([]*discoveredResource, []error) {
+	resourcesMap := resourcesMap()
+	names := map[string]bool{}
+	var resources []*discoveredResource
+	var errs []error
+	for _, item := range listed {
+		resource := resourcesMap[item.terraformType()]
+		if resource.Importer == nil {
+			errs = append(errs, fmt.Errorf("%s %s can not be imported", item.terraformType(), item.id))
+			continue
+		}
+
+		d := resource.Data(nil)
+		d.SetId(item.id)
+		imported := []*schema.ResourceData{d}
+		if resource.Importer.State != nil {
+			var err error
+			if imported, err = resource.Importer.State(d, clients); err != nil {
+				errs = append(errs, fmt.Errorf("could not import %s %s: %v", item.terraformType(), item.id, err))
+				continue
+			}
+		}
+		if len(imported) != 1 {
+			errs = append(errs, fmt.Errorf("%s %s is imported as %d resources", item.terraformType(), item.id, len(imported)))
+			continue
+		}
+
+		d = imported[0]
+		if err := resource.Read(d, clients); err != nil {
+			errs = append(errs, fmt.Errorf("could not read %s %s: %v", item.terraformType(), item.id, err))
+			continue
+		}
+		if d.Id() == "" {
+			errs = append(errs, fmt.Errorf("%s %s no longer exists", item.terraformType(), item.id))
+			continue
+		}
+		// The attributes with a default are not read, they would be planned as changes if left out of the state
+		for attr, attrSchema := range resource.Schema {
+			if attrSchema.Default != nil {
+				d.Set(attr, d.Get(attr))
+			}
+		}
+
+		discovered := &discoveredResource{compartmentResource: item, resource: resource, data: d}
+		discovered.name = discoveredResourceName(item, names)
+		names[discovered.address()] = true
+		resources = append(resources, discovered)
+	}
+	return resources, errs
+}
+
+var invalidResourceNameCharacters = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// discoveredResourceName derives a valid terraform name from the display name of a resource, e.g. ci_vcn for ci-vcn,
+// with a numeric suffix when it is already used by a resource of the same type
+func discoveredResourceName(resource compartmentResource, names map[string]bool) string {
+	name := resource.displayName
+	if resource.defaultResourceType != "" {
+		name = strings.TrimPrefix(name, "Default ")
+	}
+	name = strings.Trim(invalidResourceNameCharacters.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if name == "" {
+		name = strings.TrimPrefix(resource.terraformType(), "oci_")
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+
+	result := name
+	for i := 2; names[resource.terraformType()+"."+result]; i++ {
+		result = fmt.Sprintf("%s_%d", name, i)
+	}
+	return result
+}
+
+// discoveredState returns the state of the resources, as written by terraform import followed by a refresh
+func discoveredState(resources []*discoveredResource) (*terraform.State, error) {
+	state := terraform.NewState()
+	module := state.RootModule()
+	for _, resource := range resources {
+		instanceState := resource.data.State()
+		if instanceState == nil {
+			return nil, fmt.Errorf("%s has no state", resource.address())
+		}
+		if resource.resource.SchemaVersion > 0 {
+			if instanceState.Meta == nil {
+				instanceState.Meta = map[string]interface{}{}
+			}
+			instanceState.Meta["schema_version"] = strconv.Itoa(resource.resource.SchemaVersion)
+		}
+		module.Resources[resource.address()] = &terraform.ResourceState{
+			Type:     resource.terraformType(),
+			Provider: discoveredProviderName,
+			Primary:  instanceState,
+		}
+	}
+	return state, nil
+}
+
+// writeDiscoveredFiles writes the configuration and the state to temporary files, and renames them once both are written,
+// the configuration first. A failure never leaves a state file without the configuration of its resources.
+func writeDiscoveredFiles(configPath string, config []byte, statePath string, state *terraform.State) error {
+	configTempPath, err := writeDiscoveredTempFile(configPath, func(w io.Writer) error {
+		_, err := w.Write(config)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	defer os.Remove(configTempPath)
+	stateTempPath, err := writeDiscoveredTempFile(statePath, func(w io.Writer) error {
+		return terraform.WriteState(state, w)
+	})
+	if err != nil {
+		return err
+	}
+	defer os.Remove(stateTempPath)
+
+	if err := os.Rename(configTempPath, configPath); err != nil {
+		return fmt.Errorf("could not write %s: %v", configPath, err)
+	}
+	if err := os.Rename(stateTempPath, statePath); err != nil {
+		os.Remove(configPath)
+		return fmt.Errorf("could not write %s: %v", statePath, err)
+	}
+	return nil
+}
+
+// writeDiscoveredTempFile writes a temporary file next to the given path, and returns the path of the temporary file
+func writeDiscoveredTempFile(path string, write func(w io.Writer) error) (string, error) {
+	file, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".")
+	if err != nil {
+		return "", fmt.Errorf("could not write %s: %v", path, err)
+	}
+	if err = file.Chmod(0644); err == nil {
+		err = write(file)
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return "", fmt.Errorf("could not write %s: %v", path, err)
+	}
+	return file.Name(), nil
+}
+
+// writeDiscoveredConfig writes the configuration of the resources, and returns the names of the variables it declares.
+// The attributes that reference other discovered resources are written as interpolations, so that terraform knows the
+// order in which they are created, and the sensitive attributes as references to variables.
+func writeDiscoveredConfig(w io.Writer, resources []*discoveredResource) []string {
+	references := map[string]discoveredReference{}
+	for _, resource := range resources {
+		references[resource.id] = discoveredReference(fmt.Sprintf("${%s.id}", resource.address()))
+	}
+
+	vcnAddresses := map[string]string{}
+	for _, resource := range resources {
+		if resource.terraformType() == "oci_core_vcn" {
+			vcnAddresses[resource.id] = resource.address()
+		}
+	}
+
+	writer := &discoveredConfigWriter{w: w, references: references, variables: map[string]string{}}
+	for i, resource := range resources {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "resource %q %q {\n", resource.terraformType(), resource.name)
+
+		values := map[string]interface{}{}
+		for attr := range resource.resource.Schema {
+			values[attr] = resource.data.Get(attr)
+		}
+		// The default resources are managed through the attributes of their VCN rather than their own OCID
+		if vcnAddress, ok := vcnAddresses[resource.vcnId()]; ok && resource.defaultResourceType != "" {
+			for attr, defaultResourceType := range vcnDefaultResourceTypes {
+				if defaultResourceType == resource.defaultResourceType {
+					values["manage_default_resource_id"] = discoveredReference(fmt.Sprintf("${%s.%s}", vcnAddress, attr))
+				}
+			}
+		}
+
+		writer.self = resource.id
+		writer.address = resource.address()
+		writer.writeBody(resource.resource.Schema, values, 1)
+		fmt.Fprintln(w, "}")
+	}
+
+	for _, name := range writer.variableNames {
+		fmt.Fprintf(w, "\nvariable %q {\n  description = %q\n}\n", name, writer.variables[name])
+	}
+	return writer.variableNames
+}
+
+// discoveredReference is an interpolation written as is, while the interpolations in the string values are escaped
+type discoveredReference string
+
+// discoveredConfigWriter writes the attributes of the resources in the syntax of terraform 0.11
+type discoveredConfigWriter struct {
+	w          io.Writer
+	references map[string]discoveredReference
+	// self is the OCID of the resource being written, which is never replaced by a reference, and address its address
+	self    string
+	address string
+	// variables maps the names of the variables of the sensitive attributes to their descriptions, in the order of
+	// variableNames
+	variables     map[string]string
+	variableNames []string
+}
+
+// writeBody writes the arguments of a resource or nested block, followed by its nested blocks. The computed attributes
+// and the ones left to their default values are not written.
+func (c *discoveredConfigWriter) writeBody(schemaMap map[string]*schema.Schema, values map[string]interface{}, depth int) {
+	var attrs, blocks []string
+	for attr, attrSchema := range schemaMap {
+		if !attrSchema.Required && !attrSchema.Optional || attrSchema.Deprecated != "" || attrSchema.Removed != "" {
+			continue
+		}
+		if !attrSchema.Required && isDefaultDiscoveredValue(attrSchema, values[attr]) {
+			continue
+		}
+		if _, isBlock := attrSchema.Elem.(*schema.Resource); isBlock {
+			blocks = append(blocks, attr)
+		} else {
+			attrs = append(attrs, attr)
+		}
+	}
+	sort.Strings(attrs)
+	sort.Strings(blocks)
+
+	indent := strings.Repeat("  ", depth)
+	for _, attr := range attrs {
+		value := values[attr]
+		if schemaMap[attr].Sensitive {
+			value = c.variable(attr)
+		}
+		fmt.Fprintf(c.w, "%s%s = %s\n", indent, attr, c.formatValue(value))
+	}
+	for _, attr := range blocks {
+		elem := schemaMap[attr].Elem.(*schema.Resource)
+		for _, block := range discoveredList(values[attr]) {
+			blockValues, _ := block.(map[string]interface{})
+			fmt.Fprintf(c.w, "\n%s%s {\n", indent, attr)
+			c.writeBody(elem.Schema, blockValues, depth+1)
+			fmt.Fprintf(c.w, "%s}\n", indent)
+		}
+	}
+}
+
+// variable declares a variable for a sensitive attribute of the resource being written, and returns a reference to it.
+// The values of the sensitive attributes are only written to the state.
+func (c *discoveredConfigWriter) variable(attr string) discoveredReference {
+	name := strings.Replace(strings.TrimPrefix(c.address, "oci_"), ".", "_", -1) + "_" + attr
+	result := name
+	for i := 2; c.variables[result] != ""; i++ {
+		result = fmt.Sprintf("%s_%d", name, i)
+	}
+	c.variables[result] = fmt.Sprintf("%s of %s", attr, c.address)
+	c.variableNames = append(c.variableNames, result)
+	return discoveredReference(fmt.Sprintf("${var.%s}", result))
+}
+
+func (c *discoveredConfigWriter) formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case discoveredReference:
+		return strconv.Quote(string(v))
+	case string:
+		if reference, ok := c.references[v]; ok && v != c.self {
+			return strconv.Quote(string(reference))
+		}
+		return strconv.Quote(strings.Replace(v, "${", "$${", -1))
+	case bool, int, float64:
+		return fmt.Sprint(v)
+	case map[string]interface{}:
+		var keys []string
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		var items []string
+		for _, key := range keys {
+			items = append(items, fmt.Sprintf("%q = %s", key, c.formatValue(v[key])))
+		}
+		return "{" + strings.Join(items, ", ") + "}"
+	case []interface{}, *schema.Set:
+		var items []string
+		for _, item := range discoveredList(v) {
+			items = append(items, c.formatValue(item))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	default:
+		return strconv.Quote(fmt.Sprint(v))
+	}
+}
+
+func discoveredList(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case *schema.Set:
+		return v.List()
+	case []interface{}:
+		return v
+	}
+	return nil
+}
+
+// isDefaultDiscoveredValue returns whether an optional attribute can be left out of the configuration without a diff
+func isDefaultDiscoveredValue(attrSchema *schema.Schema, value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	if attrSchema.Default != nil {
+		return reflect.DeepEqual(value, attrSchema.Default)
+	}
+	switch v := value.(type) {
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return len(discoveredList(value)) == 0
+}
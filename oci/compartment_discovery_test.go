@@ -0,0 +1,203 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hil/ast"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_core "github.com/oracle/oci-go-sdk/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiscoverCompartment_basic(t *testing.T) {
+	clients := newFakeVirtualNetworkClients(t, newFakeVirtualNetwork(), "")
+	client := clients.virtualNetworkClient
+	ctx := context.Background()
+
+	vcn := createCleanupTestVcn(t, client, "prod-vcn", "10.0.0.0/16", map[string]string{"env": "prod"})
+	createCleanupTestVcn(t, client, "other-vcn", "10.1.0.0/16", nil)
+
+	internetGateway, err := client.CreateInternetGateway(ctx, oci_core.CreateInternetGatewayRequest{CreateInternetGatewayDetails: oci_core.CreateInternetGatewayDetails{
+		CompartmentId: oci_common.String(testTenancyOCID),
+		DisplayName:   oci_common.String("gateway"),
+		VcnId:         vcn.Id,
+		IsEnabled:     oci_common.Bool(true),
+	}})
+	assert.NoError(t, err)
+	_, err = client.UpdateRouteTable(ctx, oci_core.UpdateRouteTableRequest{RtId: vcn.DefaultRouteTableId, UpdateRouteTableDetails: oci_core.UpdateRouteTableDetails{
+		RouteRules: []oci_core.RouteRule{{Destination: oci_common.String("0.0.0.0/0"), NetworkEntityId: internetGateway.Id}},
+	}})
+	assert.NoError(t, err)
+	subnet, err := client.CreateSubnet(ctx, oci_core.CreateSubnetRequest{CreateSubnetDetails: oci_core.CreateSubnetDetails{
+		CidrBlock:     oci_common.String("10.0.1.0/24"),
+		CompartmentId: oci_common.String(testTenancyOCID),
+		DisplayName:   oci_common.String("1st subnet"),
+		VcnId:         vcn.Id,
+	}})
+	assert.NoError(t, err)
+
+	outputDir, err := ioutil.TempDir("", "discover")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+
+	filter, err := newCompartmentResourceFilter(testTenancyOCID, "", map[string]string{"env": "prod"}, "CoreVcn,CoreSubnet,CoreRouteTable,CoreSecurityList,CoreDhcpOptions,CoreInternetGateway")
+	assert.NoError(t, err)
+	var output bytes.Buffer
+	if !assert.Equal(t, 0, discoverCompartment(clients, filter, outputDir, &output), output.String()) {
+		return
+	}
+	assert.Contains(t, output.String(), "Not discovered: the resources of the other types, import them with terraform import: ")
+	assert.Contains(t, output.String(), "oci_load_balancer_certificate")
+
+	// Only the configuration and the state are left in the output directory
+	files, err := ioutil.ReadDir(outputDir)
+	assert.NoError(t, err)
+	var fileNames []string
+	for _, file := range files {
+		fileNames = append(fileNames, file.Name())
+	}
+	assert.Equal(t, []string{discoveredConfigFileName, discoveredStateFileName}, fileNames)
+
+	// The configuration references the discovered resources, and leaves out the computed attributes
+	configBytes, err := ioutil.ReadFile(filepath.Join(outputDir, discoveredConfigFileName))
+	assert.NoError(t, err)
+	configText := string(configBytes)
+	assert.Contains(t, configText, `resource "oci_core_vcn" "prod_vcn" {`)
+	assert.Contains(t, configText, `resource "oci_core_subnet" "_1st_subnet" {`)
+	assert.Contains(t, configText, `vcn_id = "${oci_core_vcn.prod_vcn.id}"`)
+	assert.Contains(t, configText, `network_entity_id = "${oci_core_internet_gateway.gateway.id}"`)
+	assert.Contains(t, configText, `manage_default_resource_id = "${oci_core_vcn.prod_vcn.default_route_table_id}"`)
+	assert.Contains(t, configText, `freeform_tags = {"env" = "prod"}`)
+	assert.NotContains(t, configText, "other_vcn")
+	assert.NotContains(t, configText, "time_created")
+
+	cfg, err := config.LoadFile(filepath.Join(outputDir, discoveredConfigFileName))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NoError(t, cfg.Validate().Err())
+	// The VCN, its subnet and gateway, and its 3 default resources
+	assert.Len(t, cfg.Resources, 6)
+
+	stateFile, err := os.Open(filepath.Join(outputDir, discoveredStateFileName))
+	assert.NoError(t, err)
+	defer stateFile.Close()
+	state, err := terraform.ReadState(stateFile)
+	if !assert.NoError(t, err) {
+		return
+	}
+	resources := state.RootModule().Resources
+	assert.Len(t, resources, 6)
+	if assert.Contains(t, resources, "oci_core_subnet._1st_subnet") {
+		assert.Equal(t, *subnet.Id, resources["oci_core_subnet._1st_subnet"].Primary.ID)
+		assert.Equal(t, "10.0.1.0/24", resources["oci_core_subnet._1st_subnet"].Primary.Attributes["cidr_block"])
+	}
+	for address, resource := range resources {
+		assert.Equal(t, "provider.oci", resource.Provider, address)
+	}
+
+	// The state matches the configuration, once its references are interpolated from the state
+	for _, resourceConfig := range cfg.Resources {
+		address := resourceConfig.Type + "." + resourceConfig.Name
+		if !assert.Contains(t, resources, address) {
+			continue
+		}
+		variables := map[string]ast.Variable{}
+		for key, variable := range resourceConfig.RawConfig.Variables {
+			reference := variable.(*config.ResourceVariable)
+			referenced := resources[reference.ResourceId()].Primary
+			value := referenced.Attributes[reference.Field]
+			if reference.Field == "id" {
+				value = referenced.ID
+			}
+			variables[key] = ast.Variable{Type: ast.TypeString, Value: value}
+		}
+		assert.NoError(t, resourceConfig.RawConfig.Interpolate(variables))
+		diff, err := resourcesMap()[resourceConfig.Type].Diff(resources[address].Primary, terraform.NewResourceConfig(resourceConfig.RawConfig), clients)
+		assert.NoError(t, err)
+		assert.True(t, diff.Empty(), "%s has changes: %v", address, diff)
+	}
+
+	// The existing files are never overwritten
+	output.Reset()
+	assert.Equal(t, 1, discoverCompartment(clients, filter, outputDir, &output))
+	assert.Contains(t, output.String(), "already exists")
+}
+
+func TestUndiscoveredResourceTypes(t *testing.T) {
+	undiscovered := undiscoveredResourceTypes()
+	for _, resourceType := range []string{"oci_dns_record", "oci_identity_api_key", "oci_load_balancer_certificate", "oci_ons_subscription"} {
+		assert.Contains(t, undiscovered, resourceType)
+	}
+	for _, resourceType := range []string{"oci_core_vcn", "oci_core_instance", "oci_core_default_route_table"} {
+		assert.NotContains(t, undiscovered, resourceType)
+	}
+}
+
+func TestDiscoveredResourceName_unique(t *testing.T) {
+	vcnType := getCompartmentResourceType("CoreVcn")
+	names := map[string]bool{"oci_core_vcn.my_vcn": true, "oci_core_vcn.my_vcn_2": true}
+	assert.Equal(t, "my_vcn_3", discoveredResourceName(compartmentResource{resourceType: vcnType, displayName: "My VCN"}, names))
+	assert.Equal(t, "core_vcn", discoveredResourceName(compartmentResource{resourceType: vcnType, displayName: "---"}, names))
+
+	routeTableType := getCompartmentResourceType("CoreRouteTable")
+	assert.Equal(t, "my_vcn", discoveredResourceName(compartmentResource{resourceType: routeTableType, displayName: "My VCN"}, names))
+	assert.Equal(t, "route_table_for_my_vcn", discoveredResourceName(compartmentResource{
+		resourceType:        routeTableType,
+		displayName:         "Default Route Table for my-vcn",
+		defaultResourceType: "oci_core_default_route_table",
+	}, names))
+}
+
+func TestWriteDiscoveredConfig_sensitive(t *testing.T) {
+	resource := &schema.Resource{Schema: map[string]*schema.Schema{
+		"name":     {Type: schema.TypeString, Required: true},
+		"password": {Type: schema.TypeString, Required: true, Sensitive: true},
+		"token":    {Type: schema.TypeString, Optional: true, Sensitive: true},
+		"secret":   {Type: schema.TypeString, Optional: true, Sensitive: true},
+	}}
+	var resources []*discoveredResource
+	for _, name := range []string{"first", "second"} {
+		data := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{"name": name, "password": "hunter2", "token": "abc"})
+		data.SetId("ocid1.test.oc1.." + name)
+		resources = append(resources, &discoveredResource{
+			compartmentResource: compartmentResource{resourceType: &compartmentResourceType{resourceType: "oci_test"}, id: data.Id()},
+			name:                name,
+			resource:            resource,
+			data:                data,
+		})
+	}
+
+	// The values of the sensitive attributes are replaced by variables, unless they are left to their default
+	var output bytes.Buffer
+	variables := writeDiscoveredConfig(&output, resources)
+	assert.Equal(t, []string{"test_first_password", "test_first_token", "test_second_password", "test_second_token"}, variables)
+	configText := output.String()
+	assert.NotContains(t, configText, "hunter2")
+	assert.NotContains(t, configText, "abc")
+	assert.NotContains(t, configText, "secret")
+	assert.Contains(t, configText, `password = "${var.test_first_password}"`)
+	assert.Contains(t, configText, `token = "${var.test_second_token}"`)
+	assert.Contains(t, configText, `variable "test_second_password" {`)
+
+	configFile, err := ioutil.TempFile("", "discovered-*.tf")
+	assert.NoError(t, err)
+	defer os.Remove(configFile.Name())
+	configFile.WriteString(configText)
+	configFile.Close()
+	cfg, err := config.LoadFile(configFile.Name())
+	if assert.NoError(t, err) {
+		assert.NoError(t, cfg.Validate().Err())
+		assert.Len(t, cfg.Variables, 4)
+	}
+}
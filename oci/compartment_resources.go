@@ -0,0 +1,373 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"flag"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// compartmentResourceType describes how the subcommands of the provider binary list the resources of a type in a
+// compartment
+type compartmentResourceType struct {
+	// name of the type in the DependencyGraph, e.g. CoreSubnet
+	name string
+	// resourceType is used to read and delete the resources, dataSourceType and listAttr to list them
+	resourceType   string
+	dataSourceType string
+	listAttr       string
+	// The resources of vcnScoped types are listed for each VCN of the compartment
+	vcnScoped bool
+	// The resources of attachedOnly types are only selected with the VCN they attach an instance to, never by the filter
+	attachedOnly bool
+}
+
+// The resource types listed in a compartment. They are listed with the data sources of the provider rather than the test
+// sweepers, which are only compiled into the tests, list the compartment of the acceptance tests with their clients, and
+// ignore display names and tags. The cleanup command deletes them in the order of the DependencyGraph, like the sweepers.
+var compartmentResourceTypes = []compartmentResourceType{
+	{name: "CoreVcn", resourceType: "oci_core_vcn", dataSourceType: "oci_core_vcns", listAttr: "virtual_networks"},
+	{name: "CoreSubnet", resourceType: "oci_core_subnet", dataSourceType: "oci_core_subnets", listAttr: "subnets", vcnScoped: true},
+	{name: "CoreRouteTable", resourceType: "oci_core_route_table", dataSourceType: "oci_core_route_tables", listAttr: "route_tables", vcnScoped: true},
+	{name: "CoreSecurityList", resourceType: "oci_core_security_list", dataSourceType: "oci_core_security_lists", listAttr: "security_lists", vcnScoped: true},
+	{name: "CoreDhcpOptions", resourceType: "oci_core_dhcp_options", dataSourceType: "oci_core_dhcp_options", listAttr: "options", vcnScoped: true},
+	{name: "CoreInternetGateway", resourceType: "oci_core_internet_gateway", dataSourceType: "oci_core_internet_gateways", listAttr: "gateways", vcnScoped: true},
+	{name: "CoreNatGateway", resourceType: "oci_core_nat_gateway", dataSourceType: "oci_core_nat_gateways", listAttr: "nat_gateways", vcnScoped: true},
+	{name: "CoreServiceGateway", resourceType: "oci_core_service_gateway", dataSourceType: "oci_core_service_gateways", listAttr: "service_gateways", vcnScoped: true},
+	{name: "CoreLocalPeeringGateway", resourceType: "oci_core_local_peering_gateway", dataSourceType: "oci_core_local_peering_gateways", listAttr: "local_peering_gateways", vcnScoped: true},
+	{name: "CoreInstance", resourceType: "oci_core_instance", dataSourceType: "oci_core_instances", listAttr: "instances"},
+	{name: "CoreVnicAttachment", resourceType: "oci_core_vnic_attachment", dataSourceType: "oci_core_vnic_attachments", listAttr: "vnic_attachments", attachedOnly: true},
+	{name: "CoreVolume", resourceType: "oci_core_volume", dataSourceType: "oci_core_volumes", listAttr: "volumes"},
+	{name: "CoreVolumeBackup", resourceType: "oci_core_volume_backup", dataSourceType: "oci_core_volume_backups", listAttr: "volume_backups"},
+}
+
+// The default resources of a VCN, by the VCN attribute referencing them
+var vcnDefaultResourceTypes = map[string]string{
+	"default_dhcp_options_id":  "oci_core_default_dhcp_options",
+	"default_route_table_id":   "oci_core_default_route_table",
+	"default_security_list_id": "oci_core_default_security_list",
+}
+
+// Lifecycle states of the resources that are being deleted, which are not listed
+var deletedLifecycleStates = map[string]bool{
+	"DELETED":     true,
+	"DELETING":    true,
+	"DETACHED":    true,
+	"DETACHING":   true,
+	"TERMINATED":  true,
+	"TERMINATING": true,
+}
+
+// compartmentResource is a resource listed in a compartment
+type compartmentResource struct {
+	resourceType *compartmentResourceType
+	id           string
+	displayName  string
+	// defaultResourceType is the terraform type of the default resources of the VCNs, e.g. oci_core_default_route_table
+	defaultResourceType string
+	// attributes of the resource, as listed by the data source of its type
+	attributes map[string]interface{}
+}
+
+// terraformType returns the terraform type used to read and delete the resource
+func (r compartmentResource) terraformType() string {
+	if r.defaultResourceType != "" {
+		return r.defaultResourceType
+	}
+	return r.resourceType.resourceType
+}
+
+// compartmentResourceFilter selects the resources of a compartment by type, display name and tags
+type compartmentResourceFilter struct {
+	compartmentId string
+	nameRegex     *regexp.Regexp
+	tags          map[string]string
+	// types restricts the filter to some of the compartmentResourceTypes, all of them are selected when it is empty
+	types map[string]bool
+}
+
+// tagFiltersFlag collects the repeated -tag key=value flags
+type tagFiltersFlag map[string]string
+
+func (f tagFiltersFlag) String() string {
+	var tags []string
+	for key, value := range f {
+		tags = append(tags, key+"="+value)
+	}
+	sort.Strings(tags)
+	return strings.Join(tags, ",")
+}
+
+func (f tagFiltersFlag) Set(value string) error {
+	parts := strings.SplitN(value, "=", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return fmt.Errorf("tag filters must have the form key=value or namespace.key=value, got %s", value)
+	}
+	f[parts[0]] = parts[1]
+	return nil
+}
+
+// compartmentResourceFlags are the flags shared by the subcommands listing the resources of a compartment
+type compartmentResourceFlags struct {
+	compartmentId *string
+	nameRegex     *string
+	tags          tagFiltersFlag
+	types         *string
+	region        *string
+	profile       *string
+}
+
+func addCompartmentResourceFlags(flags *flag.FlagSet, action string) *compartmentResourceFlags {
+	result := &compartmentResourceFlags{tags: tagFiltersFlag{}}
+	result.compartmentId = flags.String("compartment-id", "", "OCID of the compartment (required)")
+	result.nameRegex = flags.String("name-regex", "", "only "+action+" the resources whose display name matches this regular expression")
+	flags.Var(result.tags, "tag", "only "+action+" the resources with this freeform or defined tag, as key=value or namespace.key=value (repeatable)")
+	result.types = flags.String("types", "", "comma separated types of the resources to "+action+", e.g. CoreVcn,CoreSubnet (default all)")
+	result.region = flags.String("region", "", "region of the resources, overrides the region of the environment or config file")
+	result.profile = flags.String("config-file-profile", "", "profile of the config file used to authenticate")
+	return result
+}
+
+func (f *compartmentResourceFlags) filter() (*compartmentResourceFilter, error) {
+	if *f.compartmentId == "" {
+		return nil, fmt.Errorf("-compartment-id is required")
+	}
+	return newCompartmentResourceFilter(*f.compartmentId, *f.nameRegex, f.tags, *f.types)
+}
+
+// clients configures the provider outside of terraform, applying the defaults of the provider schema, so that it
+// authenticates with the same environment variables and config file as in terraform
+func (f *compartmentResourceFlags) clients() (*OracleClients, error) {
+	providerConfig := map[string]interface{}{}
+	if *f.region != "" {
+		providerConfig[regionAttrName] = *f.region
+	}
+	if *f.profile != "" {
+		providerConfig[configFileProfileAttrName] = *f.profile
+	}
+
+	raw, err := config.NewRawConfig(providerConfig)
+	if err != nil {
+		return nil, err
+	}
+	p := Provider(ProviderConfig).(*schema.Provider)
+	if err := p.Configure(terraform.NewResourceConfig(raw)); err != nil {
+		return nil, fmt.Errorf("could not configure the provider: %v", err)
+	}
+	return p.Meta().(*OracleClients), nil
+}
+
+func newCompartmentResourceFilter(compartmentId string, nameRegex string, tags map[string]string, types string) (*compartmentResourceFilter, error) {
+	filter := &compartmentResourceFilter{compartmentId: compartmentId, tags: tags, types: map[string]bool{}}
+	if nameRegex != "" {
+		var err error
+		if filter.nameRegex, err = regexp.Compile(nameRegex); err != nil {
+			return nil, fmt.Errorf("invalid -name-regex: %v", err)
+		}
+	}
+	for _, name := range strings.Split(types, ",") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+		if getCompartmentResourceType(name) == nil {
+			return nil, fmt.Errorf("unsupported resource type %s, supported types are: %s", name, strings.Join(compartmentResourceTypeNames(), ", "))
+		}
+		filter.types[name] = true
+	}
+	return filter, nil
+}
+
+func getCompartmentResourceType(name string) *compartmentResourceType {
+	for i := range compartmentResourceTypes {
+		if compartmentResourceTypes[i].name == name {
+			return &compartmentResourceTypes[i]
+		}
+	}
+	return nil
+}
+
+func compartmentResourceTypeNames() []string {
+	var names []string
+	for _, resourceType := range compartmentResourceTypes {
+		names = append(names, resourceType.name)
+	}
+	return names
+}
+
+func (f *compartmentResourceFilter) includesType(name string) bool {
+	return len(f.types) == 0 || f.types[name]
+}
+
+// matches returns whether a listed resource matches the name and tag filters
+func (f *compartmentResourceFilter) matches(attributes map[string]interface{}) bool {
+	if f.nameRegex != nil {
+		displayName, _ := attributes["display_name"].(string)
+		if !f.nameRegex.MatchString(displayName) {
+			return false
+		}
+	}
+	for key, value := range f.tags {
+		if !hasTag(attributes, "freeform_tags", key, value) && !hasTag(attributes, "defined_tags", key, value) {
+			return false
+		}
+	}
+	return true
+}
+
+func hasTag(attributes map[string]interface{}, attr string, key string, value string) bool {
+	tags, _ := attributes[attr].(map[string]interface{})
+	tag, ok := tags[key]
+	return ok && fmt.Sprint(tag) == value
+}
+
+// listCompartmentResources lists the resources selected by a filter with the data sources of their types. The resources
+// of the selected VCNs are selected too, whether they match the filter or not, including the default resources of the
+// VCNs, the instances whose primary VNIC is in one of their subnets, and the attachments of the secondary VNICs in their
+// subnets. The VCNs are listed even if their type is not selected, to list the resources of the other types they contain.
+func listCompartmentResources(clients *OracleClients, filter *compartmentResourceFilter) ([]compartmentResource, error) {
+	vcns, err := listDataSourceItems(clients, getCompartmentResourceType("CoreVcn"), filter.compartmentId, "")
+	if err != nil {
+		return nil, err
+	}
+
+	selectedVcnIds := map[string]bool{}
+	defaultResourceTypes := map[string]string{}
+	for _, vcn := range vcns {
+		selected := filter.includesType("CoreVcn") && filter.matches(vcn)
+		if selected {
+			selectedVcnIds[vcn["id"].(string)] = true
+		}
+		for attr, defaultResourceType := range vcnDefaultResourceTypes {
+			if id, ok := vcn[attr].(string); ok {
+				// The default resources of the other VCNs are never selected
+				defaultResourceTypes[id] = ""
+				if selected {
+					defaultResourceTypes[id] = defaultResourceType
+				}
+			}
+		}
+	}
+
+	attachedIds := map[string]bool{}
+	if len(selectedVcnIds) > 0 && (filter.includesType("CoreInstance") || filter.includesType("CoreVnicAttachment")) {
+		if attachedIds, err = listVcnAttachedIds(clients, filter.compartmentId, selectedVcnIds); err != nil {
+			return nil, err
+		}
+	}
+
+	var resources []compartmentResource
+	for i := range compartmentResourceTypes {
+		resourceType := &compartmentResourceTypes[i]
+		if !filter.includesType(resourceType.name) {
+			continue
+		}
+
+		var items []map[string]interface{}
+		if resourceType.name == "CoreVcn" {
+			items = vcns
+		} else if resourceType.vcnScoped {
+			for _, vcn := range vcns {
+				vcnItems, err := listDataSourceItems(clients, resourceType, filter.compartmentId, vcn["id"].(string))
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, vcnItems...)
+			}
+		} else if items, err = listDataSourceItems(clients, resourceType, filter.compartmentId, ""); err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			id, _ := item["id"].(string)
+			displayName, _ := item["display_name"].(string)
+			vcnId, _ := item["vcn_id"].(string)
+			resource := compartmentResource{resourceType: resourceType, id: id, displayName: displayName, attributes: item}
+
+			if defaultResourceType, isDefault := defaultResourceTypes[id]; isDefault {
+				if defaultResourceType == "" {
+					continue
+				}
+				resource.defaultResourceType = defaultResourceType
+			} else if resourceType.attachedOnly && !attachedIds[id] {
+				continue
+			} else if !selectedVcnIds[vcnId] && !attachedIds[id] && !filter.matches(item) {
+				continue
+			}
+			resources = append(resources, resource)
+		}
+	}
+	return resources, nil
+}
+
+// listVcnAttachedIds returns the IDs of the instances whose primary VNIC is in a subnet of the selected VCNs, and of the
+// attachments of the secondary VNICs in these subnets. The primary VNICs can not be detached, they go away with their
+// instance.
+func listVcnAttachedIds(clients *OracleClients, compartmentId string, selectedVcnIds map[string]bool) (map[string]bool, error) {
+	subnetIds := map[string]bool{}
+	for vcnId := range selectedVcnIds {
+		subnets, err := listDataSourceItems(clients, getCompartmentResourceType("CoreSubnet"), compartmentId, vcnId)
+		if err != nil {
+			return nil, err
+		}
+		for _, subnet := range subnets {
+			subnetIds[subnet["id"].(string)] = true
+		}
+	}
+
+	attachments, err := listDataSourceItems(clients, getCompartmentResourceType("CoreVnicAttachment"), compartmentId, "")
+	if err != nil {
+		return nil, err
+	}
+	vnicDataSource := dataSourcesMap()["oci_core_vnic"]
+	attachedIds := map[string]bool{}
+	for _, attachment := range attachments {
+		if subnetId, _ := attachment["subnet_id"].(string); !subnetIds[subnetId] {
+			continue
+		}
+		vnicId, _ := attachment["vnic_id"].(string)
+		vnic := vnicDataSource.Data(nil)
+		vnic.Set("vnic_id", vnicId)
+		if err := vnicDataSource.Read(vnic, clients); err != nil {
+			return nil, fmt.Errorf("could not read the VNIC %s: %v", vnicId, err)
+		}
+		if vnic.Get("is_primary").(bool) {
+			attachedIds[attachment["instance_id"].(string)] = true
+		} else {
+			attachedIds[attachment["id"].(string)] = true
+		}
+	}
+	return attachedIds, nil
+}
+
+// listDataSourceItems reads the data source of a type, and returns the resources that are not being deleted
+func listDataSourceItems(clients *OracleClients, resourceType *compartmentResourceType, compartmentId string, vcnId string) ([]map[string]interface{}, error) {
+	dataSource := dataSourcesMap()[resourceType.dataSourceType]
+	d := dataSource.Data(nil)
+	d.Set("compartment_id", compartmentId)
+	if vcnId != "" {
+		d.Set("vcn_id", vcnId)
+	}
+	if err := dataSource.Read(d, clients); err != nil {
+		return nil, fmt.Errorf("could not list %s: %v", resourceType.name, err)
+	}
+
+	var items []map[string]interface{}
+	for _, listed := range d.Get(resourceType.listAttr).([]interface{}) {
+		item, ok := listed.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if state, _ := item["state"].(string); deletedLifecycleStates[state] {
+			continue
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
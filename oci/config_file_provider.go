@@ -0,0 +1,230 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rsa"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+)
+
+const (
+	defaultConfigFileName    = "config"
+	defaultConfigDirName     = ".oci"
+	defaultConfigFileProfile = "DEFAULT"
+
+	configFileTenancyKey           = "tenancy"
+	configFileUserKey              = "user"
+	configFileFingerprintKey       = "fingerprint"
+	configFileKeyFileKey           = "key_file"
+	configFilePassphraseKey        = "pass_phrase"
+	configFileRegionKey            = "region"
+	configFileSecurityTokenFileKey = "security_token_file"
+)
+
+// getHomeFolder returns the home folder of the user running Terraform, in which the SDK config file is looked up
+func getHomeFolder() string {
+	current, err := user.Current()
+	if err != nil {
+		home := os.Getenv("HOME")
+		if home == "" {
+			home = os.Getenv("USERPROFILE")
+		}
+		return home
+	}
+	return current.HomeDir
+}
+
+func getDefaultConfigFilePath() string {
+	return filepath.Join(getHomeFolder(), defaultConfigDirName, defaultConfigFileName)
+}
+
+// expandPath replaces a leading ~ in paths read from the config file with the user's home folder
+func expandPath(path string) string {
+	if strings.HasPrefix(path, "~") {
+		return filepath.Join(getHomeFolder(), path[1:])
+	}
+	return path
+}
+
+// readConfigFileProfile returns the key/value pairs of a profile of an SDK config file
+func readConfigFileProfile(configFilePath string, profile string) (map[string]string, error) {
+	data, err := ioutil.ReadFile(expandPath(configFilePath))
+	if err != nil {
+		return nil, fmt.Errorf("can not read config file %s: %v", configFilePath, err)
+	}
+
+	values := map[string]string{}
+	found := false
+	inProfile := false
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			inProfile = strings.TrimSpace(line[1:len(line)-1]) == profile
+			found = found || inProfile
+			continue
+		}
+
+		if !inProfile {
+			continue
+		}
+		if separator := strings.Index(line, "="); separator > 0 {
+			values[strings.TrimSpace(line[:separator])] = strings.TrimSpace(line[separator+1:])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("can not read config file %s: %v", configFilePath, err)
+	}
+
+	if !found {
+		return nil, fmt.Errorf("profile %s was not found in config file %s", profile, configFilePath)
+	}
+	return values, nil
+}
+
+// configFileConfigProvider reads the credentials of a profile of an SDK config file, for the values that are not set
+// in the provider block
+type configFileConfigProvider struct {
+	path    string
+	profile string
+	// values is nil if the config file does not exist
+	values             map[string]string
+	privateKeyPassword string
+}
+
+// getConfigFileConfigProvider returns the profile of the config file selected by the config_file_path and
+// config_file_profile attributes. A missing config file is only an error if one of them is set.
+func getConfigFileConfigProvider(d *schema.ResourceData) (*configFileConfigProvider, error) {
+	configFilePath, hasConfigFilePath := d.GetOk(configFilePathAttrName)
+	profile, hasProfile := d.GetOk(configFileProfileAttrName)
+	if !hasConfigFilePath {
+		configFilePath = getDefaultConfigFilePath()
+	}
+	if !hasProfile {
+		profile = defaultConfigFileProfile
+	}
+	privateKeyPassword := ""
+	if password, ok := d.GetOk(privateKeyPasswordAttrName); ok {
+		privateKeyPassword = password.(string)
+	}
+
+	return newConfigFileConfigProvider(configFilePath.(string), profile.(string), privateKeyPassword, hasConfigFilePath || hasProfile)
+}
+
+func newConfigFileConfigProvider(configFilePath string, profile string, privateKeyPassword string, required bool) (*configFileConfigProvider, error) {
+	provider := &configFileConfigProvider{
+		path:               configFilePath,
+		profile:            profile,
+		privateKeyPassword: privateKeyPassword,
+	}
+
+	if _, err := os.Stat(expandPath(configFilePath)); os.IsNotExist(err) && !required {
+		return provider, nil
+	}
+
+	values, err := readConfigFileProfile(configFilePath, profile)
+	if err != nil {
+		// The default profile is optional, e.g. the config file may only hold session profiles
+		if !required {
+			return provider, nil
+		}
+		return nil, err
+	}
+	provider.values = values
+	return provider, nil
+}
+
+func (p *configFileConfigProvider) String() string {
+	return fmt.Sprintf("profile %s of config file %s", p.profile, p.path)
+}
+
+func (p *configFileConfigProvider) get(key string) (string, error) {
+	if value := p.values[key]; value != "" {
+		return value, nil
+	}
+	return "", fmt.Errorf("can not get %s from %s", key, p)
+}
+
+func (p *configFileConfigProvider) TenancyOCID() (string, error) {
+	return p.get(configFileTenancyKey)
+}
+
+func (p *configFileConfigProvider) UserOCID() (string, error) {
+	return p.get(configFileUserKey)
+}
+
+func (p *configFileConfigProvider) KeyFingerprint() (string, error) {
+	return p.get(configFileFingerprintKey)
+}
+
+func (p *configFileConfigProvider) Region() (string, error) {
+	return p.get(configFileRegionKey)
+}
+
+func (p *configFileConfigProvider) KeyID() (string, error) {
+	tenancy, err := p.TenancyOCID()
+	if err != nil {
+		return "", err
+	}
+
+	user, err := p.UserOCID()
+	if err != nil {
+		return "", err
+	}
+
+	fingerprint, err := p.KeyFingerprint()
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s/%s/%s", tenancy, user, fingerprint), nil
+}
+
+func (p *configFileConfigProvider) PrivateRSAKey() (*rsa.PrivateKey, error) {
+	keyFile, err := p.get(configFileKeyFileKey)
+	if err != nil {
+		return nil, err
+	}
+
+	pemFileContent, err := ioutil.ReadFile(expandPath(keyFile))
+	if err != nil {
+		return nil, fmt.Errorf("can not read private key from %s: %v", keyFile, err)
+	}
+
+	// The private_key_password of the provider takes precedence over the pass_phrase of the profile
+	password := p.privateKeyPassword
+	if password == "" {
+		password = p.values[configFilePassphraseKey]
+	}
+	return oci_common.PrivateKeyFromBytes(pemFileContent, &password)
+}
+
+// requireConfigFileValues returns an error naming the keys that are missing from a profile of a config file
+func requireConfigFileValues(values map[string]string, configFilePath string, profile string, keys ...string) error {
+	var missing []string
+	for _, key := range keys {
+		if values[key] == "" {
+			missing = append(missing, key)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("profile %s of config file %s is missing %s", profile, configFilePath, strings.Join(missing, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeConfigFileTestFiles(t *testing.T, dir string) string {
+	configFilePath := filepath.Join(dir, "config")
+	config := "[DEFAULT]\n" +
+		"user=ocid1.user.oc1..defaultuser\n" +
+		"fingerprint=" + testKeyFingerPrint + "\n" +
+		"tenancy=" + testTenancyOCID + "\n" +
+		"region=us-ashburn-1\n" +
+		"\n" +
+		"[prod]\n" +
+		"user=" + testUserOCID + "\n" +
+		"fingerprint=" + testKeyFingerPrint + "\n" +
+		"key_file=" + filepath.Join(dir, "oci_api_key.pem") + "\n" +
+		"pass_phrase=password\n" +
+		"tenancy=" + testTenancyOCID + "\n" +
+		"region=us-phoenix-1\n" +
+		"\n" +
+		"[nopassphrase]\n" +
+		"user=" + testUserOCID + "\n" +
+		"fingerprint=" + testKeyFingerPrint + "\n" +
+		"key_file=" + filepath.Join(dir, "oci_api_key.pem") + "\n" +
+		"pass_phrase=\n" +
+		"tenancy=" + testTenancyOCID + "\n" +
+		"region=us-phoenix-1\n"
+
+	assert.NoError(t, ioutil.WriteFile(configFilePath, []byte(config), 0600))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "oci_api_key.pem"), []byte(testPrivateKey), 0600))
+	return configFilePath
+}
+
+func TestConfigFileConfigProvider_profile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config-file")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	configFilePath := writeConfigFileTestFiles(t, dir)
+
+	provider, err := newConfigFileConfigProvider(configFilePath, "prod", "", true)
+	assert.NoError(t, err)
+	user, _ := provider.UserOCID()
+	assert.Equal(t, testUserOCID, user)
+	region, _ := provider.Region()
+	assert.Equal(t, "us-phoenix-1", region)
+	keyId, err := provider.KeyID()
+	assert.NoError(t, err)
+	assert.Equal(t, testTenancyOCID+"/"+testUserOCID+"/"+testKeyFingerPrint, keyId)
+	privateKey, err := provider.PrivateRSAKey()
+	assert.NoError(t, err)
+	assert.NotNil(t, privateKey)
+
+	// The private_key_password of the provider is used unless it is empty, even if the pass_phrase of the profile is
+	provider, err = newConfigFileConfigProvider(configFilePath, "nopassphrase", "password", true)
+	assert.NoError(t, err)
+	privateKey, err = provider.PrivateRSAKey()
+	assert.NoError(t, err)
+	assert.NotNil(t, privateKey)
+	provider, err = newConfigFileConfigProvider(configFilePath, "prod", "wrong password", true)
+	assert.NoError(t, err)
+	_, err = provider.PrivateRSAKey()
+	assert.Error(t, err)
+
+	provider, err = newConfigFileConfigProvider(configFilePath, defaultConfigFileProfile, "", true)
+	assert.NoError(t, err)
+	_, err = provider.PrivateRSAKey()
+	assert.EqualError(t, err, "can not get key_file from profile DEFAULT of config file "+configFilePath)
+
+	_, err = newConfigFileConfigProvider(configFilePath, "missing", "", true)
+	assert.EqualError(t, err, "profile missing was not found in config file "+configFilePath)
+
+	// The default config file is optional
+	provider, err = newConfigFileConfigProvider(filepath.Join(dir, "missing"), defaultConfigFileProfile, "", false)
+	assert.NoError(t, err)
+	_, err = provider.TenancyOCID()
+	assert.Error(t, err)
+	_, err = newConfigFileConfigProvider(filepath.Join(dir, "missing"), defaultConfigFileProfile, "", true)
+	assert.Error(t, err)
+}
+
+func TestProviderConfig_configFileProfile(t *testing.T) {
+	for _, apiKeyConfigAttribute := range apiKeyConfigAttributes {
+		if getEnvSettingWithBlankDefault(apiKeyConfigAttribute) != "" {
+			t.Skip("apiKeyConfigAttributes are set through environment variables, skip the test")
+		}
+	}
+
+	dir, err := ioutil.TempDir("", "config-file")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	configFilePath := writeConfigFileTestFiles(t, dir)
+
+	r := &schema.Resource{
+		Schema: schemaMap(),
+	}
+	d := r.Data(nil)
+	d.Set(authAttrName, authAPIKeySetting)
+	d.Set(configFilePathAttrName, configFilePath)
+	d.Set(configFileProfileAttrName, "prod")
+
+	client, err := ProviderConfig(d)
+	assert.NoError(t, err)
+	oracleClient, ok := client.(*OracleClients)
+	assert.True(t, ok)
+	assert.Contains(t, oracleClient.computeClient.Host, "us-phoenix-1")
+
+	// Values of the provider block take precedence over the ones of the profile
+	d.Set(regionAttrName, "us-ashburn-1")
+	client, err = ProviderConfig(d)
+	assert.NoError(t, err)
+	assert.Contains(t, client.(*OracleClients).computeClient.Host, "us-ashburn-1")
+
+	d = r.Data(nil)
+	d.Set(authAttrName, authAPIKeySetting)
+	d.Set(configFilePathAttrName, configFilePath)
+	d.Set(configFileProfileAttrName, defaultConfigFileProfile)
+	d.Set(regionAttrName, "us-phoenix-1")
+	_, err = ProviderConfig(d)
+	assert.EqualError(t, err, "when auth is set to 'ApiKey', tenancy_ocid, user_ocid, fingerprint and a private key are required, "+
+		"but private_key_path is not set in the provider block or in profile DEFAULT of config file "+configFilePath)
+
+	d.Set(configFileProfileAttrName, "missing")
+	_, err = ProviderConfig(d)
+	assert.EqualError(t, err, "profile missing was not found in config file "+configFilePath)
+}
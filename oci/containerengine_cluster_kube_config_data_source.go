@@ -42,7 +42,7 @@ func readSingularContainerengineClusterKubeConfig(d *schema.ResourceData, m inte
 	sync.D = d
 	sync.Client = m.(*OracleClients).containerEngineClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type ContainerengineClusterKubeConfigDataSourceCrud struct {
@@ -55,7 +55,7 @@ func (s *ContainerengineClusterKubeConfigDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ContainerengineClusterKubeConfigDataSourceCrud) Get() error {
+func (s *ContainerengineClusterKubeConfigDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_containerengine.CreateKubeconfigRequest{}
 
 	if clusterId, ok := s.D.GetOkExists("cluster_id"); ok {
@@ -75,7 +75,7 @@ func (s *ContainerengineClusterKubeConfigDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "containerengine")
 
-	response, err := s.Client.CreateKubeconfig(context.Background(), request)
+	response, err := s.Client.CreateKubeconfig(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -34,7 +34,7 @@ func readSingularContainerengineClusterOption(d *schema.ResourceData, m interfac
 	sync.D = d
 	sync.Client = m.(*OracleClients).containerEngineClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type ContainerengineClusterOptionDataSourceCrud struct {
@@ -47,7 +47,7 @@ func (s *ContainerengineClusterOptionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ContainerengineClusterOptionDataSourceCrud) Get() error {
+func (s *ContainerengineClusterOptionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_containerengine.GetClusterOptionsRequest{}
 
 	if clusterOptionId, ok := s.D.GetOkExists("cluster_option_id"); ok {
@@ -57,7 +57,7 @@ func (s *ContainerengineClusterOptionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "containerengine")
 
-	response, err := s.Client.GetClusterOptions(context.Background(), request)
+	response, err := s.Client.GetClusterOptions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,14 +7,8 @@ import (
 	"fmt"
 	"log"
 
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 
-	"strings"
-
-	"time"
-
-	oci_common "github.com/oracle/oci-go-sdk/common"
 	oci_containerengine "github.com/oracle/oci-go-sdk/containerengine"
 )
 
@@ -234,7 +228,7 @@ func createContainerengineCluster(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).containerEngineClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readContainerengineCluster(d *schema.ResourceData, m interface{}) error {
@@ -242,7 +236,7 @@ func readContainerengineCluster(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).containerEngineClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateContainerengineCluster(d *schema.ResourceData, m interface{}) error {
@@ -250,7 +244,7 @@ func updateContainerengineCluster(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).containerEngineClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteContainerengineCluster(d *schema.ResourceData, m interface{}) error {
@@ -259,7 +253,7 @@ func deleteContainerengineCluster(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).containerEngineClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type ContainerengineClusterResourceCrud struct {
@@ -267,6 +261,7 @@ type ContainerengineClusterResourceCrud struct {
 	Client                 *oci_containerengine.ContainerEngineClient
 	Res                    *oci_containerengine.Cluster
 	DisableNotFoundRetries bool
+	OpcWorkRequestId       *string
 }
 
 func (s *ContainerengineClusterResourceCrud) ID() string {
@@ -297,83 +292,72 @@ func (s *ContainerengineClusterResourceCrud) DeletedTarget() []string {
 	}
 }
 
-//containerEngineWorkRequestShouldRetryFunc Custom retry function for containerengine service
-func containerEngineWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
-	startTime := time.Now()
-	stopTime := startTime.Add(timeout)
-	return func(response oci_common.OCIOperationResponse) bool {
+func (s *ContainerengineClusterResourceCrud) WorkRequestTracker() WorkRequestTracker {
+	return newContainerEngineWorkRequestTracker(s.Client, s.DisableNotFoundRetries)
+}
 
-		//Stop after timeout has elapsed
-		if time.Now().After(stopTime) {
-			return false
-		}
+func (s *ContainerengineClusterResourceCrud) WorkRequestId() *string {
+	return s.OpcWorkRequestId
+}
 
-		//Make sure we stop on default rules
-		if shouldRetry(response, false, "containerengine", startTime) {
-			return true
-		}
+func (s *ContainerengineClusterResourceCrud) WorkRequestSucceeded(ctx context.Context, operation string, workRequest *WorkRequestStatus) error {
+	actionType := oci_containerengine.WorkRequestResourceActionTypeCreated
+	switch operation {
+	case updateOperation:
+		actionType = oci_containerengine.WorkRequestResourceActionTypeUpdated
+	case deleteOperation:
+		return nil
+	}
 
-		// Only stop if the time Finished is set
-		if okeRes, ok := response.Response.(oci_containerengine.GetWorkRequestResponse); ok {
-			return okeRes.TimeFinished == nil
-		}
-		return false
+	clusterID := workRequest.ResourceIdentifier("cluster", string(actionType))
+	if clusterID == nil {
+		return fmt.Errorf("work request %s succeeded but did not report the cluster as %s", workRequest.Id, actionType)
 	}
+
+	//Fetch the cluster object
+	requestGet := oci_containerengine.GetClusterRequest{}
+	requestGet.ClusterId = clusterID
+	requestGet.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+	responseGet, err := s.Client.GetCluster(ctx, requestGet)
+	if err != nil {
+		return err
+	}
+	s.Res = &responseGet.Cluster
+
+	return nil
 }
 
-//containerEngineWaitForWorkRequest custom logic to extract an identifier from a workRequest
-func containerEngineWaitForWorkRequest(wId *string, entityType string, action oci_containerengine.WorkRequestResourceActionTypeEnum,
-	timeout time.Duration, disableFoundRetries bool, client *oci_containerengine.ContainerEngineClient) (*string, error) {
-	retryPolicy := getRetryPolicy(disableFoundRetries, "containerengine")
-	retryPolicy.ShouldRetryOperation = containerEngineWorkRequestShouldRetryFunc(timeout)
-
-	response := oci_containerengine.GetWorkRequestResponse{}
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{
-			string(oci_containerengine.WorkRequestStatusInProgress),
-			string(oci_containerengine.WorkRequestStatusAccepted),
-			string(oci_containerengine.WorkRequestStatusCanceling),
-		},
-		Target: []string{
-			string(oci_containerengine.WorkRequestStatusSucceeded),
-			string(oci_containerengine.WorkRequestStatusFailed),
-			string(oci_containerengine.WorkRequestStatusCanceled),
-		},
-		Refresh: func() (interface{}, string, error) {
-			var err error
-			response, err = client.GetWorkRequest(context.Background(),
-				oci_containerengine.GetWorkRequestRequest{
-					WorkRequestId: wId,
-					RequestMetadata: oci_common.RequestMetadata{
-						RetryPolicy: retryPolicy,
-					},
-				})
-			wr := &response.WorkRequest
-			return wr, string(wr.Status), err
-		},
-		Timeout: timeout,
+func (s *ContainerengineClusterResourceCrud) WorkRequestFailed(ctx context.Context, operation string, workRequest *WorkRequestStatus) {
+	if operation != createOperation || workRequest == nil {
+		return
 	}
-	if _, e := stateConf.WaitForState(); e != nil {
-		return nil, e
+	clusterID := workRequest.ResourceIdentifier("cluster", "")
+	if clusterID == nil {
+		return
 	}
 
-	var identifier *string
-	//The work request response contains an array of objects that finished the operation
-	for _, res := range response.Resources {
-		if strings.Contains(strings.ToLower(*res.EntityType), entityType) {
-			identifier = res.Identifier
-			if res.ActionType == action {
-				return res.Identifier, nil
-			}
-		}
+	//Try to clean up
+	log.Printf("[DEBUG] creation failed, attempting to delete the cluster: %v\n", *clusterID)
+
+	delReq := oci_containerengine.DeleteClusterRequest{}
+	delReq.ClusterId = clusterID
+	delReq.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+
+	//Issue the delete delReq
+	delRes, delErr := s.Client.DeleteCluster(ctx, delReq)
+	if delErr != nil {
+		log.Printf("[DEBUG] cleanup DeleteCluster failed with the error: %v\n", delErr)
+		return
 	}
 
-	//Otherwise the operation ended unsucessfully
-	errorMessage, _ := getErrorFromWorkRequest(wId, response.CompartmentId, client, disableFoundRetries)
-	return identifier, fmt.Errorf("work request did not succeed, workId: %s, entity: %s, action: %s. Message: %s", *wId, entityType, action, errorMessage)
+	//Wait until request finishes
+	_, delErr = waitForWorkRequest(ctx, s.WorkRequestTracker(), delRes.OpcWorkRequestId, s.D.Timeout(schema.TimeoutCreate))
+	if delErr != nil {
+		log.Printf("[DEBUG] cleanup delWorkRequest failed with the error: %v\n", delErr)
+	}
 }
 
-func (s *ContainerengineClusterResourceCrud) Create() error {
+func (s *ContainerengineClusterResourceCrud) Create(ctx context.Context) error {
 	request := oci_containerengine.CreateClusterRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -408,62 +392,22 @@ func (s *ContainerengineClusterResourceCrud) Create() error {
 	}
 	//Trigger a create request
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
-	response, err := s.Client.CreateCluster(context.Background(), request)
-	if err != nil {
-		return err
-	}
-
-	workId := response.OpcWorkRequestId
-	//Wait until it finishes
-	clusterID, err := containerEngineWaitForWorkRequest(workId, "cluster",
-		oci_containerengine.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries, s.Client)
-
-	if err != nil {
-		if clusterID != nil {
-			//Try to clean up
-			log.Printf("[DEBUG] creation failed, attempting to delete the cluster: %v\n", clusterID)
-
-			delReq := oci_containerengine.DeleteClusterRequest{}
-			delReq.ClusterId = clusterID
-			delReq.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
-
-			//Issue the delete delReq
-			delRes, delErr := s.Client.DeleteCluster(context.Background(), delReq)
-			if delErr != nil {
-				return err
-			}
-			delWorkRequest := delRes.OpcWorkRequestId
-
-			//Wait until request finishes
-			_, delErr = containerEngineWaitForWorkRequest(delWorkRequest, "cluster",
-				oci_containerengine.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries, s.Client)
-			if delErr != nil {
-				log.Printf("[DEBUG] cleanup delWorkRequest failed with the error: %v\n", delErr)
-			}
-		}
-		return err
-	}
-
-	//Fetch the cluster object
-	requestGet := oci_containerengine.GetClusterRequest{}
-	requestGet.ClusterId = clusterID
-	requestGet.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
-	responseGet, err := s.Client.GetCluster(context.Background(), requestGet)
+	response, err := s.Client.CreateCluster(ctx, request)
 	if err != nil {
 		return err
 	}
-	s.Res = &responseGet.Cluster
 
+	s.OpcWorkRequestId = response.OpcWorkRequestId
 	return nil
 }
 
-func (s *ContainerengineClusterResourceCrud) Get() error {
+func (s *ContainerengineClusterResourceCrud) Get(ctx context.Context) error {
 	id := s.D.Id()
 	request := oci_containerengine.GetClusterRequest{}
 	request.ClusterId = &id
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
-	response, err := s.Client.GetCluster(context.Background(), request)
+	response, err := s.Client.GetCluster(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -472,7 +416,7 @@ func (s *ContainerengineClusterResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ContainerengineClusterResourceCrud) Update() error {
+func (s *ContainerengineClusterResourceCrud) Update(ctx context.Context) error {
 	request := oci_containerengine.UpdateClusterRequest{}
 
 	tmp := s.D.Id()
@@ -490,51 +434,27 @@ func (s *ContainerengineClusterResourceCrud) Update() error {
 
 	//Issue update request
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
-	response, err := s.Client.UpdateCluster(context.Background(), request)
-	if err != nil {
-		return err
-	}
-	workRequest := response.OpcWorkRequestId
-
-	//Wait until request finishes
-	clusterID, err := containerEngineWaitForWorkRequest(workRequest, "cluster",
-		oci_containerengine.WorkRequestResourceActionTypeUpdated,
-		s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
-	if err != nil {
-		return err
-	}
-
-	//Refresh data
-	requestGet := oci_containerengine.GetClusterRequest{}
-	requestGet.ClusterId = clusterID
-	requestGet.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
-	responseGet, err := s.Client.GetCluster(context.Background(), requestGet)
+	response, err := s.Client.UpdateCluster(ctx, request)
 	if err != nil {
 		return err
 	}
-
-	s.Res = &responseGet.Cluster
+	s.OpcWorkRequestId = response.OpcWorkRequestId
 	return nil
 }
 
-func (s *ContainerengineClusterResourceCrud) Delete() error {
+func (s *ContainerengineClusterResourceCrud) Delete(ctx context.Context) error {
 	request := oci_containerengine.DeleteClusterRequest{}
 	tmp := s.D.Id()
 	request.ClusterId = &tmp
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
 	//Issue the delete request
-	response, err := s.Client.DeleteCluster(context.Background(), request)
+	response, err := s.Client.DeleteCluster(ctx, request)
 	if err != nil {
 		return err
 	}
-	workRequest := response.OpcWorkRequestId
-
-	//Wait until request finishes
-	_, err = containerEngineWaitForWorkRequest(workRequest, "cluster",
-		oci_containerengine.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
-
-	return err
+	s.OpcWorkRequestId = response.OpcWorkRequestId
+	return nil
 }
 
 func (s *ContainerengineClusterResourceCrud) SetData() error {
@@ -749,24 +669,3 @@ func KubernetesNetworkConfigToMap(obj *oci_containerengine.KubernetesNetworkConf
 
 	return result
 }
-
-// getErrorFromWorkRequest retuns a concatened string of all errors for a given work request, if there is a reading the error it returns an empty string an error
-func getErrorFromWorkRequest(workRequestId *string, compartmentId *string, client *oci_containerengine.ContainerEngineClient, disableFoundAutoRetries bool) (string, error) {
-	req := oci_containerengine.ListWorkRequestErrorsRequest{}
-	req.WorkRequestId = workRequestId
-	req.CompartmentId = compartmentId
-	req.RequestMetadata.RetryPolicy = getRetryPolicy(disableFoundAutoRetries, "containerengine")
-	res, err := client.ListWorkRequestErrors(context.Background(), req)
-
-	if err != nil {
-		return "", err
-	}
-
-	allErrs := make([]string, 0)
-	for _, errs := range res.Items {
-		allErrs = append(allErrs, *errs.Message)
-	}
-
-	errorMessage := strings.Join(allErrs, "\n")
-	return errorMessage, nil
-}
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_containerengine "github.com/oracle/oci-go-sdk/containerengine"
 )
 
@@ -13,7 +14,8 @@ func ContainerengineClustersDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readContainerengineClusters,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -34,6 +36,23 @@ func ContainerengineClustersDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(ContainerengineClusterResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_containerengine.ListClustersSortById),
+					string(oci_containerengine.ListClustersSortByName),
+					string(oci_containerengine.ListClustersSortByTimeCreated),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_containerengine.ListClustersSortOrderAsc),
+					string(oci_containerengine.ListClustersSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -43,7 +62,7 @@ func readContainerengineClusters(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).containerEngineClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type ContainerengineClustersDataSourceCrud struct {
@@ -56,7 +75,7 @@ func (s *ContainerengineClustersDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ContainerengineClustersDataSourceCrud) Get() error {
+func (s *ContainerengineClustersDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_containerengine.ListClustersRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -77,9 +96,18 @@ func (s *ContainerengineClustersDataSourceCrud) Get() error {
 		request.LifecycleState = enumStates
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_containerengine.ListClustersSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_containerengine.ListClustersSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "containerengine")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListClusters(context.Background(), request)
+	response, err := s.Client.ListClusters(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -87,8 +115,8 @@ func (s *ContainerengineClustersDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListClusters(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListClusters(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -162,6 +190,8 @@ func (s *ContainerengineClustersDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, ContainerengineClustersDataSource().Schema["clusters"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("clusters", resources); err != nil {
 		return err
 	}
@@ -170,7 +170,7 @@ func readSingularContainerengineNodePool(d *schema.ResourceData, m interface{})
 	sync.D = d
 	sync.Client = m.(*OracleClients).containerEngineClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type ContainerengineNodePoolDataSourceCrud struct {
@@ -183,7 +183,7 @@ func (s *ContainerengineNodePoolDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ContainerengineNodePoolDataSourceCrud) Get() error {
+func (s *ContainerengineNodePoolDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_containerengine.GetNodePoolRequest{}
 
 	if nodePoolId, ok := s.D.GetOkExists("node_pool_id"); ok {
@@ -193,7 +193,7 @@ func (s *ContainerengineNodePoolDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "containerengine")
 
-	response, err := s.Client.GetNodePool(context.Background(), request)
+	response, err := s.Client.GetNodePool(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -48,7 +48,7 @@ func readSingularContainerengineNodePoolOption(d *schema.ResourceData, m interfa
 	sync.D = d
 	sync.Client = m.(*OracleClients).containerEngineClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type ContainerengineNodePoolOptionDataSourceCrud struct {
@@ -61,7 +61,7 @@ func (s *ContainerengineNodePoolOptionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ContainerengineNodePoolOptionDataSourceCrud) Get() error {
+func (s *ContainerengineNodePoolOptionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_containerengine.GetNodePoolOptionsRequest{}
 
 	if nodePoolOptionId, ok := s.D.GetOkExists("node_pool_option_id"); ok {
@@ -71,7 +71,7 @@ func (s *ContainerengineNodePoolOptionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "containerengine")
 
-	response, err := s.Client.GetNodePoolOptions(context.Background(), request)
+	response, err := s.Client.GetNodePoolOptions(ctx, request)
 	if err != nil {
 		return err
 	}
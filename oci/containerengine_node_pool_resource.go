@@ -200,7 +200,7 @@ func createContainerengineNodePool(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).containerEngineClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readContainerengineNodePool(d *schema.ResourceData, m interface{}) error {
@@ -208,7 +208,7 @@ func readContainerengineNodePool(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).containerEngineClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateContainerengineNodePool(d *schema.ResourceData, m interface{}) error {
@@ -216,7 +216,7 @@ func updateContainerengineNodePool(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).containerEngineClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteContainerengineNodePool(d *schema.ResourceData, m interface{}) error {
@@ -225,7 +225,7 @@ func deleteContainerengineNodePool(d *schema.ResourceData, m interface{}) error
 	sync.Client = m.(*OracleClients).containerEngineClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type ContainerengineNodePoolResourceCrud struct {
@@ -233,13 +233,79 @@ type ContainerengineNodePoolResourceCrud struct {
 	Client                 *oci_containerengine.ContainerEngineClient
 	Res                    *oci_containerengine.NodePool
 	DisableNotFoundRetries bool
+	OpcWorkRequestId       *string
 }
 
 func (s *ContainerengineNodePoolResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *ContainerengineNodePoolResourceCrud) Create() error {
+func (s *ContainerengineNodePoolResourceCrud) WorkRequestTracker() WorkRequestTracker {
+	return newContainerEngineWorkRequestTracker(s.Client, s.DisableNotFoundRetries)
+}
+
+func (s *ContainerengineNodePoolResourceCrud) WorkRequestId() *string {
+	return s.OpcWorkRequestId
+}
+
+func (s *ContainerengineNodePoolResourceCrud) WorkRequestSucceeded(ctx context.Context, operation string, workRequest *WorkRequestStatus) error {
+	actionType := oci_containerengine.WorkRequestResourceActionTypeCreated
+	switch operation {
+	case updateOperation:
+		actionType = oci_containerengine.WorkRequestResourceActionTypeUpdated
+	case deleteOperation:
+		return nil
+	}
+
+	nodePoolID := workRequest.ResourceIdentifier("nodepool", string(actionType))
+	if nodePoolID == nil {
+		return fmt.Errorf("work request %s succeeded but did not report the node pool as %s", workRequest.Id, actionType)
+	}
+
+	//Fetch the node pool object
+	requestGet := oci_containerengine.GetNodePoolRequest{}
+	requestGet.NodePoolId = nodePoolID
+	requestGet.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+	responseGet, err := s.Client.GetNodePool(ctx, requestGet)
+	if err != nil {
+		return err
+	}
+	s.Res = &responseGet.NodePool
+
+	return nil
+}
+
+func (s *ContainerengineNodePoolResourceCrud) WorkRequestFailed(ctx context.Context, operation string, workRequest *WorkRequestStatus) {
+	if operation != createOperation || workRequest == nil {
+		return
+	}
+	nodePoolID := workRequest.ResourceIdentifier("nodepool", "")
+	if nodePoolID == nil {
+		return
+	}
+
+	//Try to clean up
+	log.Printf("[DEBUG] creation failed, attempting to delete the node pool: %v\n", *nodePoolID)
+
+	delReq := oci_containerengine.DeleteNodePoolRequest{}
+	delReq.NodePoolId = nodePoolID
+	delReq.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+
+	//Issue the delete delReq
+	delRes, delErr := s.Client.DeleteNodePool(ctx, delReq)
+	if delErr != nil {
+		log.Printf("[DEBUG] cleanup DeleteNodePool failed with the error: %v\n", delErr)
+		return
+	}
+
+	//Wait until request finishes
+	_, delErr = waitForWorkRequest(ctx, s.WorkRequestTracker(), delRes.OpcWorkRequestId, s.D.Timeout(schema.TimeoutCreate))
+	if delErr != nil {
+		log.Printf("[DEBUG] cleanup delWorkRequest failed with the error: %v\n", delErr)
+	}
+}
+
+func (s *ContainerengineNodePoolResourceCrud) Create(ctx context.Context) error {
 	request := oci_containerengine.CreateNodePoolRequest{}
 
 	if clusterId, ok := s.D.GetOkExists("cluster_id"); ok {
@@ -323,57 +389,16 @@ func (s *ContainerengineNodePoolResourceCrud) Create() error {
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
 	//Trigger create request
-	response, err := s.Client.CreateNodePool(context.Background(), request)
+	response, err := s.Client.CreateNodePool(ctx, request)
 	if err != nil {
 		return err
 	}
 
-	workID := response.OpcWorkRequestId
-
-	//Wait until it finishes
-	nodePoolID, err := containerEngineWaitForWorkRequest(workID, "nodepool",
-		oci_containerengine.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries,
-		s.Client)
-	if err != nil {
-		if nodePoolID != nil {
-			//Try to clean up
-			log.Printf("[DEBUG] creation failed, attempting to delete the node pool: %v\n", nodePoolID)
-
-			delReq := oci_containerengine.DeleteNodePoolRequest{}
-			delReq.NodePoolId = nodePoolID
-			delReq.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
-
-			//Issues delete delRequest
-			delRes, delErr := s.Client.DeleteNodePool(context.Background(), delReq)
-			if delErr != nil {
-				return err
-			}
-			delWorkRequest := delRes.OpcWorkRequestId
-
-			//Wait until delRequest finishes
-			_, delErr = containerEngineWaitForWorkRequest(delWorkRequest, "nodepool",
-				oci_containerengine.WorkRequestResourceActionTypeDeleted,
-				s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries, s.Client)
-			if delErr != nil {
-				log.Printf("[DEBUG] cleanup delWorkRequest failed with the error: %v\n", delErr)
-			}
-		}
-		return err
-	}
-
-	//Fetch nodepool object
-	requestGet := oci_containerengine.GetNodePoolRequest{}
-	requestGet.NodePoolId = nodePoolID
-	requestGet.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
-	responseGet, err := s.Client.GetNodePool(context.Background(), requestGet)
-	if err != nil {
-		return err
-	}
-	s.Res = &responseGet.NodePool
+	s.OpcWorkRequestId = response.OpcWorkRequestId
 	return nil
 }
 
-func (s *ContainerengineNodePoolResourceCrud) Get() error {
+func (s *ContainerengineNodePoolResourceCrud) Get(ctx context.Context) error {
 	request := oci_containerengine.GetNodePoolRequest{}
 
 	tmp := s.D.Id()
@@ -381,7 +406,7 @@ func (s *ContainerengineNodePoolResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
-	response, err := s.Client.GetNodePool(context.Background(), request)
+	response, err := s.Client.GetNodePool(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -390,7 +415,7 @@ func (s *ContainerengineNodePoolResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ContainerengineNodePoolResourceCrud) Update() error {
+func (s *ContainerengineNodePoolResourceCrud) Update(ctx context.Context) error {
 	request := oci_containerengine.UpdateNodePoolRequest{}
 
 	request.InitialNodeLabels = []oci_containerengine.KeyValue{}
@@ -442,34 +467,15 @@ func (s *ContainerengineNodePoolResourceCrud) Update() error {
 
 	//Issue update request
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
-	response, err := s.Client.UpdateNodePool(context.Background(), request)
+	response, err := s.Client.UpdateNodePool(ctx, request)
 	if err != nil {
 		return err
 	}
-	workRequest := response.OpcWorkRequestId
-
-	//Wait until request finishes
-	nodePoolID, err := containerEngineWaitForWorkRequest(workRequest, "nodepool",
-		oci_containerengine.WorkRequestResourceActionTypeUpdated,
-		s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
-	if err != nil {
-		return err
-	}
-
-	//Refresh data
-	requestGet := oci_containerengine.GetNodePoolRequest{}
-	requestGet.NodePoolId = nodePoolID
-	requestGet.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
-	responseGet, err := s.Client.GetNodePool(context.Background(), requestGet)
-	if err != nil {
-		return err
-	}
-
-	s.Res = &responseGet.NodePool
+	s.OpcWorkRequestId = response.OpcWorkRequestId
 	return nil
 }
 
-func (s *ContainerengineNodePoolResourceCrud) Delete() error {
+func (s *ContainerengineNodePoolResourceCrud) Delete(ctx context.Context) error {
 	request := oci_containerengine.DeleteNodePoolRequest{}
 
 	tmp := s.D.Id()
@@ -478,20 +484,13 @@ func (s *ContainerengineNodePoolResourceCrud) Delete() error {
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
 	//Issue delete request
-	response, err := s.Client.DeleteNodePool(context.Background(), request)
+	response, err := s.Client.DeleteNodePool(ctx, request)
 	if err != nil {
 		return err
 	}
 
-	workRequest := response.OpcWorkRequestId
-
-	//Wait until request finishes
-	_, err = containerEngineWaitForWorkRequest(workRequest, "nodepool",
-		oci_containerengine.WorkRequestResourceActionTypeDeleted,
-		s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
-
-	return err
-
+	s.OpcWorkRequestId = response.OpcWorkRequestId
+	return nil
 }
 
 func (s *ContainerengineNodePoolResourceCrud) SetData() error {
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_containerengine "github.com/oracle/oci-go-sdk/containerengine"
 )
 
@@ -13,7 +14,8 @@ func ContainerengineNodePoolsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readContainerengineNodePools,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"cluster_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,6 +33,23 @@ func ContainerengineNodePoolsDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(ContainerengineNodePoolDataSource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_containerengine.ListNodePoolsSortById),
+					string(oci_containerengine.ListNodePoolsSortByName),
+					string(oci_containerengine.ListNodePoolsSortByTimeCreated),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_containerengine.ListNodePoolsSortOrderAsc),
+					string(oci_containerengine.ListNodePoolsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -40,7 +59,7 @@ func readContainerengineNodePools(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).containerEngineClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type ContainerengineNodePoolsDataSourceCrud struct {
@@ -53,7 +72,7 @@ func (s *ContainerengineNodePoolsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ContainerengineNodePoolsDataSourceCrud) Get() error {
+func (s *ContainerengineNodePoolsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_containerengine.ListNodePoolsRequest{}
 
 	if clusterId, ok := s.D.GetOkExists("cluster_id"); ok {
@@ -71,9 +90,18 @@ func (s *ContainerengineNodePoolsDataSourceCrud) Get() error {
 		request.Name = &tmp
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_containerengine.ListNodePoolsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_containerengine.ListNodePoolsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "containerengine")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListNodePools(context.Background(), request)
+	response, err := s.Client.ListNodePools(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -81,8 +109,8 @@ func (s *ContainerengineNodePoolsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListNodePools(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListNodePools(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -158,6 +186,8 @@ func (s *ContainerengineNodePoolsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, ContainerengineNodePoolsDataSource().Schema["node_pools"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("node_pools", resources); err != nil {
 		return err
 	}
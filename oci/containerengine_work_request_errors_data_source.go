@@ -56,7 +56,7 @@ func readContainerengineWorkRequestErrors(d *schema.ResourceData, m interface{})
 	sync.D = d
 	sync.Client = m.(*OracleClients).containerEngineClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type ContainerengineWorkRequestErrorsDataSourceCrud struct {
@@ -69,7 +69,7 @@ func (s *ContainerengineWorkRequestErrorsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ContainerengineWorkRequestErrorsDataSourceCrud) Get() error {
+func (s *ContainerengineWorkRequestErrorsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_containerengine.ListWorkRequestErrorsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -84,7 +84,7 @@ func (s *ContainerengineWorkRequestErrorsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "containerengine")
 
-	response, err := s.Client.ListWorkRequestErrors(context.Background(), request)
+	response, err := s.Client.ListWorkRequestErrors(ctx, request)
 	if err != nil {
 		return err
 	}
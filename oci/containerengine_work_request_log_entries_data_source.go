@@ -52,7 +52,7 @@ func readContainerengineWorkRequestLogEntries(d *schema.ResourceData, m interfac
 	sync.D = d
 	sync.Client = m.(*OracleClients).containerEngineClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type ContainerengineWorkRequestLogEntriesDataSourceCrud struct {
@@ -65,7 +65,7 @@ func (s *ContainerengineWorkRequestLogEntriesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ContainerengineWorkRequestLogEntriesDataSourceCrud) Get() error {
+func (s *ContainerengineWorkRequestLogEntriesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_containerengine.ListWorkRequestLogsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -80,7 +80,7 @@ func (s *ContainerengineWorkRequestLogEntriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "containerengine")
 
-	response, err := s.Client.ListWorkRequestLogs(context.Background(), request)
+	response, err := s.Client.ListWorkRequestLogs(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_containerengine "github.com/oracle/oci-go-sdk/containerengine"
 )
 
@@ -13,7 +14,8 @@ func ContainerengineWorkRequestsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readContainerengineWorkRequests,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"cluster_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -107,6 +109,26 @@ func ContainerengineWorkRequestsDataSource() *schema.Resource {
 					},
 				},
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_containerengine.ListWorkRequestsSortById),
+					string(oci_containerengine.ListWorkRequestsSortByOperationType),
+					string(oci_containerengine.ListWorkRequestsSortByStatus),
+					string(oci_containerengine.ListWorkRequestsSortByTimeAccepted),
+					string(oci_containerengine.ListWorkRequestsSortByTimeStarted),
+					string(oci_containerengine.ListWorkRequestsSortByTimeFinished),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_containerengine.ListWorkRequestsSortOrderAsc),
+					string(oci_containerengine.ListWorkRequestsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -116,7 +138,7 @@ func readContainerengineWorkRequests(d *schema.ResourceData, m interface{}) erro
 	sync.D = d
 	sync.Client = m.(*OracleClients).containerEngineClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type ContainerengineWorkRequestsDataSourceCrud struct {
@@ -129,7 +151,7 @@ func (s *ContainerengineWorkRequestsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ContainerengineWorkRequestsDataSourceCrud) Get() error {
+func (s *ContainerengineWorkRequestsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_containerengine.ListWorkRequestsRequest{}
 
 	if clusterId, ok := s.D.GetOkExists("cluster_id"); ok {
@@ -160,9 +182,18 @@ func (s *ContainerengineWorkRequestsDataSourceCrud) Get() error {
 		request.Status = tmp
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_containerengine.ListWorkRequestsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_containerengine.ListWorkRequestsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "containerengine")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListWorkRequests(context.Background(), request)
+	response, err := s.Client.ListWorkRequests(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -170,8 +201,8 @@ func (s *ContainerengineWorkRequestsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListWorkRequests(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListWorkRequests(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -229,6 +260,8 @@ func (s *ContainerengineWorkRequestsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, ContainerengineWorkRequestsDataSource().Schema["work_requests"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("work_requests", resources); err != nil {
 		return err
 	}
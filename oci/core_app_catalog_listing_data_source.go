@@ -55,7 +55,7 @@ func readSingularCoreAppCatalogListing(d *schema.ResourceData, m interface{}) er
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreAppCatalogListingDataSourceCrud struct {
@@ -68,7 +68,7 @@ func (s *CoreAppCatalogListingDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreAppCatalogListingDataSourceCrud) Get() error {
+func (s *CoreAppCatalogListingDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetAppCatalogListingRequest{}
 
 	if listingId, ok := s.D.GetOkExists("listing_id"); ok {
@@ -78,7 +78,7 @@ func (s *CoreAppCatalogListingDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := s.Client.GetAppCatalogListing(context.Background(), request)
+	response, err := s.Client.GetAppCatalogListing(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,9 @@ import (
 
 func AppCatalogListingResourceVersionAgreementResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importAppCatalogListingResourceVersionAgreement,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createAppCatalogListingResourceVersionAgreement,
 		Read:     readAppCatalogListingResourceVersionAgreement,
@@ -53,7 +56,7 @@ func createAppCatalogListingResourceVersionAgreement(d *schema.ResourceData, m i
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readAppCatalogListingResourceVersionAgreement(d *schema.ResourceData, m interface{}) error {
@@ -61,7 +64,7 @@ func readAppCatalogListingResourceVersionAgreement(d *schema.ResourceData, m int
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func deleteAppCatalogListingResourceVersionAgreement(d *schema.ResourceData, m interface{}) error {
@@ -70,7 +73,31 @@ func deleteAppCatalogListingResourceVersionAgreement(d *schema.ResourceData, m i
 	sync.Client = m.(*OracleClients).computeClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
+}
+
+// importAppCatalogListingResourceVersionAgreement retrieves the agreements of a listing resource version, with an ID of
+// the form listings/{listingId}/resourceVersions/{listingResourceVersion}. The agreements can not be read back, so new
+// ones are retrieved, the way they are when the resource is created.
+func importAppCatalogListingResourceVersionAgreement(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	values, err := parseCompositeId(d.Id(), "listings", "resourceVersions")
+	if err != nil {
+		return nil, err
+	}
+	d.Set("listing_id", values[0])
+	d.Set("listing_resource_version", values[1])
+
+	sync := &AppCatalogListingResourceVersionAgreementResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).computeClient
+	if err := sync.Create(m.(*OracleClients).StopContext()); err != nil {
+		return nil, err
+	}
+	if err := sync.SetData(); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
 }
 
 type AppCatalogListingResourceVersionAgreementResourceCrud struct {
@@ -84,7 +111,7 @@ func (s *AppCatalogListingResourceVersionAgreementResourceCrud) ID() string {
 	return s.Res.TimeRetrieved.Format(time.RFC3339Nano)
 }
 
-func (s *AppCatalogListingResourceVersionAgreementResourceCrud) Create() error {
+func (s *AppCatalogListingResourceVersionAgreementResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.GetAppCatalogListingAgreementsRequest{}
 
 	if listingId, ok := s.D.GetOkExists("listing_id"); ok {
@@ -99,7 +126,7 @@ func (s *AppCatalogListingResourceVersionAgreementResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := s.Client.GetAppCatalogListingAgreements(context.Background(), request)
+	response, err := s.Client.GetAppCatalogListingAgreements(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -108,11 +135,11 @@ func (s *AppCatalogListingResourceVersionAgreementResourceCrud) Create() error {
 	return nil
 }
 
-func (s *AppCatalogListingResourceVersionAgreementResourceCrud) Get() error {
+func (s *AppCatalogListingResourceVersionAgreementResourceCrud) Get(ctx context.Context) error {
 	return nil
 }
 
-func (s *AppCatalogListingResourceVersionAgreementResourceCrud) Delete() error {
+func (s *AppCatalogListingResourceVersionAgreementResourceCrud) Delete(ctx context.Context) error {
 	return nil
 }
 
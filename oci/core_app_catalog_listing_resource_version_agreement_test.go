@@ -53,6 +53,20 @@ func TestCoreAppCatalogListingResourceVersionAgreementResource_basic(t *testing.
 					resource.TestMatchResourceAttr(resourceName, "time_retrieved", RCF3339NanoReg),
 				),
 			},
+			// verify resource import
+			{
+				Config:            config,
+				ImportState:       true,
+				ImportStateIdFunc: getImportCompositeIdFunc(resourceName, "listings", "listing_id", "resourceVersions", "listing_resource_version"),
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					// The agreements are retrieved again, so only their listing is the same
+					if len(states) != 1 || states[0].Attributes["listing_id"] == "" || states[0].Attributes["signature"] == "" {
+						return fmt.Errorf("expected the agreements of the listing to be imported, got %v", states)
+					}
+					return nil
+				},
+				ResourceName: resourceName,
+			},
 		},
 	})
 }
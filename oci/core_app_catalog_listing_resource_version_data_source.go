@@ -71,7 +71,7 @@ func readSingularCoreAppCatalogListingResourceVersion(d *schema.ResourceData, m
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreAppCatalogListingResourceVersionDataSourceCrud struct {
@@ -84,7 +84,7 @@ func (s *CoreAppCatalogListingResourceVersionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreAppCatalogListingResourceVersionDataSourceCrud) Get() error {
+func (s *CoreAppCatalogListingResourceVersionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetAppCatalogListingResourceVersionRequest{}
 
 	if listingId, ok := s.D.GetOkExists("listing_id"); ok {
@@ -99,7 +99,7 @@ func (s *CoreAppCatalogListingResourceVersionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := s.Client.GetAppCatalogListingResourceVersion(context.Background(), request)
+	response, err := s.Client.GetAppCatalogListingResourceVersion(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
@@ -13,7 +14,8 @@ func CoreAppCatalogListingResourceVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreAppCatalogListingResourceVersions,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"listing_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -75,6 +77,14 @@ func CoreAppCatalogListingResourceVersionsDataSource() *schema.Resource {
 					},
 				},
 			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListAppCatalogListingResourceVersionsSortOrderAsc),
+					string(oci_core.ListAppCatalogListingResourceVersionsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -84,7 +94,7 @@ func readCoreAppCatalogListingResourceVersions(d *schema.ResourceData, m interfa
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreAppCatalogListingResourceVersionsDataSourceCrud struct {
@@ -97,7 +107,7 @@ func (s *CoreAppCatalogListingResourceVersionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreAppCatalogListingResourceVersionsDataSourceCrud) Get() error {
+func (s *CoreAppCatalogListingResourceVersionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListAppCatalogListingResourceVersionsRequest{}
 
 	if listingId, ok := s.D.GetOkExists("listing_id"); ok {
@@ -105,9 +115,14 @@ func (s *CoreAppCatalogListingResourceVersionsDataSourceCrud) Get() error {
 		request.ListingId = &tmp
 	}
 
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_core.ListAppCatalogListingResourceVersionsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListAppCatalogListingResourceVersions(context.Background(), request)
+	response, err := s.Client.ListAppCatalogListingResourceVersions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -115,8 +130,8 @@ func (s *CoreAppCatalogListingResourceVersionsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListAppCatalogListingResourceVersions(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListAppCatalogListingResourceVersions(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -160,6 +175,8 @@ func (s *CoreAppCatalogListingResourceVersionsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreAppCatalogListingResourceVersionsDataSource().Schema["app_catalog_listing_resource_versions"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("app_catalog_listing_resource_versions", resources); err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
@@ -13,7 +14,8 @@ func CoreAppCatalogListingsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreAppCatalogListings,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"display_name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -71,6 +73,14 @@ func CoreAppCatalogListingsDataSource() *schema.Resource {
 					},
 				},
 			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListAppCatalogListingsSortOrderAsc),
+					string(oci_core.ListAppCatalogListingsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -80,7 +90,7 @@ func readCoreAppCatalogListings(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreAppCatalogListingsDataSourceCrud struct {
@@ -93,7 +103,7 @@ func (s *CoreAppCatalogListingsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreAppCatalogListingsDataSourceCrud) Get() error {
+func (s *CoreAppCatalogListingsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListAppCatalogListingsRequest{}
 
 	if displayName, ok := s.D.GetOkExists("display_name"); ok {
@@ -111,9 +121,14 @@ func (s *CoreAppCatalogListingsDataSourceCrud) Get() error {
 		request.PublisherType = &tmp
 	}
 
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_core.ListAppCatalogListingsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListAppCatalogListings(context.Background(), request)
+	response, err := s.Client.ListAppCatalogListings(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -121,8 +136,8 @@ func (s *CoreAppCatalogListingsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListAppCatalogListings(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListAppCatalogListings(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -168,6 +183,8 @@ func (s *CoreAppCatalogListingsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreAppCatalogListingsDataSource().Schema["app_catalog_listings"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("app_catalog_listings", resources); err != nil {
 		return err
 	}
@@ -4,11 +4,7 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"net/url"
-	"regexp"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -93,7 +89,7 @@ func createCoreAppCatalogSubscription(d *schema.ResourceData, m interface{}) err
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreAppCatalogSubscription(d *schema.ResourceData, m interface{}) error {
@@ -101,7 +97,7 @@ func readCoreAppCatalogSubscription(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func deleteCoreAppCatalogSubscription(d *schema.ResourceData, m interface{}) error {
@@ -110,7 +106,7 @@ func deleteCoreAppCatalogSubscription(d *schema.ResourceData, m interface{}) err
 	sync.Client = m.(*OracleClients).computeClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreAppCatalogSubscriptionResourceCrud struct {
@@ -124,7 +120,7 @@ func (s *CoreAppCatalogSubscriptionResourceCrud) ID() string {
 	return getSubscriptionCompositeId(*s.Res.CompartmentId, *s.Res.ListingId, *s.Res.ListingResourceVersion)
 }
 
-func (s *CoreAppCatalogSubscriptionResourceCrud) Create() error {
+func (s *CoreAppCatalogSubscriptionResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateAppCatalogSubscriptionRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -164,17 +160,17 @@ func (s *CoreAppCatalogSubscriptionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.CreateAppCatalogSubscription(context.Background(), request)
+	_, err := s.Client.CreateAppCatalogSubscription(ctx, request)
 	if err != nil {
 		return err
 	}
 	retentionPolicyFunc := func() bool { return s.Res != nil && s.Res.TimeCreated != nil }
 	compositeId := getSubscriptionCompositeId(*request.CompartmentId, *request.ListingId, *request.ListingResourceVersion)
 	s.D.SetId(compositeId)
-	return WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutCreate))
+	return WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *CoreAppCatalogSubscriptionResourceCrud) Get() error {
+func (s *CoreAppCatalogSubscriptionResourceCrud) Get(ctx context.Context) error {
 	compartmentId, listingId, listingResourceVersion, err := parseSubscriptionCompositeId(s.D.Id())
 	if err != nil {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
@@ -187,7 +183,7 @@ func (s *CoreAppCatalogSubscriptionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.ListAppCatalogSubscriptions(context.Background(), request)
+	response, err := s.Client.ListAppCatalogSubscriptions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -212,7 +208,7 @@ func (s *CoreAppCatalogSubscriptionResourceCrud) Get() error {
 
 	for !isFound && response.OpcNextPage != nil {
 		request.Page = response.OpcNextPage
-		response, err := s.Client.ListAppCatalogSubscriptions(context.Background(), request)
+		response, err := s.Client.ListAppCatalogSubscriptions(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -238,7 +234,7 @@ func (s *CoreAppCatalogSubscriptionResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreAppCatalogSubscriptionResourceCrud) Delete() error {
+func (s *CoreAppCatalogSubscriptionResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteAppCatalogSubscriptionRequest{}
 	compartmentId, listingId, listingResourceVersion, err := parseSubscriptionCompositeId(s.D.Id())
 	if err != nil {
@@ -250,12 +246,12 @@ func (s *CoreAppCatalogSubscriptionResourceCrud) Delete() error {
 	request.ResourceVersion = &listingResourceVersion
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err = s.Client.DeleteAppCatalogSubscription(context.Background(), request)
+	_, err = s.Client.DeleteAppCatalogSubscription(ctx, request)
 	if err != nil {
 		return err
 	}
 	retentionPolicyFunc := func() bool { return s.Res == nil }
-	return WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutDelete))
+	return WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutDelete))
 }
 
 func (s *CoreAppCatalogSubscriptionResourceCrud) SetData() error {
@@ -306,22 +302,15 @@ func (s *CoreAppCatalogSubscriptionResourceCrud) SetData() error {
 }
 
 func parseSubscriptionCompositeId(compositeId string) (compartmentId string, listingId string, listingResourceVersion string, err error) {
-	parts := strings.Split(compositeId, "/")
-	match, _ := regexp.MatchString("compartmentId/.*/listingId/.*/listingResourceVersion/.*", compositeId)
-	if !match || len(parts) != 6 {
-		err = fmt.Errorf("illegal compositeId %s encountered", compositeId)
+	values, err := parseCompositeId(compositeId, "compartmentId", "listingId", "listingResourceVersion")
+	if err != nil {
 		return
 	}
-	compartmentId, _ = url.PathUnescape(parts[1])
-	listingId, _ = url.PathUnescape(parts[3])
-	listingResourceVersion, _ = url.PathUnescape(parts[5])
+	compartmentId, listingId, listingResourceVersion = values[0], values[1], values[2]
+
 	return
 }
 
 func getSubscriptionCompositeId(compartmentId string, listingId string, listingResourceVersion string) string {
-	compartmentId = url.PathEscape(compartmentId)
-	listingId = url.PathEscape(listingId)
-	listingResourceVersion = url.PathEscape(listingResourceVersion)
-	compositeId := "compartmentId/" + compartmentId + "/listingId/" + listingId + "/listingResourceVersion/" + listingResourceVersion
-	return compositeId
+	return getCompositeId("compartmentId", compartmentId, "listingId", listingId, "listingResourceVersion", listingResourceVersion)
 }
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
@@ -13,7 +14,8 @@ func CoreAppCatalogSubscriptionsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreAppCatalogSubscriptions,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -27,6 +29,22 @@ func CoreAppCatalogSubscriptionsDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     CoreAppCatalogSubscriptionResource(),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListAppCatalogSubscriptionsSortByTimecreated),
+					string(oci_core.ListAppCatalogSubscriptionsSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListAppCatalogSubscriptionsSortOrderAsc),
+					string(oci_core.ListAppCatalogSubscriptionsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -36,7 +54,7 @@ func readCoreAppCatalogSubscriptions(d *schema.ResourceData, m interface{}) erro
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreAppCatalogSubscriptionsDataSourceCrud struct {
@@ -49,7 +67,7 @@ func (s *CoreAppCatalogSubscriptionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreAppCatalogSubscriptionsDataSourceCrud) Get() error {
+func (s *CoreAppCatalogSubscriptionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListAppCatalogSubscriptionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -62,9 +80,18 @@ func (s *CoreAppCatalogSubscriptionsDataSourceCrud) Get() error {
 		request.ListingId = &tmp
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_core.ListAppCatalogSubscriptionsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_core.ListAppCatalogSubscriptionsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListAppCatalogSubscriptions(context.Background(), request)
+	response, err := s.Client.ListAppCatalogSubscriptions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -72,8 +99,8 @@ func (s *CoreAppCatalogSubscriptionsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListAppCatalogSubscriptions(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListAppCatalogSubscriptions(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -133,6 +160,8 @@ func (s *CoreAppCatalogSubscriptionsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreAppCatalogSubscriptionsDataSource().Schema["app_catalog_subscriptions"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("app_catalog_subscriptions", resources); err != nil {
 		return err
 	}
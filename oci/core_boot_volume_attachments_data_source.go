@@ -13,7 +13,8 @@ func CoreBootVolumeAttachmentsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreBootVolumeAttachments,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"availability_domain": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -92,7 +93,7 @@ func readCoreBootVolumeAttachments(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreBootVolumeAttachmentsDataSourceCrud struct {
@@ -105,7 +106,7 @@ func (s *CoreBootVolumeAttachmentsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreBootVolumeAttachmentsDataSourceCrud) Get() error {
+func (s *CoreBootVolumeAttachmentsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListBootVolumeAttachmentsRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -129,8 +130,9 @@ func (s *CoreBootVolumeAttachmentsDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListBootVolumeAttachments(context.Background(), request)
+	response, err := s.Client.ListBootVolumeAttachments(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -138,8 +140,8 @@ func (s *CoreBootVolumeAttachmentsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListBootVolumeAttachments(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListBootVolumeAttachments(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -198,6 +200,8 @@ func (s *CoreBootVolumeAttachmentsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreBootVolumeAttachmentsDataSource().Schema["boot_volume_attachments"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("boot_volume_attachments", resources); err != nil {
 		return err
 	}
@@ -86,7 +86,7 @@ func readSingularCoreBootVolumeBackup(d *schema.ResourceData, m interface{}) err
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreBootVolumeBackupDataSourceCrud struct {
@@ -99,7 +99,7 @@ func (s *CoreBootVolumeBackupDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreBootVolumeBackupDataSourceCrud) Get() error {
+func (s *CoreBootVolumeBackupDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetBootVolumeBackupRequest{}
 
 	if bootVolumeBackupId, ok := s.D.GetOkExists("boot_volume_backup_id"); ok {
@@ -109,7 +109,7 @@ func (s *CoreBootVolumeBackupDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := s.Client.GetBootVolumeBackup(context.Background(), request)
+	response, err := s.Client.GetBootVolumeBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -101,7 +101,7 @@ func createCoreBootVolumeBackup(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreBootVolumeBackup(d *schema.ResourceData, m interface{}) error {
@@ -109,7 +109,7 @@ func readCoreBootVolumeBackup(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreBootVolumeBackup(d *schema.ResourceData, m interface{}) error {
@@ -117,7 +117,7 @@ func updateCoreBootVolumeBackup(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCoreBootVolumeBackup(d *schema.ResourceData, m interface{}) error {
@@ -126,7 +126,7 @@ func deleteCoreBootVolumeBackup(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).blockstorageClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreBootVolumeBackupResourceCrud struct {
@@ -165,7 +165,7 @@ func (s *CoreBootVolumeBackupResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreBootVolumeBackupResourceCrud) Create() error {
+func (s *CoreBootVolumeBackupResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateBootVolumeBackupRequest{}
 
 	if bootVolumeId, ok := s.D.GetOkExists("boot_volume_id"); ok {
@@ -196,7 +196,7 @@ func (s *CoreBootVolumeBackupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateBootVolumeBackup(context.Background(), request)
+	response, err := s.Client.CreateBootVolumeBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -205,7 +205,7 @@ func (s *CoreBootVolumeBackupResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreBootVolumeBackupResourceCrud) Get() error {
+func (s *CoreBootVolumeBackupResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetBootVolumeBackupRequest{}
 
 	tmp := s.D.Id()
@@ -213,7 +213,7 @@ func (s *CoreBootVolumeBackupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetBootVolumeBackup(context.Background(), request)
+	response, err := s.Client.GetBootVolumeBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -222,7 +222,7 @@ func (s *CoreBootVolumeBackupResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreBootVolumeBackupResourceCrud) Update() error {
+func (s *CoreBootVolumeBackupResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateBootVolumeBackupRequest{}
 
 	tmp := s.D.Id()
@@ -247,7 +247,7 @@ func (s *CoreBootVolumeBackupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateBootVolumeBackup(context.Background(), request)
+	response, err := s.Client.UpdateBootVolumeBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -256,7 +256,7 @@ func (s *CoreBootVolumeBackupResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreBootVolumeBackupResourceCrud) Delete() error {
+func (s *CoreBootVolumeBackupResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteBootVolumeBackupRequest{}
 
 	tmp := s.D.Id()
@@ -264,7 +264,7 @@ func (s *CoreBootVolumeBackupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteBootVolumeBackup(context.Background(), request)
+	_, err := s.Client.DeleteBootVolumeBackup(ctx, request)
 	return err
 }
 
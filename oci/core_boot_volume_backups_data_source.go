@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
@@ -14,7 +15,8 @@ func CoreBootVolumeBackupsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreBootVolumeBackups,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"boot_volume_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -36,6 +38,22 @@ func CoreBootVolumeBackupsDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(CoreBootVolumeBackupResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListBootVolumeBackupsSortByTimecreated),
+					string(oci_core.ListBootVolumeBackupsSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListBootVolumeBackupsSortOrderAsc),
+					string(oci_core.ListBootVolumeBackupsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -45,7 +63,7 @@ func readCoreBootVolumeBackups(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreBootVolumeBackupsDataSourceCrud struct {
@@ -58,7 +76,7 @@ func (s *CoreBootVolumeBackupsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreBootVolumeBackupsDataSourceCrud) Get() error {
+func (s *CoreBootVolumeBackupsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListBootVolumeBackupsRequest{}
 
 	if bootVolumeId, ok := s.D.GetOkExists("boot_volume_id"); ok {
@@ -80,9 +98,18 @@ func (s *CoreBootVolumeBackupsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_core.BootVolumeBackupLifecycleStateEnum(state.(string))
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_core.ListBootVolumeBackupsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_core.ListBootVolumeBackupsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListBootVolumeBackups(context.Background(), request)
+	response, err := s.Client.ListBootVolumeBackups(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -90,8 +117,8 @@ func (s *CoreBootVolumeBackupsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListBootVolumeBackups(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListBootVolumeBackups(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -171,6 +198,8 @@ func (s *CoreBootVolumeBackupsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreBootVolumeBackupsDataSource().Schema["boot_volume_backups"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("boot_volume_backups", resources); err != nil {
 		return err
 	}
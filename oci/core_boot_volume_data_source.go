@@ -111,7 +111,7 @@ func readSingularCoreBootVolume(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreBootVolumeDataSourceCrud struct {
@@ -124,7 +124,7 @@ func (s *CoreBootVolumeDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreBootVolumeDataSourceCrud) Get() error {
+func (s *CoreBootVolumeDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetBootVolumeRequest{}
 
 	if bootVolumeId, ok := s.D.GetOkExists("boot_volume_id"); ok {
@@ -134,7 +134,7 @@ func (s *CoreBootVolumeDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := s.Client.GetBootVolume(context.Background(), request)
+	response, err := s.Client.GetBootVolume(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -142,7 +142,7 @@ func createCoreBootVolume(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreBootVolume(d *schema.ResourceData, m interface{}) error {
@@ -150,7 +150,7 @@ func readCoreBootVolume(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreBootVolume(d *schema.ResourceData, m interface{}) error {
@@ -158,7 +158,7 @@ func updateCoreBootVolume(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCoreBootVolume(d *schema.ResourceData, m interface{}) error {
@@ -167,7 +167,7 @@ func deleteCoreBootVolume(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).blockstorageClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreBootVolumeResourceCrud struct {
@@ -218,7 +218,7 @@ func (s *CoreBootVolumeResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *CoreBootVolumeResourceCrud) Create() error {
+func (s *CoreBootVolumeResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateBootVolumeRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -280,7 +280,7 @@ func (s *CoreBootVolumeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateBootVolume(context.Background(), request)
+	response, err := s.Client.CreateBootVolume(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -289,7 +289,7 @@ func (s *CoreBootVolumeResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreBootVolumeResourceCrud) Get() error {
+func (s *CoreBootVolumeResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetBootVolumeRequest{}
 
 	tmp := s.D.Id()
@@ -297,7 +297,7 @@ func (s *CoreBootVolumeResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetBootVolume(context.Background(), request)
+	response, err := s.Client.GetBootVolume(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -306,7 +306,7 @@ func (s *CoreBootVolumeResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreBootVolumeResourceCrud) Update() error {
+func (s *CoreBootVolumeResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateBootVolumeRequest{}
 
 	tmp := s.D.Id()
@@ -340,7 +340,7 @@ func (s *CoreBootVolumeResourceCrud) Update() error {
 
 		keyUpdateRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-		_, err := s.Client.UpdateBootVolumeKmsKey(context.Background(), keyUpdateRequest)
+		_, err := s.Client.UpdateBootVolumeKmsKey(ctx, keyUpdateRequest)
 		if err != nil {
 			return err
 		}
@@ -357,7 +357,7 @@ func (s *CoreBootVolumeResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateBootVolume(context.Background(), request)
+	response, err := s.Client.UpdateBootVolume(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -366,7 +366,7 @@ func (s *CoreBootVolumeResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreBootVolumeResourceCrud) Delete() error {
+func (s *CoreBootVolumeResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteBootVolumeRequest{}
 
 	tmp := s.D.Id()
@@ -374,7 +374,7 @@ func (s *CoreBootVolumeResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteBootVolume(context.Background(), request)
+	_, err := s.Client.DeleteBootVolume(ctx, request)
 	return err
 }
 
@@ -438,7 +438,7 @@ func (s *CoreBootVolumeResourceCrud) SetData() error {
 	}
 
 	// Add backup policy id from the other API
-	backupPolicyId, err := getBackupPolicyId(s.Res.Id, s.Client)
+	backupPolicyId, err := getBackupPolicyId(s.OperationContext(), s.Res.Id, s.Client)
 	if err != nil {
 		log.Printf("[ERROR] Received an error when fetching backup policy id %v", err)
 	} else if backupPolicyId != nil {
@@ -14,7 +14,8 @@ func CoreBootVolumesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreBootVolumes,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"availability_domain": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -41,7 +42,7 @@ func readCoreBootVolumes(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreBootVolumesDataSourceCrud struct {
@@ -54,7 +55,7 @@ func (s *CoreBootVolumesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreBootVolumesDataSourceCrud) Get() error {
+func (s *CoreBootVolumesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListBootVolumesRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -73,8 +74,9 @@ func (s *CoreBootVolumesDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListBootVolumes(context.Background(), request)
+	response, err := s.Client.ListBootVolumes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -82,8 +84,8 @@ func (s *CoreBootVolumesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListBootVolumes(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListBootVolumes(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -170,6 +172,8 @@ func (s *CoreBootVolumesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreBootVolumesDataSource().Schema["boot_volumes"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("boot_volumes", resources); err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
@@ -13,7 +14,8 @@ func CoreConsoleHistoriesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreConsoleHistories,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"availability_domain": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -35,6 +37,22 @@ func CoreConsoleHistoriesDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(CoreConsoleHistoryResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListConsoleHistoriesSortByTimecreated),
+					string(oci_core.ListConsoleHistoriesSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListConsoleHistoriesSortOrderAsc),
+					string(oci_core.ListConsoleHistoriesSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -44,7 +62,7 @@ func readCoreConsoleHistories(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreConsoleHistoriesDataSourceCrud struct {
@@ -57,7 +75,7 @@ func (s *CoreConsoleHistoriesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreConsoleHistoriesDataSourceCrud) Get() error {
+func (s *CoreConsoleHistoriesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListConsoleHistoriesRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -79,9 +97,18 @@ func (s *CoreConsoleHistoriesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_core.ConsoleHistoryLifecycleStateEnum(state.(string))
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_core.ListConsoleHistoriesSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_core.ListConsoleHistoriesSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListConsoleHistories(context.Background(), request)
+	response, err := s.Client.ListConsoleHistories(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -89,8 +116,8 @@ func (s *CoreConsoleHistoriesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListConsoleHistories(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListConsoleHistories(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -150,6 +177,8 @@ func (s *CoreConsoleHistoriesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreConsoleHistoriesDataSource().Schema["console_histories"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("console_histories", resources); err != nil {
 		return err
 	}
@@ -55,7 +55,7 @@ func readSingularCoreConsoleHistoryContent(d *schema.ResourceData, m interface{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreConsoleHistoryContentDataSourceCrud struct {
@@ -68,7 +68,7 @@ func (s *CoreConsoleHistoryContentDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreConsoleHistoryContentDataSourceCrud) Get() error {
+func (s *CoreConsoleHistoryContentDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetConsoleHistoryContentRequest{}
 
 	if consoleHistoryId, ok := s.D.GetOkExists("console_history_id"); ok {
@@ -88,7 +88,7 @@ func (s *CoreConsoleHistoryContentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := s.Client.GetConsoleHistoryContent(context.Background(), request)
+	response, err := s.Client.GetConsoleHistoryContent(ctx, request)
 	if err != nil {
 		return err
 	}
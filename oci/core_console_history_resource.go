@@ -74,7 +74,7 @@ func createCoreConsoleHistory(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreConsoleHistory(d *schema.ResourceData, m interface{}) error {
@@ -82,7 +82,7 @@ func readCoreConsoleHistory(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreConsoleHistory(d *schema.ResourceData, m interface{}) error {
@@ -90,7 +90,7 @@ func updateCoreConsoleHistory(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCoreConsoleHistory(d *schema.ResourceData, m interface{}) error {
@@ -99,7 +99,7 @@ func deleteCoreConsoleHistory(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).computeClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreConsoleHistoryResourceCrud struct {
@@ -126,7 +126,7 @@ func (s *CoreConsoleHistoryResourceCrud) CreatedTarget() []string {
 	}
 }
 
-func (s *CoreConsoleHistoryResourceCrud) Create() error {
+func (s *CoreConsoleHistoryResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CaptureConsoleHistoryRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -153,7 +153,7 @@ func (s *CoreConsoleHistoryResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CaptureConsoleHistory(context.Background(), request)
+	response, err := s.Client.CaptureConsoleHistory(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -162,7 +162,7 @@ func (s *CoreConsoleHistoryResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreConsoleHistoryResourceCrud) Get() error {
+func (s *CoreConsoleHistoryResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetConsoleHistoryRequest{}
 
 	tmp := s.D.Id()
@@ -170,7 +170,7 @@ func (s *CoreConsoleHistoryResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetConsoleHistory(context.Background(), request)
+	response, err := s.Client.GetConsoleHistory(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -179,7 +179,7 @@ func (s *CoreConsoleHistoryResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreConsoleHistoryResourceCrud) Update() error {
+func (s *CoreConsoleHistoryResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateConsoleHistoryRequest{}
 
 	tmp := s.D.Id()
@@ -204,7 +204,7 @@ func (s *CoreConsoleHistoryResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateConsoleHistory(context.Background(), request)
+	response, err := s.Client.UpdateConsoleHistory(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -213,7 +213,7 @@ func (s *CoreConsoleHistoryResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreConsoleHistoryResourceCrud) Delete() error {
+func (s *CoreConsoleHistoryResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteConsoleHistoryRequest{}
 
 	tmp := s.D.Id()
@@ -221,7 +221,7 @@ func (s *CoreConsoleHistoryResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteConsoleHistory(context.Background(), request)
+	_, err := s.Client.DeleteConsoleHistory(ctx, request)
 	return err
 }
 
@@ -67,7 +67,7 @@ func createCoreCpe(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreCpe(d *schema.ResourceData, m interface{}) error {
@@ -75,7 +75,7 @@ func readCoreCpe(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreCpe(d *schema.ResourceData, m interface{}) error {
@@ -83,7 +83,7 @@ func updateCoreCpe(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCoreCpe(d *schema.ResourceData, m interface{}) error {
@@ -92,7 +92,7 @@ func deleteCoreCpe(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreCpeResourceCrud struct {
@@ -106,7 +106,7 @@ func (s *CoreCpeResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *CoreCpeResourceCrud) Create() error {
+func (s *CoreCpeResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateCpeRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -138,7 +138,7 @@ func (s *CoreCpeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateCpe(context.Background(), request)
+	response, err := s.Client.CreateCpe(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -147,7 +147,7 @@ func (s *CoreCpeResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreCpeResourceCrud) Get() error {
+func (s *CoreCpeResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetCpeRequest{}
 
 	tmp := s.D.Id()
@@ -155,7 +155,7 @@ func (s *CoreCpeResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetCpe(context.Background(), request)
+	response, err := s.Client.GetCpe(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -164,7 +164,7 @@ func (s *CoreCpeResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreCpeResourceCrud) Update() error {
+func (s *CoreCpeResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateCpeRequest{}
 
 	tmp := s.D.Id()
@@ -189,7 +189,7 @@ func (s *CoreCpeResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateCpe(context.Background(), request)
+	response, err := s.Client.UpdateCpe(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -198,7 +198,7 @@ func (s *CoreCpeResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreCpeResourceCrud) Delete() error {
+func (s *CoreCpeResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteCpeRequest{}
 
 	tmp := s.D.Id()
@@ -206,7 +206,7 @@ func (s *CoreCpeResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteCpe(context.Background(), request)
+	_, err := s.Client.DeleteCpe(ctx, request)
 	return err
 }
 
@@ -13,7 +13,8 @@ func CoreCpesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreCpes,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -42,7 +43,7 @@ func readCoreCpes(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreCpesDataSourceCrud struct {
@@ -55,7 +56,7 @@ func (s *CoreCpesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreCpesDataSourceCrud) Get() error {
+func (s *CoreCpesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListCpesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -74,8 +75,9 @@ func (s *CoreCpesDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListCpes(context.Background(), request)
+	response, err := s.Client.ListCpes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -83,8 +85,8 @@ func (s *CoreCpesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListCpes(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListCpes(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -138,6 +140,8 @@ func (s *CoreCpesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreCpesDataSource().Schema["cpes"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("cpes", resources); err != nil {
 		return err
 	}
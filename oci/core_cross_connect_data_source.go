@@ -63,7 +63,7 @@ func readSingularCoreCrossConnect(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreCrossConnectDataSourceCrud struct {
@@ -76,7 +76,7 @@ func (s *CoreCrossConnectDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreCrossConnectDataSourceCrud) Get() error {
+func (s *CoreCrossConnectDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetCrossConnectRequest{}
 
 	if crossConnectId, ok := s.D.GetOkExists("cross_connect_id"); ok {
@@ -86,7 +86,7 @@ func (s *CoreCrossConnectDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := s.Client.GetCrossConnect(context.Background(), request)
+	response, err := s.Client.GetCrossConnect(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -47,7 +47,7 @@ func readSingularCoreCrossConnectGroup(d *schema.ResourceData, m interface{}) er
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreCrossConnectGroupDataSourceCrud struct {
@@ -60,7 +60,7 @@ func (s *CoreCrossConnectGroupDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreCrossConnectGroupDataSourceCrud) Get() error {
+func (s *CoreCrossConnectGroupDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetCrossConnectGroupRequest{}
 
 	if crossConnectGroupId, ok := s.D.GetOkExists("cross_connect_group_id"); ok {
@@ -70,7 +70,7 @@ func (s *CoreCrossConnectGroupDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := s.Client.GetCrossConnectGroup(context.Background(), request)
+	response, err := s.Client.GetCrossConnectGroup(ctx, request)
 	if err != nil {
 		return err
 	}
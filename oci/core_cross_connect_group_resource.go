@@ -58,7 +58,7 @@ func createCoreCrossConnectGroup(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreCrossConnectGroup(d *schema.ResourceData, m interface{}) error {
@@ -66,7 +66,7 @@ func readCoreCrossConnectGroup(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreCrossConnectGroup(d *schema.ResourceData, m interface{}) error {
@@ -74,7 +74,7 @@ func updateCoreCrossConnectGroup(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCoreCrossConnectGroup(d *schema.ResourceData, m interface{}) error {
@@ -83,7 +83,7 @@ func deleteCoreCrossConnectGroup(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreCrossConnectGroupResourceCrud struct {
@@ -135,7 +135,7 @@ func (s *CoreCrossConnectGroupResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreCrossConnectGroupResourceCrud) Create() error {
+func (s *CoreCrossConnectGroupResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateCrossConnectGroupRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -155,7 +155,7 @@ func (s *CoreCrossConnectGroupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateCrossConnectGroup(context.Background(), request)
+	response, err := s.Client.CreateCrossConnectGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -164,7 +164,7 @@ func (s *CoreCrossConnectGroupResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreCrossConnectGroupResourceCrud) Get() error {
+func (s *CoreCrossConnectGroupResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetCrossConnectGroupRequest{}
 
 	tmp := s.D.Id()
@@ -172,7 +172,7 @@ func (s *CoreCrossConnectGroupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetCrossConnectGroup(context.Background(), request)
+	response, err := s.Client.GetCrossConnectGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -181,7 +181,7 @@ func (s *CoreCrossConnectGroupResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreCrossConnectGroupResourceCrud) Update() error {
+func (s *CoreCrossConnectGroupResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateCrossConnectGroupRequest{}
 
 	tmp := s.D.Id()
@@ -199,7 +199,7 @@ func (s *CoreCrossConnectGroupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateCrossConnectGroup(context.Background(), request)
+	response, err := s.Client.UpdateCrossConnectGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -208,7 +208,7 @@ func (s *CoreCrossConnectGroupResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreCrossConnectGroupResourceCrud) Delete() error {
+func (s *CoreCrossConnectGroupResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteCrossConnectGroupRequest{}
 
 	tmp := s.D.Id()
@@ -216,7 +216,7 @@ func (s *CoreCrossConnectGroupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteCrossConnectGroup(context.Background(), request)
+	_, err := s.Client.DeleteCrossConnectGroup(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
@@ -13,7 +14,8 @@ func CoreCrossConnectGroupsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreCrossConnectGroups,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -31,6 +33,22 @@ func CoreCrossConnectGroupsDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(CoreCrossConnectGroupResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListCrossConnectGroupsSortByTimecreated),
+					string(oci_core.ListCrossConnectGroupsSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListCrossConnectGroupsSortOrderAsc),
+					string(oci_core.ListCrossConnectGroupsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -40,7 +58,7 @@ func readCoreCrossConnectGroups(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreCrossConnectGroupsDataSourceCrud struct {
@@ -53,7 +71,7 @@ func (s *CoreCrossConnectGroupsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreCrossConnectGroupsDataSourceCrud) Get() error {
+func (s *CoreCrossConnectGroupsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListCrossConnectGroupsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -70,9 +88,18 @@ func (s *CoreCrossConnectGroupsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_core.CrossConnectGroupLifecycleStateEnum(state.(string))
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_core.ListCrossConnectGroupsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_core.ListCrossConnectGroupsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListCrossConnectGroups(context.Background(), request)
+	response, err := s.Client.ListCrossConnectGroups(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -80,8 +107,8 @@ func (s *CoreCrossConnectGroupsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListCrossConnectGroups(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListCrossConnectGroups(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -131,6 +158,8 @@ func (s *CoreCrossConnectGroupsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreCrossConnectGroupsDataSource().Schema["cross_connect_groups"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("cross_connect_groups", resources); err != nil {
 		return err
 	}
@@ -13,7 +13,8 @@ func CoreCrossConnectLocationsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreCrossConnectLocations,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -48,7 +49,7 @@ func readCoreCrossConnectLocations(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreCrossConnectLocationsDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *CoreCrossConnectLocationsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreCrossConnectLocationsDataSourceCrud) Get() error {
+func (s *CoreCrossConnectLocationsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListCrossConnectLocationsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -70,8 +71,9 @@ func (s *CoreCrossConnectLocationsDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListCrossConnectLocations(context.Background(), request)
+	response, err := s.Client.ListCrossConnectLocations(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -79,8 +81,8 @@ func (s *CoreCrossConnectLocationsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListCrossConnectLocations(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListCrossConnectLocations(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -118,6 +120,8 @@ func (s *CoreCrossConnectLocationsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreCrossConnectLocationsDataSource().Schema["cross_connect_locations"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("cross_connect_locations", resources); err != nil {
 		return err
 	}
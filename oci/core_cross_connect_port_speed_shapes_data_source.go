@@ -13,7 +13,8 @@ func CoreCrossConnectPortSpeedShapesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreCrossConnectPortSpeedShapes,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -48,7 +49,7 @@ func readCoreCrossConnectPortSpeedShapes(d *schema.ResourceData, m interface{})
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreCrossConnectPortSpeedShapesDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *CoreCrossConnectPortSpeedShapesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreCrossConnectPortSpeedShapesDataSourceCrud) Get() error {
+func (s *CoreCrossConnectPortSpeedShapesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListCrossconnectPortSpeedShapesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -70,8 +71,9 @@ func (s *CoreCrossConnectPortSpeedShapesDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListCrossconnectPortSpeedShapes(context.Background(), request)
+	response, err := s.Client.ListCrossconnectPortSpeedShapes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -79,8 +81,8 @@ func (s *CoreCrossConnectPortSpeedShapesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListCrossconnectPortSpeedShapes(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListCrossconnectPortSpeedShapes(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -118,6 +120,8 @@ func (s *CoreCrossConnectPortSpeedShapesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreCrossConnectPortSpeedShapesDataSource().Schema["cross_connect_port_speed_shapes"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("cross_connect_port_speed_shapes", resources); err != nil {
 		return err
 	}
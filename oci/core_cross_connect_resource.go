@@ -96,7 +96,8 @@ func createCoreCrossConnect(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	err := CreateResource(d, sync)
+	ctx := m.(*OracleClients).StopContext()
+	err := CreateResource(ctx, d, sync)
 	if err != nil {
 		return err
 	}
@@ -104,7 +105,7 @@ func createCoreCrossConnect(d *schema.ResourceData, m interface{}) error {
 	// Issue an Update if 'is_active' is set to true
 	if _, ok := sync.D.GetOkExists("is_active"); ok {
 		log.Printf("[DEBUG] CrossConnect resource is set to be active, calling 'Update' for the resource")
-		return UpdateResource(d, sync)
+		return UpdateResource(ctx, d, sync)
 	}
 
 	return nil
@@ -115,7 +116,7 @@ func readCoreCrossConnect(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreCrossConnect(d *schema.ResourceData, m interface{}) error {
@@ -123,7 +124,7 @@ func updateCoreCrossConnect(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCoreCrossConnect(d *schema.ResourceData, m interface{}) error {
@@ -132,7 +133,7 @@ func deleteCoreCrossConnect(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreCrossConnectResourceCrud struct {
@@ -191,7 +192,7 @@ func (s *CoreCrossConnectResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreCrossConnectResourceCrud) Create() error {
+func (s *CoreCrossConnectResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateCrossConnectRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -236,7 +237,7 @@ func (s *CoreCrossConnectResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateCrossConnect(context.Background(), request)
+	response, err := s.Client.CreateCrossConnect(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -246,7 +247,7 @@ func (s *CoreCrossConnectResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreCrossConnectResourceCrud) Get() error {
+func (s *CoreCrossConnectResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetCrossConnectRequest{}
 
 	tmp := s.D.Id()
@@ -254,7 +255,7 @@ func (s *CoreCrossConnectResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetCrossConnect(context.Background(), request)
+	response, err := s.Client.GetCrossConnect(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -263,7 +264,7 @@ func (s *CoreCrossConnectResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreCrossConnectResourceCrud) Update() error {
+func (s *CoreCrossConnectResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateCrossConnectRequest{}
 
 	tmp := s.D.Id()
@@ -290,7 +291,7 @@ func (s *CoreCrossConnectResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateCrossConnect(context.Background(), request)
+	response, err := s.Client.UpdateCrossConnect(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -299,7 +300,7 @@ func (s *CoreCrossConnectResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreCrossConnectResourceCrud) Delete() error {
+func (s *CoreCrossConnectResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteCrossConnectRequest{}
 
 	tmp := s.D.Id()
@@ -307,7 +308,7 @@ func (s *CoreCrossConnectResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteCrossConnect(context.Background(), request)
+	_, err := s.Client.DeleteCrossConnect(ctx, request)
 	return err
 }
 
@@ -39,7 +39,7 @@ func readSingularCoreCrossConnectStatus(d *schema.ResourceData, m interface{}) e
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreCrossConnectStatusDataSourceCrud struct {
@@ -52,7 +52,7 @@ func (s *CoreCrossConnectStatusDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreCrossConnectStatusDataSourceCrud) Get() error {
+func (s *CoreCrossConnectStatusDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetCrossConnectStatusRequest{}
 
 	if crossConnectId, ok := s.D.GetOkExists("cross_connect_id"); ok {
@@ -62,7 +62,7 @@ func (s *CoreCrossConnectStatusDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := s.Client.GetCrossConnectStatus(context.Background(), request)
+	response, err := s.Client.GetCrossConnectStatus(ctx, request)
 	if err != nil {
 		return err
 	}
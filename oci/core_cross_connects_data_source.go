@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
@@ -13,7 +14,8 @@ func CoreCrossConnectsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreCrossConnects,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -35,6 +37,22 @@ func CoreCrossConnectsDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(CoreCrossConnectResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListCrossConnectsSortByTimecreated),
+					string(oci_core.ListCrossConnectsSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListCrossConnectsSortOrderAsc),
+					string(oci_core.ListCrossConnectsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -44,7 +62,7 @@ func readCoreCrossConnects(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreCrossConnectsDataSourceCrud struct {
@@ -57,7 +75,7 @@ func (s *CoreCrossConnectsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreCrossConnectsDataSourceCrud) Get() error {
+func (s *CoreCrossConnectsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListCrossConnectsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -79,9 +97,18 @@ func (s *CoreCrossConnectsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_core.CrossConnectLifecycleStateEnum(state.(string))
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_core.ListCrossConnectsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_core.ListCrossConnectsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListCrossConnects(context.Background(), request)
+	response, err := s.Client.ListCrossConnects(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -89,8 +116,8 @@ func (s *CoreCrossConnectsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListCrossConnects(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListCrossConnects(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -156,6 +183,8 @@ func (s *CoreCrossConnectsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreCrossConnectsDataSource().Schema["cross_connects"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("cross_connects", resources); err != nil {
 		return err
 	}
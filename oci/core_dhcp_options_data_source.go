@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
@@ -13,7 +14,8 @@ func CoreDhcpOptionsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreDhcpOptionsList,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -45,6 +47,22 @@ func CoreDhcpOptionsDataSource() *schema.Resource {
 				Optional:   true,
 				Deprecated: FieldDeprecated("page"),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListDhcpOptionsSortByTimecreated),
+					string(oci_core.ListDhcpOptionsSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListDhcpOptionsSortOrderAsc),
+					string(oci_core.ListDhcpOptionsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -54,7 +72,7 @@ func readCoreDhcpOptionsList(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreDhcpOptionsDataSourceCrud struct {
@@ -67,7 +85,7 @@ func (s *CoreDhcpOptionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreDhcpOptionsDataSourceCrud) Get() error {
+func (s *CoreDhcpOptionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListDhcpOptionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -99,9 +117,18 @@ func (s *CoreDhcpOptionsDataSourceCrud) Get() error {
 		request.Page = &tmp
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_core.ListDhcpOptionsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_core.ListDhcpOptionsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListDhcpOptions(context.Background(), request)
+	response, err := s.Client.ListDhcpOptions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -109,8 +136,8 @@ func (s *CoreDhcpOptionsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListDhcpOptions(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListDhcpOptions(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -169,6 +196,8 @@ func (s *CoreDhcpOptionsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreDhcpOptionsDataSource().Schema["options"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("options", resources); err != nil {
 		return err
 	}
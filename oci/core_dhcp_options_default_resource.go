@@ -30,7 +30,7 @@ func createDefaultDhcpOptions(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteDefaultDhcpOptions(d *schema.ResourceData, m interface{}) error {
@@ -39,15 +39,15 @@ func deleteDefaultDhcpOptions(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
-func (s *DefaultDhcpOptionsResourceCrud) Create() error {
+func (s *DefaultDhcpOptionsResourceCrud) Create(ctx context.Context) error {
 	// If we are creating a default resource, then don't have to
 	// actually create it. Just set the ID and update it.
 	if defaultId, ok := s.D.GetOkExists("manage_default_resource_id"); ok {
 		s.D.SetId(defaultId.(string))
-		return s.Update()
+		return s.Update(ctx)
 	}
 
 	return fmt.Errorf("Default resource does not have a manage_default_resource_id set")
@@ -55,7 +55,7 @@ func (s *DefaultDhcpOptionsResourceCrud) Create() error {
 
 // This creates a DHCP option with no dns servers
 // This is used to clear out default DHCP options resources that can't otherwise be deleted
-func (s *DefaultDhcpOptionsResourceCrud) reset() error {
+func (s *DefaultDhcpOptionsResourceCrud) reset(ctx context.Context) error {
 	request := oci_core.UpdateDhcpOptionsRequest{}
 
 	tmp := s.D.Id()
@@ -70,7 +70,7 @@ func (s *DefaultDhcpOptionsResourceCrud) reset() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateDhcpOptions(context.Background(), request)
+	response, err := s.Client.UpdateDhcpOptions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -79,11 +79,11 @@ func (s *DefaultDhcpOptionsResourceCrud) reset() error {
 	return nil
 }
 
-func (s *DefaultDhcpOptionsResourceCrud) Delete() error {
+func (s *DefaultDhcpOptionsResourceCrud) Delete(ctx context.Context) error {
 	if _, ok := s.D.GetOkExists("manage_default_resource_id"); ok {
 		// We can't actually delete a default resource.
 		// Clear out its settings and mark it as deleted.
-		err := s.reset()
+		err := s.reset(ctx)
 		s.D.Set("state", s.DeletedTarget()[0])
 		return err
 	}
@@ -122,7 +122,7 @@ func createCoreDhcpOptions(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreDhcpOptions(d *schema.ResourceData, m interface{}) error {
@@ -130,7 +130,7 @@ func readCoreDhcpOptions(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreDhcpOptions(d *schema.ResourceData, m interface{}) error {
@@ -138,7 +138,7 @@ func updateCoreDhcpOptions(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCoreDhcpOptions(d *schema.ResourceData, m interface{}) error {
@@ -147,7 +147,7 @@ func deleteCoreDhcpOptions(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreDhcpOptionsResourceCrud struct {
@@ -185,7 +185,7 @@ func (s *CoreDhcpOptionsResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreDhcpOptionsResourceCrud) Create() error {
+func (s *CoreDhcpOptionsResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateDhcpOptionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -233,7 +233,7 @@ func (s *CoreDhcpOptionsResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateDhcpOptions(context.Background(), request)
+	response, err := s.Client.CreateDhcpOptions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -242,7 +242,7 @@ func (s *CoreDhcpOptionsResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreDhcpOptionsResourceCrud) Get() error {
+func (s *CoreDhcpOptionsResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetDhcpOptionsRequest{}
 
 	tmp := s.D.Id()
@@ -250,7 +250,7 @@ func (s *CoreDhcpOptionsResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetDhcpOptions(context.Background(), request)
+	response, err := s.Client.GetDhcpOptions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -259,7 +259,7 @@ func (s *CoreDhcpOptionsResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreDhcpOptionsResourceCrud) Update() error {
+func (s *CoreDhcpOptionsResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateDhcpOptionsRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -300,7 +300,7 @@ func (s *CoreDhcpOptionsResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateDhcpOptions(context.Background(), request)
+	response, err := s.Client.UpdateDhcpOptions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -309,7 +309,7 @@ func (s *CoreDhcpOptionsResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreDhcpOptionsResourceCrud) Delete() error {
+func (s *CoreDhcpOptionsResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteDhcpOptionsRequest{}
 
 	tmp := s.D.Id()
@@ -317,7 +317,7 @@ func (s *CoreDhcpOptionsResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteDhcpOptions(context.Background(), request)
+	_, err := s.Client.DeleteDhcpOptions(ctx, request)
 	return err
 }
 
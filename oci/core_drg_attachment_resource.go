@@ -71,7 +71,7 @@ func createCoreDrgAttachment(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreDrgAttachment(d *schema.ResourceData, m interface{}) error {
@@ -79,7 +79,7 @@ func readCoreDrgAttachment(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreDrgAttachment(d *schema.ResourceData, m interface{}) error {
@@ -87,7 +87,7 @@ func updateCoreDrgAttachment(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCoreDrgAttachment(d *schema.ResourceData, m interface{}) error {
@@ -96,7 +96,7 @@ func deleteCoreDrgAttachment(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreDrgAttachmentResourceCrud struct {
@@ -134,7 +134,7 @@ func (s *CoreDrgAttachmentResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreDrgAttachmentResourceCrud) Create() error {
+func (s *CoreDrgAttachmentResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateDrgAttachmentRequest{}
 
 	if displayName, ok := s.D.GetOkExists("display_name"); ok {
@@ -159,7 +159,7 @@ func (s *CoreDrgAttachmentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateDrgAttachment(context.Background(), request)
+	response, err := s.Client.CreateDrgAttachment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -168,7 +168,7 @@ func (s *CoreDrgAttachmentResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreDrgAttachmentResourceCrud) Get() error {
+func (s *CoreDrgAttachmentResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetDrgAttachmentRequest{}
 
 	tmp := s.D.Id()
@@ -176,7 +176,7 @@ func (s *CoreDrgAttachmentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetDrgAttachment(context.Background(), request)
+	response, err := s.Client.GetDrgAttachment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -185,7 +185,7 @@ func (s *CoreDrgAttachmentResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreDrgAttachmentResourceCrud) Update() error {
+func (s *CoreDrgAttachmentResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateDrgAttachmentRequest{}
 
 	if displayName, ok := s.D.GetOkExists("display_name"); ok {
@@ -203,7 +203,7 @@ func (s *CoreDrgAttachmentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateDrgAttachment(context.Background(), request)
+	response, err := s.Client.UpdateDrgAttachment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -212,7 +212,7 @@ func (s *CoreDrgAttachmentResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreDrgAttachmentResourceCrud) Delete() error {
+func (s *CoreDrgAttachmentResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteDrgAttachmentRequest{}
 
 	tmp := s.D.Id()
@@ -220,7 +220,7 @@ func (s *CoreDrgAttachmentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteDrgAttachment(context.Background(), request)
+	_, err := s.Client.DeleteDrgAttachment(ctx, request)
 	return err
 }
 
@@ -13,7 +13,8 @@ func CoreDrgAttachmentsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreDrgAttachments,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -50,7 +51,7 @@ func readCoreDrgAttachments(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreDrgAttachmentsDataSourceCrud struct {
@@ -63,7 +64,7 @@ func (s *CoreDrgAttachmentsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreDrgAttachmentsDataSourceCrud) Get() error {
+func (s *CoreDrgAttachmentsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListDrgAttachmentsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -92,8 +93,9 @@ func (s *CoreDrgAttachmentsDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListDrgAttachments(context.Background(), request)
+	response, err := s.Client.ListDrgAttachments(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -101,8 +103,8 @@ func (s *CoreDrgAttachmentsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListDrgAttachments(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListDrgAttachments(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -160,6 +162,8 @@ func (s *CoreDrgAttachmentsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreDrgAttachmentsDataSource().Schema["drg_attachments"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("drg_attachments", resources); err != nil {
 		return err
 	}
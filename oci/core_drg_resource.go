@@ -66,7 +66,7 @@ func createCoreDrg(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreDrg(d *schema.ResourceData, m interface{}) error {
@@ -74,7 +74,7 @@ func readCoreDrg(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreDrg(d *schema.ResourceData, m interface{}) error {
@@ -82,7 +82,7 @@ func updateCoreDrg(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCoreDrg(d *schema.ResourceData, m interface{}) error {
@@ -91,7 +91,7 @@ func deleteCoreDrg(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreDrgResourceCrud struct {
@@ -129,7 +129,7 @@ func (s *CoreDrgResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreDrgResourceCrud) Create() error {
+func (s *CoreDrgResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateDrgRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -156,7 +156,7 @@ func (s *CoreDrgResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateDrg(context.Background(), request)
+	response, err := s.Client.CreateDrg(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -165,7 +165,7 @@ func (s *CoreDrgResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreDrgResourceCrud) Get() error {
+func (s *CoreDrgResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetDrgRequest{}
 
 	tmp := s.D.Id()
@@ -173,7 +173,7 @@ func (s *CoreDrgResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetDrg(context.Background(), request)
+	response, err := s.Client.GetDrg(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -182,7 +182,7 @@ func (s *CoreDrgResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreDrgResourceCrud) Update() error {
+func (s *CoreDrgResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateDrgRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -207,7 +207,7 @@ func (s *CoreDrgResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateDrg(context.Background(), request)
+	response, err := s.Client.UpdateDrg(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -216,7 +216,7 @@ func (s *CoreDrgResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreDrgResourceCrud) Delete() error {
+func (s *CoreDrgResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteDrgRequest{}
 
 	tmp := s.D.Id()
@@ -224,7 +224,7 @@ func (s *CoreDrgResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteDrg(context.Background(), request)
+	_, err := s.Client.DeleteDrg(ctx, request)
 	return err
 }
 
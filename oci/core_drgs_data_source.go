@@ -13,7 +13,8 @@ func CoreDrgsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreDrgs,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -42,7 +43,7 @@ func readCoreDrgs(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreDrgsDataSourceCrud struct {
@@ -55,7 +56,7 @@ func (s *CoreDrgsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreDrgsDataSourceCrud) Get() error {
+func (s *CoreDrgsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListDrgsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -74,8 +75,9 @@ func (s *CoreDrgsDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListDrgs(context.Background(), request)
+	response, err := s.Client.ListDrgs(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -83,8 +85,8 @@ func (s *CoreDrgsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListDrgs(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListDrgs(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -136,6 +138,8 @@ func (s *CoreDrgsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreDrgsDataSource().Schema["drgs"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("drgs", resources); err != nil {
 		return err
 	}
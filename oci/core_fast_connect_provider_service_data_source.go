@@ -74,7 +74,7 @@ func readSingularCoreFastConnectProviderService(d *schema.ResourceData, m interf
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreFastConnectProviderServiceDataSourceCrud struct {
@@ -87,7 +87,7 @@ func (s *CoreFastConnectProviderServiceDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreFastConnectProviderServiceDataSourceCrud) Get() error {
+func (s *CoreFastConnectProviderServiceDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetFastConnectProviderServiceRequest{}
 
 	if providerServiceId, ok := s.D.GetOkExists("provider_service_id"); ok {
@@ -97,7 +97,7 @@ func (s *CoreFastConnectProviderServiceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := s.Client.GetFastConnectProviderService(context.Background(), request)
+	response, err := s.Client.GetFastConnectProviderService(ctx, request)
 	if err != nil {
 		return err
 	}
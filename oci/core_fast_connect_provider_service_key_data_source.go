@@ -43,7 +43,7 @@ func readSingularCoreFastConnectProviderServiceKey(d *schema.ResourceData, m int
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreFastConnectProviderServiceKeyDataSourceCrud struct {
@@ -56,7 +56,7 @@ func (s *CoreFastConnectProviderServiceKeyDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreFastConnectProviderServiceKeyDataSourceCrud) Get() error {
+func (s *CoreFastConnectProviderServiceKeyDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetFastConnectProviderServiceKeyRequest{}
 
 	if providerServiceId, ok := s.D.GetOkExists("provider_service_id"); ok {
@@ -71,7 +71,7 @@ func (s *CoreFastConnectProviderServiceKeyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := s.Client.GetFastConnectProviderServiceKey(context.Background(), request)
+	response, err := s.Client.GetFastConnectProviderServiceKey(ctx, request)
 	if err != nil {
 		return err
 	}
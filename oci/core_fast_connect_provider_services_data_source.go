@@ -13,7 +13,8 @@ func CoreFastConnectProviderServicesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreFastConnectProviderServices,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -91,7 +92,7 @@ func readCoreFastConnectProviderServices(d *schema.ResourceData, m interface{})
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreFastConnectProviderServicesDataSourceCrud struct {
@@ -104,7 +105,7 @@ func (s *CoreFastConnectProviderServicesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreFastConnectProviderServicesDataSourceCrud) Get() error {
+func (s *CoreFastConnectProviderServicesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListFastConnectProviderServicesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -113,8 +114,9 @@ func (s *CoreFastConnectProviderServicesDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListFastConnectProviderServices(context.Background(), request)
+	response, err := s.Client.ListFastConnectProviderServices(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -122,8 +124,8 @@ func (s *CoreFastConnectProviderServicesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListFastConnectProviderServices(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListFastConnectProviderServices(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -187,6 +189,8 @@ func (s *CoreFastConnectProviderServicesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreFastConnectProviderServicesDataSource().Schema["fast_connect_provider_services"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("fast_connect_provider_services", resources); err != nil {
 		return err
 	}
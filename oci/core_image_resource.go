@@ -214,7 +214,7 @@ func createCoreImage(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreImage(d *schema.ResourceData, m interface{}) error {
@@ -222,7 +222,7 @@ func readCoreImage(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreImage(d *schema.ResourceData, m interface{}) error {
@@ -230,7 +230,7 @@ func updateCoreImage(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCoreImage(d *schema.ResourceData, m interface{}) error {
@@ -239,7 +239,7 @@ func deleteCoreImage(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).computeClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreImageResourceCrud struct {
@@ -278,7 +278,7 @@ func (s *CoreImageResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreImageResourceCrud) Create() error {
+func (s *CoreImageResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateImageRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -325,7 +325,7 @@ func (s *CoreImageResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateImage(context.Background(), request)
+	response, err := s.Client.CreateImage(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -334,7 +334,7 @@ func (s *CoreImageResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreImageResourceCrud) Get() error {
+func (s *CoreImageResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetImageRequest{}
 
 	tmp := s.D.Id()
@@ -342,7 +342,7 @@ func (s *CoreImageResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetImage(context.Background(), request)
+	response, err := s.Client.GetImage(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -351,7 +351,7 @@ func (s *CoreImageResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreImageResourceCrud) Update() error {
+func (s *CoreImageResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateImageRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -376,7 +376,7 @@ func (s *CoreImageResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateImage(context.Background(), request)
+	response, err := s.Client.UpdateImage(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -385,7 +385,7 @@ func (s *CoreImageResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreImageResourceCrud) Delete() error {
+func (s *CoreImageResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteImageRequest{}
 
 	tmp := s.D.Id()
@@ -393,7 +393,7 @@ func (s *CoreImageResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteImage(context.Background(), request)
+	_, err := s.Client.DeleteImage(ctx, request)
 	return err
 }
 
@@ -15,7 +15,8 @@ func CoreImagesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreImages,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -80,7 +81,7 @@ func readCoreImages(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreImagesDataSourceCrud struct {
@@ -93,7 +94,7 @@ func (s *CoreImagesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreImagesDataSourceCrud) Get() error {
+func (s *CoreImagesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListImagesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -144,8 +145,9 @@ func (s *CoreImagesDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListImages(context.Background(), request)
+	response, err := s.Client.ListImages(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -153,8 +155,8 @@ func (s *CoreImagesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListImages(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListImages(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -243,6 +245,8 @@ func (s *CoreImagesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreImagesDataSource().Schema["images"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("images", resources); err != nil {
 		return err
 	}
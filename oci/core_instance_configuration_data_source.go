@@ -490,7 +490,7 @@ func readSingularCoreInstanceConfiguration(d *schema.ResourceData, m interface{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeManagementClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreInstanceConfigurationDataSourceCrud struct {
@@ -503,7 +503,7 @@ func (s *CoreInstanceConfigurationDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreInstanceConfigurationDataSourceCrud) Get() error {
+func (s *CoreInstanceConfigurationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetInstanceConfigurationRequest{}
 
 	if instanceConfigurationId, ok := s.D.GetOkExists("instance_configuration_id"); ok {
@@ -513,7 +513,7 @@ func (s *CoreInstanceConfigurationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := s.Client.GetInstanceConfiguration(context.Background(), request)
+	response, err := s.Client.GetInstanceConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
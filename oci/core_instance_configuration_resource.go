@@ -509,7 +509,7 @@ func createCoreInstanceConfiguration(d *schema.ResourceData, m interface{}) erro
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeManagementClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreInstanceConfiguration(d *schema.ResourceData, m interface{}) error {
@@ -517,7 +517,7 @@ func readCoreInstanceConfiguration(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeManagementClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreInstanceConfiguration(d *schema.ResourceData, m interface{}) error {
@@ -525,7 +525,7 @@ func updateCoreInstanceConfiguration(d *schema.ResourceData, m interface{}) erro
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeManagementClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCoreInstanceConfiguration(d *schema.ResourceData, m interface{}) error {
@@ -534,7 +534,7 @@ func deleteCoreInstanceConfiguration(d *schema.ResourceData, m interface{}) erro
 	sync.Client = m.(*OracleClients).computeManagementClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreInstanceConfigurationResourceCrud struct {
@@ -548,7 +548,7 @@ func (s *CoreInstanceConfigurationResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *CoreInstanceConfigurationResourceCrud) Create() error {
+func (s *CoreInstanceConfigurationResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateInstanceConfigurationRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -586,7 +586,7 @@ func (s *CoreInstanceConfigurationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateInstanceConfiguration(context.Background(), request)
+	response, err := s.Client.CreateInstanceConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -595,7 +595,7 @@ func (s *CoreInstanceConfigurationResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreInstanceConfigurationResourceCrud) Get() error {
+func (s *CoreInstanceConfigurationResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetInstanceConfigurationRequest{}
 
 	tmp := s.D.Id()
@@ -603,7 +603,7 @@ func (s *CoreInstanceConfigurationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetInstanceConfiguration(context.Background(), request)
+	response, err := s.Client.GetInstanceConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -612,7 +612,7 @@ func (s *CoreInstanceConfigurationResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreInstanceConfigurationResourceCrud) Update() error {
+func (s *CoreInstanceConfigurationResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateInstanceConfigurationRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -637,7 +637,7 @@ func (s *CoreInstanceConfigurationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateInstanceConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateInstanceConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -646,7 +646,7 @@ func (s *CoreInstanceConfigurationResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreInstanceConfigurationResourceCrud) Delete() error {
+func (s *CoreInstanceConfigurationResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteInstanceConfigurationRequest{}
 
 	tmp := s.D.Id()
@@ -654,7 +654,7 @@ func (s *CoreInstanceConfigurationResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteInstanceConfiguration(context.Background(), request)
+	_, err := s.Client.DeleteInstanceConfiguration(ctx, request)
 	return err
 }
 
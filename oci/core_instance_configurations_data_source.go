@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
@@ -13,7 +14,8 @@ func CoreInstanceConfigurationsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreInstanceConfigurations,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -23,6 +25,22 @@ func CoreInstanceConfigurationsDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(CoreInstanceConfigurationResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListInstanceConfigurationsSortByTimecreated),
+					string(oci_core.ListInstanceConfigurationsSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListInstanceConfigurationsSortOrderAsc),
+					string(oci_core.ListInstanceConfigurationsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -32,7 +50,7 @@ func readCoreInstanceConfigurations(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeManagementClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreInstanceConfigurationsDataSourceCrud struct {
@@ -45,7 +63,7 @@ func (s *CoreInstanceConfigurationsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreInstanceConfigurationsDataSourceCrud) Get() error {
+func (s *CoreInstanceConfigurationsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListInstanceConfigurationsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -53,9 +71,18 @@ func (s *CoreInstanceConfigurationsDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_core.ListInstanceConfigurationsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_core.ListInstanceConfigurationsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListInstanceConfigurations(context.Background(), request)
+	response, err := s.Client.ListInstanceConfigurations(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -63,8 +90,8 @@ func (s *CoreInstanceConfigurationsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListInstanceConfigurations(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListInstanceConfigurations(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -108,6 +135,8 @@ func (s *CoreInstanceConfigurationsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreInstanceConfigurationsDataSource().Schema["instance_configurations"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("instance_configurations", resources); err != nil {
 		return err
 	}
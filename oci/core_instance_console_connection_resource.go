@@ -79,7 +79,7 @@ func createCoreInstanceConsoleConnection(d *schema.ResourceData, m interface{})
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreInstanceConsoleConnection(d *schema.ResourceData, m interface{}) error {
@@ -87,7 +87,7 @@ func readCoreInstanceConsoleConnection(d *schema.ResourceData, m interface{}) er
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func deleteCoreInstanceConsoleConnection(d *schema.ResourceData, m interface{}) error {
@@ -96,7 +96,7 @@ func deleteCoreInstanceConsoleConnection(d *schema.ResourceData, m interface{})
 	sync.Client = m.(*OracleClients).computeClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreInstanceConsoleConnectionResourceCrud struct {
@@ -134,7 +134,7 @@ func (s *CoreInstanceConsoleConnectionResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreInstanceConsoleConnectionResourceCrud) Create() error {
+func (s *CoreInstanceConsoleConnectionResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateInstanceConsoleConnectionRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -161,7 +161,7 @@ func (s *CoreInstanceConsoleConnectionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateInstanceConsoleConnection(context.Background(), request)
+	response, err := s.Client.CreateInstanceConsoleConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -170,7 +170,7 @@ func (s *CoreInstanceConsoleConnectionResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreInstanceConsoleConnectionResourceCrud) Get() error {
+func (s *CoreInstanceConsoleConnectionResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetInstanceConsoleConnectionRequest{}
 
 	tmp := s.D.Id()
@@ -178,7 +178,7 @@ func (s *CoreInstanceConsoleConnectionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetInstanceConsoleConnection(context.Background(), request)
+	response, err := s.Client.GetInstanceConsoleConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -187,7 +187,7 @@ func (s *CoreInstanceConsoleConnectionResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreInstanceConsoleConnectionResourceCrud) Delete() error {
+func (s *CoreInstanceConsoleConnectionResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteInstanceConsoleConnectionRequest{}
 
 	tmp := s.D.Id()
@@ -195,7 +195,7 @@ func (s *CoreInstanceConsoleConnectionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteInstanceConsoleConnection(context.Background(), request)
+	_, err := s.Client.DeleteInstanceConsoleConnection(ctx, request)
 	return err
 }
 
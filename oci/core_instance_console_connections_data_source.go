@@ -13,7 +13,8 @@ func CoreInstanceConsoleConnectionsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreInstanceConsoleConnections,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -36,7 +37,7 @@ func readCoreInstanceConsoleConnections(d *schema.ResourceData, m interface{}) e
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreInstanceConsoleConnectionsDataSourceCrud struct {
@@ -49,7 +50,7 @@ func (s *CoreInstanceConsoleConnectionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreInstanceConsoleConnectionsDataSourceCrud) Get() error {
+func (s *CoreInstanceConsoleConnectionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListInstanceConsoleConnectionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -63,8 +64,9 @@ func (s *CoreInstanceConsoleConnectionsDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListInstanceConsoleConnections(context.Background(), request)
+	response, err := s.Client.ListInstanceConsoleConnections(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -72,8 +74,8 @@ func (s *CoreInstanceConsoleConnectionsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListInstanceConsoleConnections(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListInstanceConsoleConnections(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -133,6 +135,8 @@ func (s *CoreInstanceConsoleConnectionsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreInstanceConsoleConnectionsDataSource().Schema["instance_console_connections"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("instance_console_connections", resources); err != nil {
 		return err
 	}
@@ -35,7 +35,7 @@ func readSingularCoreInstanceCredential(d *schema.ResourceData, m interface{}) e
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreInstanceCredentialDataSourceCrud struct {
@@ -48,7 +48,7 @@ func (s *CoreInstanceCredentialDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreInstanceCredentialDataSourceCrud) Get() error {
+func (s *CoreInstanceCredentialDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetWindowsInstanceInitialCredentialsRequest{}
 
 	if instanceId, ok := s.D.GetOkExists("instance_id"); ok {
@@ -58,7 +58,7 @@ func (s *CoreInstanceCredentialDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := s.Client.GetWindowsInstanceInitialCredentials(context.Background(), request)
+	response, err := s.Client.GetWindowsInstanceInitialCredentials(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -220,7 +220,7 @@ func readSingularCoreInstance(d *schema.ResourceData, m interface{}) error {
 	sync.VirtualNetworkClient = m.(*OracleClients).virtualNetworkClient
 	sync.BlockStorageClient = m.(*OracleClients).blockstorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreInstanceDataSourceCrud struct {
@@ -231,7 +231,7 @@ func (s *CoreInstanceDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreInstanceDataSourceCrud) Get() error {
+func (s *CoreInstanceDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetInstanceRequest{}
 
 	if instanceId, ok := s.D.GetOkExists("instance_id"); ok {
@@ -241,7 +241,7 @@ func (s *CoreInstanceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := s.Client.GetInstance(context.Background(), request)
+	response, err := s.Client.GetInstance(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -325,7 +325,7 @@ func (s *CoreInstanceDataSourceCrud) SetData() error {
 		s.D.Set("shape", *s.Res.Shape)
 	}
 
-	bootVolume, bootVolumeErr := s.getBootVolume()
+	bootVolume, bootVolumeErr := s.getBootVolume(s.OperationContext())
 	if bootVolumeErr != nil {
 		log.Printf("[WARN] Could not get the boot volume: %q", bootVolumeErr)
 	}
@@ -364,7 +364,7 @@ func (s *CoreInstanceDataSourceCrud) SetData() error {
 	}
 
 	if s.Res.LifecycleState == oci_core.InstanceLifecycleStateRunning {
-		vnic, vnicError := s.getPrimaryVnic()
+		vnic, vnicError := s.getPrimaryVnic(s.OperationContext())
 		if vnicError != nil || vnic == nil {
 			log.Printf("[WARN] Primary VNIC could not be found during instance refresh: %q", vnicError)
 		} else {
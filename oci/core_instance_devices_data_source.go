@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
@@ -13,7 +14,8 @@ func CoreInstanceDevicesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreInstanceDevices,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"instance_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -47,6 +49,22 @@ func CoreInstanceDevicesDataSource() *schema.Resource {
 					},
 				},
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListInstanceDevicesSortByTimecreated),
+					string(oci_core.ListInstanceDevicesSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListInstanceDevicesSortOrderAsc),
+					string(oci_core.ListInstanceDevicesSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -56,7 +74,7 @@ func readCoreInstanceDevices(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreInstanceDevicesDataSourceCrud struct {
@@ -69,7 +87,7 @@ func (s *CoreInstanceDevicesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreInstanceDevicesDataSourceCrud) Get() error {
+func (s *CoreInstanceDevicesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListInstanceDevicesRequest{}
 
 	if instanceId, ok := s.D.GetOkExists("instance_id"); ok {
@@ -87,9 +105,18 @@ func (s *CoreInstanceDevicesDataSourceCrud) Get() error {
 		request.Name = &tmp
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_core.ListInstanceDevicesSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_core.ListInstanceDevicesSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListInstanceDevices(context.Background(), request)
+	response, err := s.Client.ListInstanceDevices(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -97,8 +124,8 @@ func (s *CoreInstanceDevicesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListInstanceDevices(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListInstanceDevices(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -136,6 +163,8 @@ func (s *CoreInstanceDevicesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreInstanceDevicesDataSource().Schema["devices"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("devices", resources); err != nil {
 		return err
 	}
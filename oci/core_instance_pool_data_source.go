@@ -150,7 +150,7 @@ func readSingularCoreInstancePool(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeManagementClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreInstancePoolDataSourceCrud struct {
@@ -163,7 +163,7 @@ func (s *CoreInstancePoolDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreInstancePoolDataSourceCrud) Get() error {
+func (s *CoreInstancePoolDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetInstancePoolRequest{}
 
 	if instancePoolId, ok := s.D.GetOkExists("instance_pool_id"); ok {
@@ -173,7 +173,7 @@ func (s *CoreInstancePoolDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := s.Client.GetInstancePool(context.Background(), request)
+	response, err := s.Client.GetInstancePool(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
@@ -13,7 +14,8 @@ func CoreInstancePoolInstancesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreInstancePoolInstances,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -113,6 +115,22 @@ func CoreInstancePoolInstancesDataSource() *schema.Resource {
 					},
 				},
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListInstancePoolInstancesSortByTimecreated),
+					string(oci_core.ListInstancePoolInstancesSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListInstancePoolInstancesSortOrderAsc),
+					string(oci_core.ListInstancePoolInstancesSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -122,7 +140,7 @@ func readCoreInstancePoolInstances(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeManagementClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreInstancePoolInstancesDataSourceCrud struct {
@@ -135,7 +153,7 @@ func (s *CoreInstancePoolInstancesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreInstancePoolInstancesDataSourceCrud) Get() error {
+func (s *CoreInstancePoolInstancesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListInstancePoolInstancesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -153,9 +171,18 @@ func (s *CoreInstancePoolInstancesDataSourceCrud) Get() error {
 		request.InstancePoolId = &tmp
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_core.ListInstancePoolInstancesSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_core.ListInstancePoolInstancesSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListInstancePoolInstances(context.Background(), request)
+	response, err := s.Client.ListInstancePoolInstances(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -163,8 +190,8 @@ func (s *CoreInstancePoolInstancesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListInstancePoolInstances(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListInstancePoolInstances(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -238,6 +265,8 @@ func (s *CoreInstancePoolInstancesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreInstancePoolInstancesDataSource().Schema["instances"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("instances", resources); err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -108,6 +109,11 @@ func CoreInstancePoolResource() *schema.Resource {
 				Computed: true,
 				Elem:     schema.TypeString,
 			},
+			"graceful_stop_timeout_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 			"load_balancers": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -155,6 +161,20 @@ func CoreInstancePoolResource() *schema.Resource {
 					},
 				},
 			},
+			"reboot_action": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(oci_core.InstanceActionActionSoftreset),
+				DiffSuppressFunc: EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.InstanceActionActionSoftreset),
+					string(oci_core.InstanceActionActionReset),
+				}, true),
+			},
+			"reboot_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 
 			// Computed
 			"state": {
@@ -183,8 +203,9 @@ func createCoreInstancePool(d *schema.ResourceData, m interface{}) error {
 	sync := &CoreInstancePoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeManagementClient
+	sync.ComputeClient = m.(*OracleClients).computeClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreInstancePool(d *schema.ResourceData, m interface{}) error {
@@ -192,15 +213,16 @@ func readCoreInstancePool(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeManagementClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreInstancePool(d *schema.ResourceData, m interface{}) error {
 	sync := &CoreInstancePoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeManagementClient
+	sync.ComputeClient = m.(*OracleClients).computeClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCoreInstancePool(d *schema.ResourceData, m interface{}) error {
@@ -209,12 +231,13 @@ func deleteCoreInstancePool(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).computeManagementClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreInstancePoolResourceCrud struct {
 	BaseCrud
 	Client                 *oci_core.ComputeManagementClient
+	ComputeClient          *oci_core.ComputeClient
 	Res                    *oci_core.InstancePool
 	DisableNotFoundRetries bool
 }
@@ -267,7 +290,7 @@ func (s *CoreInstancePoolResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *CoreInstancePoolResourceCrud) Create() error {
+func (s *CoreInstancePoolResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateInstancePoolRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -336,7 +359,7 @@ func (s *CoreInstancePoolResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateInstancePool(context.Background(), request)
+	response, err := s.Client.CreateInstancePool(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -346,7 +369,7 @@ func (s *CoreInstancePoolResourceCrud) Create() error {
 		desiredStateStr = desiredState.(string)
 	}
 
-	instancePool, err := s.setInstancePoolDesiredState(response.InstancePool.Id, desiredStateStr)
+	instancePool, err := s.setInstancePoolDesiredState(ctx, response.InstancePool.Id, desiredStateStr)
 	if err != nil {
 		return err
 	}
@@ -356,22 +379,29 @@ func (s *CoreInstancePoolResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreInstancePoolResourceCrud) setInstancePoolDesiredState(instancePoolId *string, desiredState string) (*oci_core.InstancePool, error) {
+func (s *CoreInstancePoolResourceCrud) setInstancePoolDesiredState(ctx context.Context, instancePoolId *string, desiredState string) (*oci_core.InstancePool, error) {
 	switch strings.ToLower(desiredState) {
 	case instancePoolRunningState:
 		startRequest := oci_core.StartInstancePoolRequest{}
 		startRequest.InstancePoolId = instancePoolId
 		startRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-		startResponse, err := s.Client.StartInstancePool(context.Background(), startRequest)
+		startResponse, err := s.Client.StartInstancePool(ctx, startRequest)
 
 		return &startResponse.InstancePool, err
 	case instancePoolStoppedState:
+		if timeout, ok := s.D.GetOkExists("graceful_stop_timeout_in_seconds"); ok && timeout.(int) > 0 {
+			if err := s.softStopInstances(ctx, instancePoolId, time.Duration(timeout.(int))*time.Second); err != nil {
+				return nil, err
+			}
+		}
+
+		// the instances which did not stop gracefully are stopped with the instance pool
 		stopRequest := oci_core.StopInstancePoolRequest{}
 		stopRequest.InstancePoolId = instancePoolId
 		stopRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-		stopResponse, err := s.Client.StopInstancePool(context.Background(), stopRequest)
+		stopResponse, err := s.Client.StopInstancePool(ctx, stopRequest)
 
 		return &stopResponse.InstancePool, err
 	default:
@@ -380,7 +410,57 @@ func (s *CoreInstancePoolResourceCrud) setInstancePoolDesiredState(instancePoolI
 
 }
 
-func (s *CoreInstancePoolResourceCrud) Get() error {
+// softStopInstances sends a SOFTSTOP to the running instances of the instance pool, and waits until they stop or a
+// timeout expires
+func (s *CoreInstancePoolResourceCrud) softStopInstances(ctx context.Context, instancePoolId *string, timeout time.Duration) error {
+	request := oci_core.ListInstancePoolInstancesRequest{}
+	request.InstancePoolId = instancePoolId
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	var instanceIds []string
+	for {
+		response, err := s.Client.ListInstancePoolInstances(ctx, request)
+		if err != nil {
+			return err
+		}
+		for _, instance := range response.Items {
+			if instance.Id != nil && instance.State != nil && strings.EqualFold(*instance.State, string(oci_core.InstanceLifecycleStateRunning)) {
+				instanceIds = append(instanceIds, *instance.Id)
+			}
+		}
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+
+	return softStopInstances(ctx, s.ComputeClient, instanceIds, timeout)
+}
+
+// resetInstances reboots the instances of the instance pool with its reboot_action
+func (s *CoreInstancePoolResourceCrud) resetInstances(ctx context.Context, instancePoolId *string) (*oci_core.InstancePool, error) {
+	if strings.EqualFold(s.D.Get("reboot_action").(string), string(oci_core.InstanceActionActionReset)) {
+		request := oci_core.ResetInstancePoolRequest{}
+		request.InstancePoolId = instancePoolId
+		request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+		response, err := s.Client.ResetInstancePool(ctx, request)
+		return &response.InstancePool, err
+	}
+
+	request := oci_core.SoftresetInstancePoolRequest{}
+	request.InstancePoolId = instancePoolId
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.SoftresetInstancePool(ctx, request)
+	return &response.InstancePool, err
+}
+
+func (s *CoreInstancePoolResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetInstancePoolRequest{}
 
 	tmp := s.D.Id()
@@ -388,7 +468,7 @@ func (s *CoreInstancePoolResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetInstancePool(context.Background(), request)
+	response, err := s.Client.GetInstancePool(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -396,7 +476,7 @@ func (s *CoreInstancePoolResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreInstancePoolResourceCrud) Update() error {
+func (s *CoreInstancePoolResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateInstancePoolRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -447,7 +527,7 @@ func (s *CoreInstancePoolResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateInstancePool(context.Background(), request)
+	response, err := s.Client.UpdateInstancePool(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -457,17 +537,25 @@ func (s *CoreInstancePoolResourceCrud) Update() error {
 		desiredStateStr = desiredState.(string)
 	}
 
-	instancePool, err := s.setInstancePoolDesiredState(response.InstancePool.Id, desiredStateStr)
+	instancePool, err := s.setInstancePoolDesiredState(ctx, response.InstancePool.Id, desiredStateStr)
 	if err != nil {
 		return err
 	}
 
+	// reboot the running instances when the reboot trigger changes
+	if s.D.HasChange("reboot_trigger") && strings.EqualFold(desiredStateStr, instancePoolRunningState) && !s.D.HasChange("state") {
+		instancePool, err = s.resetInstances(ctx, response.InstancePool.Id)
+		if err != nil {
+			return err
+		}
+	}
+
 	s.Res = instancePool
 
 	return nil
 }
 
-func (s *CoreInstancePoolResourceCrud) Delete() error {
+func (s *CoreInstancePoolResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.TerminateInstancePoolRequest{}
 
 	tmp := s.D.Id()
@@ -475,7 +563,7 @@ func (s *CoreInstancePoolResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.TerminateInstancePool(context.Background(), request)
+	_, err := s.Client.TerminateInstancePool(ctx, request)
 	return err
 }
 
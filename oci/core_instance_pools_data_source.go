@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
@@ -13,7 +14,8 @@ func CoreInstancePoolsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreInstancePools,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -31,6 +33,22 @@ func CoreInstancePoolsDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(CoreInstancePoolResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListInstancePoolsSortByTimecreated),
+					string(oci_core.ListInstancePoolsSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListInstancePoolsSortOrderAsc),
+					string(oci_core.ListInstancePoolsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -40,7 +58,7 @@ func readCoreInstancePools(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeManagementClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreInstancePoolsDataSourceCrud struct {
@@ -53,7 +71,7 @@ func (s *CoreInstancePoolsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreInstancePoolsDataSourceCrud) Get() error {
+func (s *CoreInstancePoolsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListInstancePoolsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -70,9 +88,18 @@ func (s *CoreInstancePoolsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_core.InstancePoolSummaryLifecycleStateEnum(state.(string))
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_core.ListInstancePoolsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_core.ListInstancePoolsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListInstancePools(context.Background(), request)
+	response, err := s.Client.ListInstancePools(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -80,8 +107,8 @@ func (s *CoreInstancePoolsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListInstancePools(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListInstancePools(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -136,6 +163,8 @@ func (s *CoreInstancePoolsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreInstancePoolsDataSource().Schema["instance_pools"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("instance_pools", resources); err != nil {
 		return err
 	}
@@ -8,14 +8,17 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/customdiff"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 
+	oci_common "github.com/oracle/oci-go-sdk/common"
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
@@ -48,7 +51,6 @@ func CoreInstanceResource() *schema.Resource {
 			"shape": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 
 			// Optional
@@ -73,6 +75,7 @@ func CoreInstanceResource() *schema.Resource {
 					},
 				},
 			},
+			"create_retry": createRetrySchema(),
 			"create_vnic_details": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -188,6 +191,11 @@ func CoreInstanceResource() *schema.Resource {
 				Computed: true,
 				Elem:     schema.TypeString,
 			},
+			"graceful_stop_timeout_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 			"hostname_label": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -223,20 +231,38 @@ func CoreInstanceResource() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"reboot_action": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(oci_core.InstanceActionActionSoftreset),
+				DiffSuppressFunc: EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.InstanceActionActionSoftreset),
+					string(oci_core.InstanceActionActionReset),
+				}, true),
+			},
+			"reboot_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"replace_boot_volume_on_image_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"source_details": {
 				Type:     schema.TypeList,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 				MaxItems: 1,
 				MinItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						// Required
+						// Changes of 'source_id' force a new instance, unless the boot volume is replaced as per CustomizeDiff
 						"source_id": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"source_type": {
 							Type:             schema.TypeString,
@@ -360,16 +386,91 @@ func CoreInstanceResource() *schema.Resource {
 		},
 		// CustomizeDiff for Instance resource
 		// Updates of 'ssh_authorized_keys' and 'user_data' in Instance 'metadata' should result in Force New
+		// Updates of 'shape' result in Force New only when the new shape is not compatible with the image of the instance
+		// Updates of 'source_id' in 'source_details' result in Force New unless the boot volume is replaced
 		CustomizeDiff: customdiff.All(
 			customdiff.ForceNewIfChange("metadata", func(old, new, meta interface{}) bool {
 				oldMetadataMap := objectMapToStringMap(old.(map[string]interface{}))
 				newMetadataMap := objectMapToStringMap(new.(map[string]interface{}))
 				return (oldMetadataMap["ssh_authorized_keys"] != newMetadataMap["ssh_authorized_keys"]) || (oldMetadataMap["user_data"] != newMetadataMap["user_data"])
 			}),
+			customizeDiffCoreInstanceShape,
+			customizeDiffCoreInstanceSourceId,
 		),
 	}
 }
 
+// customizeDiffCoreInstanceShape replaces the instance when its shape cannot be updated in place, i.e. when the service
+// does not list the new shape among the shapes compatible with the image of the source details. The shape of the
+// instances launched from a boot volume, or whose compatibility cannot be checked, is updated in place: there is no
+// fallback to replacing the instance when the service rejects the update.
+func customizeDiffCoreInstanceShape(diff *schema.ResourceDiff, m interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("shape") || !diff.NewValueKnown("shape") {
+		return nil
+	}
+	clients, ok := m.(*OracleClients)
+	if !ok || clients.computeClient == nil {
+		return nil
+	}
+	if sourceType, _ := diff.Get("source_details.0.source_type").(string); !strings.EqualFold(sourceType, "image") || !diff.NewValueKnown("source_details.0.source_id") {
+		return nil
+	}
+	imageId, _ := diff.Get("source_details.0.source_id").(string)
+	if imageId == "" {
+		return nil
+	}
+
+	shape := diff.Get("shape").(string)
+	compatible, err := isShapeCompatibleWithImage(clients.StopContext(), clients.computeClient, diff.Get("compartment_id").(string), diff.Get("availability_domain").(string), imageId, shape)
+	if err != nil {
+		log.Printf("[WARN] Could not check whether shape %s is compatible with image %s, updating the shape of instance %s in place: %v", shape, imageId, diff.Id(), err)
+		return nil
+	}
+	if !compatible {
+		log.Printf("[DEBUG] Shape %s is not compatible with image %s, replacing instance %s", shape, imageId, diff.Id())
+		return diff.ForceNew("shape")
+	}
+	return nil
+}
+
+// customizeDiffCoreInstanceSourceId replaces the instance when the source of its boot volume changes, unless the boot
+// volume is to be replaced by one built from the new image
+func customizeDiffCoreInstanceSourceId(diff *schema.ResourceDiff, m interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("source_details.0.source_id") {
+		return nil
+	}
+	if diff.Get("replace_boot_volume_on_image_change").(bool) && strings.EqualFold(diff.Get("source_details.0.source_type").(string), "image") {
+		return nil
+	}
+	return diff.ForceNew("source_details.0.source_id")
+}
+
+// isShapeCompatibleWithImage returns whether the shapes listed for an image in an availability domain include a shape
+func isShapeCompatibleWithImage(ctx context.Context, client *oci_core.ComputeClient, compartmentId string, availabilityDomain string, imageId string, shape string) (bool, error) {
+	request := oci_core.ListShapesRequest{
+		AvailabilityDomain: &availabilityDomain,
+		CompartmentId:      &compartmentId,
+		ImageId:            &imageId,
+	}
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+
+	for {
+		response, err := client.ListShapes(ctx, request)
+		if err != nil {
+			return false, err
+		}
+		for _, item := range response.Items {
+			if item.Shape != nil && *item.Shape == shape {
+				return true, nil
+			}
+		}
+		if response.OpcNextPage == nil {
+			return false, nil
+		}
+		request.Page = response.OpcNextPage
+	}
+}
+
 func createCoreInstance(d *schema.ResourceData, m interface{}) error {
 	sync := &CoreInstanceResourceCrud{}
 	sync.D = d
@@ -385,20 +486,21 @@ func createCoreInstance(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	if e := CreateResource(d, sync); e != nil {
+	ctx := m.(*OracleClients).StopContext()
+	if e := CreateResource(ctx, d, sync); e != nil {
 		return e
 	}
 
-	return powerOffIfNeeded(sync.D, sync, powerOff)
+	return powerOffIfNeeded(ctx, sync.D, sync, powerOff)
 }
 
-func powerOffIfNeeded(d *schema.ResourceData, sync *CoreInstanceResourceCrud, powerOff bool) error {
+func powerOffIfNeeded(ctx context.Context, d *schema.ResourceData, sync *CoreInstanceResourceCrud, powerOff bool) error {
 
 	if powerOff {
-		if err := sync.InstanceAction(oci_core.InstanceActionActionStop, oci_core.InstanceLifecycleStateStopped); err != nil {
+		if err := sync.StopInstance(ctx); err != nil {
 			return err
 		}
-		return ReadResource(sync)
+		return ReadResource(ctx, sync)
 	}
 	return nil
 }
@@ -410,7 +512,7 @@ func readCoreInstance(d *schema.ResourceData, m interface{}) error {
 	sync.VirtualNetworkClient = m.(*OracleClients).virtualNetworkClient
 	sync.BlockStorageClient = m.(*OracleClients).blockstorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreInstance(d *schema.ResourceData, m interface{}) error {
@@ -420,6 +522,8 @@ func updateCoreInstance(d *schema.ResourceData, m interface{}) error {
 	sync.VirtualNetworkClient = m.(*OracleClients).virtualNetworkClient
 	sync.BlockStorageClient = m.(*OracleClients).blockstorageClient
 
+	ctx := m.(*OracleClients).StopContext()
+
 	// switch to power on
 	powerOn, powerOff := false, false
 
@@ -432,18 +536,50 @@ func updateCoreInstance(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
+	stopped := false
+	if sync.D.HasChange("shape") {
+		shapeStopped, err := sync.UpdateShape(ctx)
+		if err != nil {
+			return err
+		}
+		stopped = shapeStopped
+	}
+	if sync.D.HasChange("source_details.0.source_id") && sync.D.Get("replace_boot_volume_on_image_change").(bool) {
+		bootVolumeStopped, err := sync.ReplaceBootVolume(ctx)
+		if err != nil {
+			return err
+		}
+		stopped = stopped || bootVolumeStopped
+	}
+	// restore the power state of the instance, unless it is to be stopped anyway
+	if stopped {
+		if powerOff {
+			powerOff = false
+			sync.D.Set("state", oci_core.InstanceLifecycleStateStopped)
+		} else {
+			powerOn = true
+		}
+	}
+
 	if powerOn {
-		if err := sync.InstanceAction(oci_core.InstanceActionActionStart, oci_core.InstanceLifecycleStateRunning); err != nil {
+		if err := sync.InstanceAction(ctx, oci_core.InstanceActionActionStart, oci_core.InstanceLifecycleStateRunning); err != nil {
 			return err
 		}
 		sync.D.Set("state", oci_core.InstanceLifecycleStateRunning)
 	}
-	if err := UpdateResource(d, sync); err != nil {
+	if err := UpdateResource(ctx, d, sync); err != nil {
 		return err
 	}
+	// reboot the running instance when its reboot trigger changes, unless it is restarted or stopped anyway
+	if sync.D.HasChange("reboot_trigger") && !powerOn && !powerOff && sync.Res.LifecycleState == oci_core.InstanceLifecycleStateRunning {
+		rebootAction := oci_core.InstanceActionActionEnum(strings.ToUpper(sync.D.Get("reboot_action").(string)))
+		if err := sync.InstanceAction(ctx, rebootAction, oci_core.InstanceLifecycleStateRunning); err != nil {
+			return err
+		}
+	}
 	// switch to power off
 	if powerOff {
-		if err := sync.InstanceAction(oci_core.InstanceActionActionStop, oci_core.InstanceLifecycleStateStopped); err != nil {
+		if err := sync.StopInstance(ctx); err != nil {
 			return err
 		}
 		sync.D.Set("state", oci_core.InstanceLifecycleStateStopped)
@@ -459,7 +595,7 @@ func deleteCoreInstance(d *schema.ResourceData, m interface{}) error {
 	sync.BlockStorageClient = m.(*OracleClients).blockstorageClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreInstanceResourceCrud struct {
@@ -500,7 +636,7 @@ func (s *CoreInstanceResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreInstanceResourceCrud) Create() error {
+func (s *CoreInstanceResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.LaunchInstanceRequest{}
 
 	if agentConfig, ok := s.D.GetOkExists("agent_config"); ok {
@@ -612,7 +748,7 @@ func (s *CoreInstanceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.LaunchInstance(context.Background(), request)
+	response, err := s.Client.LaunchInstance(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -621,7 +757,7 @@ func (s *CoreInstanceResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreInstanceResourceCrud) Get() error {
+func (s *CoreInstanceResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetInstanceRequest{}
 
 	tmp := s.D.Id()
@@ -629,7 +765,7 @@ func (s *CoreInstanceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetInstance(context.Background(), request)
+	response, err := s.Client.GetInstance(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -638,7 +774,7 @@ func (s *CoreInstanceResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreInstanceResourceCrud) Update() error {
+func (s *CoreInstanceResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateInstanceRequest{}
 
 	if agentConfig, ok := s.D.GetOkExists("agent_config"); ok {
@@ -686,7 +822,7 @@ func (s *CoreInstanceResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateInstance(context.Background(), request)
+	response, err := s.Client.UpdateInstance(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -701,7 +837,7 @@ func (s *CoreInstanceResourceCrud) Update() error {
 		return nil
 	}
 
-	vnic, err := s.getPrimaryVnic()
+	vnic, err := s.getPrimaryVnic(ctx)
 	if err != nil {
 		log.Printf("[ERROR] Primary VNIC could not be found during instance update: %q (Instance ID: \"%v\", State: %q)", err, s.Res.Id, s.Res.LifecycleState)
 		return err
@@ -718,7 +854,7 @@ func (s *CoreInstanceResourceCrud) Update() error {
 		UpdateVnicDetails: updateVnicDetails,
 	}
 
-	_, err = s.VirtualNetworkClient.UpdateVnic(context.Background(), vnicOpts)
+	_, err = s.VirtualNetworkClient.UpdateVnic(ctx, vnicOpts)
 
 	if err != nil {
 		log.Printf("[ERROR] Primary VNIC could not be updated during instance update: %q (Instance ID: \"%v\", State: %q)", err, s.Res.Id, s.Res.LifecycleState)
@@ -728,7 +864,7 @@ func (s *CoreInstanceResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreInstanceResourceCrud) InstanceAction(action oci_core.InstanceActionActionEnum, state oci_core.InstanceLifecycleStateEnum) error {
+func (s *CoreInstanceResourceCrud) InstanceAction(ctx context.Context, action oci_core.InstanceActionActionEnum, state oci_core.InstanceLifecycleStateEnum) error {
 	request := oci_core.InstanceActionRequest{}
 	request.Action = action
 
@@ -737,17 +873,355 @@ func (s *CoreInstanceResourceCrud) InstanceAction(action oci_core.InstanceAction
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.InstanceAction(context.Background(), request)
+	_, err := s.Client.InstanceAction(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == state }
-	return WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 
 }
 
-func (s *CoreInstanceResourceCrud) Delete() error {
+// UpdateShape changes the shape of the instance in place, stopping the instance first if it is running. It returns
+// whether the instance was stopped. The instance is started again if the update fails.
+func (s *CoreInstanceResourceCrud) UpdateShape(ctx context.Context) (bool, error) {
+	if err := s.Get(ctx); err != nil {
+		return false, err
+	}
+
+	stopped := false
+	if s.Res.LifecycleState == oci_core.InstanceLifecycleStateRunning {
+		if err := s.StopInstance(ctx); err != nil {
+			return false, err
+		}
+		stopped = true
+	}
+
+	request := updateInstanceShapeRequest{}
+
+	shape := s.D.Get("shape").(string)
+	request.Shape = &shape
+
+	tmp := s.D.Id()
+	request.InstanceId = &tmp
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	if err := updateInstanceShape(ctx, s.Client, request); err != nil {
+		return false, s.startAfterFailedUpdate(ctx, stopped, err)
+	}
+
+	shapeUpdatedFunc := func() bool {
+		return s.Res.Shape != nil && *s.Res.Shape == shape &&
+			(s.Res.LifecycleState == oci_core.InstanceLifecycleStateStopped || s.Res.LifecycleState == oci_core.InstanceLifecycleStateRunning)
+	}
+	if err := WaitForResourceCondition(ctx, s, shapeUpdatedFunc, s.D.Timeout(schema.TimeoutUpdate)); err != nil {
+		return false, s.startAfterFailedUpdate(ctx, stopped, err)
+	}
+	return stopped, nil
+}
+
+// startAfterFailedUpdate starts the instance again if it was stopped for an update that failed, and returns the error
+// of the update
+func (s *CoreInstanceResourceCrud) startAfterFailedUpdate(ctx context.Context, stopped bool, err error) error {
+	if !stopped {
+		return err
+	}
+	if startErr := s.InstanceAction(ctx, oci_core.InstanceActionActionStart, oci_core.InstanceLifecycleStateRunning); startErr != nil {
+		return fmt.Errorf("%v, and the instance stopped for the update could not be started again: %v", err, startErr)
+	}
+	return err
+}
+
+// ReplaceBootVolume replaces the boot volume of the instance by one built from the image of its source details. The
+// new boot volume is built by a temporary instance, terminated once launched while preserving its boot volume. The
+// instance is stopped while its boot volume is replaced, and its previous boot volume is deleted unless
+// preserve_boot_volume is set. It returns whether the instance was stopped. When the replacement fails, the previous
+// boot volume is attached again, the new one deleted, and the instance started again.
+func (s *CoreInstanceResourceCrud) ReplaceBootVolume(ctx context.Context) (bool, error) {
+	if err := s.Get(ctx); err != nil {
+		return false, err
+	}
+
+	bootVolumeId, err := s.buildBootVolume(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	stopped := false
+	if s.Res.LifecycleState == oci_core.InstanceLifecycleStateRunning {
+		if err := s.StopInstance(ctx); err != nil {
+			return false, s.rollbackBootVolumeReplacement(ctx, bootVolumeId, nil, stopped, err)
+		}
+		stopped = true
+	}
+
+	attachment, err := s.getBootVolumeAttachment(ctx)
+	if err != nil {
+		return false, s.rollbackBootVolumeReplacement(ctx, bootVolumeId, nil, stopped, err)
+	}
+
+	detachRequest := oci_core.DetachBootVolumeRequest{BootVolumeAttachmentId: attachment.Id}
+	detachRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	if _, err := s.Client.DetachBootVolume(ctx, detachRequest); err != nil {
+		return false, s.rollbackBootVolumeReplacement(ctx, bootVolumeId, nil, stopped, err)
+	}
+	if err := s.waitForBootVolumeAttachmentState(ctx, attachment.Id, oci_core.BootVolumeAttachmentLifecycleStateDetached); err != nil {
+		return false, s.rollbackBootVolumeReplacement(ctx, bootVolumeId, attachment.BootVolumeId, stopped, err)
+	}
+
+	if err := s.attachBootVolume(ctx, bootVolumeId); err != nil {
+		return false, s.rollbackBootVolumeReplacement(ctx, bootVolumeId, attachment.BootVolumeId, stopped, err)
+	}
+
+	if preserveBootVolume, ok := s.D.GetOkExists("preserve_boot_volume"); ok && preserveBootVolume.(bool) {
+		log.Printf("[DEBUG] Preserving boot volume %s replaced on instance %s", *attachment.BootVolumeId, s.D.Id())
+		return stopped, nil
+	}
+
+	deleteRequest := oci_core.DeleteBootVolumeRequest{BootVolumeId: attachment.BootVolumeId}
+	deleteRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	_, err = s.BlockStorageClient.DeleteBootVolume(ctx, deleteRequest)
+	return stopped, err
+}
+
+// attachBootVolume attaches a boot volume to the instance, and waits for it to be attached
+func (s *CoreInstanceResourceCrud) attachBootVolume(ctx context.Context, bootVolumeId string) error {
+	attachRequest := oci_core.AttachBootVolumeRequest{}
+	attachRequest.BootVolumeId = &bootVolumeId
+	tmp := s.D.Id()
+	attachRequest.InstanceId = &tmp
+	attachRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	attachResponse, err := s.Client.AttachBootVolume(ctx, attachRequest)
+	if err != nil {
+		return err
+	}
+	return s.waitForBootVolumeAttachmentState(ctx, attachResponse.Id, oci_core.BootVolumeAttachmentLifecycleStateAttached)
+}
+
+// rollbackBootVolumeReplacement restores the instance after its boot volume could not be replaced: the previous boot
+// volume is attached again if it was detached, the new boot volume is deleted, and the instance is started again if it
+// was stopped. It returns the error of the replacement, along with the errors of the rollback.
+func (s *CoreInstanceResourceCrud) rollbackBootVolumeReplacement(ctx context.Context, newBootVolumeId string, detachedBootVolumeId *string, stopped bool, err error) error {
+	if detachedBootVolumeId != nil {
+		if attachErr := s.attachBootVolume(ctx, *detachedBootVolumeId); attachErr != nil {
+			err = fmt.Errorf("%v, and the previous boot volume %s could not be attached again: %v", err, *detachedBootVolumeId, attachErr)
+			// The instance cannot be started without a boot volume
+			stopped = false
+		}
+	}
+
+	deleteRequest := oci_core.DeleteBootVolumeRequest{BootVolumeId: &newBootVolumeId}
+	deleteRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+	if _, deleteErr := s.BlockStorageClient.DeleteBootVolume(ctx, deleteRequest); deleteErr != nil {
+		err = fmt.Errorf("%v, and the new boot volume %s could not be deleted: %v", err, newBootVolumeId, deleteErr)
+	}
+
+	return s.startAfterFailedUpdate(ctx, stopped, err)
+}
+
+// buildBootVolume launches a temporary instance from the image of the source details, in the availability domain and
+// subnet of the instance, and terminates it while preserving its boot volume. It returns the ID of the boot volume.
+func (s *CoreInstanceResourceCrud) buildBootVolume(ctx context.Context) (string, error) {
+	vnic, err := s.getPrimaryVnic(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	request := oci_core.LaunchInstanceRequest{}
+	request.AvailabilityDomain = s.Res.AvailabilityDomain
+	request.CompartmentId = s.Res.CompartmentId
+	request.Shape = s.Res.Shape
+	request.CreateVnicDetails = &oci_core.CreateVnicDetails{
+		AssignPublicIp: oci_common.Bool(false),
+		SubnetId:       vnic.SubnetId,
+	}
+	if s.Res.DisplayName != nil {
+		tmp := fmt.Sprintf("%s-boot-volume", *s.Res.DisplayName)
+		request.DisplayName = &tmp
+	}
+
+	sourceDetails, err := s.mapToInstanceSourceDetails(fmt.Sprintf("%s.%d.%%s", "source_details", 0))
+	if err != nil {
+		return "", err
+	}
+	request.SourceDetails = sourceDetails
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.LaunchInstance(ctx, request)
+	if err != nil {
+		return "", err
+	}
+
+	builder := &CoreInstanceResourceCrud{
+		Client:                 s.Client,
+		VirtualNetworkClient:   s.VirtualNetworkClient,
+		BlockStorageClient:     s.BlockStorageClient,
+		Res:                    &response.Instance,
+		DisableNotFoundRetries: true,
+	}
+	builder.D = CoreInstanceResource().Data(nil)
+	builder.D.SetId(*response.Instance.Id)
+	timeout := s.D.Timeout(schema.TimeoutUpdate)
+
+	launchedFunc := func() bool {
+		return builder.Res.LifecycleState != oci_core.InstanceLifecycleStateProvisioning &&
+			builder.Res.LifecycleState != oci_core.InstanceLifecycleStateStarting
+	}
+	err = WaitForResourceCondition(ctx, builder, launchedFunc, timeout)
+	if err == nil && builder.Res.LifecycleState != oci_core.InstanceLifecycleStateRunning {
+		err = fmt.Errorf("the instance %s building the boot volume reached the %s state", builder.D.Id(), builder.Res.LifecycleState)
+	}
+
+	var attachment *oci_core.BootVolumeAttachment
+	if err == nil {
+		attachment, err = builder.getBootVolumeAttachment(ctx)
+	}
+	// the boot volume is preserved only once it is known, the instance is terminated in any case
+	builder.D.Set("preserve_boot_volume", err == nil)
+	if deleteErr := builder.Delete(ctx); deleteErr != nil {
+		log.Printf("[ERROR] Could not terminate the instance %s building the boot volume: %v", builder.D.Id(), deleteErr)
+		if err == nil {
+			err = deleteErr
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+
+	terminatedFunc := func() bool { return builder.Res.LifecycleState == oci_core.InstanceLifecycleStateTerminated }
+	if err := WaitForResourceCondition(ctx, builder, terminatedFunc, timeout); err != nil {
+		return "", err
+	}
+	return *attachment.BootVolumeId, nil
+}
+
+func (s *CoreInstanceResourceCrud) waitForBootVolumeAttachmentState(ctx context.Context, id *string, state oci_core.BootVolumeAttachmentLifecycleStateEnum) error {
+	attachment := &CoreBootVolumeAttachmentFetcher{Client: s.Client, Id: id}
+	stateFunc := func() bool { return attachment.Res.LifecycleState == state }
+	return WaitForResourceCondition(ctx, attachment, stateFunc, s.D.Timeout(schema.TimeoutUpdate))
+}
+
+// CoreBootVolumeAttachmentFetcher gets a boot volume attachment while waiting for its state to change
+type CoreBootVolumeAttachmentFetcher struct {
+	Client *oci_core.ComputeClient
+	Id     *string
+	Res    *oci_core.BootVolumeAttachment
+}
+
+func (s *CoreBootVolumeAttachmentFetcher) Get(ctx context.Context) error {
+	request := oci_core.GetBootVolumeAttachmentRequest{BootVolumeAttachmentId: s.Id}
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+
+	response, err := s.Client.GetBootVolumeAttachment(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.BootVolumeAttachment
+	return nil
+}
+
+// updateInstanceShapeRequest updates the shape of an instance, which the UpdateInstanceDetails of the SDK do not include
+type updateInstanceShapeRequest struct {
+	InstanceId                 *string `mandatory:"true" contributesTo:"path" name:"instanceId"`
+	UpdateInstanceShapeDetails `contributesTo:"body"`
+	RequestMetadata            oci_common.RequestMetadata
+}
+
+// UpdateInstanceShapeDetails is the body of an updateInstanceShapeRequest
+type UpdateInstanceShapeDetails struct {
+	Shape *string `mandatory:"true" json:"shape"`
+}
+
+func (request updateInstanceShapeRequest) HTTPRequest(method, path string) (http.Request, error) {
+	return oci_common.MakeDefaultHTTPRequestWithTaggedStruct(method, path, request)
+}
+
+func (request updateInstanceShapeRequest) RetryPolicy() *oci_common.RetryPolicy {
+	return request.RequestMetadata.RetryPolicy
+}
+
+type updateInstanceShapeResponse struct {
+	RawResponse *http.Response
+}
+
+func (response updateInstanceShapeResponse) HTTPResponse() *http.Response {
+	return response.RawResponse
+}
+
+func updateInstanceShape(ctx context.Context, client *oci_core.ComputeClient, request updateInstanceShapeRequest) error {
+	policy := oci_common.NoRetryPolicy()
+	if request.RetryPolicy() != nil {
+		policy = *request.RetryPolicy()
+	}
+
+	_, err := oci_common.Retry(ctx, request, func(ctx context.Context, request oci_common.OCIRequest) (oci_common.OCIResponse, error) {
+		httpRequest, err := request.HTTPRequest(http.MethodPut, "/instances/{instanceId}")
+		if err != nil {
+			return nil, err
+		}
+
+		httpResponse, err := client.Call(ctx, &httpRequest)
+		defer oci_common.CloseBodyIfValid(httpResponse)
+		return updateInstanceShapeResponse{RawResponse: httpResponse}, err
+	}, policy)
+	return err
+}
+
+// StopInstance stops the instance, first gracefully with a SOFTSTOP when graceful_stop_timeout_in_seconds is set, and
+// then with a STOP when the instance has not stopped within that timeout
+func (s *CoreInstanceResourceCrud) StopInstance(ctx context.Context) error {
+	if timeout, ok := s.D.GetOkExists("graceful_stop_timeout_in_seconds"); ok && timeout.(int) > 0 {
+		if err := softStopInstances(ctx, s.Client, []string{s.D.Id()}, time.Duration(timeout.(int))*time.Second); err != nil {
+			return err
+		}
+		if err := s.Get(ctx); err != nil {
+			return err
+		}
+		if s.Res.LifecycleState == oci_core.InstanceLifecycleStateStopped {
+			return nil
+		}
+		log.Printf("[DEBUG] Instance %s did not stop gracefully within %d seconds, stopping it", s.D.Id(), timeout.(int))
+	}
+
+	return s.InstanceAction(ctx, oci_core.InstanceActionActionStop, oci_core.InstanceLifecycleStateStopped)
+}
+
+// softStopInstances sends a SOFTSTOP to running instances, and waits until they stop or a timeout expires
+func softStopInstances(ctx context.Context, client *oci_core.ComputeClient, instanceIds []string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	var instances []*CoreInstanceResourceCrud
+	for _, instanceId := range instanceIds {
+		instance := &CoreInstanceResourceCrud{Client: client}
+		instance.D = CoreInstanceResource().Data(nil)
+		instance.D.SetId(instanceId)
+
+		request := oci_core.InstanceActionRequest{}
+		request.Action = oci_core.InstanceActionActionSoftstop
+		request.InstanceId = &instanceId
+		request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+
+		if _, err := client.InstanceAction(ctx, request); err != nil {
+			return err
+		}
+		instances = append(instances, instance)
+	}
+
+	for _, instance := range instances {
+		stoppedFunc := func() bool { return instance.Res.LifecycleState == oci_core.InstanceLifecycleStateStopped }
+		if err := WaitForResourceCondition(ctx, instance, stoppedFunc, time.Until(deadline)); err != nil {
+			log.Printf("[DEBUG] Instance %s has not stopped gracefully: %v", instance.D.Id(), err)
+		}
+	}
+	return nil
+}
+
+func (s *CoreInstanceResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.TerminateInstanceRequest{}
 
 	tmp := s.D.Id()
@@ -760,7 +1234,7 @@ func (s *CoreInstanceResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.TerminateInstance(context.Background(), request)
+	_, err := s.Client.TerminateInstance(ctx, request)
 	return err
 }
 
@@ -833,7 +1307,7 @@ func (s *CoreInstanceResourceCrud) SetData() error {
 		s.D.Set("shape", *s.Res.Shape)
 	}
 
-	bootVolume, bootVolumeErr := s.getBootVolume()
+	bootVolume, bootVolumeErr := s.getBootVolume(s.OperationContext())
 	if bootVolumeErr != nil {
 		log.Printf("[WARN] Could not get the boot volume: %q", bootVolumeErr)
 	}
@@ -876,7 +1350,7 @@ func (s *CoreInstanceResourceCrud) SetData() error {
 	}
 
 	if s.Res.LifecycleState == oci_core.InstanceLifecycleStateRunning {
-		vnic, vnicError := s.getPrimaryVnic()
+		vnic, vnicError := s.getPrimaryVnic(s.OperationContext())
 		if vnicError != nil || vnic == nil {
 			log.Printf("[WARN] Primary VNIC could not be found during instance refresh: %q", vnicError)
 		} else {
@@ -1101,7 +1575,10 @@ func InstanceSourceDetailsToMap(obj *oci_core.InstanceSourceDetails, bootVolume
 			result["kms_key_id"] = string(*v.KmsKeyId)
 		}
 
-		if v.ImageId != nil {
+		if bootVolume != nil && bootVolume.ImageId != nil {
+			// The boot volume may have been replaced by one built from another image than the instance was launched from
+			result["source_id"] = string(*bootVolume.ImageId)
+		} else if v.ImageId != nil {
 			result["source_id"] = string(*v.ImageId)
 		}
 	default:
@@ -1158,7 +1635,7 @@ func mapToExtendedMetadata(rm map[string]interface{}) (map[string]interface{}, e
 	return result, nil
 }
 
-func (s *CoreInstanceResourceCrud) getPrimaryVnic() (*oci_core.Vnic, error) {
+func (s *CoreInstanceResourceCrud) getPrimaryVnic(ctx context.Context) (*oci_core.Vnic, error) {
 	request := oci_core.ListVnicAttachmentsRequest{
 		CompartmentId: s.Res.CompartmentId,
 		InstanceId:    s.Res.Id,
@@ -1167,7 +1644,7 @@ func (s *CoreInstanceResourceCrud) getPrimaryVnic() (*oci_core.Vnic, error) {
 	var attachments []oci_core.VnicAttachment
 
 	for {
-		result, err := s.Client.ListVnicAttachments(context.Background(), request)
+		result, err := s.Client.ListVnicAttachments(ctx, request)
 		if err != nil {
 			return nil, err
 		}
@@ -1187,7 +1664,7 @@ func (s *CoreInstanceResourceCrud) getPrimaryVnic() (*oci_core.Vnic, error) {
 	for _, attachment := range attachments {
 		if attachment.LifecycleState == oci_core.VnicAttachmentLifecycleStateAttached {
 			request := oci_core.GetVnicRequest{VnicId: attachment.VnicId}
-			response, _ := s.VirtualNetworkClient.GetVnic(context.Background(), request)
+			response, _ := s.VirtualNetworkClient.GetVnic(ctx, request)
 			vnic := &response.Vnic
 
 			// Ignore errors on GetVnic, since we might not have permissions to view some secondary VNICs.
@@ -1200,14 +1677,16 @@ func (s *CoreInstanceResourceCrud) getPrimaryVnic() (*oci_core.Vnic, error) {
 	return nil, errors.New("Primary VNIC not found.")
 }
 
-func (s *CoreInstanceResourceCrud) getBootVolume() (*oci_core.BootVolume, error) {
+// getBootVolumeAttachment returns the attachment of the boot volume of the instance, preferring an attached one over the
+// attachments of the boot volumes detached from the instance
+func (s *CoreInstanceResourceCrud) getBootVolumeAttachment(ctx context.Context) (*oci_core.BootVolumeAttachment, error) {
 	request := oci_core.ListBootVolumeAttachmentsRequest{
 		AvailabilityDomain: s.Res.AvailabilityDomain,
 		CompartmentId:      s.Res.CompartmentId,
 		InstanceId:         s.Res.Id,
 	}
 
-	response, err := s.Client.ListBootVolumeAttachments(context.Background(), request)
+	response, err := s.Client.ListBootVolumeAttachments(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -1216,13 +1695,29 @@ func (s *CoreInstanceResourceCrud) getBootVolume() (*oci_core.BootVolume, error)
 		return nil, fmt.Errorf("Could not find any attached boot volumes")
 	}
 
-	bootVolumeId := response.Items[0].BootVolumeId
-	if bootVolumeId == nil {
+	attachment := response.Items[0]
+	for _, item := range response.Items {
+		if item.LifecycleState == oci_core.BootVolumeAttachmentLifecycleStateAttached {
+			attachment = item
+			break
+		}
+	}
+
+	if attachment.BootVolumeId == nil {
 		return nil, fmt.Errorf("Found a boot volume attachment with no boot volume ID")
 	}
 
-	bootVolumeRequest := oci_core.GetBootVolumeRequest{BootVolumeId: bootVolumeId}
-	bootVolumeResponse, err := s.BlockStorageClient.GetBootVolume(context.Background(), bootVolumeRequest)
+	return &attachment, nil
+}
+
+func (s *CoreInstanceResourceCrud) getBootVolume(ctx context.Context) (*oci_core.BootVolume, error) {
+	attachment, err := s.getBootVolumeAttachment(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bootVolumeRequest := oci_core.GetBootVolumeRequest{BootVolumeId: attachment.BootVolumeId}
+	bootVolumeResponse, err := s.BlockStorageClient.GetBootVolume(ctx, bootVolumeRequest)
 	if err != nil {
 		return nil, err
 	}
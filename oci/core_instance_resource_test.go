@@ -3,15 +3,24 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"regexp"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	oci_common "github.com/oracle/oci-go-sdk/common"
 	"github.com/oracle/oci-go-sdk/core"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -925,6 +934,58 @@ func (s *ResourceCoreInstanceTestSuite) TestAccResourceCoreInstance_failedByTime
 	})
 }
 
+// Tests that the shape of an instance is updated in place
+func (s *ResourceCoreInstanceTestSuite) TestAccResourceCoreInstance_updateShape() {
+
+	var instanceId string
+	config := func(shape string) string {
+		return s.Config + `
+				resource "oci_core_instance" "t" {
+					availability_domain = "${data.oci_identity_availability_domains.ADs.availability_domains.0.name}"
+					compartment_id = "${var.compartment_id}"
+					subnet_id = "${oci_core_subnet.t.id}"
+					image = "${var.InstanceImageOCID[var.region]}"
+					shape = "` + shape + `"
+					metadata {
+						ssh_authorized_keys = "${var.ssh_public_key}"
+					}
+				}`
+	}
+
+	resource.Test(s.T(), resource.TestCase{
+		Providers: s.Providers,
+		Steps: []resource.TestStep{
+			// verify create
+			{
+				Config: config("VM.Standard2.1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(s.ResourceName, "shape", "VM.Standard2.1"),
+					resource.TestCheckResourceAttr(s.ResourceName, "state", string(core.InstanceLifecycleStateRunning)),
+					func(ts *terraform.State) (err error) {
+						instanceId, err = fromInstanceState(ts, s.ResourceName, "id")
+						return err
+					},
+				),
+			},
+			// verify the shape is updated without replacing the running instance
+			{
+				Config: config("VM.Standard2.2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(s.ResourceName, "shape", "VM.Standard2.2"),
+					resource.TestCheckResourceAttr(s.ResourceName, "state", string(core.InstanceLifecycleStateRunning)),
+					func(ts *terraform.State) (err error) {
+						newId, err := fromInstanceState(ts, s.ResourceName, "id")
+						if newId != instanceId {
+							return fmt.Errorf("expected same instance ocid, got a new one")
+						}
+						return err
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestIsStatefulResource(t *testing.T) {
 	var _ StatefulResource = (*CoreInstanceResourceCrud)(nil)
 }
@@ -932,3 +993,584 @@ func TestIsStatefulResource(t *testing.T) {
 func TestResourceCoreInstanceTestSuite(t *testing.T) {
 	suite.Run(t, new(ResourceCoreInstanceTestSuite))
 }
+
+// fakeInstanceCompute serves the compute, networking and block storage requests made to update an instance in place
+type fakeInstanceCompute struct {
+	instances   map[string]*core.Instance
+	bootVolumes map[string]*core.BootVolume
+	attachments []*core.BootVolumeAttachment
+	pool        *core.InstancePool
+	shapes      []string
+	actions     []string
+	ids         int
+	// ignoreSoftStop leaves the instances running on a SOFTSTOP
+	ignoreSoftStop bool
+	// rejectNextAttach fails the next attachment of a boot volume
+	rejectNextAttach bool
+}
+
+func newFakeInstanceCompute(shapes ...string) *fakeInstanceCompute {
+	return &fakeInstanceCompute{
+		instances:   map[string]*core.Instance{},
+		bootVolumes: map[string]*core.BootVolume{},
+		shapes:      shapes,
+	}
+}
+
+// launch adds a running instance, with a boot volume built from an image
+func (f *fakeInstanceCompute) launch(displayName string, shape string, imageId string) *core.Instance {
+	f.ids++
+	instance := &core.Instance{
+		AvailabilityDomain: oci_common.String("ad"),
+		CompartmentId:      oci_common.String(testTenancyOCID),
+		DisplayName:        oci_common.String(displayName),
+		Id:                 oci_common.String(fmt.Sprintf("ocid1.instance.oc1..%d", f.ids)),
+		ImageId:            oci_common.String(imageId),
+		LifecycleState:     core.InstanceLifecycleStateRunning,
+		Shape:              oci_common.String(shape),
+		SourceDetails:      core.InstanceSourceViaImageDetails{ImageId: oci_common.String(imageId)},
+	}
+	f.instances[*instance.Id] = instance
+
+	bootVolume := &core.BootVolume{
+		Id:             oci_common.String(fmt.Sprintf("ocid1.bootvolume.oc1..%d", f.ids)),
+		ImageId:        oci_common.String(imageId),
+		LifecycleState: core.BootVolumeLifecycleStateAvailable,
+		SizeInGBs:      oci_common.Int64(47),
+	}
+	f.bootVolumes[*bootVolume.Id] = bootVolume
+	f.attach(*bootVolume.Id, *instance.Id)
+	return instance
+}
+
+func (f *fakeInstanceCompute) attach(bootVolumeId string, instanceId string) *core.BootVolumeAttachment {
+	f.ids++
+	attachment := &core.BootVolumeAttachment{
+		BootVolumeId:   oci_common.String(bootVolumeId),
+		Id:             oci_common.String(fmt.Sprintf("ocid1.bootvolumeattachment.oc1..%d", f.ids)),
+		InstanceId:     oci_common.String(instanceId),
+		LifecycleState: core.BootVolumeAttachmentLifecycleStateAttached,
+	}
+	f.attachments = append(f.attachments, attachment)
+	return attachment
+}
+
+func (f *fakeInstanceCompute) attachment(id string) *core.BootVolumeAttachment {
+	for _, attachment := range f.attachments {
+		if *attachment.Id == id {
+			return attachment
+		}
+	}
+	return nil
+}
+
+func (f *fakeInstanceCompute) Do(request *http.Request) (*http.Response, error) {
+	recorder := httptest.NewRecorder()
+	status, body := f.handle(request)
+	if body == nil {
+		status, body = http.StatusNotFound, map[string]string{"code": "NotAuthorizedOrNotFound", "message": request.URL.Path}
+	}
+
+	recorder.Header().Set("Content-Type", "application/json")
+	recorder.WriteHeader(status)
+	json.NewEncoder(recorder).Encode(body)
+	response := recorder.Result()
+	response.Request = request
+	return response, nil
+}
+
+func (f *fakeInstanceCompute) handle(request *http.Request) (int, interface{}) {
+	// The paths are made of the API version, a collection and the ID of an item of the collection
+	path := strings.Split(strings.Trim(request.URL.Path, "/"), "/")
+	collection, id := path[1], ""
+	if len(path) > 2 {
+		id = path[2]
+	}
+	details := map[string]interface{}{}
+	if request.Body != nil {
+		json.NewDecoder(request.Body).Decode(&details)
+	}
+
+	switch collection + " " + request.Method {
+	case "shapes GET":
+		var items []core.Shape
+		for _, shape := range f.shapes {
+			items = append(items, core.Shape{Shape: oci_common.String(shape)})
+		}
+		return http.StatusOK, items
+	case "instances POST":
+		if id == "" {
+			sourceDetails := details["sourceDetails"].(map[string]interface{})
+			f.actions = append(f.actions, "launch "+details["displayName"].(string))
+			return http.StatusOK, f.launch(details["displayName"].(string), details["shape"].(string), sourceDetails["imageId"].(string))
+		}
+		instance := f.instances[id]
+		action := core.InstanceActionActionEnum(request.URL.Query().Get("action"))
+		f.actions = append(f.actions, string(action))
+		f.instanceAction(instance, action)
+		return http.StatusOK, instance
+	case "instances GET":
+		return http.StatusOK, f.instances[id]
+	case "instances PUT":
+		instance := f.instances[id]
+		if shape, ok := details["shape"].(string); ok {
+			f.actions = append(f.actions, "shape "+shape)
+			compatible := false
+			for _, compatibleShape := range f.shapes {
+				compatible = compatible || compatibleShape == shape
+			}
+			if !compatible {
+				return http.StatusBadRequest, map[string]string{"code": "InvalidParameter", "message": "The shape is not compatible with the image"}
+			}
+			instance.Shape = &shape
+		}
+		return http.StatusOK, instance
+	case "instances DELETE":
+		instance := f.instances[id]
+		instance.LifecycleState = core.InstanceLifecycleStateTerminated
+		preserveBootVolume := request.URL.Query().Get("preserveBootVolume") == "true"
+		f.actions = append(f.actions, fmt.Sprintf("terminate %s preserving its boot volume %v", *instance.DisplayName, preserveBootVolume))
+		for _, attachment := range f.attachments {
+			if *attachment.InstanceId == id {
+				attachment.LifecycleState = core.BootVolumeAttachmentLifecycleStateDetached
+				if !preserveBootVolume {
+					delete(f.bootVolumes, *attachment.BootVolumeId)
+				}
+			}
+		}
+		return http.StatusNoContent, instance
+	case "bootVolumeAttachments GET":
+		if id != "" {
+			return http.StatusOK, f.attachment(id)
+		}
+		items := []*core.BootVolumeAttachment{}
+		for _, attachment := range f.attachments {
+			if *attachment.InstanceId == request.URL.Query().Get("instanceId") {
+				items = append(items, attachment)
+			}
+		}
+		return http.StatusOK, items
+	case "bootVolumeAttachments POST":
+		bootVolumeId := details["bootVolumeId"].(string)
+		f.actions = append(f.actions, "attach "+bootVolumeId)
+		if f.rejectNextAttach {
+			f.rejectNextAttach = false
+			return http.StatusBadRequest, map[string]string{"code": "InvalidParameter", "message": "The boot volume cannot be attached"}
+		}
+		return http.StatusOK, f.attach(bootVolumeId, details["instanceId"].(string))
+	case "bootVolumeAttachments DELETE":
+		attachment := f.attachment(id)
+		f.actions = append(f.actions, "detach "+*attachment.BootVolumeId)
+		attachment.LifecycleState = core.BootVolumeAttachmentLifecycleStateDetached
+		return http.StatusNoContent, attachment
+	case "bootVolumes GET":
+		return http.StatusOK, f.bootVolumes[id]
+	case "bootVolumes DELETE":
+		f.actions = append(f.actions, "delete "+id)
+		delete(f.bootVolumes, id)
+		return http.StatusNoContent, map[string]string{}
+	case "instancePools GET":
+		if len(path) > 3 {
+			var items []core.InstanceSummary
+			for _, instance := range f.instances {
+				if instance.LifecycleState != core.InstanceLifecycleStateTerminated {
+					items = append(items, core.InstanceSummary{Id: instance.Id, State: oci_common.String(strings.Title(strings.ToLower(string(instance.LifecycleState))))})
+				}
+			}
+			return http.StatusOK, items
+		}
+		return http.StatusOK, f.pool
+	case "instancePools PUT":
+		return http.StatusOK, f.pool
+	case "instancePools POST":
+		action := path[len(path)-1]
+		f.actions = append(f.actions, "pool "+action)
+		for _, instance := range f.instances {
+			f.instanceAction(instance, core.InstanceActionActionEnum(strings.ToUpper(action)))
+		}
+		if action == "stop" {
+			f.pool.LifecycleState = core.InstancePoolLifecycleStateStopped
+		} else {
+			f.pool.LifecycleState = core.InstancePoolLifecycleStateRunning
+		}
+		return http.StatusOK, f.pool
+	case "vnicAttachments GET":
+		return http.StatusOK, []core.VnicAttachment{{
+			InstanceId:     oci_common.String(request.URL.Query().Get("instanceId")),
+			LifecycleState: core.VnicAttachmentLifecycleStateAttached,
+			VnicId:         oci_common.String("ocid1.vnic.oc1..vnic"),
+		}}
+	case "vnics GET":
+		return http.StatusOK, core.Vnic{
+			Id:        oci_common.String(id),
+			IsPrimary: oci_common.Bool(true),
+			PrivateIp: oci_common.String("10.0.1.2"),
+			SubnetId:  oci_common.String("ocid1.subnet.oc1..subnet"),
+		}
+	}
+	return http.StatusNotFound, nil
+}
+
+func (f *fakeInstanceCompute) instanceAction(instance *core.Instance, action core.InstanceActionActionEnum) {
+	switch action {
+	case core.InstanceActionActionStop:
+		instance.LifecycleState = core.InstanceLifecycleStateStopped
+	case core.InstanceActionActionSoftstop:
+		if !f.ignoreSoftStop {
+			instance.LifecycleState = core.InstanceLifecycleStateStopped
+		}
+	default:
+		instance.LifecycleState = core.InstanceLifecycleStateRunning
+	}
+}
+
+func newFakeInstanceClients(t *testing.T, fake *fakeInstanceCompute) *OracleClients {
+	password := "password"
+	configProvider := oci_common.NewRawConfigurationProvider(testTenancyOCID, testUserOCID, "us-phoenix-1", testKeyFingerPrint, testPrivateKey, &password)
+	computeClient, err := core.NewComputeClientWithConfigurationProvider(configProvider)
+	assert.NoError(t, err)
+	computeClient.HTTPClient = fake
+	virtualNetworkClient, err := core.NewVirtualNetworkClientWithConfigurationProvider(configProvider)
+	assert.NoError(t, err)
+	virtualNetworkClient.HTTPClient = fake
+	blockstorageClient, err := core.NewBlockstorageClientWithConfigurationProvider(configProvider)
+	assert.NoError(t, err)
+	blockstorageClient.HTTPClient = fake
+	computeManagementClient, err := core.NewComputeManagementClientWithConfigurationProvider(configProvider)
+	assert.NoError(t, err)
+	computeManagementClient.HTTPClient = fake
+	return &OracleClients{
+		blockstorageClient:      &blockstorageClient,
+		computeClient:           &computeClient,
+		computeManagementClient: &computeManagementClient,
+		virtualNetworkClient:    &virtualNetworkClient,
+	}
+}
+
+// fakeInstanceState returns the state of an instance of the fake, as if it was created from a configuration
+func fakeInstanceState(instance *core.Instance, attributes map[string]string) *terraform.InstanceState {
+	state := &terraform.InstanceState{
+		ID: *instance.Id,
+		Attributes: map[string]string{
+			"id":                           *instance.Id,
+			"availability_domain":          *instance.AvailabilityDomain,
+			"compartment_id":               *instance.CompartmentId,
+			"display_name":                 *instance.DisplayName,
+			"image":                        *instance.ImageId,
+			"shape":                        *instance.Shape,
+			"source_details.#":             "1",
+			"source_details.0.source_id":   *instance.ImageId,
+			"source_details.0.source_type": "image",
+			"state":                        string(instance.LifecycleState),
+		},
+	}
+	for key, value := range attributes {
+		state.Attributes[key] = value
+	}
+	return state
+}
+
+// fakeInstanceDiff returns the diff between the state of an instance and a configuration
+func fakeInstanceDiff(t *testing.T, state *terraform.InstanceState, raw map[string]interface{}, clients *OracleClients) *terraform.InstanceDiff {
+	rawConfig, err := config.NewRawConfig(raw)
+	assert.NoError(t, err)
+	diff, err := CoreInstanceResource().Diff(state, terraform.NewResourceConfig(rawConfig), clients)
+	assert.NoError(t, err)
+	return diff
+}
+
+func TestCoreInstanceResourceCrud_updateShape(t *testing.T) {
+	fake := newFakeInstanceCompute("VM.Standard2.1", "VM.Standard2.2")
+	clients := newFakeInstanceClients(t, fake)
+	instance := fake.launch("instance", "VM.Standard2.1", "ocid1.image.oc1..image")
+
+	updateShape := func(shape string) (bool, error) {
+		sync := &CoreInstanceResourceCrud{Client: clients.computeClient}
+		sync.D = schema.TestResourceDataRaw(t, CoreInstanceResource().Schema, map[string]interface{}{
+			"availability_domain": "ad",
+			"compartment_id":      testTenancyOCID,
+			"shape":               shape,
+		})
+		sync.D.SetId(*instance.Id)
+		return sync.UpdateShape(context.Background())
+	}
+
+	// A running instance is stopped for its shape to be updated
+	stopped, err := updateShape("VM.Standard2.2")
+	assert.NoError(t, err)
+	assert.True(t, stopped)
+	assert.Equal(t, []string{"STOP", "shape VM.Standard2.2"}, fake.actions)
+	assert.Equal(t, "VM.Standard2.2", *instance.Shape)
+
+	// A stopped instance is left stopped
+	fake.actions = nil
+	stopped, err = updateShape("VM.Standard2.1")
+	assert.NoError(t, err)
+	assert.False(t, stopped)
+	assert.Equal(t, []string{"shape VM.Standard2.1"}, fake.actions)
+
+	// The errors of the service are returned, once the instance stopped for the update is started again
+	instance.LifecycleState = core.InstanceLifecycleStateRunning
+	fake.actions = nil
+	stopped, err = updateShape("BM.Standard2.52")
+	assert.Error(t, err)
+	assert.False(t, stopped)
+	assert.Equal(t, []string{"STOP", "shape BM.Standard2.52", "START"}, fake.actions)
+	assert.Equal(t, "VM.Standard2.1", *instance.Shape)
+	assert.Equal(t, core.InstanceLifecycleStateRunning, instance.LifecycleState)
+}
+
+func TestCoreInstanceResource_shapeDiff(t *testing.T) {
+	fake := newFakeInstanceCompute("VM.Standard2.1", "VM.Standard2.2")
+	clients := newFakeInstanceClients(t, fake)
+	state := fakeInstanceState(fake.launch("instance", "VM.Standard2.1", "ocid1.image.oc1..image"), nil)
+
+	shapeDiff := func(shape string) *terraform.ResourceAttrDiff {
+		diff := fakeInstanceDiff(t, state, map[string]interface{}{
+			"availability_domain": "ad",
+			"compartment_id":      testTenancyOCID,
+			"shape":               shape,
+		}, clients)
+		if diff == nil {
+			return nil
+		}
+		return diff.Attributes["shape"]
+	}
+
+	// A shape compatible with the image is updated in place
+	if diff := shapeDiff("VM.Standard2.2"); assert.NotNil(t, diff) {
+		assert.Equal(t, "VM.Standard2.2", diff.New)
+		assert.False(t, diff.RequiresNew)
+	}
+
+	// Other shapes replace the instance
+	if diff := shapeDiff("BM.Standard2.52"); assert.NotNil(t, diff) {
+		assert.True(t, diff.RequiresNew)
+	}
+
+	// The compatibility is checked with the image of the source details, not the deprecated image attribute
+	state.Attributes["image"] = "ocid1.image.oc1..other"
+	if diff := shapeDiff("BM.Standard2.52"); assert.NotNil(t, diff) {
+		assert.True(t, diff.RequiresNew)
+	}
+
+	// The shape of an instance launched from a boot volume is updated in place
+	state.Attributes["source_details.0.source_type"] = "bootVolume"
+	state.Attributes["source_details.0.source_id"] = "ocid1.bootvolume.oc1..bootvolume"
+	if diff := shapeDiff("BM.Standard2.52"); assert.NotNil(t, diff) {
+		assert.False(t, diff.RequiresNew)
+	}
+}
+
+func TestCoreInstanceResource_replaceBootVolume(t *testing.T) {
+	fake := newFakeInstanceCompute("VM.Standard2.1")
+	clients := newFakeInstanceClients(t, fake)
+	instance := fake.launch("instance", "VM.Standard2.1", "ocid1.image.oc1..old")
+	oldBootVolumeId := *fake.attachments[0].BootVolumeId
+
+	instanceConfig := func(replaceBootVolume bool, preserveBootVolume bool) map[string]interface{} {
+		return map[string]interface{}{
+			"availability_domain":                 "ad",
+			"compartment_id":                      testTenancyOCID,
+			"display_name":                        "instance",
+			"shape":                               "VM.Standard2.1",
+			"preserve_boot_volume":                preserveBootVolume,
+			"replace_boot_volume_on_image_change": replaceBootVolume,
+			"source_details": []interface{}{map[string]interface{}{
+				"source_id":   "ocid1.image.oc1..new",
+				"source_type": "image",
+			}},
+		}
+	}
+
+	// The instance is replaced unless its boot volume is to be replaced
+	diff := fakeInstanceDiff(t, fakeInstanceState(instance, nil), instanceConfig(false, false), clients)
+	if assert.NotNil(t, diff) && assert.Contains(t, diff.Attributes, "source_details.0.source_id") {
+		assert.True(t, diff.Attributes["source_details.0.source_id"].RequiresNew)
+	}
+
+	state := fakeInstanceState(instance, map[string]string{"replace_boot_volume_on_image_change": "true"})
+	diff = fakeInstanceDiff(t, state, instanceConfig(true, false), clients)
+	if !assert.NotNil(t, diff) || !assert.False(t, diff.RequiresNew()) {
+		return
+	}
+	state, err := CoreInstanceResource().Apply(state, diff, clients)
+	assert.NoError(t, err)
+
+	// The boot volume is built by a temporary instance, and replaced while the instance is stopped
+	newBootVolumeId := fake.attachments[len(fake.attachments)-1].BootVolumeId
+	assert.Equal(t, []string{
+		"launch instance-boot-volume",
+		"terminate instance-boot-volume preserving its boot volume true",
+		"STOP",
+		"detach " + oldBootVolumeId,
+		"attach " + *newBootVolumeId,
+		"delete " + oldBootVolumeId,
+		"START",
+	}, fake.actions)
+	assert.Equal(t, *instance.Id, state.ID)
+	assert.Equal(t, "ocid1.image.oc1..new", state.Attributes["source_details.0.source_id"])
+	assert.Equal(t, *newBootVolumeId, state.Attributes["boot_volume_id"])
+	assert.Equal(t, string(core.InstanceLifecycleStateRunning), state.Attributes["state"])
+	assert.NotContains(t, fake.bootVolumes, oldBootVolumeId)
+
+	// The previous boot volume is kept when it is to be preserved
+	fake.actions = nil
+	config := instanceConfig(true, true)
+	config["source_details"].([]interface{})[0].(map[string]interface{})["source_id"] = "ocid1.image.oc1..newer"
+	diff = fakeInstanceDiff(t, state, config, clients)
+	_, err = CoreInstanceResource().Apply(state, diff, clients)
+	assert.NoError(t, err)
+	assert.Contains(t, fake.bootVolumes, *newBootVolumeId)
+	assert.NotContains(t, fake.actions, "delete "+*newBootVolumeId)
+}
+
+func TestCoreInstanceResource_replaceBootVolumeRollback(t *testing.T) {
+	fake := newFakeInstanceCompute("VM.Standard2.1")
+	clients := newFakeInstanceClients(t, fake)
+	instance := fake.launch("instance", "VM.Standard2.1", "ocid1.image.oc1..old")
+	oldBootVolumeId := *fake.attachments[0].BootVolumeId
+
+	state := fakeInstanceState(instance, map[string]string{"replace_boot_volume_on_image_change": "true"})
+	diff := fakeInstanceDiff(t, state, map[string]interface{}{
+		"availability_domain":                 "ad",
+		"compartment_id":                      testTenancyOCID,
+		"display_name":                        "instance",
+		"shape":                               "VM.Standard2.1",
+		"replace_boot_volume_on_image_change": true,
+		"source_details": []interface{}{map[string]interface{}{
+			"source_id":   "ocid1.image.oc1..new",
+			"source_type": "image",
+		}},
+	}, clients)
+	if !assert.NotNil(t, diff) {
+		return
+	}
+
+	// When the new boot volume cannot be attached, the previous one is attached again, and the new one deleted
+	fake.rejectNextAttach = true
+	_, err := CoreInstanceResource().Apply(state, diff, clients)
+	assert.Error(t, err)
+	newBootVolumeId := *fake.attachments[1].BootVolumeId
+	assert.Equal(t, []string{
+		"launch instance-boot-volume",
+		"terminate instance-boot-volume preserving its boot volume true",
+		"STOP",
+		"detach " + oldBootVolumeId,
+		"attach " + newBootVolumeId,
+		"attach " + oldBootVolumeId,
+		"delete " + newBootVolumeId,
+		"START",
+	}, fake.actions)
+	assert.Contains(t, fake.bootVolumes, oldBootVolumeId)
+	assert.NotContains(t, fake.bootVolumes, newBootVolumeId)
+	assert.Equal(t, core.InstanceLifecycleStateRunning, instance.LifecycleState)
+	attached := fake.attachments[len(fake.attachments)-1]
+	assert.Equal(t, oldBootVolumeId, *attached.BootVolumeId)
+	assert.Equal(t, core.BootVolumeAttachmentLifecycleStateAttached, attached.LifecycleState)
+}
+
+func TestCoreInstanceResource_powerActions(t *testing.T) {
+	fake := newFakeInstanceCompute("VM.Standard2.1")
+	clients := newFakeInstanceClients(t, fake)
+	instance := fake.launch("instance", "VM.Standard2.1", "ocid1.image.oc1..image")
+	state := fakeInstanceState(instance, map[string]string{"reboot_action": "SOFTRESET", "reboot_trigger": "1"})
+
+	apply := func(attributes map[string]interface{}) []string {
+		fake.actions = nil
+		raw := map[string]interface{}{
+			"availability_domain": "ad",
+			"compartment_id":      testTenancyOCID,
+			"display_name":        "instance",
+			"shape":               "VM.Standard2.1",
+		}
+		for key, value := range attributes {
+			raw[key] = value
+		}
+		diff := fakeInstanceDiff(t, state, raw, clients)
+		if diff == nil {
+			return nil
+		}
+		var err error
+		state, err = CoreInstanceResource().Apply(state, diff, clients)
+		assert.NoError(t, err)
+		return fake.actions
+	}
+
+	// A change of the reboot trigger reboots the instance
+	assert.Equal(t, []string{"SOFTRESET"}, apply(map[string]interface{}{"reboot_trigger": "2"}))
+	assert.Equal(t, []string{"RESET"}, apply(map[string]interface{}{"reboot_trigger": "3", "reboot_action": "reset"}))
+	assert.Empty(t, apply(map[string]interface{}{"reboot_trigger": "3", "reboot_action": "reset"}))
+
+	// The instance is stopped gracefully, and then forcibly when it does not stop in time
+	assert.Equal(t, []string{"SOFTSTOP"}, apply(map[string]interface{}{"state": "STOPPED", "graceful_stop_timeout_in_seconds": 60}))
+	assert.Equal(t, string(core.InstanceLifecycleStateStopped), state.Attributes["state"])
+	assert.Equal(t, []string{"START"}, apply(map[string]interface{}{"state": "RUNNING", "reboot_trigger": "4"}))
+	fake.ignoreSoftStop = true
+	assert.Equal(t, []string{"SOFTSTOP", "STOP"}, apply(map[string]interface{}{"state": "STOPPED", "graceful_stop_timeout_in_seconds": 1}))
+	assert.Equal(t, string(core.InstanceLifecycleStateStopped), state.Attributes["state"])
+}
+
+func TestCoreInstancePoolResource_powerActions(t *testing.T) {
+	fake := newFakeInstanceCompute("VM.Standard2.1")
+	clients := newFakeInstanceClients(t, fake)
+	fake.launch("instance-1", "VM.Standard2.1", "ocid1.image.oc1..image")
+	fake.launch("instance-2", "VM.Standard2.1", "ocid1.image.oc1..image")
+	fake.pool = &core.InstancePool{
+		CompartmentId:           oci_common.String(testTenancyOCID),
+		Id:                      oci_common.String("ocid1.instancepool.oc1..pool"),
+		InstanceConfigurationId: oci_common.String("ocid1.instanceconfiguration.oc1..configuration"),
+		LifecycleState:          core.InstancePoolLifecycleStateRunning,
+		PlacementConfigurations: []core.InstancePoolPlacementConfiguration{{
+			AvailabilityDomain: oci_common.String("ad"),
+			PrimarySubnetId:    oci_common.String("ocid1.subnet.oc1..subnet"),
+		}},
+		Size: oci_common.Int(2),
+	}
+	state := &terraform.InstanceState{
+		ID: *fake.pool.Id,
+		Attributes: map[string]string{
+			"id":                         *fake.pool.Id,
+			"compartment_id":             testTenancyOCID,
+			"instance_configuration_id":  *fake.pool.InstanceConfigurationId,
+			"load_balancers.#":           "0",
+			"placement_configurations.#": "1",
+			"placement_configurations.0.availability_domain": "ad",
+			"placement_configurations.0.primary_subnet_id":   "ocid1.subnet.oc1..subnet",
+			"reboot_action":  "SOFTRESET",
+			"reboot_trigger": "1",
+			"size":           "2",
+			"state":          "RUNNING",
+		},
+	}
+
+	apply := func(attributes map[string]interface{}) []string {
+		fake.actions = nil
+		raw := map[string]interface{}{
+			"compartment_id":            testTenancyOCID,
+			"instance_configuration_id": *fake.pool.InstanceConfigurationId,
+			"placement_configurations": []interface{}{map[string]interface{}{
+				"availability_domain": "ad",
+				"primary_subnet_id":   "ocid1.subnet.oc1..subnet",
+			}},
+			"size": 2,
+		}
+		for key, value := range attributes {
+			raw[key] = value
+		}
+		rawConfig, err := config.NewRawConfig(raw)
+		assert.NoError(t, err)
+		diff, err := CoreInstancePoolResource().Diff(state, terraform.NewResourceConfig(rawConfig), clients)
+		assert.NoError(t, err)
+		state, err = CoreInstancePoolResource().Apply(state, diff, clients)
+		assert.NoError(t, err)
+		return fake.actions
+	}
+
+	// A change of the reboot trigger reboots the instances
+	assert.Equal(t, []string{"pool start", "pool softreset"}, apply(map[string]interface{}{"reboot_trigger": "2"}))
+
+	// The instances are stopped gracefully before the instance pool is stopped
+	assert.Equal(t, []string{"SOFTSTOP", "SOFTSTOP", "pool stop"}, apply(map[string]interface{}{"reboot_trigger": "2", "state": "stopped", "graceful_stop_timeout_in_seconds": 60}))
+	assert.Equal(t, string(core.InstancePoolLifecycleStateStopped), state.Attributes["state"])
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
@@ -14,7 +15,8 @@ func CoreInstancesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreInstances,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"availability_domain": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -46,6 +48,22 @@ func CoreInstancesDataSource() *schema.Resource {
 				Optional:   true,
 				Deprecated: FieldDeprecated("page"),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListInstancesSortByTimecreated),
+					string(oci_core.ListInstancesSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListInstancesSortOrderAsc),
+					string(oci_core.ListInstancesSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -55,7 +73,7 @@ func readCoreInstances(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreInstancesDataSourceCrud struct {
@@ -68,7 +86,7 @@ func (s *CoreInstancesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreInstancesDataSourceCrud) Get() error {
+func (s *CoreInstancesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListInstancesRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -90,9 +108,18 @@ func (s *CoreInstancesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_core.InstanceLifecycleStateEnum(state.(string))
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_core.ListInstancesSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_core.ListInstancesSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListInstances(context.Background(), request)
+	response, err := s.Client.ListInstances(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -100,8 +127,8 @@ func (s *CoreInstancesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListInstances(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListInstances(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -213,6 +240,8 @@ func (s *CoreInstancesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreInstancesDataSource().Schema["instances"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("instances", resources); err != nil {
 		return err
 	}
@@ -83,7 +83,7 @@ func createCoreInternetGateway(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreInternetGateway(d *schema.ResourceData, m interface{}) error {
@@ -91,7 +91,7 @@ func readCoreInternetGateway(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreInternetGateway(d *schema.ResourceData, m interface{}) error {
@@ -99,7 +99,7 @@ func updateCoreInternetGateway(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCoreInternetGateway(d *schema.ResourceData, m interface{}) error {
@@ -108,7 +108,7 @@ func deleteCoreInternetGateway(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreInternetGatewayResourceCrud struct {
@@ -146,7 +146,7 @@ func (s *CoreInternetGatewayResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreInternetGatewayResourceCrud) Create() error {
+func (s *CoreInternetGatewayResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateInternetGatewayRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -183,7 +183,7 @@ func (s *CoreInternetGatewayResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateInternetGateway(context.Background(), request)
+	response, err := s.Client.CreateInternetGateway(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -192,7 +192,7 @@ func (s *CoreInternetGatewayResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreInternetGatewayResourceCrud) Get() error {
+func (s *CoreInternetGatewayResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetInternetGatewayRequest{}
 
 	tmp := s.D.Id()
@@ -200,7 +200,7 @@ func (s *CoreInternetGatewayResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetInternetGateway(context.Background(), request)
+	response, err := s.Client.GetInternetGateway(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -209,7 +209,7 @@ func (s *CoreInternetGatewayResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreInternetGatewayResourceCrud) Update() error {
+func (s *CoreInternetGatewayResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateInternetGatewayRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -239,7 +239,7 @@ func (s *CoreInternetGatewayResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateInternetGateway(context.Background(), request)
+	response, err := s.Client.UpdateInternetGateway(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -248,7 +248,7 @@ func (s *CoreInternetGatewayResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreInternetGatewayResourceCrud) Delete() error {
+func (s *CoreInternetGatewayResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteInternetGatewayRequest{}
 
 	tmp := s.D.Id()
@@ -256,7 +256,7 @@ func (s *CoreInternetGatewayResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteInternetGateway(context.Background(), request)
+	_, err := s.Client.DeleteInternetGateway(ctx, request)
 	return err
 }
 
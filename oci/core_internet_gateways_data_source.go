@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
@@ -13,7 +14,8 @@ func CoreInternetGatewaysDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreInternetGateways,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -45,6 +47,22 @@ func CoreInternetGatewaysDataSource() *schema.Resource {
 				Optional:   true,
 				Deprecated: FieldDeprecated("page"),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListInternetGatewaysSortByTimecreated),
+					string(oci_core.ListInternetGatewaysSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListInternetGatewaysSortOrderAsc),
+					string(oci_core.ListInternetGatewaysSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -54,7 +72,7 @@ func readCoreInternetGateways(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreInternetGatewaysDataSourceCrud struct {
@@ -67,7 +85,7 @@ func (s *CoreInternetGatewaysDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreInternetGatewaysDataSourceCrud) Get() error {
+func (s *CoreInternetGatewaysDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListInternetGatewaysRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -99,9 +117,18 @@ func (s *CoreInternetGatewaysDataSourceCrud) Get() error {
 		request.Page = &tmp
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_core.ListInternetGatewaysSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_core.ListInternetGatewaysSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListInternetGateways(context.Background(), request)
+	response, err := s.Client.ListInternetGateways(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -109,8 +136,8 @@ func (s *CoreInternetGatewaysDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListInternetGateways(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListInternetGateways(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -167,6 +194,8 @@ func (s *CoreInternetGatewaysDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreInternetGatewaysDataSource().Schema["gateways"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("gateways", resources); err != nil {
 		return err
 	}
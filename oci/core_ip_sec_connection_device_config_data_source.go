@@ -56,7 +56,7 @@ func readSingularCoreIpSecConnectionDeviceConfig(d *schema.ResourceData, m inter
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreIpSecConnectionDeviceConfigDataSourceCrud struct {
@@ -69,7 +69,7 @@ func (s *CoreIpSecConnectionDeviceConfigDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreIpSecConnectionDeviceConfigDataSourceCrud) Get() error {
+func (s *CoreIpSecConnectionDeviceConfigDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetIPSecConnectionDeviceConfigRequest{}
 
 	if ipsecId, ok := s.D.GetOkExists("ipsec_id"); ok {
@@ -79,7 +79,7 @@ func (s *CoreIpSecConnectionDeviceConfigDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := s.Client.GetIPSecConnectionDeviceConfig(context.Background(), request)
+	response, err := s.Client.GetIPSecConnectionDeviceConfig(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -66,7 +66,7 @@ func readSingularCoreIpSecConnectionDeviceStatus(d *schema.ResourceData, m inter
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreIpSecConnectionDeviceStatusDataSourceCrud struct {
@@ -79,7 +79,7 @@ func (s *CoreIpSecConnectionDeviceStatusDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreIpSecConnectionDeviceStatusDataSourceCrud) Get() error {
+func (s *CoreIpSecConnectionDeviceStatusDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetIPSecConnectionDeviceStatusRequest{}
 
 	if ipsecId, ok := s.D.GetOkExists("ipsec_id"); ok {
@@ -89,7 +89,7 @@ func (s *CoreIpSecConnectionDeviceStatusDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := s.Client.GetIPSecConnectionDeviceStatus(context.Background(), request)
+	response, err := s.Client.GetIPSecConnectionDeviceStatus(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -93,7 +93,7 @@ func createCoreIpSecConnection(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreIpSecConnection(d *schema.ResourceData, m interface{}) error {
@@ -101,7 +101,7 @@ func readCoreIpSecConnection(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreIpSecConnection(d *schema.ResourceData, m interface{}) error {
@@ -109,7 +109,7 @@ func updateCoreIpSecConnection(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCoreIpSecConnection(d *schema.ResourceData, m interface{}) error {
@@ -118,7 +118,7 @@ func deleteCoreIpSecConnection(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreIpSecConnectionResourceCrud struct {
@@ -156,7 +156,7 @@ func (s *CoreIpSecConnectionResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreIpSecConnectionResourceCrud) Create() error {
+func (s *CoreIpSecConnectionResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateIPSecConnectionRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -205,7 +205,7 @@ func (s *CoreIpSecConnectionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateIPSecConnection(context.Background(), request)
+	response, err := s.Client.CreateIPSecConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -214,7 +214,7 @@ func (s *CoreIpSecConnectionResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreIpSecConnectionResourceCrud) Get() error {
+func (s *CoreIpSecConnectionResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetIPSecConnectionRequest{}
 
 	tmp := s.D.Id()
@@ -222,7 +222,7 @@ func (s *CoreIpSecConnectionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetIPSecConnection(context.Background(), request)
+	response, err := s.Client.GetIPSecConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -231,7 +231,7 @@ func (s *CoreIpSecConnectionResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreIpSecConnectionResourceCrud) Update() error {
+func (s *CoreIpSecConnectionResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateIPSecConnectionRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -256,7 +256,7 @@ func (s *CoreIpSecConnectionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateIPSecConnection(context.Background(), request)
+	response, err := s.Client.UpdateIPSecConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -265,7 +265,7 @@ func (s *CoreIpSecConnectionResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreIpSecConnectionResourceCrud) Delete() error {
+func (s *CoreIpSecConnectionResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteIPSecConnectionRequest{}
 
 	tmp := s.D.Id()
@@ -273,7 +273,7 @@ func (s *CoreIpSecConnectionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteIPSecConnection(context.Background(), request)
+	_, err := s.Client.DeleteIPSecConnection(ctx, request)
 	return err
 }
 
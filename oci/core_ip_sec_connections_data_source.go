@@ -13,7 +13,8 @@ func CoreIpSecConnectionsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreIpSecConnections,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -50,7 +51,7 @@ func readCoreIpSecConnections(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreIpSecConnectionsDataSourceCrud struct {
@@ -63,7 +64,7 @@ func (s *CoreIpSecConnectionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreIpSecConnectionsDataSourceCrud) Get() error {
+func (s *CoreIpSecConnectionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListIPSecConnectionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -92,8 +93,9 @@ func (s *CoreIpSecConnectionsDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListIPSecConnections(context.Background(), request)
+	response, err := s.Client.ListIPSecConnections(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -101,8 +103,8 @@ func (s *CoreIpSecConnectionsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListIPSecConnections(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListIPSecConnections(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -164,6 +166,8 @@ func (s *CoreIpSecConnectionsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreIpSecConnectionsDataSource().Schema["connections"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("connections", resources); err != nil {
 		return err
 	}
@@ -51,7 +51,7 @@ func readSingularCoreLetterOfAuthority(d *schema.ResourceData, m interface{}) er
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreLetterOfAuthorityDataSourceCrud struct {
@@ -64,7 +64,7 @@ func (s *CoreLetterOfAuthorityDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreLetterOfAuthorityDataSourceCrud) Get() error {
+func (s *CoreLetterOfAuthorityDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetCrossConnectLetterOfAuthorityRequest{}
 
 	if crossConnectId, ok := s.D.GetOkExists("cross_connect_id"); ok {
@@ -74,7 +74,7 @@ func (s *CoreLetterOfAuthorityDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := s.Client.GetCrossConnectLetterOfAuthority(context.Background(), request)
+	response, err := s.Client.GetCrossConnectLetterOfAuthority(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -110,12 +110,13 @@ func createCoreLocalPeeringGateway(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	err := CreateResource(d, sync)
+	ctx := m.(*OracleClients).StopContext()
+	err := CreateResource(ctx, d, sync)
 	if err != nil {
 		return err
 	}
 	//This needs to be here rather than in the Create() because we want the resource to finish provisioning and set to the statefile before we connect
-	return sync.ConnectLocalPeeringGateway()
+	return sync.ConnectLocalPeeringGateway(ctx)
 }
 
 func readCoreLocalPeeringGateway(d *schema.ResourceData, m interface{}) error {
@@ -123,7 +124,7 @@ func readCoreLocalPeeringGateway(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreLocalPeeringGateway(d *schema.ResourceData, m interface{}) error {
@@ -131,7 +132,7 @@ func updateCoreLocalPeeringGateway(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCoreLocalPeeringGateway(d *schema.ResourceData, m interface{}) error {
@@ -140,7 +141,7 @@ func deleteCoreLocalPeeringGateway(d *schema.ResourceData, m interface{}) error
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreLocalPeeringGatewayResourceCrud struct {
@@ -178,7 +179,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreLocalPeeringGatewayResourceCrud) ConnectLocalPeeringGateway() error {
+func (s *CoreLocalPeeringGatewayResourceCrud) ConnectLocalPeeringGateway(ctx context.Context) error {
 	if s.Res == nil || s.Res.Id == nil {
 		return fmt.Errorf("CreateLocalPeeringGateway returned a nil LocalPeeringGateway or a LocalPeeringGateway without an ID")
 	}
@@ -193,7 +194,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) ConnectLocalPeeringGateway() error
 
 		connectRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-		_, err := s.Client.ConnectLocalPeeringGateways(context.Background(), connectRequest)
+		_, err := s.Client.ConnectLocalPeeringGateways(ctx, connectRequest)
 		if err != nil {
 			// we set peer_id to "" so that terraform detects a forceNew change on the next apply and the user can try the connection again
 			s.D.Set("peer_id", "")
@@ -207,7 +208,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) ConnectLocalPeeringGateway() error
 
 		request.RequestMetadata.RetryPolicy = getLocalPeeringGatewayRetryPolicy(s.D.Timeout(schema.TimeoutCreate))
 
-		response, getError := s.Client.GetLocalPeeringGateway(context.Background(), request)
+		response, getError := s.Client.GetLocalPeeringGateway(ctx, request)
 		if getError != nil {
 			log.Printf("[DEBUG] Get error while waiting for peering connection to finish: %+v", getError)
 			return getError
@@ -225,7 +226,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) ConnectLocalPeeringGateway() error
 	return nil
 }
 
-func (s *CoreLocalPeeringGatewayResourceCrud) Create() error {
+func (s *CoreLocalPeeringGatewayResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateLocalPeeringGatewayRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -262,7 +263,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateLocalPeeringGateway(context.Background(), request)
+	response, err := s.Client.CreateLocalPeeringGateway(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -271,7 +272,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreLocalPeeringGatewayResourceCrud) Get() error {
+func (s *CoreLocalPeeringGatewayResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetLocalPeeringGatewayRequest{}
 
 	tmp := s.D.Id()
@@ -279,7 +280,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetLocalPeeringGateway(context.Background(), request)
+	response, err := s.Client.GetLocalPeeringGateway(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -288,7 +289,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreLocalPeeringGatewayResourceCrud) Update() error {
+func (s *CoreLocalPeeringGatewayResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateLocalPeeringGatewayRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -318,7 +319,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateLocalPeeringGateway(context.Background(), request)
+	response, err := s.Client.UpdateLocalPeeringGateway(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -327,7 +328,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreLocalPeeringGatewayResourceCrud) Delete() error {
+func (s *CoreLocalPeeringGatewayResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteLocalPeeringGatewayRequest{}
 
 	tmp := s.D.Id()
@@ -335,7 +336,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteLocalPeeringGateway(context.Background(), request)
+	_, err := s.Client.DeleteLocalPeeringGateway(ctx, request)
 	return err
 }
 
@@ -13,7 +13,8 @@ func CoreLocalPeeringGatewaysDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreLocalPeeringGateways,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -36,7 +37,7 @@ func readCoreLocalPeeringGateways(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreLocalPeeringGatewaysDataSourceCrud struct {
@@ -49,7 +50,7 @@ func (s *CoreLocalPeeringGatewaysDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreLocalPeeringGatewaysDataSourceCrud) Get() error {
+func (s *CoreLocalPeeringGatewaysDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListLocalPeeringGatewaysRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -63,8 +64,9 @@ func (s *CoreLocalPeeringGatewaysDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListLocalPeeringGateways(context.Background(), request)
+	response, err := s.Client.ListLocalPeeringGateways(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -72,8 +74,8 @@ func (s *CoreLocalPeeringGatewaysDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListLocalPeeringGateways(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListLocalPeeringGateways(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -146,6 +148,8 @@ func (s *CoreLocalPeeringGatewaysDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreLocalPeeringGatewaysDataSource().Schema["local_peering_gateways"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("local_peering_gateways", resources); err != nil {
 		return err
 	}
@@ -65,7 +65,7 @@ func readSingularCoreNatGateway(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreNatGatewayDataSourceCrud struct {
@@ -78,7 +78,7 @@ func (s *CoreNatGatewayDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreNatGatewayDataSourceCrud) Get() error {
+func (s *CoreNatGatewayDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetNatGatewayRequest{}
 
 	if natGatewayId, ok := s.D.GetOkExists("nat_gateway_id"); ok {
@@ -88,7 +88,7 @@ func (s *CoreNatGatewayDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := s.Client.GetNatGateway(context.Background(), request)
+	response, err := s.Client.GetNatGateway(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -80,7 +80,7 @@ func createCoreNatGateway(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreNatGateway(d *schema.ResourceData, m interface{}) error {
@@ -88,7 +88,7 @@ func readCoreNatGateway(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreNatGateway(d *schema.ResourceData, m interface{}) error {
@@ -96,7 +96,7 @@ func updateCoreNatGateway(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCoreNatGateway(d *schema.ResourceData, m interface{}) error {
@@ -105,7 +105,7 @@ func deleteCoreNatGateway(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreNatGatewayResourceCrud struct {
@@ -143,7 +143,7 @@ func (s *CoreNatGatewayResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreNatGatewayResourceCrud) Create() error {
+func (s *CoreNatGatewayResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateNatGatewayRequest{}
 
 	if blockTraffic, ok := s.D.GetOkExists("block_traffic"); ok {
@@ -180,7 +180,7 @@ func (s *CoreNatGatewayResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateNatGateway(context.Background(), request)
+	response, err := s.Client.CreateNatGateway(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -189,7 +189,7 @@ func (s *CoreNatGatewayResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreNatGatewayResourceCrud) Get() error {
+func (s *CoreNatGatewayResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetNatGatewayRequest{}
 
 	tmp := s.D.Id()
@@ -197,7 +197,7 @@ func (s *CoreNatGatewayResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetNatGateway(context.Background(), request)
+	response, err := s.Client.GetNatGateway(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -206,7 +206,7 @@ func (s *CoreNatGatewayResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreNatGatewayResourceCrud) Update() error {
+func (s *CoreNatGatewayResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateNatGatewayRequest{}
 
 	if blockTraffic, ok := s.D.GetOkExists("block_traffic"); ok {
@@ -236,7 +236,7 @@ func (s *CoreNatGatewayResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateNatGateway(context.Background(), request)
+	response, err := s.Client.UpdateNatGateway(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -245,7 +245,7 @@ func (s *CoreNatGatewayResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreNatGatewayResourceCrud) Delete() error {
+func (s *CoreNatGatewayResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteNatGatewayRequest{}
 
 	tmp := s.D.Id()
@@ -253,7 +253,7 @@ func (s *CoreNatGatewayResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteNatGateway(context.Background(), request)
+	_, err := s.Client.DeleteNatGateway(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
@@ -13,7 +14,8 @@ func CoreNatGatewaysDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreNatGateways,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -35,6 +37,22 @@ func CoreNatGatewaysDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(CoreNatGatewayResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListNatGatewaysSortByTimecreated),
+					string(oci_core.ListNatGatewaysSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListNatGatewaysSortOrderAsc),
+					string(oci_core.ListNatGatewaysSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -44,7 +62,7 @@ func readCoreNatGateways(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreNatGatewaysDataSourceCrud struct {
@@ -57,7 +75,7 @@ func (s *CoreNatGatewaysDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreNatGatewaysDataSourceCrud) Get() error {
+func (s *CoreNatGatewaysDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListNatGatewaysRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -79,9 +97,18 @@ func (s *CoreNatGatewaysDataSourceCrud) Get() error {
 		request.VcnId = &tmp
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_core.ListNatGatewaysSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_core.ListNatGatewaysSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListNatGateways(context.Background(), request)
+	response, err := s.Client.ListNatGateways(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -89,8 +116,8 @@ func (s *CoreNatGatewaysDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListNatGateways(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListNatGateways(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -154,6 +181,8 @@ func (s *CoreNatGatewaysDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreNatGatewaysDataSource().Schema["nat_gateways"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("nat_gateways", resources); err != nil {
 		return err
 	}
@@ -40,7 +40,7 @@ func readCorePeerRegionForRemotePeerings(d *schema.ResourceData, m interface{})
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CorePeerRegionForRemotePeeringsDataSourceCrud struct {
@@ -53,12 +53,12 @@ func (s *CorePeerRegionForRemotePeeringsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CorePeerRegionForRemotePeeringsDataSourceCrud) Get() error {
+func (s *CorePeerRegionForRemotePeeringsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListAllowedPeerRegionsForRemotePeeringRequest{}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := s.Client.ListAllowedPeerRegionsForRemotePeering(context.Background(), request)
+	response, err := s.Client.ListAllowedPeerRegionsForRemotePeering(ctx, request)
 	if err != nil {
 		return err
 	}
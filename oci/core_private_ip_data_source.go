@@ -23,7 +23,7 @@ func readSingularCorePrivateIp(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CorePrivateIpDataSourceCrud struct {
@@ -36,7 +36,7 @@ func (s *CorePrivateIpDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CorePrivateIpDataSourceCrud) Get() error {
+func (s *CorePrivateIpDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetPrivateIpRequest{}
 
 	if privateIpId, ok := s.D.GetOkExists("private_ip_id"); ok {
@@ -46,7 +46,7 @@ func (s *CorePrivateIpDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := s.Client.GetPrivateIp(context.Background(), request)
+	response, err := s.Client.GetPrivateIp(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -89,7 +89,7 @@ func createCorePrivateIp(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCorePrivateIp(d *schema.ResourceData, m interface{}) error {
@@ -97,7 +97,7 @@ func readCorePrivateIp(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCorePrivateIp(d *schema.ResourceData, m interface{}) error {
@@ -105,7 +105,7 @@ func updateCorePrivateIp(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCorePrivateIp(d *schema.ResourceData, m interface{}) error {
@@ -114,7 +114,7 @@ func deleteCorePrivateIp(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CorePrivateIpResourceCrud struct {
@@ -128,7 +128,7 @@ func (s *CorePrivateIpResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *CorePrivateIpResourceCrud) Create() error {
+func (s *CorePrivateIpResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreatePrivateIpRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -165,7 +165,7 @@ func (s *CorePrivateIpResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreatePrivateIp(context.Background(), request)
+	response, err := s.Client.CreatePrivateIp(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -174,7 +174,7 @@ func (s *CorePrivateIpResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CorePrivateIpResourceCrud) Get() error {
+func (s *CorePrivateIpResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetPrivateIpRequest{}
 
 	tmp := s.D.Id()
@@ -182,7 +182,7 @@ func (s *CorePrivateIpResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetPrivateIp(context.Background(), request)
+	response, err := s.Client.GetPrivateIp(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -191,7 +191,7 @@ func (s *CorePrivateIpResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CorePrivateIpResourceCrud) Update() error {
+func (s *CorePrivateIpResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdatePrivateIpRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -226,7 +226,7 @@ func (s *CorePrivateIpResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdatePrivateIp(context.Background(), request)
+	response, err := s.Client.UpdatePrivateIp(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -235,7 +235,7 @@ func (s *CorePrivateIpResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CorePrivateIpResourceCrud) Delete() error {
+func (s *CorePrivateIpResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeletePrivateIpRequest{}
 
 	tmp := s.D.Id()
@@ -243,7 +243,7 @@ func (s *CorePrivateIpResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeletePrivateIp(context.Background(), request)
+	_, err := s.Client.DeletePrivateIp(ctx, request)
 	return err
 }
 
@@ -13,7 +13,8 @@ func CorePrivateIpsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCorePrivateIps,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"ip_address": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -40,7 +41,7 @@ func readCorePrivateIps(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CorePrivateIpsDataSourceCrud struct {
@@ -53,7 +54,7 @@ func (s *CorePrivateIpsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CorePrivateIpsDataSourceCrud) Get() error {
+func (s *CorePrivateIpsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListPrivateIpsRequest{}
 
 	if ipAddress, ok := s.D.GetOkExists("ip_address"); ok {
@@ -72,8 +73,9 @@ func (s *CorePrivateIpsDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListPrivateIps(context.Background(), request)
+	response, err := s.Client.ListPrivateIps(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -81,8 +83,8 @@ func (s *CorePrivateIpsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListPrivateIps(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListPrivateIps(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -158,6 +160,8 @@ func (s *CorePrivateIpsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CorePrivateIpsDataSource().Schema["private_ips"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("private_ips", resources); err != nil {
 		return err
 	}
@@ -89,7 +89,7 @@ func readSingularCorePublicIp(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CorePublicIpDataSourceCrud struct {
@@ -102,30 +102,30 @@ func (s *CorePublicIpDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CorePublicIpDataSourceCrud) Get() error {
+func (s *CorePublicIpDataSourceCrud) Get(ctx context.Context) error {
 	// public ip resource fetching strategies ordered by specificity
 	if id, ok := s.D.GetOkExists("id"); ok {
-		return s.getById(id.(string))
+		return s.getById(ctx, id.(string))
 	}
 
 	if privateIpId, ok := s.D.GetOkExists("private_ip_id"); ok {
-		return s.getByPrivateIpId(privateIpId.(string))
+		return s.getByPrivateIpId(ctx, privateIpId.(string))
 	}
 
 	if ipAddress, ok := s.D.GetOkExists("ip_address"); ok {
-		return s.getByPublicIp(ipAddress.(string))
+		return s.getByPublicIp(ctx, ipAddress.(string))
 	}
 
 	return errors.New("require at least an id, private_ip_id, or ip_address to get a public ip data source")
 }
 
-func (s *CorePublicIpDataSourceCrud) getById(id string) error {
+func (s *CorePublicIpDataSourceCrud) getById(ctx context.Context, id string) error {
 	request := oci_core.GetPublicIpRequest{}
 	request.PublicIpId = &id
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := s.Client.GetPublicIp(context.Background(), request)
+	response, err := s.Client.GetPublicIp(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -134,14 +134,14 @@ func (s *CorePublicIpDataSourceCrud) getById(id string) error {
 	return nil
 }
 
-func (s *CorePublicIpDataSourceCrud) getByPrivateIpId(privateIpId string) error {
+func (s *CorePublicIpDataSourceCrud) getByPrivateIpId(ctx context.Context, privateIpId string) error {
 	request := oci_core.GetPublicIpByPrivateIpIdRequest{}
 	if privateIpId, ok := s.D.GetOkExists("private_ip_id"); ok {
 		tmp := privateIpId.(string)
 		request.PrivateIpId = &tmp
 	}
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
-	response, err := s.Client.GetPublicIpByPrivateIpId(context.Background(), request)
+	response, err := s.Client.GetPublicIpByPrivateIpId(ctx, request)
 
 	if err != nil {
 		return err
@@ -151,7 +151,7 @@ func (s *CorePublicIpDataSourceCrud) getByPrivateIpId(privateIpId string) error
 	return nil
 }
 
-func (s *CorePublicIpDataSourceCrud) getByPublicIp(ipAddress string) error {
+func (s *CorePublicIpDataSourceCrud) getByPublicIp(ctx context.Context, ipAddress string) error {
 	request := oci_core.GetPublicIpByIpAddressRequest{}
 	if ipAddress, ok := s.D.GetOkExists("ip_address"); ok {
 		tmp := ipAddress.(string)
@@ -159,7 +159,7 @@ func (s *CorePublicIpDataSourceCrud) getByPublicIp(ipAddress string) error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
-	response, err := s.Client.GetPublicIpByIpAddress(context.Background(), request)
+	response, err := s.Client.GetPublicIpByIpAddress(ctx, request)
 
 	if err != nil {
 		return err
@@ -95,7 +95,7 @@ func createCorePublicIp(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCorePublicIp(d *schema.ResourceData, m interface{}) error {
@@ -103,7 +103,7 @@ func readCorePublicIp(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCorePublicIp(d *schema.ResourceData, m interface{}) error {
@@ -111,7 +111,7 @@ func updateCorePublicIp(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCorePublicIp(d *schema.ResourceData, m interface{}) error {
@@ -120,7 +120,7 @@ func deleteCorePublicIp(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CorePublicIpResourceCrud struct {
@@ -177,7 +177,7 @@ func (s *CorePublicIpResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *CorePublicIpResourceCrud) Create() error {
+func (s *CorePublicIpResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreatePublicIpRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -213,7 +213,7 @@ func (s *CorePublicIpResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreatePublicIp(context.Background(), request)
+	response, err := s.Client.CreatePublicIp(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -222,7 +222,7 @@ func (s *CorePublicIpResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CorePublicIpResourceCrud) Get() error {
+func (s *CorePublicIpResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetPublicIpRequest{}
 
 	tmp := s.D.Id()
@@ -230,7 +230,7 @@ func (s *CorePublicIpResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetPublicIp(context.Background(), request)
+	response, err := s.Client.GetPublicIp(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -239,7 +239,7 @@ func (s *CorePublicIpResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CorePublicIpResourceCrud) Update() error {
+func (s *CorePublicIpResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdatePublicIpRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -274,7 +274,7 @@ func (s *CorePublicIpResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdatePublicIp(context.Background(), request)
+	response, err := s.Client.UpdatePublicIp(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -283,7 +283,7 @@ func (s *CorePublicIpResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CorePublicIpResourceCrud) Delete() error {
+func (s *CorePublicIpResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeletePublicIpRequest{}
 
 	tmp := s.D.Id()
@@ -291,7 +291,7 @@ func (s *CorePublicIpResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeletePublicIp(context.Background(), request)
+	_, err := s.Client.DeletePublicIp(ctx, request)
 	return err
 }
 
@@ -13,7 +13,8 @@ func CorePublicIpsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCorePublicIps,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"availability_domain": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -44,7 +45,7 @@ func readCorePublicIps(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CorePublicIpsDataSourceCrud struct {
@@ -57,7 +58,7 @@ func (s *CorePublicIpsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CorePublicIpsDataSourceCrud) Get() error {
+func (s *CorePublicIpsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListPublicIpsRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -79,8 +80,9 @@ func (s *CorePublicIpsDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListPublicIps(context.Background(), request)
+	response, err := s.Client.ListPublicIps(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -88,8 +90,8 @@ func (s *CorePublicIpsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListPublicIps(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListPublicIps(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -162,6 +164,8 @@ func (s *CorePublicIpsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CorePublicIpsDataSource().Schema["public_ips"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("public_ips", resources); err != nil {
 		return err
 	}
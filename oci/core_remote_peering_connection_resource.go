@@ -89,13 +89,14 @@ func createCoreRemotePeeringConnection(d *schema.ResourceData, m interface{}) er
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	err := CreateResource(d, sync)
+	ctx := m.(*OracleClients).StopContext()
+	err := CreateResource(ctx, d, sync)
 	if err != nil {
 		return err
 	}
 
 	//This needs to be here rather than in the Create() because we want the resource to finish provisioning and set to the statefile before we connect
-	return sync.ConnectRemotePeeringConnection()
+	return sync.ConnectRemotePeeringConnection(ctx)
 }
 
 func readCoreRemotePeeringConnection(d *schema.ResourceData, m interface{}) error {
@@ -103,7 +104,7 @@ func readCoreRemotePeeringConnection(d *schema.ResourceData, m interface{}) erro
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreRemotePeeringConnection(d *schema.ResourceData, m interface{}) error {
@@ -111,7 +112,7 @@ func updateCoreRemotePeeringConnection(d *schema.ResourceData, m interface{}) er
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCoreRemotePeeringConnection(d *schema.ResourceData, m interface{}) error {
@@ -120,7 +121,7 @@ func deleteCoreRemotePeeringConnection(d *schema.ResourceData, m interface{}) er
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreRemotePeeringConnectionResourceCrud struct {
@@ -158,7 +159,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreRemotePeeringConnectionResourceCrud) ConnectRemotePeeringConnection() error {
+func (s *CoreRemotePeeringConnectionResourceCrud) ConnectRemotePeeringConnection(ctx context.Context) error {
 	if s.Res == nil || s.Res.Id == nil {
 		return fmt.Errorf("CreateRemotePeeringConnection returned a nil RemotePeeringConnection or a RemotePeeringConnection without an ID")
 	}
@@ -182,7 +183,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) ConnectRemotePeeringConnection
 
 	connectRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ConnectRemotePeeringConnections(context.Background(), connectRequest)
+	_, err := s.Client.ConnectRemotePeeringConnections(ctx, connectRequest)
 	if err != nil {
 		// we set peer_id to "" so that terraform detects a forceNew change on the next apply and the user can try the connection again
 		s.D.Set("peer_id", "")
@@ -196,7 +197,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) ConnectRemotePeeringConnection
 
 	request.RequestMetadata.RetryPolicy = getRemotePeeringConnectionRetryPolicy(s.D.Timeout(schema.TimeoutCreate))
 
-	response, getError := s.Client.GetRemotePeeringConnection(context.Background(), request)
+	response, getError := s.Client.GetRemotePeeringConnection(ctx, request)
 	if getError != nil {
 		log.Printf("[DEBUG] Get error while waiting for peering connection to finish: %+v", getError)
 		return getError
@@ -214,7 +215,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) ConnectRemotePeeringConnection
 	return nil
 }
 
-func (s *CoreRemotePeeringConnectionResourceCrud) Create() error {
+func (s *CoreRemotePeeringConnectionResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateRemotePeeringConnectionRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -234,7 +235,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateRemotePeeringConnection(context.Background(), request)
+	response, err := s.Client.CreateRemotePeeringConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -243,7 +244,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreRemotePeeringConnectionResourceCrud) Get() error {
+func (s *CoreRemotePeeringConnectionResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetRemotePeeringConnectionRequest{}
 
 	tmp := s.D.Id()
@@ -251,7 +252,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetRemotePeeringConnection(context.Background(), request)
+	response, err := s.Client.GetRemotePeeringConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -260,7 +261,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreRemotePeeringConnectionResourceCrud) Update() error {
+func (s *CoreRemotePeeringConnectionResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateRemotePeeringConnectionRequest{}
 
 	if displayName, ok := s.D.GetOkExists("display_name"); ok {
@@ -273,7 +274,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateRemotePeeringConnection(context.Background(), request)
+	response, err := s.Client.UpdateRemotePeeringConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -282,7 +283,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreRemotePeeringConnectionResourceCrud) Delete() error {
+func (s *CoreRemotePeeringConnectionResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteRemotePeeringConnectionRequest{}
 
 	tmp := s.D.Id()
@@ -290,7 +291,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteRemotePeeringConnection(context.Background(), request)
+	_, err := s.Client.DeleteRemotePeeringConnection(ctx, request)
 	return err
 }
 
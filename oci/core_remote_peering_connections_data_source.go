@@ -13,7 +13,8 @@ func CoreRemotePeeringConnectionsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreRemotePeeringConnections,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -36,7 +37,7 @@ func readCoreRemotePeeringConnections(d *schema.ResourceData, m interface{}) err
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreRemotePeeringConnectionsDataSourceCrud struct {
@@ -49,7 +50,7 @@ func (s *CoreRemotePeeringConnectionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreRemotePeeringConnectionsDataSourceCrud) Get() error {
+func (s *CoreRemotePeeringConnectionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListRemotePeeringConnectionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -63,8 +64,9 @@ func (s *CoreRemotePeeringConnectionsDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListRemotePeeringConnections(context.Background(), request)
+	response, err := s.Client.ListRemotePeeringConnections(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -72,8 +74,8 @@ func (s *CoreRemotePeeringConnectionsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListRemotePeeringConnections(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListRemotePeeringConnections(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -141,6 +143,8 @@ func (s *CoreRemotePeeringConnectionsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreRemotePeeringConnectionsDataSource().Schema["remote_peering_connections"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("remote_peering_connections", resources); err != nil {
 		return err
 	}
@@ -42,7 +42,7 @@ func createRouteTableAttachment(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteRouteTableAttachment(d *schema.ResourceData, m interface{}) error {
@@ -51,7 +51,7 @@ func deleteRouteTableAttachment(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readRouteTableAttachment(d *schema.ResourceData, m interface{}) error {
@@ -60,7 +60,7 @@ func readRouteTableAttachment(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 	sync.DisableNotFoundRetries = true
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type RouteTableAttachmentResourceCrud struct {
@@ -88,7 +88,7 @@ func (s *RouteTableAttachmentResourceCrud) ID() string {
 	return getRouteTableAttachmentId(*s.Res.Id, *s.Res.RouteTableId)
 }
 
-func (s *RouteTableAttachmentResourceCrud) Create() error {
+func (s *RouteTableAttachmentResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.UpdateSubnetRequest{}
 
 	if routeTableId, ok := s.D.GetOkExists("route_table_id"); ok {
@@ -102,7 +102,7 @@ func (s *RouteTableAttachmentResourceCrud) Create() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
-	response, err := s.Client.UpdateSubnet(context.Background(), request)
+	response, err := s.Client.UpdateSubnet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -111,7 +111,7 @@ func (s *RouteTableAttachmentResourceCrud) Create() error {
 	return nil
 }
 
-func (s *RouteTableAttachmentResourceCrud) Get() error {
+func (s *RouteTableAttachmentResourceCrud) Get(ctx context.Context) error {
 
 	subnetId, routeTableId, err := parseRouteTableAttachmentId(s.D.Id())
 	if err != nil {
@@ -120,7 +120,7 @@ func (s *RouteTableAttachmentResourceCrud) Get() error {
 	request := oci_core.GetSubnetRequest{}
 	request.SubnetId = &subnetId
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
-	response, err := s.Client.GetSubnet(context.Background(), request)
+	response, err := s.Client.GetSubnet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -133,7 +133,7 @@ func (s *RouteTableAttachmentResourceCrud) Get() error {
 	return nil
 }
 
-func (s *RouteTableAttachmentResourceCrud) Delete() error {
+func (s *RouteTableAttachmentResourceCrud) Delete(ctx context.Context) error {
 
 	var subnetIdStr = s.D.Get("subnet_id").(string)
 	var retryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
@@ -141,7 +141,7 @@ func (s *RouteTableAttachmentResourceCrud) Delete() error {
 	subnetRequest := oci_core.GetSubnetRequest{}
 	subnetRequest.SubnetId = &subnetIdStr
 	subnetRequest.RequestMetadata.RetryPolicy = retryPolicy
-	subnetResponse, err := s.Client.GetSubnet(context.Background(), subnetRequest)
+	subnetResponse, err := s.Client.GetSubnet(ctx, subnetRequest)
 	if err != nil {
 		return err
 	}
@@ -149,7 +149,7 @@ func (s *RouteTableAttachmentResourceCrud) Delete() error {
 	vcnRequest := oci_core.GetVcnRequest{}
 	vcnRequest.VcnId = subnetResponse.VcnId
 	vcnRequest.RequestMetadata.RetryPolicy = retryPolicy
-	vcnResponse, err := s.Client.GetVcn(context.Background(), vcnRequest)
+	vcnResponse, err := s.Client.GetVcn(ctx, vcnRequest)
 	if err != nil {
 		return err
 	}
@@ -158,7 +158,7 @@ func (s *RouteTableAttachmentResourceCrud) Delete() error {
 	updateSubnetRequest.RouteTableId = vcnResponse.DefaultRouteTableId
 	updateSubnetRequest.SubnetId = &subnetIdStr
 	updateSubnetRequest.RequestMetadata.RetryPolicy = retryPolicy
-	updateSubnetResponse, err := s.Client.UpdateSubnet(context.Background(), updateSubnetRequest)
+	updateSubnetResponse, err := s.Client.UpdateSubnet(ctx, updateSubnetRequest)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -93,6 +94,11 @@ func CoreRouteTableResource() *schema.Resource {
 				Computed: true,
 				Elem:     schema.TypeString,
 			},
+			"ignore_unowned_rules": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 
 			// Computed
 			"state": {
@@ -118,7 +124,7 @@ func createCoreRouteTable(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreRouteTable(d *schema.ResourceData, m interface{}) error {
@@ -126,7 +132,7 @@ func readCoreRouteTable(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreRouteTable(d *schema.ResourceData, m interface{}) error {
@@ -134,7 +140,7 @@ func updateCoreRouteTable(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCoreRouteTable(d *schema.ResourceData, m interface{}) error {
@@ -143,7 +149,7 @@ func deleteCoreRouteTable(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreRouteTableResourceCrud struct {
@@ -153,6 +159,10 @@ type CoreRouteTableResourceCrud struct {
 	DisableNotFoundRetries bool
 }
 
+func (s *CoreRouteTableResourceCrud) GetMutex() *sync.Mutex {
+	return routeTableMutexes.GetOrCreateMutex(s.D.Id())
+}
+
 func (s *CoreRouteTableResourceCrud) ID() string {
 	return *s.Res.Id
 }
@@ -181,7 +191,7 @@ func (s *CoreRouteTableResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreRouteTableResourceCrud) Create() error {
+func (s *CoreRouteTableResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateRouteTableRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -230,7 +240,7 @@ func (s *CoreRouteTableResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateRouteTable(context.Background(), request)
+	response, err := s.Client.CreateRouteTable(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -239,7 +249,7 @@ func (s *CoreRouteTableResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreRouteTableResourceCrud) Get() error {
+func (s *CoreRouteTableResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetRouteTableRequest{}
 
 	tmp := s.D.Id()
@@ -247,7 +257,7 @@ func (s *CoreRouteTableResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetRouteTable(context.Background(), request)
+	response, err := s.Client.GetRouteTable(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -256,7 +266,7 @@ func (s *CoreRouteTableResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreRouteTableResourceCrud) Update() error {
+func (s *CoreRouteTableResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateRouteTableRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -298,7 +308,43 @@ func (s *CoreRouteTableResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateRouteTable(context.Background(), request)
+	if ignoreUnownedRules, ok := s.D.GetOkExists("ignore_unowned_rules"); ok && ignoreUnownedRules.(bool) {
+		return retryConcurrentRuleUpdate(func() error {
+			return s.updateWithUnownedRules(ctx, request)
+		})
+	}
+
+	response, err := s.Client.UpdateRouteTable(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.RouteTable
+	return nil
+}
+
+// updateWithUnownedRules updates the route table with its rules along with the rules it does not own, e.g. those
+// managed by oci_core_route_table_rule resources, as long as the route table is not changed in between
+func (s *CoreRouteTableResourceCrud) updateWithUnownedRules(ctx context.Context, request oci_core.UpdateRouteTableRequest) error {
+	getRequest := oci_core.GetRouteTableRequest{}
+	getRequest.RtId = request.RtId
+	getRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	getResponse, err := s.Client.GetRouteTable(ctx, getRequest)
+	if err != nil {
+		return err
+	}
+
+	ownedRouteRules := getOwnedRuleHashes(s.D, "route_rules")
+	for _, rule := range getResponse.RouteRules {
+		if !ownedRouteRules[routeRulesHashCodeForSets(RouteRuleToMap(rule))] {
+			request.RouteRules = append(request.RouteRules, rule)
+		}
+	}
+
+	request.IfMatch = getResponse.Etag
+
+	response, err := s.Client.UpdateRouteTable(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -307,7 +353,7 @@ func (s *CoreRouteTableResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreRouteTableResourceCrud) Delete() error {
+func (s *CoreRouteTableResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteRouteTableRequest{}
 
 	tmp := s.D.Id()
@@ -315,7 +361,7 @@ func (s *CoreRouteTableResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteRouteTable(context.Background(), request)
+	_, err := s.Client.DeleteRouteTable(ctx, request)
 	return err
 }
 
@@ -338,6 +384,9 @@ func (s *CoreRouteTableResourceCrud) SetData() error {
 	for _, item := range s.Res.RouteRules {
 		routeRules = append(routeRules, RouteRuleToMap(item))
 	}
+	if ignoreUnownedRules, ok := s.D.GetOkExists("ignore_unowned_rules"); ok && ignoreUnownedRules.(bool) {
+		routeRules = filterOwnedRules(s.D, "route_rules", routeRules)
+	}
 	s.D.Set("route_rules", schema.NewSet(routeRulesHashCodeForSets, routeRules))
 
 	s.D.Set("state", s.Res.LifecycleState)
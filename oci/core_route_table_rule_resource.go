@@ -0,0 +1,266 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	oci_core "github.com/oracle/oci-go-sdk/core"
+)
+
+// CoreRouteTableRuleResource manages a single rule of a route table, so that the rules of a route table shared by
+// several configurations can be managed separately. Every change to the rule replaces it.
+func CoreRouteTableRuleResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importCoreRouteTableRule,
+		},
+		Timeouts: DefaultTimeout,
+		Create:   createCoreRouteTableRule,
+		Read:     readCoreRouteTableRule,
+		Delete:   deleteCoreRouteTableRule,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"destination": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"network_entity_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"destination_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			// Computed
+		},
+	}
+}
+
+func createCoreRouteTableRule(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreRouteTableRuleResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).virtualNetworkClient
+
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
+}
+
+func readCoreRouteTableRule(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreRouteTableRuleResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).virtualNetworkClient
+
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
+}
+
+func deleteCoreRouteTableRule(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreRouteTableRuleResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).virtualNetworkClient
+	sync.DisableNotFoundRetries = true
+
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
+}
+
+// importCoreRouteTableRule imports a rule of a route table, with an ID of the form routeTables/{routeTableId}/routeRules/{ruleHash}
+func importCoreRouteTableRule(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	values, err := parseCompositeId(d.Id(), "routeTables", "routeRules")
+	if err != nil {
+		return nil, err
+	}
+	d.Set("route_table_id", values[0])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type CoreRouteTableRuleResourceCrud struct {
+	BaseCrud
+	Client                 *oci_core.VirtualNetworkClient
+	Res                    *oci_core.RouteTable
+	DisableNotFoundRetries bool
+	// The hash of the rule, as computed for the rules of oci_core_route_table, and its index in the route table
+	ruleHash  int
+	ruleIndex int
+}
+
+func (s *CoreRouteTableRuleResourceCrud) GetMutex() *sync.Mutex {
+	return routeTableMutexes.GetOrCreateMutex(s.D.Get("route_table_id").(string))
+}
+
+func (s *CoreRouteTableRuleResourceCrud) ID() string {
+	return getCompositeId("routeTables", *s.Res.Id, "routeRules", strconv.Itoa(s.ruleHash))
+}
+
+func (s *CoreRouteTableRuleResourceCrud) getRouteTable(ctx context.Context) (*oci_core.RouteTable, *string, error) {
+	request := oci_core.GetRouteTableRequest{}
+
+	tmp := s.D.Get("route_table_id").(string)
+	request.RtId = &tmp
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.GetRouteTable(ctx, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &response.RouteTable, response.Etag, nil
+}
+
+// setRuleIndex sets the index of the rule in the route table, or -1 when it is not in the route table
+func (s *CoreRouteTableRuleResourceCrud) setRuleIndex(routeTable *oci_core.RouteTable) {
+	s.ruleIndex = -1
+	for i, rule := range routeTable.RouteRules {
+		if routeRulesHashCodeForSets(RouteRuleToMap(rule)) == s.ruleHash {
+			s.ruleIndex = i
+			return
+		}
+	}
+}
+
+func (s *CoreRouteTableRuleResourceCrud) Create(ctx context.Context) error {
+	request := oci_core.UpdateRouteTableRequest{}
+
+	rule := oci_core.RouteRule{}
+
+	destination := s.D.Get("destination").(string)
+	rule.Destination = &destination
+
+	if destinationType, ok := s.D.GetOkExists("destination_type"); ok {
+		rule.DestinationType = oci_core.RouteRuleDestinationTypeEnum(destinationType.(string))
+	}
+
+	networkEntityId := s.D.Get("network_entity_id").(string)
+	rule.NetworkEntityId = &networkEntityId
+
+	s.ruleHash = routeRulesHashCodeForSets(RouteRuleToMap(rule))
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	return retryConcurrentRuleUpdate(func() error {
+		routeTable, etag, err := s.getRouteTable(ctx)
+		if err != nil {
+			return err
+		}
+
+		s.setRuleIndex(routeTable)
+		if s.ruleIndex >= 0 {
+			return fmt.Errorf("the rule already exists in route table %s", *routeTable.Id)
+		}
+
+		request.RouteRules = append(routeTable.RouteRules, rule)
+		request.RtId = routeTable.Id
+		request.IfMatch = etag
+
+		response, err := s.Client.UpdateRouteTable(ctx, request)
+		if err != nil {
+			return err
+		}
+
+		s.Res = &response.RouteTable
+		s.setRuleIndex(s.Res)
+		if s.ruleIndex < 0 {
+			return fmt.Errorf("the rule was not found in route table %s after it was added", *s.Res.Id)
+		}
+		return nil
+	})
+}
+
+// findRule finds the rule identified by the ID of the resource in its route table
+func (s *CoreRouteTableRuleResourceCrud) findRule(ctx context.Context) (*oci_core.RouteTable, *string, error) {
+	values, err := parseCompositeId(s.D.Id(), "routeTables", "routeRules")
+	if err != nil {
+		return nil, nil, err
+	}
+	s.ruleHash, err = strconv.Atoi(values[1])
+	if err != nil {
+		return nil, nil, fmt.Errorf("illegal compositeId %s encountered: %v", s.D.Id(), err)
+	}
+
+	routeTable, etag, err := s.getRouteTable(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.setRuleIndex(routeTable)
+	return routeTable, etag, nil
+}
+
+func (s *CoreRouteTableRuleResourceCrud) Get(ctx context.Context) error {
+	routeTable, _, err := s.findRule(ctx)
+	if err != nil {
+		return err
+	}
+
+	if s.ruleIndex < 0 || routeTable.LifecycleState == oci_core.RouteTableLifecycleStateTerminated {
+		return fmt.Errorf("the rule %d was not found in route table %s", s.ruleHash, *routeTable.Id)
+	}
+
+	s.Res = routeTable
+	return nil
+}
+
+func (s *CoreRouteTableRuleResourceCrud) Delete(ctx context.Context) error {
+	request := oci_core.UpdateRouteTableRequest{}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	return retryConcurrentRuleUpdate(func() error {
+		routeTable, etag, err := s.findRule(ctx)
+		if err != nil {
+			return err
+		}
+
+		// The rule was already removed
+		if s.ruleIndex < 0 {
+			return nil
+		}
+
+		request.RouteRules = []oci_core.RouteRule{}
+		request.RouteRules = append(request.RouteRules, routeTable.RouteRules[:s.ruleIndex]...)
+		request.RouteRules = append(request.RouteRules, routeTable.RouteRules[s.ruleIndex+1:]...)
+		request.RtId = routeTable.Id
+		request.IfMatch = etag
+
+		_, err = s.Client.UpdateRouteTable(ctx, request)
+		return err
+	})
+}
+
+func (s *CoreRouteTableRuleResourceCrud) SetData() error {
+	if s.Res.Id != nil {
+		s.D.Set("route_table_id", *s.Res.Id)
+	}
+
+	rule := s.Res.RouteRules[s.ruleIndex]
+
+	if rule.Destination != nil {
+		s.D.Set("destination", *rule.Destination)
+	}
+
+	s.D.Set("destination_type", rule.DestinationType)
+
+	if rule.NetworkEntityId != nil {
+		s.D.Set("network_entity_id", *rule.NetworkEntityId)
+	}
+
+	return nil
+}
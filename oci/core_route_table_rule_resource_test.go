@@ -0,0 +1,93 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_core "github.com/oracle/oci-go-sdk/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoreRouteTableRuleResource_sharedRouteTable(t *testing.T) {
+	clients := newFakeVirtualNetworkClients(t, newFakeVirtualNetwork(), "")
+	client := clients.virtualNetworkClient
+	ctx := context.Background()
+
+	vcn := createCleanupTestVcn(t, client, "vcn", "10.0.0.0/16", nil)
+	internetGateway, err := client.CreateInternetGateway(ctx, oci_core.CreateInternetGatewayRequest{CreateInternetGatewayDetails: oci_core.CreateInternetGatewayDetails{
+		CompartmentId: oci_common.String(testTenancyOCID),
+		VcnId:         vcn.Id,
+		IsEnabled:     oci_common.Bool(true),
+	}})
+	assert.NoError(t, err)
+	natGateway, err := client.CreateNatGateway(ctx, oci_core.CreateNatGatewayRequest{CreateNatGatewayDetails: oci_core.CreateNatGatewayDetails{
+		CompartmentId: oci_common.String(testTenancyOCID),
+		VcnId:         vcn.Id,
+	}})
+	assert.NoError(t, err)
+
+	// The route table owns the route to the internet gateway
+	routeTableConfig := map[string]interface{}{
+		"compartment_id":       testTenancyOCID,
+		"vcn_id":               *vcn.Id,
+		"ignore_unowned_rules": true,
+		"route_rules": []interface{}{
+			map[string]interface{}{"destination": "0.0.0.0/0", "network_entity_id": *internetGateway.Id},
+		},
+	}
+	routeTable := schema.TestResourceDataRaw(t, CoreRouteTableResource().Schema, routeTableConfig)
+	assert.NoError(t, CoreRouteTableResource().Create(routeTable, clients))
+	getRouteRules := func() []oci_core.RouteRule {
+		response, err := client.GetRouteTable(ctx, oci_core.GetRouteTableRequest{RtId: oci_common.String(routeTable.Id())})
+		assert.NoError(t, err)
+		return response.RouteRules
+	}
+
+	// A rule resource adds its route to the route table, only once
+	ruleConfig := map[string]interface{}{
+		"route_table_id":    routeTable.Id(),
+		"destination":       "10.1.0.0/16",
+		"network_entity_id": *natGateway.Id,
+	}
+	rule := schema.TestResourceDataRaw(t, CoreRouteTableRuleResource().Schema, ruleConfig)
+	assert.NoError(t, CoreRouteTableRuleResource().Create(rule, clients))
+	assert.Regexp(t, "^routeTables/"+regexp.QuoteMeta(routeTable.Id())+"/routeRules/[0-9]+$", rule.Id())
+	assert.Len(t, getRouteRules(), 2)
+	assert.Error(t, CoreRouteTableRuleResource().Create(schema.TestResourceDataRaw(t, CoreRouteTableRuleResource().Schema, ruleConfig), clients))
+
+	imported := CoreRouteTableRuleResource().Data(nil)
+	imported.SetId(rule.Id())
+	_, err = CoreRouteTableRuleResource().Importer.State(imported, clients)
+	assert.NoError(t, err)
+	assert.NoError(t, CoreRouteTableRuleResource().Read(imported, clients))
+	assert.Equal(t, "10.1.0.0/16", imported.Get("destination"))
+	assert.Equal(t, *natGateway.Id, imported.Get("network_entity_id"))
+
+	// The route table keeps the route it does not own when its own routes change
+	routeTableConfig["route_rules"] = []interface{}{
+		map[string]interface{}{"destination": "10.2.0.0/16", "network_entity_id": *internetGateway.Id},
+	}
+	state := applyFakeNetworkResource(t, CoreRouteTableResource(), routeTable.State(), routeTableConfig, clients)
+	assert.Equal(t, "1", state.Attributes["route_rules.#"])
+	routeRules := getRouteRules()
+	if assert.Len(t, routeRules, 2) {
+		destinations := []string{*routeRules[0].Destination, *routeRules[1].Destination}
+		assert.Contains(t, destinations, "10.1.0.0/16")
+		assert.Contains(t, destinations, "10.2.0.0/16")
+	}
+
+	// Without ignore_unowned_rules, the route table manages all of its routes again
+	routeTableConfig["ignore_unowned_rules"] = false
+	state = applyFakeNetworkResource(t, CoreRouteTableResource(), state, routeTableConfig, clients)
+	assert.Equal(t, "1", state.Attributes["route_rules.#"])
+	assert.Len(t, getRouteRules(), 1)
+
+	// The rule resource notices that its route was removed
+	assert.NoError(t, CoreRouteTableRuleResource().Read(rule, clients))
+	assert.Empty(t, rule.Id())
+}
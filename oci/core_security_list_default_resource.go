@@ -4,6 +4,7 @@ package provider
 
 import (
 	"context"
+	"sync"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -25,12 +26,16 @@ type DefaultSecurityListResourceCrud struct {
 	CoreSecurityListResourceCrud
 }
 
+func (s *DefaultSecurityListResourceCrud) GetMutex() *sync.Mutex {
+	return securityListMutexes.GetOrCreateMutex(s.D.Get("manage_default_resource_id").(string))
+}
+
 func createDefaultSecurityList(d *schema.ResourceData, m interface{}) error {
 	sync := &DefaultSecurityListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteDefaultSecurityList(d *schema.ResourceData, m interface{}) error {
@@ -39,15 +44,15 @@ func deleteDefaultSecurityList(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
-func (s *DefaultSecurityListResourceCrud) Create() error {
+func (s *DefaultSecurityListResourceCrud) Create(ctx context.Context) error {
 	// If we are creating a default resource, then don't have to
 	// actually create it. Just set the ID and update it.
 	if defaultId, ok := s.D.GetOkExists("manage_default_resource_id"); ok {
 		s.D.SetId(defaultId.(string))
-		return s.Update()
+		return s.Update(ctx)
 	}
 
 	return fmt.Errorf("Default resource does not have a manage_default_resource_id set")
@@ -55,7 +60,7 @@ func (s *DefaultSecurityListResourceCrud) Create() error {
 
 // This clears out all of the security rules from the list
 // This is used to clear out default security list resources that can't otherwise be deleted
-func (s *DefaultSecurityListResourceCrud) reset() error {
+func (s *DefaultSecurityListResourceCrud) reset(ctx context.Context) error {
 	request := oci_core.UpdateSecurityListRequest{}
 
 	tmp := s.D.Id()
@@ -67,7 +72,14 @@ func (s *DefaultSecurityListResourceCrud) reset() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateSecurityList(context.Background(), request)
+	// Only clear out the rules owned by the default security list
+	if ignoreUnownedRules, ok := s.D.GetOkExists("ignore_unowned_rules"); ok && ignoreUnownedRules.(bool) {
+		return retryConcurrentRuleUpdate(func() error {
+			return s.updateWithUnownedRules(ctx, request)
+		})
+	}
+
+	response, err := s.Client.UpdateSecurityList(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -76,11 +88,11 @@ func (s *DefaultSecurityListResourceCrud) reset() error {
 	return nil
 }
 
-func (s *DefaultSecurityListResourceCrud) Delete() error {
+func (s *DefaultSecurityListResourceCrud) Delete(ctx context.Context) error {
 	if _, ok := s.D.GetOkExists("manage_default_resource_id"); ok {
 		// We can't actually delete a default resource.
 		// Clear out its settings and mark it as deleted.
-		err := s.reset()
+		err := s.reset(ctx)
 		s.D.Set("state", s.DeletedTarget()[0])
 		return err
 	}
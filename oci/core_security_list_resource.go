@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -370,6 +371,11 @@ func CoreSecurityListResource() *schema.Resource {
 				Computed: true,
 				Elem:     schema.TypeString,
 			},
+			"ignore_unowned_rules": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 
 			// Computed
 			"state": {
@@ -389,7 +395,7 @@ func createCoreSecurityList(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreSecurityList(d *schema.ResourceData, m interface{}) error {
@@ -397,7 +403,7 @@ func readCoreSecurityList(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreSecurityList(d *schema.ResourceData, m interface{}) error {
@@ -405,7 +411,7 @@ func updateCoreSecurityList(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCoreSecurityList(d *schema.ResourceData, m interface{}) error {
@@ -414,7 +420,7 @@ func deleteCoreSecurityList(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreSecurityListResourceCrud struct {
@@ -424,6 +430,10 @@ type CoreSecurityListResourceCrud struct {
 	DisableNotFoundRetries bool
 }
 
+func (s *CoreSecurityListResourceCrud) GetMutex() *sync.Mutex {
+	return securityListMutexes.GetOrCreateMutex(s.D.Id())
+}
+
 func (s *CoreSecurityListResourceCrud) ID() string {
 	return *s.Res.Id
 }
@@ -452,7 +462,7 @@ func (s *CoreSecurityListResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreSecurityListResourceCrud) Create() error {
+func (s *CoreSecurityListResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateSecurityListRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -518,7 +528,7 @@ func (s *CoreSecurityListResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateSecurityList(context.Background(), request)
+	response, err := s.Client.CreateSecurityList(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -527,7 +537,7 @@ func (s *CoreSecurityListResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreSecurityListResourceCrud) Get() error {
+func (s *CoreSecurityListResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetSecurityListRequest{}
 
 	tmp := s.D.Id()
@@ -535,7 +545,7 @@ func (s *CoreSecurityListResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetSecurityList(context.Background(), request)
+	response, err := s.Client.GetSecurityList(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -544,7 +554,7 @@ func (s *CoreSecurityListResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreSecurityListResourceCrud) Update() error {
+func (s *CoreSecurityListResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateSecurityListRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -603,7 +613,50 @@ func (s *CoreSecurityListResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateSecurityList(context.Background(), request)
+	if ignoreUnownedRules, ok := s.D.GetOkExists("ignore_unowned_rules"); ok && ignoreUnownedRules.(bool) {
+		return retryConcurrentRuleUpdate(func() error {
+			return s.updateWithUnownedRules(ctx, request)
+		})
+	}
+
+	response, err := s.Client.UpdateSecurityList(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.SecurityList
+	return nil
+}
+
+// updateWithUnownedRules updates the security list with its rules along with the rules it does not own, e.g. those
+// managed by oci_core_security_list_rule resources, as long as the security list is not changed in between
+func (s *CoreSecurityListResourceCrud) updateWithUnownedRules(ctx context.Context, request oci_core.UpdateSecurityListRequest) error {
+	getRequest := oci_core.GetSecurityListRequest{}
+	getRequest.SecurityListId = request.SecurityListId
+	getRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	getResponse, err := s.Client.GetSecurityList(ctx, getRequest)
+	if err != nil {
+		return err
+	}
+
+	ownedEgressRules := getOwnedRuleHashes(s.D, "egress_security_rules")
+	for _, rule := range getResponse.EgressSecurityRules {
+		if !ownedEgressRules[egressSecurityRulesHashCodeForSets(EgressSecurityRuleToMap(rule))] {
+			request.EgressSecurityRules = append(request.EgressSecurityRules, rule)
+		}
+	}
+
+	ownedIngressRules := getOwnedRuleHashes(s.D, "ingress_security_rules")
+	for _, rule := range getResponse.IngressSecurityRules {
+		if !ownedIngressRules[ingressSecurityRulesHashCodeForSets(IngressSecurityRuleToMap(rule))] {
+			request.IngressSecurityRules = append(request.IngressSecurityRules, rule)
+		}
+	}
+
+	request.IfMatch = getResponse.Etag
+
+	response, err := s.Client.UpdateSecurityList(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -612,7 +665,7 @@ func (s *CoreSecurityListResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreSecurityListResourceCrud) Delete() error {
+func (s *CoreSecurityListResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteSecurityListRequest{}
 
 	tmp := s.D.Id()
@@ -620,7 +673,7 @@ func (s *CoreSecurityListResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteSecurityList(context.Background(), request)
+	_, err := s.Client.DeleteSecurityList(ctx, request)
 	return err
 }
 
@@ -641,6 +694,9 @@ func (s *CoreSecurityListResourceCrud) SetData() error {
 	for _, item := range s.Res.EgressSecurityRules {
 		egressSecurityRules = append(egressSecurityRules, EgressSecurityRuleToMap(item))
 	}
+	if ignoreUnownedRules, ok := s.D.GetOkExists("ignore_unowned_rules"); ok && ignoreUnownedRules.(bool) {
+		egressSecurityRules = filterOwnedRules(s.D, "egress_security_rules", egressSecurityRules)
+	}
 	s.D.Set("egress_security_rules", schema.NewSet(egressSecurityRulesHashCodeForSets, egressSecurityRules))
 
 	s.D.Set("freeform_tags", s.Res.FreeformTags)
@@ -649,6 +705,9 @@ func (s *CoreSecurityListResourceCrud) SetData() error {
 	for _, item := range s.Res.IngressSecurityRules {
 		ingressSecurityRules = append(ingressSecurityRules, IngressSecurityRuleToMap(item))
 	}
+	if ignoreUnownedRules, ok := s.D.GetOkExists("ignore_unowned_rules"); ok && ignoreUnownedRules.(bool) {
+		ingressSecurityRules = filterOwnedRules(s.D, "ingress_security_rules", ingressSecurityRules)
+	}
 	s.D.Set("ingress_security_rules", schema.NewSet(ingressSecurityRulesHashCodeForSets, ingressSecurityRules))
 
 	s.D.Set("state", s.Res.LifecycleState)
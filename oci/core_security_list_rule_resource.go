@@ -0,0 +1,435 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	oci_core "github.com/oracle/oci-go-sdk/core"
+)
+
+const (
+	securityListRuleDirectionIngress = "INGRESS"
+	securityListRuleDirectionEgress  = "EGRESS"
+)
+
+// CoreSecurityListRuleResource manages a single rule of a security list, so that the rules of a security list shared
+// by several configurations can be managed separately. Every change to the rule replaces it.
+func CoreSecurityListRuleResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importCoreSecurityListRule,
+		},
+		Timeouts: DefaultTimeout,
+		Create:   createCoreSecurityListRule,
+		Read:     readCoreSecurityListRule,
+		Delete:   deleteCoreSecurityListRule,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"direction": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{securityListRuleDirectionIngress, securityListRuleDirectionEgress}, false),
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_list_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"destination": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"destination_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"icmp_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+						"type": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+
+						// Optional
+						"code": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+							Default:  -1,
+						},
+
+						// Computed
+					},
+				},
+			},
+			"source": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"source_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"stateless": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"tcp_options": securityListRulePortOptionsSchema(),
+			"udp_options": securityListRulePortOptionsSchema(),
+		},
+	}
+}
+
+func securityListRulePortOptionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				// Required
+
+				// Optional
+				"source_port_range": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					MinItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							// Required
+							"max": {
+								Type:     schema.TypeInt,
+								Required: true,
+								ForceNew: true,
+							},
+							"min": {
+								Type:     schema.TypeInt,
+								Required: true,
+								ForceNew: true,
+							},
+
+							// Optional
+
+							// Computed
+						},
+					},
+				},
+				// The destination port range, as in the rules of oci_core_security_list
+				"max": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					ForceNew: true,
+				},
+				"min": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					ForceNew: true,
+				},
+
+				// Computed
+			},
+		},
+	}
+}
+
+func createCoreSecurityListRule(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreSecurityListRuleResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).virtualNetworkClient
+
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
+}
+
+func readCoreSecurityListRule(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreSecurityListRuleResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).virtualNetworkClient
+
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
+}
+
+func deleteCoreSecurityListRule(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreSecurityListRuleResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).virtualNetworkClient
+	sync.DisableNotFoundRetries = true
+
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
+}
+
+// importCoreSecurityListRule imports a rule of a security list, with an ID of the form
+// securityLists/{securityListId}/ingressSecurityRules/{ruleHash} or securityLists/{securityListId}/egressSecurityRules/{ruleHash}
+func importCoreSecurityListRule(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	for _, direction := range []string{securityListRuleDirectionIngress, securityListRuleDirectionEgress} {
+		if values, err := parseCompositeId(d.Id(), "securityLists", securityListRulesKey(direction)); err == nil {
+			d.Set("security_list_id", values[0])
+			d.Set("direction", direction)
+			return []*schema.ResourceData{d}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("illegal compositeId %s encountered", d.Id())
+}
+
+// securityListRulesKey returns the name of the rules of a direction in the security list API
+func securityListRulesKey(direction string) string {
+	if direction == securityListRuleDirectionEgress {
+		return "egressSecurityRules"
+	}
+	return "ingressSecurityRules"
+}
+
+type CoreSecurityListRuleResourceCrud struct {
+	BaseCrud
+	Client                 *oci_core.VirtualNetworkClient
+	Res                    *oci_core.SecurityList
+	DisableNotFoundRetries bool
+	// The hash of the rule, as computed for the rules of oci_core_security_list, and its index in the security list
+	ruleHash  int
+	ruleIndex int
+}
+
+func (s *CoreSecurityListRuleResourceCrud) GetMutex() *sync.Mutex {
+	return securityListMutexes.GetOrCreateMutex(s.D.Get("security_list_id").(string))
+}
+
+func (s *CoreSecurityListRuleResourceCrud) ID() string {
+	return getCompositeId("securityLists", *s.Res.Id, securityListRulesKey(s.direction()), strconv.Itoa(s.ruleHash))
+}
+
+func (s *CoreSecurityListRuleResourceCrud) direction() string {
+	return s.D.Get("direction").(string)
+}
+
+// securityListCrud returns a CoreSecurityListResourceCrud reading the rule from the top level of the resource data,
+// which has the same schema as a rule of oci_core_security_list
+func (s *CoreSecurityListRuleResourceCrud) securityListCrud() *CoreSecurityListResourceCrud {
+	return &CoreSecurityListResourceCrud{BaseCrud: BaseCrud{D: s.D}}
+}
+
+// getRuleHashes returns the hashes of the rules of the security list in the direction of the rule
+func (s *CoreSecurityListRuleResourceCrud) getRuleHashes(securityList *oci_core.SecurityList) []int {
+	var hashes []int
+	if s.direction() == securityListRuleDirectionEgress {
+		for _, rule := range securityList.EgressSecurityRules {
+			hashes = append(hashes, egressSecurityRulesHashCodeForSets(EgressSecurityRuleToMap(rule)))
+		}
+	} else {
+		for _, rule := range securityList.IngressSecurityRules {
+			hashes = append(hashes, ingressSecurityRulesHashCodeForSets(IngressSecurityRuleToMap(rule)))
+		}
+	}
+	return hashes
+}
+
+func (s *CoreSecurityListRuleResourceCrud) getSecurityList(ctx context.Context) (*oci_core.SecurityList, *string, error) {
+	request := oci_core.GetSecurityListRequest{}
+
+	tmp := s.D.Get("security_list_id").(string)
+	request.SecurityListId = &tmp
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.GetSecurityList(ctx, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &response.SecurityList, response.Etag, nil
+}
+
+func (s *CoreSecurityListRuleResourceCrud) Create(ctx context.Context) error {
+	request := oci_core.UpdateSecurityListRequest{}
+
+	var ingressRule oci_core.IngressSecurityRule
+	var egressRule oci_core.EgressSecurityRule
+	if s.direction() == securityListRuleDirectionEgress {
+		if _, ok := s.D.GetOkExists("destination"); !ok {
+			return fmt.Errorf("the destination of an %s rule is required", s.direction())
+		}
+		rule, err := s.securityListCrud().mapToEgressSecurityRule("%s")
+		if err != nil {
+			return err
+		}
+		egressRule = rule
+		s.ruleHash = egressSecurityRulesHashCodeForSets(EgressSecurityRuleToMap(rule))
+	} else {
+		if _, ok := s.D.GetOkExists("source"); !ok {
+			return fmt.Errorf("the source of an %s rule is required", s.direction())
+		}
+		rule, err := s.securityListCrud().mapToIngressSecurityRule("%s")
+		if err != nil {
+			return err
+		}
+		ingressRule = rule
+		s.ruleHash = ingressSecurityRulesHashCodeForSets(IngressSecurityRuleToMap(rule))
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	return retryConcurrentRuleUpdate(func() error {
+		securityList, etag, err := s.getSecurityList(ctx)
+		if err != nil {
+			return err
+		}
+
+		s.setRuleIndex(securityList)
+		if s.ruleIndex >= 0 {
+			return fmt.Errorf("the %s rule already exists in security list %s", s.direction(), *securityList.Id)
+		}
+
+		if s.direction() == securityListRuleDirectionEgress {
+			request.EgressSecurityRules = append(securityList.EgressSecurityRules, egressRule)
+		} else {
+			request.IngressSecurityRules = append(securityList.IngressSecurityRules, ingressRule)
+		}
+		request.SecurityListId = securityList.Id
+		request.IfMatch = etag
+
+		response, err := s.Client.UpdateSecurityList(ctx, request)
+		if err != nil {
+			return err
+		}
+
+		s.Res = &response.SecurityList
+		s.setRuleIndex(s.Res)
+		if s.ruleIndex < 0 {
+			return fmt.Errorf("the %s rule was not found in security list %s after it was added", s.direction(), *s.Res.Id)
+		}
+		return nil
+	})
+}
+
+// findRule finds the rule identified by the ID of the resource in its security list
+func (s *CoreSecurityListRuleResourceCrud) findRule(ctx context.Context) (*oci_core.SecurityList, *string, error) {
+	values, err := parseCompositeId(s.D.Id(), "securityLists", securityListRulesKey(s.direction()))
+	if err != nil {
+		return nil, nil, err
+	}
+	s.ruleHash, err = strconv.Atoi(values[1])
+	if err != nil {
+		return nil, nil, fmt.Errorf("illegal compositeId %s encountered: %v", s.D.Id(), err)
+	}
+
+	securityList, etag, err := s.getSecurityList(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.setRuleIndex(securityList)
+	return securityList, etag, nil
+}
+
+// setRuleIndex sets the index of the rule in the security list, or -1 when it is not in the security list
+func (s *CoreSecurityListRuleResourceCrud) setRuleIndex(securityList *oci_core.SecurityList) {
+	s.ruleIndex = -1
+	for i, hash := range s.getRuleHashes(securityList) {
+		if hash == s.ruleHash {
+			s.ruleIndex = i
+			return
+		}
+	}
+}
+
+func (s *CoreSecurityListRuleResourceCrud) Get(ctx context.Context) error {
+	securityList, _, err := s.findRule(ctx)
+	if err != nil {
+		return err
+	}
+
+	if s.ruleIndex < 0 || securityList.LifecycleState == oci_core.SecurityListLifecycleStateTerminated {
+		return fmt.Errorf("the %s rule %d was not found in security list %s", s.direction(), s.ruleHash, *securityList.Id)
+	}
+
+	s.Res = securityList
+	return nil
+}
+
+func (s *CoreSecurityListRuleResourceCrud) Delete(ctx context.Context) error {
+	request := oci_core.UpdateSecurityListRequest{}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	return retryConcurrentRuleUpdate(func() error {
+		securityList, etag, err := s.findRule(ctx)
+		if err != nil {
+			return err
+		}
+
+		// The rule was already removed
+		if s.ruleIndex < 0 {
+			return nil
+		}
+
+		if s.direction() == securityListRuleDirectionEgress {
+			request.EgressSecurityRules = []oci_core.EgressSecurityRule{}
+			request.EgressSecurityRules = append(request.EgressSecurityRules, securityList.EgressSecurityRules[:s.ruleIndex]...)
+			request.EgressSecurityRules = append(request.EgressSecurityRules, securityList.EgressSecurityRules[s.ruleIndex+1:]...)
+		} else {
+			request.IngressSecurityRules = []oci_core.IngressSecurityRule{}
+			request.IngressSecurityRules = append(request.IngressSecurityRules, securityList.IngressSecurityRules[:s.ruleIndex]...)
+			request.IngressSecurityRules = append(request.IngressSecurityRules, securityList.IngressSecurityRules[s.ruleIndex+1:]...)
+		}
+		request.SecurityListId = securityList.Id
+		request.IfMatch = etag
+
+		_, err = s.Client.UpdateSecurityList(ctx, request)
+		return err
+	})
+}
+
+func (s *CoreSecurityListRuleResourceCrud) SetData() error {
+	if s.Res.Id != nil {
+		s.D.Set("security_list_id", *s.Res.Id)
+	}
+
+	// The attributes of the resource are those of a rule of oci_core_security_list
+	var rule map[string]interface{}
+	if s.direction() == securityListRuleDirectionEgress {
+		rule = EgressSecurityRuleToMap(s.Res.EgressSecurityRules[s.ruleIndex])
+	} else {
+		rule = IngressSecurityRuleToMap(s.Res.IngressSecurityRules[s.ruleIndex])
+	}
+	for key, value := range rule {
+		s.D.Set(key, value)
+	}
+
+	return nil
+}
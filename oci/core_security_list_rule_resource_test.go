@@ -0,0 +1,199 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_core "github.com/oracle/oci-go-sdk/core"
+	"github.com/stretchr/testify/assert"
+)
+
+// applyFakeNetworkResource applies a configuration to the state of a resource against the fake VirtualNetwork API, and
+// returns the new state of the resource
+func applyFakeNetworkResource(t *testing.T, resource *schema.Resource, state *terraform.InstanceState, raw map[string]interface{}, clients *OracleClients) *terraform.InstanceState {
+	rawConfig, err := config.NewRawConfig(raw)
+	assert.NoError(t, err)
+	diff, err := resource.Diff(state, terraform.NewResourceConfig(rawConfig), clients)
+	assert.NoError(t, err)
+	if diff == nil {
+		return state
+	}
+	assert.False(t, diff.RequiresNew(), "the resource should be updated in place")
+	state, err = resource.Apply(state, diff, clients)
+	assert.NoError(t, err)
+	return state
+}
+
+func TestCoreSecurityListRuleResource_sharedSecurityList(t *testing.T) {
+	clients := newFakeVirtualNetworkClients(t, newFakeVirtualNetwork(), "")
+	client := clients.virtualNetworkClient
+	ctx := context.Background()
+
+	vcn := createCleanupTestVcn(t, client, "vcn", "10.0.0.0/16", nil)
+	getSecurityList := func() oci_core.SecurityList {
+		response, err := client.GetSecurityList(ctx, oci_core.GetSecurityListRequest{SecurityListId: vcn.DefaultSecurityListId})
+		assert.NoError(t, err)
+		return response.SecurityList
+	}
+
+	// The default security list owns an ICMP rule, and leaves alone the SSH and egress rules it was created with
+	icmpRule := map[string]interface{}{"protocol": "1", "source": "10.0.0.0/16", "icmp_options": []interface{}{map[string]interface{}{"type": 3, "code": 4}}}
+	securityListConfig := map[string]interface{}{
+		"manage_default_resource_id": *vcn.DefaultSecurityListId,
+		"ignore_unowned_rules":       true,
+		"ingress_security_rules":     []interface{}{icmpRule},
+	}
+	securityList := schema.TestResourceDataRaw(t, CoreDefaultSecurityListResource().Schema, securityListConfig)
+	assert.NoError(t, CoreDefaultSecurityListResource().Create(securityList, clients))
+	assert.Equal(t, 1, securityList.Get("ingress_security_rules.#"))
+	assert.Equal(t, 0, securityList.Get("egress_security_rules.#"))
+	assert.Len(t, getSecurityList().IngressSecurityRules, 2)
+	assert.Len(t, getSecurityList().EgressSecurityRules, 1)
+
+	// The rule resources add their rules to the security list
+	httpsRule := schema.TestResourceDataRaw(t, CoreSecurityListRuleResource().Schema, map[string]interface{}{
+		"security_list_id": *vcn.DefaultSecurityListId,
+		"direction":        "INGRESS",
+		"protocol":         "6",
+		"source":           "0.0.0.0/0",
+		"tcp_options":      []interface{}{map[string]interface{}{"min": 443, "max": 443}},
+	})
+	assert.NoError(t, CoreSecurityListRuleResource().Create(httpsRule, clients))
+	assert.Regexp(t, "^securityLists/"+regexp.QuoteMeta(*vcn.DefaultSecurityListId)+"/ingressSecurityRules/[0-9]+$", httpsRule.Id())
+
+	egressRule := schema.TestResourceDataRaw(t, CoreSecurityListRuleResource().Schema, map[string]interface{}{
+		"security_list_id": *vcn.DefaultSecurityListId,
+		"direction":        "EGRESS",
+		"protocol":         "17",
+		"destination":      "10.1.0.0/16",
+		"stateless":        true,
+	})
+	assert.NoError(t, CoreSecurityListRuleResource().Create(egressRule, clients))
+	assert.Len(t, getSecurityList().IngressSecurityRules, 3)
+	assert.Len(t, getSecurityList().EgressSecurityRules, 2)
+
+	// The same rule can not be added twice
+	duplicateRule := schema.TestResourceDataRaw(t, CoreSecurityListRuleResource().Schema, map[string]interface{}{
+		"security_list_id": *vcn.DefaultSecurityListId,
+		"direction":        "EGRESS",
+		"protocol":         "17",
+		"destination":      "10.1.0.0/16",
+		"stateless":        true,
+	})
+	assert.Error(t, CoreSecurityListRuleResource().Create(duplicateRule, clients))
+	missingSourceRule := schema.TestResourceDataRaw(t, CoreSecurityListRuleResource().Schema, map[string]interface{}{
+		"security_list_id": *vcn.DefaultSecurityListId,
+		"direction":        "INGRESS",
+		"protocol":         "all",
+	})
+	assert.Error(t, CoreSecurityListRuleResource().Create(missingSourceRule, clients))
+
+	// An imported rule is read from the security list
+	importedRule := CoreSecurityListRuleResource().Data(nil)
+	importedRule.SetId(httpsRule.Id())
+	imported, err := CoreSecurityListRuleResource().Importer.State(importedRule, clients)
+	if assert.NoError(t, err) && assert.Len(t, imported, 1) {
+		assert.NoError(t, CoreSecurityListRuleResource().Read(imported[0], clients))
+		assert.Equal(t, httpsRule.Id(), imported[0].Id())
+		assert.Equal(t, "0.0.0.0/0", imported[0].Get("source"))
+		assert.Equal(t, 443, imported[0].Get("tcp_options.0.max"))
+		assert.Equal(t, false, imported[0].Get("stateless"))
+	}
+
+	// The security list ignores the rules it does not own, and keeps them when its own rules change
+	state := securityList.State()
+	securityListConfig["ingress_security_rules"] = []interface{}{
+		map[string]interface{}{"protocol": "1", "source": "10.0.0.0/16", "icmp_options": []interface{}{map[string]interface{}{"type": 3}}},
+	}
+	state = applyFakeNetworkResource(t, CoreDefaultSecurityListResource(), state, securityListConfig, clients)
+	assert.Equal(t, "1", state.Attributes["ingress_security_rules.#"])
+	assert.Equal(t, "0", state.Attributes["egress_security_rules.#"])
+	ingressRules := getSecurityList().IngressSecurityRules
+	if assert.Len(t, ingressRules, 3) {
+		for _, rule := range ingressRules {
+			if *rule.Protocol == "1" {
+				assert.Nil(t, rule.IcmpOptions.Code, "the ICMP rule should have been replaced")
+			}
+		}
+	}
+
+	// Refreshing the security list does not show the rules it does not own, so that there is nothing to change
+	securityList = CoreDefaultSecurityListResource().Data(state)
+	assert.NoError(t, CoreDefaultSecurityListResource().Read(securityList, clients))
+	state = securityList.State()
+	assert.Equal(t, "1", state.Attributes["ingress_security_rules.#"])
+	rawConfig, err := config.NewRawConfig(securityListConfig)
+	assert.NoError(t, err)
+	diff, err := CoreDefaultSecurityListResource().Diff(state, terraform.NewResourceConfig(rawConfig), clients)
+	assert.NoError(t, err)
+	assert.Nil(t, diff)
+
+	// Deleting a rule resource only removes its rule
+	httpsRuleState := httpsRule.State()
+	assert.NoError(t, CoreSecurityListRuleResource().Delete(httpsRule, clients))
+	assert.Len(t, getSecurityList().IngressSecurityRules, 2)
+	assert.Len(t, getSecurityList().EgressSecurityRules, 2)
+
+	// A rule that was removed is no longer read, and is not removed again
+	removedRule := CoreSecurityListRuleResource().Data(httpsRuleState)
+	assert.NoError(t, CoreSecurityListRuleResource().Read(removedRule, clients))
+	assert.Empty(t, removedRule.Id())
+	removedRule = CoreSecurityListRuleResource().Data(httpsRuleState)
+	assert.NoError(t, CoreSecurityListRuleResource().Delete(removedRule, clients))
+	assert.Len(t, getSecurityList().IngressSecurityRules, 2)
+
+	// Resetting the default security list only clears out its own rules
+	securityList = CoreDefaultSecurityListResource().Data(state)
+	assert.NoError(t, CoreDefaultSecurityListResource().Delete(securityList, clients))
+	remaining := getSecurityList()
+	if assert.Len(t, remaining.IngressSecurityRules, 1) {
+		assert.Equal(t, "6", *remaining.IngressSecurityRules[0].Protocol)
+	}
+	assert.Len(t, remaining.EgressSecurityRules, 2)
+}
+
+func TestRetryConcurrentRuleUpdate_etagMismatch(t *testing.T) {
+	clients := newFakeVirtualNetworkClients(t, newFakeVirtualNetwork(), "")
+	client := clients.virtualNetworkClient
+	vcn := createCleanupTestVcn(t, client, "vcn", "10.0.0.0/16", nil)
+
+	attempts := 0
+	err := retryConcurrentRuleUpdate(func() error {
+		attempts++
+		request := oci_core.UpdateSecurityListRequest{SecurityListId: vcn.DefaultSecurityListId}
+		if attempts == 1 {
+			request.IfMatch = oci_common.String("0")
+		}
+		_, err := client.UpdateSecurityList(context.Background(), request)
+		return err
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+
+	// The update is given up on after a few attempts, and other errors are not retried
+	attempts = 0
+	err = retryConcurrentRuleUpdate(func() error {
+		attempts++
+		_, err := client.UpdateSecurityList(context.Background(), oci_core.UpdateSecurityListRequest{SecurityListId: vcn.DefaultSecurityListId, IfMatch: oci_common.String("0")})
+		return err
+	})
+	assertFakeVirtualNetworkError(t, err, http.StatusPreconditionFailed)
+	assert.Equal(t, concurrentRuleUpdateAttempts, attempts)
+
+	attempts = 0
+	err = retryConcurrentRuleUpdate(func() error {
+		attempts++
+		_, err := client.UpdateSecurityList(context.Background(), oci_core.UpdateSecurityListRequest{SecurityListId: oci_common.String("ocid1.securitylist.oc1.phx.missing")})
+		return err
+	})
+	assertFakeVirtualNetworkError(t, err, http.StatusNotFound)
+	assert.Equal(t, 1, attempts)
+}
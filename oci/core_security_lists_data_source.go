@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
@@ -13,7 +14,8 @@ func CoreSecurityListsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreSecurityLists,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -45,6 +47,22 @@ func CoreSecurityListsDataSource() *schema.Resource {
 				Optional:   true,
 				Deprecated: FieldDeprecated("page"),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListSecurityListsSortByTimecreated),
+					string(oci_core.ListSecurityListsSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListSecurityListsSortOrderAsc),
+					string(oci_core.ListSecurityListsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -54,7 +72,7 @@ func readCoreSecurityLists(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreSecurityListsDataSourceCrud struct {
@@ -67,7 +85,7 @@ func (s *CoreSecurityListsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreSecurityListsDataSourceCrud) Get() error {
+func (s *CoreSecurityListsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListSecurityListsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -99,9 +117,18 @@ func (s *CoreSecurityListsDataSourceCrud) Get() error {
 		request.Page = &tmp
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_core.ListSecurityListsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_core.ListSecurityListsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListSecurityLists(context.Background(), request)
+	response, err := s.Client.ListSecurityLists(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -109,8 +136,8 @@ func (s *CoreSecurityListsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListSecurityLists(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListSecurityLists(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -175,6 +202,8 @@ func (s *CoreSecurityListsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreSecurityListsDataSource().Schema["security_lists"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("security_lists", resources); err != nil {
 		return err
 	}
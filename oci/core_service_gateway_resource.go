@@ -100,7 +100,7 @@ func createCoreServiceGateway(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreServiceGateway(d *schema.ResourceData, m interface{}) error {
@@ -108,7 +108,7 @@ func readCoreServiceGateway(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreServiceGateway(d *schema.ResourceData, m interface{}) error {
@@ -116,7 +116,7 @@ func updateCoreServiceGateway(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCoreServiceGateway(d *schema.ResourceData, m interface{}) error {
@@ -125,7 +125,7 @@ func deleteCoreServiceGateway(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreServiceGatewayResourceCrud struct {
@@ -163,7 +163,7 @@ func (s *CoreServiceGatewayResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreServiceGatewayResourceCrud) Create() error {
+func (s *CoreServiceGatewayResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateServiceGatewayRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -212,7 +212,7 @@ func (s *CoreServiceGatewayResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateServiceGateway(context.Background(), request)
+	response, err := s.Client.CreateServiceGateway(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -221,7 +221,7 @@ func (s *CoreServiceGatewayResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreServiceGatewayResourceCrud) Get() error {
+func (s *CoreServiceGatewayResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetServiceGatewayRequest{}
 
 	tmp := s.D.Id()
@@ -229,7 +229,7 @@ func (s *CoreServiceGatewayResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetServiceGateway(context.Background(), request)
+	response, err := s.Client.GetServiceGateway(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -238,7 +238,7 @@ func (s *CoreServiceGatewayResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreServiceGatewayResourceCrud) Update() error {
+func (s *CoreServiceGatewayResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateServiceGatewayRequest{}
 
 	if blockTraffic, ok := s.D.GetOkExists("block_traffic"); ok {
@@ -285,7 +285,7 @@ func (s *CoreServiceGatewayResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateServiceGateway(context.Background(), request)
+	response, err := s.Client.UpdateServiceGateway(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -294,7 +294,7 @@ func (s *CoreServiceGatewayResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreServiceGatewayResourceCrud) Delete() error {
+func (s *CoreServiceGatewayResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteServiceGatewayRequest{}
 
 	tmp := s.D.Id()
@@ -302,7 +302,7 @@ func (s *CoreServiceGatewayResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteServiceGateway(context.Background(), request)
+	_, err := s.Client.DeleteServiceGateway(ctx, request)
 	return err
 }
 
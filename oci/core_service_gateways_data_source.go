@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
@@ -13,7 +14,8 @@ func CoreServiceGatewaysDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreServiceGateways,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -31,6 +33,22 @@ func CoreServiceGatewaysDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(CoreServiceGatewayResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListServiceGatewaysSortByTimecreated),
+					string(oci_core.ListServiceGatewaysSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListServiceGatewaysSortOrderAsc),
+					string(oci_core.ListServiceGatewaysSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -40,7 +58,7 @@ func readCoreServiceGateways(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreServiceGatewaysDataSourceCrud struct {
@@ -53,7 +71,7 @@ func (s *CoreServiceGatewaysDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreServiceGatewaysDataSourceCrud) Get() error {
+func (s *CoreServiceGatewaysDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListServiceGatewaysRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -70,9 +88,18 @@ func (s *CoreServiceGatewaysDataSourceCrud) Get() error {
 		request.VcnId = &tmp
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_core.ListServiceGatewaysSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_core.ListServiceGatewaysSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListServiceGateways(context.Background(), request)
+	response, err := s.Client.ListServiceGateways(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -80,8 +107,8 @@ func (s *CoreServiceGatewaysDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListServiceGateways(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListServiceGateways(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -147,6 +174,8 @@ func (s *CoreServiceGatewaysDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreServiceGatewaysDataSource().Schema["service_gateways"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("service_gateways", resources); err != nil {
 		return err
 	}
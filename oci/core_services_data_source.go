@@ -13,7 +13,8 @@ func CoreServicesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreServices,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"services": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -52,7 +53,7 @@ func readCoreServices(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreServicesDataSourceCrud struct {
@@ -65,12 +66,13 @@ func (s *CoreServicesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreServicesDataSourceCrud) Get() error {
+func (s *CoreServicesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListServicesRequest{}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListServices(context.Background(), request)
+	response, err := s.Client.ListServices(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -78,8 +80,8 @@ func (s *CoreServicesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListServices(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListServices(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -125,6 +127,8 @@ func (s *CoreServicesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreServicesDataSource().Schema["services"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("services", resources); err != nil {
 		return err
 	}
@@ -13,7 +13,8 @@ func CoreShapesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreShapes,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"availability_domain": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -62,7 +63,7 @@ func readCoreShapes(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreShapesDataSourceCrud struct {
@@ -75,7 +76,7 @@ func (s *CoreShapesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreShapesDataSourceCrud) Get() error {
+func (s *CoreShapesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListShapesRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -104,8 +105,9 @@ func (s *CoreShapesDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListShapes(context.Background(), request)
+	response, err := s.Client.ListShapes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -113,8 +115,8 @@ func (s *CoreShapesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListShapes(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListShapes(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -148,6 +150,8 @@ func (s *CoreShapesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreShapesDataSource().Schema["shapes"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("shapes", resources); err != nil {
 		return err
 	}
@@ -100,7 +100,7 @@ func readSingularCoreSubnet(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreSubnetDataSourceCrud struct {
@@ -113,7 +113,7 @@ func (s *CoreSubnetDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreSubnetDataSourceCrud) Get() error {
+func (s *CoreSubnetDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetSubnetRequest{}
 
 	if subnetId, ok := s.D.GetOkExists("subnet_id"); ok {
@@ -123,7 +123,7 @@ func (s *CoreSubnetDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := s.Client.GetSubnet(context.Background(), request)
+	response, err := s.Client.GetSubnet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -128,7 +128,7 @@ func createCoreSubnet(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreSubnet(d *schema.ResourceData, m interface{}) error {
@@ -136,7 +136,7 @@ func readCoreSubnet(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreSubnet(d *schema.ResourceData, m interface{}) error {
@@ -144,7 +144,7 @@ func updateCoreSubnet(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCoreSubnet(d *schema.ResourceData, m interface{}) error {
@@ -153,7 +153,7 @@ func deleteCoreSubnet(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreSubnetResourceCrud struct {
@@ -191,7 +191,7 @@ func (s *CoreSubnetResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreSubnetResourceCrud) Create() error {
+func (s *CoreSubnetResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateSubnetRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -266,7 +266,7 @@ func (s *CoreSubnetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateSubnet(context.Background(), request)
+	response, err := s.Client.CreateSubnet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -275,7 +275,7 @@ func (s *CoreSubnetResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreSubnetResourceCrud) Get() error {
+func (s *CoreSubnetResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetSubnetRequest{}
 
 	tmp := s.D.Id()
@@ -283,7 +283,7 @@ func (s *CoreSubnetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetSubnet(context.Background(), request)
+	response, err := s.Client.GetSubnet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -292,7 +292,7 @@ func (s *CoreSubnetResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreSubnetResourceCrud) Update() error {
+func (s *CoreSubnetResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateSubnetRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -340,7 +340,7 @@ func (s *CoreSubnetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateSubnet(context.Background(), request)
+	response, err := s.Client.UpdateSubnet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -349,7 +349,7 @@ func (s *CoreSubnetResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreSubnetResourceCrud) Delete() error {
+func (s *CoreSubnetResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteSubnetRequest{}
 
 	tmp := s.D.Id()
@@ -357,7 +357,7 @@ func (s *CoreSubnetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteSubnet(context.Background(), request)
+	_, err := s.Client.DeleteSubnet(ctx, request)
 	return err
 }
 
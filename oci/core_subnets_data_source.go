@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
@@ -13,7 +14,8 @@ func CoreSubnetsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreSubnets,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -45,6 +47,22 @@ func CoreSubnetsDataSource() *schema.Resource {
 				Optional:   true,
 				Deprecated: FieldDeprecated("page"),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListSubnetsSortByTimecreated),
+					string(oci_core.ListSubnetsSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListSubnetsSortOrderAsc),
+					string(oci_core.ListSubnetsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -54,7 +72,7 @@ func readCoreSubnets(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreSubnetsDataSourceCrud struct {
@@ -67,7 +85,7 @@ func (s *CoreSubnetsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreSubnetsDataSourceCrud) Get() error {
+func (s *CoreSubnetsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListSubnetsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -99,9 +117,18 @@ func (s *CoreSubnetsDataSourceCrud) Get() error {
 		request.Page = &tmp
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_core.ListSubnetsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_core.ListSubnetsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListSubnets(context.Background(), request)
+	response, err := s.Client.ListSubnets(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -109,8 +136,8 @@ func (s *CoreSubnetsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListSubnets(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListSubnets(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -201,6 +228,8 @@ func (s *CoreSubnetsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreSubnetsDataSource().Schema["subnets"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("subnets", resources); err != nil {
 		return err
 	}
@@ -23,7 +23,7 @@ func readSingularCoreVcn(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreVcnDataSourceCrud struct {
@@ -36,7 +36,7 @@ func (s *CoreVcnDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVcnDataSourceCrud) Get() error {
+func (s *CoreVcnDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVcnRequest{}
 
 	if vcnId, ok := s.D.GetOkExists("vcn_id"); ok {
@@ -46,7 +46,7 @@ func (s *CoreVcnDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := s.Client.GetVcn(context.Background(), request)
+	response, err := s.Client.GetVcn(ctx, request)
 	if err != nil {
 		return err
 	}
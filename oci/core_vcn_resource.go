@@ -94,7 +94,7 @@ func createCoreVcn(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreVcn(d *schema.ResourceData, m interface{}) error {
@@ -102,7 +102,7 @@ func readCoreVcn(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreVcn(d *schema.ResourceData, m interface{}) error {
@@ -110,7 +110,7 @@ func updateCoreVcn(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCoreVcn(d *schema.ResourceData, m interface{}) error {
@@ -119,7 +119,7 @@ func deleteCoreVcn(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreVcnResourceCrud struct {
@@ -157,7 +157,7 @@ func (s *CoreVcnResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreVcnResourceCrud) Create() error {
+func (s *CoreVcnResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateVcnRequest{}
 
 	if cidrBlock, ok := s.D.GetOkExists("cidr_block"); ok {
@@ -194,7 +194,7 @@ func (s *CoreVcnResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVcn(context.Background(), request)
+	response, err := s.Client.CreateVcn(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -203,7 +203,7 @@ func (s *CoreVcnResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreVcnResourceCrud) Get() error {
+func (s *CoreVcnResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVcnRequest{}
 
 	tmp := s.D.Id()
@@ -211,7 +211,7 @@ func (s *CoreVcnResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVcn(context.Background(), request)
+	response, err := s.Client.GetVcn(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -220,7 +220,7 @@ func (s *CoreVcnResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreVcnResourceCrud) Update() error {
+func (s *CoreVcnResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateVcnRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -245,7 +245,7 @@ func (s *CoreVcnResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVcn(context.Background(), request)
+	response, err := s.Client.UpdateVcn(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -254,7 +254,7 @@ func (s *CoreVcnResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreVcnResourceCrud) Delete() error {
+func (s *CoreVcnResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteVcnRequest{}
 
 	tmp := s.D.Id()
@@ -262,7 +262,7 @@ func (s *CoreVcnResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVcn(context.Background(), request)
+	_, err := s.Client.DeleteVcn(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
@@ -13,7 +14,8 @@ func CoreVcnsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreVcns,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -41,6 +43,22 @@ func CoreVcnsDataSource() *schema.Resource {
 				Optional:   true,
 				Deprecated: FieldDeprecated("page"),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListVcnsSortByTimecreated),
+					string(oci_core.ListVcnsSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListVcnsSortOrderAsc),
+					string(oci_core.ListVcnsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -50,7 +68,7 @@ func readCoreVcns(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreVcnsDataSourceCrud struct {
@@ -63,7 +81,7 @@ func (s *CoreVcnsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVcnsDataSourceCrud) Get() error {
+func (s *CoreVcnsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListVcnsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -90,9 +108,18 @@ func (s *CoreVcnsDataSourceCrud) Get() error {
 		request.Page = &tmp
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_core.ListVcnsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_core.ListVcnsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListVcns(context.Background(), request)
+	response, err := s.Client.ListVcns(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -100,8 +127,8 @@ func (s *CoreVcnsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListVcns(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListVcns(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -177,6 +204,8 @@ func (s *CoreVcnsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreVcnsDataSource().Schema["virtual_networks"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("virtual_networks", resources); err != nil {
 		return err
 	}
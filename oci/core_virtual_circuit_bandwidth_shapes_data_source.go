@@ -13,7 +13,8 @@ func CoreVirtualCircuitBandwidthShapesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreVirtualCircuitBandwidthShapes,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"provider_service_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -48,7 +49,7 @@ func readCoreVirtualCircuitBandwidthShapes(d *schema.ResourceData, m interface{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreVirtualCircuitBandwidthShapesDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *CoreVirtualCircuitBandwidthShapesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVirtualCircuitBandwidthShapesDataSourceCrud) Get() error {
+func (s *CoreVirtualCircuitBandwidthShapesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListFastConnectProviderVirtualCircuitBandwidthShapesRequest{}
 
 	if providerServiceId, ok := s.D.GetOkExists("provider_service_id"); ok {
@@ -70,8 +71,9 @@ func (s *CoreVirtualCircuitBandwidthShapesDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListFastConnectProviderVirtualCircuitBandwidthShapes(context.Background(), request)
+	response, err := s.Client.ListFastConnectProviderVirtualCircuitBandwidthShapes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -79,8 +81,8 @@ func (s *CoreVirtualCircuitBandwidthShapesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListFastConnectProviderVirtualCircuitBandwidthShapes(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListFastConnectProviderVirtualCircuitBandwidthShapes(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -118,6 +120,8 @@ func (s *CoreVirtualCircuitBandwidthShapesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreVirtualCircuitBandwidthShapesDataSource().Schema["virtual_circuit_bandwidth_shapes"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("virtual_circuit_bandwidth_shapes", resources); err != nil {
 		return err
 	}
@@ -152,7 +152,7 @@ func readSingularCoreVirtualCircuit(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreVirtualCircuitDataSourceCrud struct {
@@ -165,7 +165,7 @@ func (s *CoreVirtualCircuitDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVirtualCircuitDataSourceCrud) Get() error {
+func (s *CoreVirtualCircuitDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVirtualCircuitRequest{}
 
 	if virtualCircuitId, ok := s.D.GetOkExists("virtual_circuit_id"); ok {
@@ -175,7 +175,7 @@ func (s *CoreVirtualCircuitDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := s.Client.GetVirtualCircuit(context.Background(), request)
+	response, err := s.Client.GetVirtualCircuit(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -52,7 +52,7 @@ func readCoreVirtualCircuitPublicPrefixes(d *schema.ResourceData, m interface{})
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreVirtualCircuitPublicPrefixesDataSourceCrud struct {
@@ -65,7 +65,7 @@ func (s *CoreVirtualCircuitPublicPrefixesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVirtualCircuitPublicPrefixesDataSourceCrud) Get() error {
+func (s *CoreVirtualCircuitPublicPrefixesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListVirtualCircuitPublicPrefixesRequest{}
 
 	if verificationState, ok := s.D.GetOkExists("verification_state"); ok {
@@ -79,7 +79,7 @@ func (s *CoreVirtualCircuitPublicPrefixesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := s.Client.ListVirtualCircuitPublicPrefixes(context.Background(), request)
+	response, err := s.Client.ListVirtualCircuitPublicPrefixes(ctx, request)
 	if err != nil {
 		return err
 	}
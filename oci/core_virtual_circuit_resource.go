@@ -176,7 +176,7 @@ func createCoreVirtualCircuit(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreVirtualCircuit(d *schema.ResourceData, m interface{}) error {
@@ -184,7 +184,7 @@ func readCoreVirtualCircuit(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreVirtualCircuit(d *schema.ResourceData, m interface{}) error {
@@ -192,7 +192,7 @@ func updateCoreVirtualCircuit(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCoreVirtualCircuit(d *schema.ResourceData, m interface{}) error {
@@ -201,7 +201,7 @@ func deleteCoreVirtualCircuit(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreVirtualCircuitResourceCrud struct {
@@ -254,7 +254,7 @@ func (s *CoreVirtualCircuitResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *CoreVirtualCircuitResourceCrud) Create() error {
+func (s *CoreVirtualCircuitResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateVirtualCircuitRequest{}
 
 	if bandwidthShapeName, ok := s.D.GetOkExists("bandwidth_shape_name"); ok {
@@ -339,7 +339,7 @@ func (s *CoreVirtualCircuitResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVirtualCircuit(context.Background(), request)
+	response, err := s.Client.CreateVirtualCircuit(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -348,7 +348,7 @@ func (s *CoreVirtualCircuitResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreVirtualCircuitResourceCrud) Get() error {
+func (s *CoreVirtualCircuitResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVirtualCircuitRequest{}
 
 	tmp := s.D.Id()
@@ -356,7 +356,7 @@ func (s *CoreVirtualCircuitResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVirtualCircuit(context.Background(), request)
+	response, err := s.Client.GetVirtualCircuit(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -367,7 +367,7 @@ func (s *CoreVirtualCircuitResourceCrud) Get() error {
 	ppRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 	ppRequest.VirtualCircuitId = request.VirtualCircuitId
 
-	ppResponse, ppErr := s.Client.ListVirtualCircuitPublicPrefixes(context.Background(), ppRequest)
+	ppResponse, ppErr := s.Client.ListVirtualCircuitPublicPrefixes(ctx, ppRequest)
 	if ppErr != nil {
 		return ppErr
 	}
@@ -382,11 +382,11 @@ func (s *CoreVirtualCircuitResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreVirtualCircuitResourceCrud) Update() error {
+func (s *CoreVirtualCircuitResourceCrud) Update(ctx context.Context) error {
 	// Update public prefixes, if changed
 	// Cannot update PublicPrefix when the VirtualCircuit is in state PROVISIONING so public prefixes should be updated first
 	if s.D.HasChange("public_prefixes") {
-		err := s.updatePublicPrefixes()
+		err := s.updatePublicPrefixes(ctx)
 		if err != nil {
 			return fmt.Errorf("unable to update 'public_prefixes', error: %v", err)
 		}
@@ -447,7 +447,7 @@ func (s *CoreVirtualCircuitResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVirtualCircuit(context.Background(), request)
+	response, err := s.Client.UpdateVirtualCircuit(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -457,7 +457,7 @@ func (s *CoreVirtualCircuitResourceCrud) Update() error {
 }
 
 // Update public prefixes using BulkAdd and BulkDelete APIs by computing the diff
-func (s *CoreVirtualCircuitResourceCrud) updatePublicPrefixes() error {
+func (s *CoreVirtualCircuitResourceCrud) updatePublicPrefixes(ctx context.Context) error {
 	virtualCircuitId := s.D.Id()
 
 	o, n := s.D.GetChange("public_prefixes")
@@ -494,12 +494,12 @@ func (s *CoreVirtualCircuitResourceCrud) updatePublicPrefixes() error {
 		addRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 		addRequest.PublicPrefixes = publicPrefixesToAdd
 		addRequest.VirtualCircuitId = &virtualCircuitId
-		addErr := s.Client.BulkAddVirtualCircuitPublicPrefixes(context.Background(), addRequest)
+		addErr := s.Client.BulkAddVirtualCircuitPublicPrefixes(ctx, addRequest)
 		if addErr != nil {
 			return fmt.Errorf("failed to add public prefixes, error: %v", addErr)
 		}
 
-		if waitErr := waitForUpdatedState(s.D, s); waitErr != nil {
+		if waitErr := waitForUpdatedState(ctx, s.D, s); waitErr != nil {
 			return waitErr
 		}
 	}
@@ -511,12 +511,12 @@ func (s *CoreVirtualCircuitResourceCrud) updatePublicPrefixes() error {
 		deleteRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 		deleteRequest.PublicPrefixes = publicPrefixesToDelete
 		deleteRequest.VirtualCircuitId = &virtualCircuitId
-		deleteErr := s.Client.BulkDeleteVirtualCircuitPublicPrefixes(context.Background(), deleteRequest)
+		deleteErr := s.Client.BulkDeleteVirtualCircuitPublicPrefixes(ctx, deleteRequest)
 		if deleteErr != nil {
 			return fmt.Errorf("failed to delete public prefixes, error: %v", deleteErr)
 		}
 
-		if waitErr := waitForUpdatedState(s.D, s); waitErr != nil {
+		if waitErr := waitForUpdatedState(ctx, s.D, s); waitErr != nil {
 			return waitErr
 		}
 	}
@@ -524,7 +524,7 @@ func (s *CoreVirtualCircuitResourceCrud) updatePublicPrefixes() error {
 	return nil
 }
 
-func (s *CoreVirtualCircuitResourceCrud) Delete() error {
+func (s *CoreVirtualCircuitResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteVirtualCircuitRequest{}
 
 	tmp := s.D.Id()
@@ -532,7 +532,7 @@ func (s *CoreVirtualCircuitResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVirtualCircuit(context.Background(), request)
+	_, err := s.Client.DeleteVirtualCircuit(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
@@ -13,7 +14,8 @@ func CoreVirtualCircuitsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreVirtualCircuits,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -31,6 +33,22 @@ func CoreVirtualCircuitsDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(CoreVirtualCircuitDataSource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListVirtualCircuitsSortByTimecreated),
+					string(oci_core.ListVirtualCircuitsSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListVirtualCircuitsSortOrderAsc),
+					string(oci_core.ListVirtualCircuitsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -40,7 +58,7 @@ func readCoreVirtualCircuits(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreVirtualCircuitsDataSourceCrud struct {
@@ -53,7 +71,7 @@ func (s *CoreVirtualCircuitsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVirtualCircuitsDataSourceCrud) Get() error {
+func (s *CoreVirtualCircuitsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListVirtualCircuitsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -70,9 +88,18 @@ func (s *CoreVirtualCircuitsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_core.VirtualCircuitLifecycleStateEnum(state.(string))
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_core.ListVirtualCircuitsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_core.ListVirtualCircuitsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListVirtualCircuits(context.Background(), request)
+	response, err := s.Client.ListVirtualCircuits(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -80,8 +107,8 @@ func (s *CoreVirtualCircuitsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListVirtualCircuits(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListVirtualCircuits(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -181,6 +208,8 @@ func (s *CoreVirtualCircuitsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreVirtualCircuitsDataSource().Schema["virtual_circuits"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("virtual_circuits", resources); err != nil {
 		return err
 	}
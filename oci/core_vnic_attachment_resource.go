@@ -168,7 +168,7 @@ func createCoreVnicAttachment(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).computeClient
 	sync.VirtualNetworkClient = m.(*OracleClients).virtualNetworkClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreVnicAttachment(d *schema.ResourceData, m interface{}) error {
@@ -177,7 +177,7 @@ func readCoreVnicAttachment(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).computeClient
 	sync.VirtualNetworkClient = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreVnicAttachment(d *schema.ResourceData, m interface{}) error {
@@ -186,7 +186,7 @@ func updateCoreVnicAttachment(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).computeClient
 	sync.VirtualNetworkClient = m.(*OracleClients).virtualNetworkClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCoreVnicAttachment(d *schema.ResourceData, m interface{}) error {
@@ -196,7 +196,7 @@ func deleteCoreVnicAttachment(d *schema.ResourceData, m interface{}) error {
 	sync.VirtualNetworkClient = m.(*OracleClients).virtualNetworkClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreVnicAttachmentResourceCrud struct {
@@ -235,7 +235,7 @@ func (s *CoreVnicAttachmentResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreVnicAttachmentResourceCrud) Create() error {
+func (s *CoreVnicAttachmentResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.AttachVnicRequest{}
 
 	if createVnicDetails, ok := s.D.GetOkExists("create_vnic_details"); ok {
@@ -266,7 +266,7 @@ func (s *CoreVnicAttachmentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.AttachVnic(context.Background(), request)
+	response, err := s.Client.AttachVnic(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -278,10 +278,10 @@ func (s *CoreVnicAttachmentResourceCrud) Create() error {
 // @CODEGEN 1/2018: Generator doesn't give us an Update method for VnicAttachment.
 // However, the existing behavior allows vnic to be updated through the create_vnic_details.
 // So keep this Update functionality in the provider.
-func (s *CoreVnicAttachmentResourceCrud) Update() error {
+func (s *CoreVnicAttachmentResourceCrud) Update(ctx context.Context) error {
 	// We should fetch the VnicAttachment in order to update
 	// the state data after the update call.
-	err := s.Get()
+	err := s.Get(ctx)
 	if err != nil {
 		return err
 	}
@@ -309,11 +309,11 @@ func (s *CoreVnicAttachmentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err = s.VirtualNetworkClient.UpdateVnic(context.Background(), request)
+	_, err = s.VirtualNetworkClient.UpdateVnic(ctx, request)
 	return err
 }
 
-func (s *CoreVnicAttachmentResourceCrud) Get() error {
+func (s *CoreVnicAttachmentResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVnicAttachmentRequest{}
 
 	tmp := s.D.Id()
@@ -321,7 +321,7 @@ func (s *CoreVnicAttachmentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVnicAttachment(context.Background(), request)
+	response, err := s.Client.GetVnicAttachment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -330,7 +330,7 @@ func (s *CoreVnicAttachmentResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreVnicAttachmentResourceCrud) Delete() error {
+func (s *CoreVnicAttachmentResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DetachVnicRequest{}
 
 	tmp := s.D.Id()
@@ -338,7 +338,7 @@ func (s *CoreVnicAttachmentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DetachVnic(context.Background(), request)
+	_, err := s.Client.DetachVnic(ctx, request)
 	return err
 }
 
@@ -386,7 +386,7 @@ func (s *CoreVnicAttachmentResourceCrud) SetData() error {
 	request.VnicId = s.Res.VnicId
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.VirtualNetworkClient.GetVnic(context.Background(), request)
+	response, err := s.VirtualNetworkClient.GetVnic(s.OperationContext(), request)
 	if err != nil {
 		// VNIC might not be found when attaching or detaching.
 		log.Printf("[DEBUG] VNIC not found during VNIC Attachment refresh. (VNIC ID: %q, Error: %q)", *request.VnicId, err)
@@ -13,7 +13,8 @@ func CoreVnicAttachmentsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreVnicAttachments,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"availability_domain": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -54,7 +55,7 @@ func readCoreVnicAttachments(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreVnicAttachmentsDataSourceCrud struct {
@@ -67,7 +68,7 @@ func (s *CoreVnicAttachmentsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVnicAttachmentsDataSourceCrud) Get() error {
+func (s *CoreVnicAttachmentsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListVnicAttachmentsRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -101,8 +102,9 @@ func (s *CoreVnicAttachmentsDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListVnicAttachments(context.Background(), request)
+	response, err := s.Client.ListVnicAttachments(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -110,8 +112,8 @@ func (s *CoreVnicAttachmentsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListVnicAttachments(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListVnicAttachments(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -181,6 +183,8 @@ func (s *CoreVnicAttachmentsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreVnicAttachmentsDataSource().Schema["vnic_attachments"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("vnic_attachments", resources); err != nil {
 		return err
 	}
@@ -85,7 +85,7 @@ func readSingularCoreVnic(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).virtualNetworkClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreVnicDataSourceCrud struct {
@@ -98,7 +98,7 @@ func (s *CoreVnicDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVnicDataSourceCrud) Get() error {
+func (s *CoreVnicDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVnicRequest{}
 
 	if vnicId, ok := s.D.GetOkExists("vnic_id"); ok {
@@ -108,7 +108,7 @@ func (s *CoreVnicDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := s.Client.GetVnic(context.Background(), request)
+	response, err := s.Client.GetVnic(ctx, request)
 	if err != nil {
 		return err
 	}
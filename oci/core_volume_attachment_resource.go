@@ -127,7 +127,7 @@ func createCoreVolumeAttachment(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreVolumeAttachment(d *schema.ResourceData, m interface{}) error {
@@ -135,7 +135,7 @@ func readCoreVolumeAttachment(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func deleteCoreVolumeAttachment(d *schema.ResourceData, m interface{}) error {
@@ -144,7 +144,7 @@ func deleteCoreVolumeAttachment(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).computeClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreVolumeAttachmentResourceCrud struct {
@@ -183,7 +183,7 @@ func (s *CoreVolumeAttachmentResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreVolumeAttachmentResourceCrud) Create() error {
+func (s *CoreVolumeAttachmentResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.AttachVolumeRequest{}
 	err := s.populateTopLevelPolymorphicAttachVolumeRequest(&request)
 	if err != nil {
@@ -192,7 +192,7 @@ func (s *CoreVolumeAttachmentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.AttachVolume(context.Background(), request)
+	response, err := s.Client.AttachVolume(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -201,7 +201,7 @@ func (s *CoreVolumeAttachmentResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreVolumeAttachmentResourceCrud) Get() error {
+func (s *CoreVolumeAttachmentResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVolumeAttachmentRequest{}
 
 	tmp := s.D.Id()
@@ -209,7 +209,7 @@ func (s *CoreVolumeAttachmentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVolumeAttachment(context.Background(), request)
+	response, err := s.Client.GetVolumeAttachment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -218,7 +218,7 @@ func (s *CoreVolumeAttachmentResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreVolumeAttachmentResourceCrud) Delete() error {
+func (s *CoreVolumeAttachmentResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DetachVolumeRequest{}
 
 	tmp := s.D.Id()
@@ -226,7 +226,7 @@ func (s *CoreVolumeAttachmentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DetachVolume(context.Background(), request)
+	_, err := s.Client.DetachVolume(ctx, request)
 	return err
 }
 
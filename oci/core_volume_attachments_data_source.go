@@ -14,7 +14,8 @@ func CoreVolumeAttachmentsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreVolumeAttachments,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"availability_domain": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -55,7 +56,7 @@ func readCoreVolumeAttachments(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).computeClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreVolumeAttachmentsDataSourceCrud struct {
@@ -68,7 +69,7 @@ func (s *CoreVolumeAttachmentsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVolumeAttachmentsDataSourceCrud) Get() error {
+func (s *CoreVolumeAttachmentsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListVolumeAttachmentsRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -102,8 +103,9 @@ func (s *CoreVolumeAttachmentsDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListVolumeAttachments(context.Background(), request)
+	response, err := s.Client.ListVolumeAttachments(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -111,8 +113,8 @@ func (s *CoreVolumeAttachmentsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListVolumeAttachments(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListVolumeAttachments(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -255,6 +257,8 @@ func (s *CoreVolumeAttachmentsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreVolumeAttachmentsDataSource().Schema["volume_attachments"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("volume_attachments", resources); err != nil {
 		return err
 	}
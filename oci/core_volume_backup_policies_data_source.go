@@ -13,7 +13,8 @@ func CoreVolumeBackupPoliciesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreVolumeBackupPolicies,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"volume_backup_policies": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -78,7 +79,7 @@ func readCoreVolumeBackupPolicies(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreVolumeBackupPoliciesDataSourceCrud struct {
@@ -91,12 +92,13 @@ func (s *CoreVolumeBackupPoliciesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVolumeBackupPoliciesDataSourceCrud) Get() error {
+func (s *CoreVolumeBackupPoliciesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListVolumeBackupPoliciesRequest{}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListVolumeBackupPolicies(context.Background(), request)
+	response, err := s.Client.ListVolumeBackupPolicies(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -104,8 +106,8 @@ func (s *CoreVolumeBackupPoliciesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListVolumeBackupPolicies(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListVolumeBackupPolicies(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -153,6 +155,8 @@ func (s *CoreVolumeBackupPoliciesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreVolumeBackupPoliciesDataSource().Schema["volume_backup_policies"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("volume_backup_policies", resources); err != nil {
 		return err
 	}
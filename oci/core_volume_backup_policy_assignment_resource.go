@@ -48,7 +48,7 @@ func createCoreVolumeBackupPolicyAssignment(d *schema.ResourceData, m interface{
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreVolumeBackupPolicyAssignment(d *schema.ResourceData, m interface{}) error {
@@ -56,7 +56,7 @@ func readCoreVolumeBackupPolicyAssignment(d *schema.ResourceData, m interface{})
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func deleteCoreVolumeBackupPolicyAssignment(d *schema.ResourceData, m interface{}) error {
@@ -65,7 +65,7 @@ func deleteCoreVolumeBackupPolicyAssignment(d *schema.ResourceData, m interface{
 	sync.Client = m.(*OracleClients).blockstorageClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreVolumeBackupPolicyAssignmentResourceCrud struct {
@@ -79,7 +79,7 @@ func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Create() error {
+func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateVolumeBackupPolicyAssignmentRequest{}
 
 	if assetId, ok := s.D.GetOkExists("asset_id"); ok {
@@ -94,7 +94,7 @@ func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVolumeBackupPolicyAssignment(context.Background(), request)
+	response, err := s.Client.CreateVolumeBackupPolicyAssignment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +103,7 @@ func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Get() error {
+func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVolumeBackupPolicyAssignmentRequest{}
 
 	tmp := s.D.Id()
@@ -111,7 +111,7 @@ func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVolumeBackupPolicyAssignment(context.Background(), request)
+	response, err := s.Client.GetVolumeBackupPolicyAssignment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -120,7 +120,7 @@ func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Delete() error {
+func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteVolumeBackupPolicyAssignmentRequest{}
 
 	tmp := s.D.Id()
@@ -128,7 +128,7 @@ func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVolumeBackupPolicyAssignment(context.Background(), request)
+	_, err := s.Client.DeleteVolumeBackupPolicyAssignment(ctx, request)
 	return err
 }
 
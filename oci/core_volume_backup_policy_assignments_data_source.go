@@ -13,7 +13,8 @@ func CoreVolumeBackupPolicyAssignmentsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreVolumeBackupPolicyAssignments,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"asset_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -32,7 +33,7 @@ func readCoreVolumeBackupPolicyAssignments(d *schema.ResourceData, m interface{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreVolumeBackupPolicyAssignmentsDataSourceCrud struct {
@@ -45,7 +46,7 @@ func (s *CoreVolumeBackupPolicyAssignmentsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVolumeBackupPolicyAssignmentsDataSourceCrud) Get() error {
+func (s *CoreVolumeBackupPolicyAssignmentsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVolumeBackupPolicyAssetAssignmentRequest{}
 
 	if assetId, ok := s.D.GetOkExists("asset_id"); ok {
@@ -54,8 +55,9 @@ func (s *CoreVolumeBackupPolicyAssignmentsDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.GetVolumeBackupPolicyAssetAssignment(context.Background(), request)
+	response, err := s.Client.GetVolumeBackupPolicyAssetAssignment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -63,8 +65,8 @@ func (s *CoreVolumeBackupPolicyAssignmentsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.GetVolumeBackupPolicyAssetAssignment(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.GetVolumeBackupPolicyAssetAssignment(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -108,6 +110,8 @@ func (s *CoreVolumeBackupPolicyAssignmentsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreVolumeBackupPolicyAssignmentsDataSource().Schema["volume_backup_policy_assignments"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("volume_backup_policy_assignments", resources); err != nil {
 		return err
 	}
@@ -139,7 +139,7 @@ func createCoreVolumeBackup(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreVolumeBackup(d *schema.ResourceData, m interface{}) error {
@@ -147,7 +147,7 @@ func readCoreVolumeBackup(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreVolumeBackup(d *schema.ResourceData, m interface{}) error {
@@ -155,7 +155,7 @@ func updateCoreVolumeBackup(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCoreVolumeBackup(d *schema.ResourceData, m interface{}) error {
@@ -164,7 +164,7 @@ func deleteCoreVolumeBackup(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).blockstorageClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreVolumeBackupResourceCrud struct {
@@ -206,12 +206,12 @@ func (s *CoreVolumeBackupResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreVolumeBackupResourceCrud) Create() error {
+func (s *CoreVolumeBackupResourceCrud) Create(ctx context.Context) error {
 	if s.isCopyCreate() {
-		return s.createVolumeBackupCopy()
+		return s.createVolumeBackupCopy(ctx)
 	}
 
-	return s.CreateVolumeBackup()
+	return s.CreateVolumeBackup(ctx)
 }
 
 func (s *CoreVolumeBackupResourceCrud) isCopyCreate() bool {
@@ -223,7 +223,7 @@ func (s *CoreVolumeBackupResourceCrud) isCopyCreate() bool {
 	return false
 }
 
-func (s *CoreVolumeBackupResourceCrud) createVolumeBackupCopy() error {
+func (s *CoreVolumeBackupResourceCrud) createVolumeBackupCopy(ctx context.Context) error {
 	copyVolumeBackupRequest := oci_core.CopyVolumeBackupRequest{}
 
 	configProvider := *s.Client.ConfigurationProvider()
@@ -258,7 +258,7 @@ func (s *CoreVolumeBackupResourceCrud) createVolumeBackupCopy() error {
 		copyVolumeBackupRequest.DisplayName = &tmp
 	}
 
-	response, err := s.SourceRegionClient.CopyVolumeBackup(context.Background(), copyVolumeBackupRequest)
+	response, err := s.SourceRegionClient.CopyVolumeBackup(ctx, copyVolumeBackupRequest)
 	if err != nil {
 		return err
 	}
@@ -267,7 +267,7 @@ func (s *CoreVolumeBackupResourceCrud) createVolumeBackupCopy() error {
 	return nil
 }
 
-func (s *CoreVolumeBackupResourceCrud) CreateVolumeBackup() error {
+func (s *CoreVolumeBackupResourceCrud) CreateVolumeBackup(ctx context.Context) error {
 	request := oci_core.CreateVolumeBackupRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -298,7 +298,7 @@ func (s *CoreVolumeBackupResourceCrud) CreateVolumeBackup() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVolumeBackup(context.Background(), request)
+	response, err := s.Client.CreateVolumeBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -307,7 +307,7 @@ func (s *CoreVolumeBackupResourceCrud) CreateVolumeBackup() error {
 	return nil
 }
 
-func (s *CoreVolumeBackupResourceCrud) Get() error {
+func (s *CoreVolumeBackupResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVolumeBackupRequest{}
 
 	tmp := s.D.Id()
@@ -315,7 +315,7 @@ func (s *CoreVolumeBackupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVolumeBackup(context.Background(), request)
+	response, err := s.Client.GetVolumeBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -324,7 +324,7 @@ func (s *CoreVolumeBackupResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreVolumeBackupResourceCrud) Update() error {
+func (s *CoreVolumeBackupResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateVolumeBackupRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -349,7 +349,7 @@ func (s *CoreVolumeBackupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVolumeBackup(context.Background(), request)
+	response, err := s.Client.UpdateVolumeBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -358,7 +358,7 @@ func (s *CoreVolumeBackupResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreVolumeBackupResourceCrud) Delete() error {
+func (s *CoreVolumeBackupResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteVolumeBackupRequest{}
 
 	tmp := s.D.Id()
@@ -366,7 +366,7 @@ func (s *CoreVolumeBackupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVolumeBackup(context.Background(), request)
+	_, err := s.Client.DeleteVolumeBackup(ctx, request)
 	return err
 }
 
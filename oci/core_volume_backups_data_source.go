@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
@@ -14,7 +15,8 @@ func CoreVolumeBackupsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreVolumeBackups,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -50,6 +52,22 @@ func CoreVolumeBackupsDataSource() *schema.Resource {
 				Optional:   true,
 				Deprecated: FieldDeprecated("page"),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListVolumeBackupsSortByTimecreated),
+					string(oci_core.ListVolumeBackupsSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListVolumeBackupsSortOrderAsc),
+					string(oci_core.ListVolumeBackupsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -59,7 +77,7 @@ func readCoreVolumeBackups(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreVolumeBackupsDataSourceCrud struct {
@@ -72,7 +90,7 @@ func (s *CoreVolumeBackupsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVolumeBackupsDataSourceCrud) Get() error {
+func (s *CoreVolumeBackupsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListVolumeBackupsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -109,9 +127,18 @@ func (s *CoreVolumeBackupsDataSourceCrud) Get() error {
 		request.Page = &tmp
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_core.ListVolumeBackupsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_core.ListVolumeBackupsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListVolumeBackups(context.Background(), request)
+	response, err := s.Client.ListVolumeBackups(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -119,8 +146,8 @@ func (s *CoreVolumeBackupsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListVolumeBackups(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListVolumeBackups(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -208,6 +235,8 @@ func (s *CoreVolumeBackupsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreVolumeBackupsDataSource().Schema["volume_backups"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("volume_backups", resources); err != nil {
 		return err
 	}
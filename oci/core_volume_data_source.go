@@ -107,7 +107,7 @@ func readSingularCoreVolume(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreVolumeDataSourceCrud struct {
@@ -120,7 +120,7 @@ func (s *CoreVolumeDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVolumeDataSourceCrud) Get() error {
+func (s *CoreVolumeDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVolumeRequest{}
 
 	if volumeId, ok := s.D.GetOkExists("volume_id"); ok {
@@ -130,7 +130,7 @@ func (s *CoreVolumeDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := s.Client.GetVolume(context.Background(), request)
+	response, err := s.Client.GetVolume(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -106,7 +106,7 @@ func createCoreVolumeGroupBackup(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreVolumeGroupBackup(d *schema.ResourceData, m interface{}) error {
@@ -114,7 +114,7 @@ func readCoreVolumeGroupBackup(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreVolumeGroupBackup(d *schema.ResourceData, m interface{}) error {
@@ -122,7 +122,7 @@ func updateCoreVolumeGroupBackup(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCoreVolumeGroupBackup(d *schema.ResourceData, m interface{}) error {
@@ -131,7 +131,7 @@ func deleteCoreVolumeGroupBackup(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).blockstorageClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreVolumeGroupBackupResourceCrud struct {
@@ -171,7 +171,7 @@ func (s *CoreVolumeGroupBackupResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreVolumeGroupBackupResourceCrud) Create() error {
+func (s *CoreVolumeGroupBackupResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateVolumeGroupBackupRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -207,7 +207,7 @@ func (s *CoreVolumeGroupBackupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVolumeGroupBackup(context.Background(), request)
+	response, err := s.Client.CreateVolumeGroupBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -216,7 +216,7 @@ func (s *CoreVolumeGroupBackupResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreVolumeGroupBackupResourceCrud) Get() error {
+func (s *CoreVolumeGroupBackupResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVolumeGroupBackupRequest{}
 
 	tmp := s.D.Id()
@@ -224,7 +224,7 @@ func (s *CoreVolumeGroupBackupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVolumeGroupBackup(context.Background(), request)
+	response, err := s.Client.GetVolumeGroupBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -233,7 +233,7 @@ func (s *CoreVolumeGroupBackupResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreVolumeGroupBackupResourceCrud) Update() error {
+func (s *CoreVolumeGroupBackupResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateVolumeGroupBackupRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -258,7 +258,7 @@ func (s *CoreVolumeGroupBackupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVolumeGroupBackup(context.Background(), request)
+	response, err := s.Client.UpdateVolumeGroupBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -267,7 +267,7 @@ func (s *CoreVolumeGroupBackupResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreVolumeGroupBackupResourceCrud) Delete() error {
+func (s *CoreVolumeGroupBackupResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteVolumeGroupBackupRequest{}
 
 	tmp := s.D.Id()
@@ -275,7 +275,7 @@ func (s *CoreVolumeGroupBackupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVolumeGroupBackup(context.Background(), request)
+	_, err := s.Client.DeleteVolumeGroupBackup(ctx, request)
 	return err
 }
 
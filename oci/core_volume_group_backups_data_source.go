@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
@@ -14,7 +15,8 @@ func CoreVolumeGroupBackupsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreVolumeGroupBackups,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -32,6 +34,22 @@ func CoreVolumeGroupBackupsDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(CoreVolumeGroupBackupResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListVolumeGroupBackupsSortByTimecreated),
+					string(oci_core.ListVolumeGroupBackupsSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListVolumeGroupBackupsSortOrderAsc),
+					string(oci_core.ListVolumeGroupBackupsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -41,7 +59,7 @@ func readCoreVolumeGroupBackups(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreVolumeGroupBackupsDataSourceCrud struct {
@@ -54,7 +72,7 @@ func (s *CoreVolumeGroupBackupsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVolumeGroupBackupsDataSourceCrud) Get() error {
+func (s *CoreVolumeGroupBackupsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListVolumeGroupBackupsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -72,9 +90,18 @@ func (s *CoreVolumeGroupBackupsDataSourceCrud) Get() error {
 		request.VolumeGroupId = &tmp
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_core.ListVolumeGroupBackupsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_core.ListVolumeGroupBackupsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListVolumeGroupBackups(context.Background(), request)
+	response, err := s.Client.ListVolumeGroupBackups(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -82,8 +109,8 @@ func (s *CoreVolumeGroupBackupsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListVolumeGroupBackups(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListVolumeGroupBackups(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -163,6 +190,8 @@ func (s *CoreVolumeGroupBackupsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreVolumeGroupBackupsDataSource().Schema["volume_group_backups"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("volume_group_backups", resources); err != nil {
 		return err
 	}
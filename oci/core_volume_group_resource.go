@@ -143,7 +143,7 @@ func createCoreVolumeGroup(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreVolumeGroup(d *schema.ResourceData, m interface{}) error {
@@ -151,7 +151,7 @@ func readCoreVolumeGroup(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreVolumeGroup(d *schema.ResourceData, m interface{}) error {
@@ -159,7 +159,7 @@ func updateCoreVolumeGroup(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCoreVolumeGroup(d *schema.ResourceData, m interface{}) error {
@@ -168,7 +168,7 @@ func deleteCoreVolumeGroup(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).blockstorageClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreVolumeGroupResourceCrud struct {
@@ -206,7 +206,7 @@ func (s *CoreVolumeGroupResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreVolumeGroupResourceCrud) Create() error {
+func (s *CoreVolumeGroupResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateVolumeGroupRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -249,7 +249,7 @@ func (s *CoreVolumeGroupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVolumeGroup(context.Background(), request)
+	response, err := s.Client.CreateVolumeGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -258,7 +258,7 @@ func (s *CoreVolumeGroupResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreVolumeGroupResourceCrud) Get() error {
+func (s *CoreVolumeGroupResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVolumeGroupRequest{}
 
 	tmp := s.D.Id()
@@ -266,7 +266,7 @@ func (s *CoreVolumeGroupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVolumeGroup(context.Background(), request)
+	response, err := s.Client.GetVolumeGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -275,7 +275,7 @@ func (s *CoreVolumeGroupResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreVolumeGroupResourceCrud) Update() error {
+func (s *CoreVolumeGroupResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateVolumeGroupRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -312,7 +312,7 @@ func (s *CoreVolumeGroupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVolumeGroup(context.Background(), request)
+	response, err := s.Client.UpdateVolumeGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -321,7 +321,7 @@ func (s *CoreVolumeGroupResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreVolumeGroupResourceCrud) Delete() error {
+func (s *CoreVolumeGroupResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteVolumeGroupRequest{}
 
 	tmp := s.D.Id()
@@ -329,7 +329,7 @@ func (s *CoreVolumeGroupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVolumeGroup(context.Background(), request)
+	_, err := s.Client.DeleteVolumeGroup(ctx, request)
 	return err
 }
 
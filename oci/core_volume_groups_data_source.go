@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
@@ -14,7 +15,8 @@ func CoreVolumeGroupsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreVolumeGroups,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"availability_domain": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -36,6 +38,22 @@ func CoreVolumeGroupsDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(CoreVolumeGroupResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListVolumeGroupsSortByTimecreated),
+					string(oci_core.ListVolumeGroupsSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListVolumeGroupsSortOrderAsc),
+					string(oci_core.ListVolumeGroupsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -45,7 +63,7 @@ func readCoreVolumeGroups(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreVolumeGroupsDataSourceCrud struct {
@@ -58,7 +76,7 @@ func (s *CoreVolumeGroupsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVolumeGroupsDataSourceCrud) Get() error {
+func (s *CoreVolumeGroupsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListVolumeGroupsRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -80,9 +98,18 @@ func (s *CoreVolumeGroupsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_core.VolumeGroupLifecycleStateEnum(state.(string))
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_core.ListVolumeGroupsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_core.ListVolumeGroupsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListVolumeGroups(context.Background(), request)
+	response, err := s.Client.ListVolumeGroups(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -90,8 +117,8 @@ func (s *CoreVolumeGroupsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListVolumeGroups(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListVolumeGroups(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -171,6 +198,8 @@ func (s *CoreVolumeGroupsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreVolumeGroupsDataSource().Schema["volume_groups"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("volume_groups", resources); err != nil {
 		return err
 	}
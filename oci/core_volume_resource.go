@@ -150,7 +150,7 @@ func createCoreVolume(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readCoreVolume(d *schema.ResourceData, m interface{}) error {
@@ -158,7 +158,7 @@ func readCoreVolume(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateCoreVolume(d *schema.ResourceData, m interface{}) error {
@@ -166,7 +166,7 @@ func updateCoreVolume(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteCoreVolume(d *schema.ResourceData, m interface{}) error {
@@ -175,7 +175,7 @@ func deleteCoreVolume(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).blockstorageClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type CoreVolumeResourceCrud struct {
@@ -226,7 +226,7 @@ func (s *CoreVolumeResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *CoreVolumeResourceCrud) Create() error {
+func (s *CoreVolumeResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateVolumeRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -307,7 +307,7 @@ func (s *CoreVolumeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVolume(context.Background(), request)
+	response, err := s.Client.CreateVolume(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -316,7 +316,7 @@ func (s *CoreVolumeResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreVolumeResourceCrud) Get() error {
+func (s *CoreVolumeResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVolumeRequest{}
 
 	tmp := s.D.Id()
@@ -324,7 +324,7 @@ func (s *CoreVolumeResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVolume(context.Background(), request)
+	response, err := s.Client.GetVolume(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -333,7 +333,7 @@ func (s *CoreVolumeResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreVolumeResourceCrud) Update() error {
+func (s *CoreVolumeResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateVolumeRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -364,7 +364,7 @@ func (s *CoreVolumeResourceCrud) Update() error {
 
 		keyUpdateRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-		_, err := s.Client.UpdateVolumeKmsKey(context.Background(), keyUpdateRequest)
+		_, err := s.Client.UpdateVolumeKmsKey(ctx, keyUpdateRequest)
 		if err != nil {
 			return err
 		}
@@ -384,7 +384,7 @@ func (s *CoreVolumeResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVolume(context.Background(), request)
+	response, err := s.Client.UpdateVolume(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -393,7 +393,7 @@ func (s *CoreVolumeResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreVolumeResourceCrud) Delete() error {
+func (s *CoreVolumeResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteVolumeRequest{}
 
 	tmp := s.D.Id()
@@ -401,7 +401,7 @@ func (s *CoreVolumeResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVolume(context.Background(), request)
+	_, err := s.Client.DeleteVolume(ctx, request)
 	return err
 }
 
@@ -461,7 +461,7 @@ func (s *CoreVolumeResourceCrud) SetData() error {
 	}
 
 	// Add backup policy id from the other API
-	backupPolicyId, err := getBackupPolicyId(s.Res.Id, s.Client)
+	backupPolicyId, err := getBackupPolicyId(s.OperationContext(), s.Res.Id, s.Client)
 	if err != nil {
 		log.Printf("[ERROR] Received an error when fetching backup policy id %v", err)
 	} else if backupPolicyId != nil {
@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
@@ -14,7 +15,8 @@ func CoreVolumesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readCoreVolumes,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"availability_domain": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -50,6 +52,22 @@ func CoreVolumesDataSource() *schema.Resource {
 				Optional:   true,
 				Deprecated: FieldDeprecated("page"),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListVolumesSortByTimecreated),
+					string(oci_core.ListVolumesSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.ListVolumesSortOrderAsc),
+					string(oci_core.ListVolumesSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -59,7 +77,7 @@ func readCoreVolumes(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type CoreVolumesDataSourceCrud struct {
@@ -72,7 +90,7 @@ func (s *CoreVolumesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVolumesDataSourceCrud) Get() error {
+func (s *CoreVolumesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListVolumesRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -109,9 +127,18 @@ func (s *CoreVolumesDataSourceCrud) Get() error {
 		request.Page = &tmp
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_core.ListVolumesSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_core.ListVolumesSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListVolumes(context.Background(), request)
+	response, err := s.Client.ListVolumes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -119,8 +146,8 @@ func (s *CoreVolumesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListVolumes(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListVolumes(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -206,6 +233,8 @@ func (s *CoreVolumesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, CoreVolumesDataSource().Schema["volumes"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("volumes", resources); err != nil {
 		return err
 	}
@@ -0,0 +1,200 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+)
+
+const (
+	createRetryAttrName                  = "create_retry"
+	createRetryMaxAttemptsAttrName       = "max_attempts"
+	createRetryRetriableFailuresAttrName = "retriable_failures"
+	createRetryIntervalSecondsAttrName   = "interval_seconds"
+	defaultCreateRetryMaxAttempts        = 3
+	defaultCreateRetryIntervalSeconds    = 60
+	createRetryOutOfCapacityFailure      = "out_of_capacity"
+	createRetryInternalErrorFailure      = "internal_error"
+	createRetryFailedStateFailure        = "failed"
+)
+
+// Messages with which services report that there is not enough capacity to create a resource
+var outOfCapacityMessages = []string{
+	"out of capacity",
+	"out of host capacity",
+	"outofcapacity",
+	"insufficient capacity",
+}
+
+// createRetryPolicy is the behavior configured through the create_retry block of a resource, for creations that
+// fail after the create request was accepted or that fail for lack of capacity
+type createRetryPolicy struct {
+	maxAttempts       int
+	interval          time.Duration
+	retriableFailures map[string]bool
+}
+
+// createRetrySchema is the optional create_retry block of resources whose creation can be retried. Since it only
+// applies to creation, changing it on an existing resource does not plan an update.
+func createRetrySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeList,
+		Optional:         true,
+		MaxItems:         1,
+		MinItems:         1,
+		DiffSuppressFunc: suppressOnExistingResource,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				// Optional
+				createRetryMaxAttemptsAttrName: {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      defaultCreateRetryMaxAttempts,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				createRetryIntervalSecondsAttrName: {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      defaultCreateRetryIntervalSeconds,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				createRetryRetriableFailuresAttrName: {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Set:      schema.HashString,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							createRetryOutOfCapacityFailure,
+							createRetryInternalErrorFailure,
+							createRetryFailedStateFailure,
+						}, false),
+					},
+				},
+			},
+		},
+	}
+}
+
+// suppressOnExistingResource suppresses the diff of attributes that only take effect when the resource is created
+func suppressOnExistingResource(key string, old string, new string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
+
+// getCreateRetryPolicy returns the create_retry behavior configured for the resource, or nil if creation should not
+// be retried
+func getCreateRetryPolicy(d *schema.ResourceData) *createRetryPolicy {
+	if d == nil {
+		return nil
+	}
+	raw, ok := d.GetOk(createRetryAttrName)
+	if !ok {
+		return nil
+	}
+	blocks := raw.([]interface{})
+	if len(blocks) == 0 || blocks[0] == nil {
+		return nil
+	}
+	block := blocks[0].(map[string]interface{})
+
+	policy := &createRetryPolicy{
+		maxAttempts:       defaultCreateRetryMaxAttempts,
+		interval:          time.Duration(defaultCreateRetryIntervalSeconds) * time.Second,
+		retriableFailures: map[string]bool{},
+	}
+	if maxAttempts, ok := block[createRetryMaxAttemptsAttrName].(int); ok {
+		policy.maxAttempts = maxAttempts
+	}
+	if intervalSeconds, ok := block[createRetryIntervalSecondsAttrName].(int); ok {
+		policy.interval = time.Duration(intervalSeconds) * time.Second
+	}
+	if failures, ok := block[createRetryRetriableFailuresAttrName].(*schema.Set); ok {
+		for _, failure := range failures.List() {
+			policy.retriableFailures[failure.(string)] = true
+		}
+	}
+	if len(policy.retriableFailures) == 0 {
+		policy.retriableFailures[createRetryOutOfCapacityFailure] = true
+	}
+
+	return policy
+}
+
+// shouldRetry returns whether another attempt should be made to create the resource after the given attempt failed.
+// failedState is set when the resource was created but ended up in the FAILED state; details are the lifecycle
+// details the service reported for it, if any.
+func (p *createRetryPolicy) shouldRetry(ctx context.Context, attempt int, err error, failedState bool, details string) bool {
+	if p == nil || attempt >= p.maxAttempts || ctx.Err() != nil {
+		return false
+	}
+
+	if p.retriableFailures[createRetryOutOfCapacityFailure] && isOutOfCapacityFailure(err, details) {
+		return true
+	}
+	if p.retriableFailures[createRetryInternalErrorFailure] {
+		if failure, ok := oci_common.IsServiceError(err); ok && failure.GetHTTPStatusCode() == 500 {
+			return true
+		}
+	}
+	if p.retriableFailures[createRetryFailedStateFailure] {
+		if _, ok := err.(*WorkRequestError); ok || failedState {
+			return true
+		}
+	}
+	return false
+}
+
+func isOutOfCapacityFailure(err error, details string) bool {
+	message := strings.ToLower(err.Error() + " " + details)
+	for _, outOfCapacityMessage := range outOfCapacityMessages {
+		if strings.Contains(message, outOfCapacityMessage) {
+			return true
+		}
+	}
+	return false
+}
+
+// wait pauses between two attempts, unless Terraform stops the provider in the meantime
+func (p *createRetryPolicy) wait(ctx context.Context) error {
+	if p.interval <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(p.interval)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// canRetryCreate returns whether the resource can be created again after a failed attempt. The resource left behind by
+// the failed attempt, if any, must be deleted first.
+func canRetryCreate(d *schema.ResourceData, sync ResourceCreator) bool {
+	if d.Id() == "" {
+		return true
+	}
+	_, ok := sync.(ResourceDeleter)
+	return ok
+}
+
+// deleteFailedResource removes the resource left behind by a failed create attempt, so that it can be created again
+func deleteFailedResource(ctx context.Context, d *schema.ResourceData, sync ResourceCreator) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Deleting %s left behind by a failed create attempt", d.Id())
+	return deleteResource(ctx, d, sync.(ResourceDeleter))
+}
@@ -0,0 +1,234 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+type testCreateRetryResourceState struct {
+	Id               *string
+	LifecycleState   string
+	LifecycleDetails string
+}
+
+type testCreateRetryResource struct {
+	BaseCrud
+	Res *testCreateRetryResourceState
+
+	// The outcome of each create attempt, either an error returned by Create or the state the resource ends up in
+	createErrors []error
+	createStates []string
+	creates      int
+	deletes      int
+}
+
+func (s *testCreateRetryResource) ID() string {
+	return *s.Res.Id
+}
+
+func (s *testCreateRetryResource) Create(ctx context.Context) error {
+	attempt := s.creates
+	s.creates++
+	if attempt < len(s.createErrors) && s.createErrors[attempt] != nil {
+		return s.createErrors[attempt]
+	}
+
+	id := fmt.Sprintf("ocid1.dbsystem.oc1..attempt%d", s.creates)
+	s.Res = &testCreateRetryResourceState{Id: &id, LifecycleState: "AVAILABLE"}
+	if attempt < len(s.createStates) {
+		s.Res.LifecycleState = s.createStates[attempt]
+	}
+	if s.Res.LifecycleState == FAILED {
+		s.Res.LifecycleDetails = "Out of host capacity."
+	}
+	return nil
+}
+
+func (s *testCreateRetryResource) Get(ctx context.Context) error {
+	return nil
+}
+
+func (s *testCreateRetryResource) Delete(ctx context.Context) error {
+	s.deletes++
+	s.Res.LifecycleState = "TERMINATED"
+	return nil
+}
+
+func (s *testCreateRetryResource) SetData() error {
+	s.D.Set("state", s.Res.LifecycleState)
+	s.D.Set("lifecycle_details", s.Res.LifecycleDetails)
+	return nil
+}
+
+func (s *testCreateRetryResource) CreatedPending() []string {
+	return []string{"PROVISIONING"}
+}
+
+func (s *testCreateRetryResource) CreatedTarget() []string {
+	return []string{"AVAILABLE"}
+}
+
+func (s *testCreateRetryResource) DeletedPending() []string {
+	return []string{"TERMINATING"}
+}
+
+func (s *testCreateRetryResource) DeletedTarget() []string {
+	return []string{"TERMINATED"}
+}
+
+func newTestCreateRetryResource(t *testing.T, createRetry []interface{}) *testCreateRetryResource {
+	resourceSchema := map[string]*schema.Schema{
+		"create_retry":      createRetrySchema(),
+		"lifecycle_details": {Type: schema.TypeString, Computed: true},
+		"shape":             {Type: schema.TypeString, Optional: true},
+		"state":             {Type: schema.TypeString, Computed: true},
+	}
+	raw := map[string]interface{}{}
+	if createRetry != nil {
+		raw["create_retry"] = createRetry
+	}
+
+	sync := &testCreateRetryResource{}
+	sync.D = schema.TestResourceDataRaw(t, resourceSchema, raw)
+	return sync
+}
+
+func TestCreateResource_retryFailedState(t *testing.T) {
+	sync := newTestCreateRetryResource(t, []interface{}{
+		map[string]interface{}{"max_attempts": 3, "interval_seconds": 0},
+	})
+	sync.createStates = []string{FAILED, "AVAILABLE"}
+
+	assert.NoError(t, CreateResource(context.Background(), sync.D, sync))
+	assert.Equal(t, 2, sync.creates)
+	assert.Equal(t, 1, sync.deletes, "the failed resource should be deleted before creating it again")
+	assert.Equal(t, "ocid1.dbsystem.oc1..attempt2", sync.D.Id())
+	assert.Equal(t, "AVAILABLE", sync.D.Get("state"))
+}
+
+func TestCreateResource_retryCreateError(t *testing.T) {
+	sync := newTestCreateRetryResource(t, []interface{}{
+		map[string]interface{}{"max_attempts": 2, "interval_seconds": 0},
+	})
+	outOfCapacity := fmt.Errorf("Service error:InternalError. Out of host capacity.. http status code: 500")
+	sync.createErrors = []error{outOfCapacity, outOfCapacity}
+
+	assert.Equal(t, outOfCapacity, CreateResource(context.Background(), sync.D, sync))
+	assert.Equal(t, 2, sync.creates, "creation should not be attempted more than max_attempts times")
+	assert.Equal(t, 0, sync.deletes)
+	assert.Empty(t, sync.D.Id())
+}
+
+func TestCreateResource_retryNotRetriable(t *testing.T) {
+	sync := newTestCreateRetryResource(t, []interface{}{
+		map[string]interface{}{"max_attempts": 3, "interval_seconds": 0},
+	})
+	limitExceeded := fmt.Errorf("Service error:LimitExceeded. The service limit has been exceeded. http status code: 400")
+	sync.createErrors = []error{limitExceeded}
+
+	assert.Equal(t, limitExceeded, CreateResource(context.Background(), sync.D, sync))
+	assert.Equal(t, 1, sync.creates)
+}
+
+func TestCreateResource_retryDisabled(t *testing.T) {
+	sync := newTestCreateRetryResource(t, nil)
+	sync.createStates = []string{FAILED}
+
+	assert.Error(t, CreateResource(context.Background(), sync.D, sync))
+	assert.Equal(t, 1, sync.creates)
+	assert.Equal(t, 0, sync.deletes)
+	assert.Empty(t, sync.D.Id(), "the failed resource should be removed from the state")
+}
+
+func TestCreateDBSystemResource_failedStateKept(t *testing.T) {
+	// Without create_retry, a failed DB system stays in the state so that the next apply destroys it
+	sync := newTestCreateRetryResource(t, nil)
+	sync.D.Set("shape", "Exadata.Quarter1.84")
+	sync.createStates = []string{FAILED}
+
+	assert.Error(t, CreateDBSystemResource(context.Background(), sync.D, sync))
+	assert.Equal(t, 1, sync.creates)
+	assert.Equal(t, 0, sync.deletes)
+	assert.Equal(t, "ocid1.dbsystem.oc1..attempt1", sync.D.Id())
+	assert.Equal(t, FAILED, sync.D.Get("state"))
+
+	// As does the last attempt once create_retry runs out of attempts
+	sync = newTestCreateRetryResource(t, []interface{}{
+		map[string]interface{}{"max_attempts": 2, "interval_seconds": 0},
+	})
+	sync.D.Set("shape", "BM.DenseIO1.36")
+	sync.createStates = []string{FAILED, FAILED}
+
+	assert.Error(t, CreateDBSystemResource(context.Background(), sync.D, sync))
+	assert.Equal(t, 2, sync.creates)
+	assert.Equal(t, 1, sync.deletes)
+	assert.Equal(t, "ocid1.dbsystem.oc1..attempt2", sync.D.Id())
+}
+
+func TestCreateRetryPolicy_shouldRetry(t *testing.T) {
+	policy := &createRetryPolicy{
+		maxAttempts:       3,
+		retriableFailures: map[string]bool{createRetryFailedStateFailure: true},
+	}
+	failed := fmt.Errorf("Resource creation failed, state FAILED")
+
+	assert.True(t, policy.shouldRetry(context.Background(), 1, failed, true, ""))
+	assert.True(t, policy.shouldRetry(context.Background(), 2, &WorkRequestError{WorkRequest: &WorkRequestStatus{}}, false, ""))
+	assert.False(t, policy.shouldRetry(context.Background(), 3, failed, true, ""))
+	assert.False(t, policy.shouldRetry(context.Background(), 1, fmt.Errorf("Out of host capacity."), false, ""))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.False(t, policy.shouldRetry(ctx, 1, failed, true, ""), "creation should not be retried once Terraform stops the provider")
+
+	var disabled *createRetryPolicy
+	assert.False(t, disabled.shouldRetry(context.Background(), 1, failed, true, ""))
+}
+
+func TestCreateRetrySchema_diffSuppressedOnExistingResources(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"create_retry": createRetrySchema(),
+			"display_name": {Type: schema.TypeString, Optional: true},
+		},
+	}
+	newConfig := func(maxAttempts int) *terraform.ResourceConfig {
+		rawConfig, err := config.NewRawConfig(map[string]interface{}{
+			"display_name": "name",
+			"create_retry": []interface{}{map[string]interface{}{"max_attempts": maxAttempts}},
+		})
+		assert.NoError(t, err)
+		return terraform.NewResourceConfig(rawConfig)
+	}
+
+	// create_retry is planned along with the creation of the resource
+	diff, err := resource.Diff(nil, newConfig(3), nil)
+	assert.NoError(t, err)
+	if assert.NotNil(t, diff) {
+		assert.Contains(t, diff.Attributes, "create_retry.0.max_attempts")
+	}
+
+	// But changing it afterwards does not plan an update that would do nothing
+	state := &terraform.InstanceState{
+		ID: "ocid1.instance.oc1..instance",
+		Attributes: map[string]string{
+			"id":                                  "ocid1.instance.oc1..instance",
+			"display_name":                        "name",
+			"create_retry.#":                      "1",
+			"create_retry.0.max_attempts":         "3",
+			"create_retry.0.interval_seconds":     "60",
+			"create_retry.0.retriable_failures.#": "0",
+		},
+	}
+	diff, err = resource.Diff(state, newConfig(5), nil)
+	assert.NoError(t, err)
+	assert.Nil(t, diff)
+}
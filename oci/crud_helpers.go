@@ -42,12 +42,31 @@ const (
 type BaseCrud struct {
 	D     *schema.ResourceData
 	Mutex *sync.Mutex
+	ctx   context.Context
 }
 
 func (s *BaseCrud) VoidState() {
 	s.D.SetId("")
 }
 
+func (s *BaseCrud) SetOperationContext(ctx context.Context) {
+	s.ctx = ctx
+}
+
+// OperationContext returns the context of the operation in progress, to be used by the service calls of SetData()
+func (s *BaseCrud) OperationContext() context.Context {
+	if s.ctx == nil {
+		return context.Background()
+	}
+	return s.ctx
+}
+
+func setOperationContext(ctx context.Context, sync interface{}) {
+	if holder, ok := sync.(OperationContextHolder); ok {
+		holder.SetOperationContext(ctx)
+	}
+}
+
 // Default implementation, used in conjunction with State()
 func (s *BaseCrud) setState(sync StatefulResource) error {
 	// Pseudo code:
@@ -146,13 +165,13 @@ func LoadBalancerResourceID(res interface{}, workReq *oci_load_balancer.WorkRequ
 	return nil, false
 }
 
-func LoadBalancerResourceGet(client *oci_load_balancer.LoadBalancerClient, d *schema.ResourceData, wr *oci_load_balancer.WorkRequest, retryPolicy *oci_common.RetryPolicy) (id string, stillWorking bool, err error) {
+func LoadBalancerResourceGet(ctx context.Context, client *oci_load_balancer.LoadBalancerClient, d *schema.ResourceData, wr *oci_load_balancer.WorkRequest, retryPolicy *oci_common.RetryPolicy) (id string, stillWorking bool, err error) {
 	// NOTE: if the id is for a work request, refresh its state and loadBalancerID.
 	if wr != nil && wr.Id != nil {
 		getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 		getWorkRequestRequest.WorkRequestId = wr.Id
 		getWorkRequestRequest.RequestMetadata.RetryPolicy = retryPolicy
-		updatedWorkRes, err := client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+		updatedWorkRes, err := client.GetWorkRequest(ctx, getWorkRequestRequest)
 		if err != nil {
 			return "", false, err
 		}
@@ -171,45 +190,7 @@ func LoadBalancerResourceGet(client *oci_load_balancer.LoadBalancerClient, d *sc
 	return id, false, nil
 }
 
-func LoadBalancerWaitForWorkRequest(client *oci_load_balancer.LoadBalancerClient, d *schema.ResourceData, wr *oci_load_balancer.WorkRequest, retryPolicy *oci_common.RetryPolicy) error {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{
-			string(oci_load_balancer.WorkRequestLifecycleStateInProgress),
-			string(oci_load_balancer.WorkRequestLifecycleStateAccepted),
-		},
-		Target: []string{
-			string(oci_load_balancer.WorkRequestLifecycleStateSucceeded),
-			string(oci_load_balancer.WorkRequestLifecycleStateFailed),
-		},
-		Refresh: func() (interface{}, string, error) {
-			getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
-			getWorkRequestRequest.WorkRequestId = wr.Id
-			getWorkRequestRequest.RequestMetadata.RetryPolicy = retryPolicy
-			workRequestResponse, err := client.GetWorkRequest(context.Background(), getWorkRequestRequest)
-			wr = &workRequestResponse.WorkRequest
-			return wr, string(wr.LifecycleState), err
-		},
-		Timeout: d.Timeout(schema.TimeoutCreate),
-	}
-
-	if _, e := stateConf.WaitForState(); e != nil {
-		return e
-	}
-
-	if wr.LifecycleState == oci_load_balancer.WorkRequestLifecycleStateFailed {
-		return fmt.Errorf("WorkRequest FAILED: %+v", wr.ErrorDetails)
-	}
-	return nil
-}
-
-func CreateDBSystemResource(d *schema.ResourceData, sync ResourceCreator) error {
-	if e := sync.Create(); e != nil {
-		return e
-	}
-
-	// ID is required for state refresh
-	d.SetId(sync.ID())
-
+func CreateDBSystemResource(ctx context.Context, d *schema.ResourceData, sync ResourceCreator) error {
 	var timeout time.Duration
 	shape := d.Get("shape")
 	timeout = d.Timeout(schema.TimeoutCreate)
@@ -220,25 +201,13 @@ func CreateDBSystemResource(d *schema.ResourceData, sync ResourceCreator) error
 			timeout = TwoHours
 		}
 	}
-	if stateful, ok := sync.(StatefullyCreatedResource); ok {
-		if e := waitForStateRefresh(stateful, timeout, "creation", stateful.CreatedPending(), stateful.CreatedTarget()); e != nil {
-			return e
-		}
-	}
 
-	d.SetId(sync.ID())
-	if e := sync.SetData(); e != nil {
-		return e
-	}
-
-	if ew, waitOK := sync.(ExtraWaitPostCreateDelete); waitOK {
-		time.Sleep(ew.ExtraWaitPostCreateDelete())
-	}
-
-	return nil
+	// A failed DB system is kept in the state when it is not created again, so that it is tainted and destroyed by the
+	// next apply rather than left in the tenancy
+	return createResource(ctx, d, sync, timeout, false)
 }
 
-func CreateResource(d *schema.ResourceData, sync ResourceCreator) error {
+func CreateResource(ctx context.Context, d *schema.ResourceData, sync ResourceCreator) error {
 	if synchronizedResource, ok := sync.(SynchronizedResource); ok {
 		if mutex := synchronizedResource.GetMutex(); mutex != nil {
 			mutex.Lock()
@@ -246,22 +215,47 @@ func CreateResource(d *schema.ResourceData, sync ResourceCreator) error {
 		}
 	}
 
-	if e := sync.Create(); e != nil {
-		return e
-	}
+	return createResource(ctx, d, sync, d.Timeout(schema.TimeoutCreate), true)
+}
 
-	// ID is required for state refresh
-	d.SetId(sync.ID())
+// createResource requests a Create() and waits for the resource to be created. If the resource has a create_retry
+// block, failed attempts that match one of its retriable failures are deleted and created again. A resource that ends
+// up FAILED without being created again is removed from the state when voidFailedState is set.
+func createResource(ctx context.Context, d *schema.ResourceData, sync ResourceCreator, timeout time.Duration, voidFailedState bool) error {
+	setOperationContext(ctx, sync)
+	retryPolicy := getCreateRetryPolicy(d)
+	for attempt := 1; ; attempt++ {
+		failedState, e := createResourceAttempt(ctx, d, sync, timeout)
+		if e == nil {
+			break
+		}
 
-	if stateful, ok := sync.(StatefullyCreatedResource); ok {
-		if e := waitForStateRefresh(stateful, d.Timeout(schema.TimeoutCreate), "creation", stateful.CreatedPending(), stateful.CreatedTarget()); e != nil {
-			if stateful.State() == FAILED {
+		var details string
+		if failedState {
+			// The lifecycle details of the failed resource usually tell why it failed
+			if setDataErr := sync.SetData(); setDataErr != nil {
+				log.Printf("[DEBUG] unable to set the data of the failed resource: %v", setDataErr)
+			}
+			if lifecycleDetails, ok := d.Get("lifecycle_details").(string); ok {
+				details = lifecycleDetails
+			}
+		}
+
+		if !retryPolicy.shouldRetry(ctx, attempt, e, failedState, details) || !canRetryCreate(d, sync) {
+			if failedState && voidFailedState {
 				// Remove resource from state if asynchronous work request has failed so that it is recreated on next apply
-				// TODO: automatic retry on WorkRequestFailed
 				sync.VoidState()
 			}
 			return e
 		}
+
+		log.Printf("[WARN] Attempt %d of %d to create the resource failed, creating it again: %v", attempt, retryPolicy.maxAttempts, e)
+		if deleteErr := deleteFailedResource(ctx, d, sync); deleteErr != nil {
+			return fmt.Errorf("%v\nThe resource could not be deleted before creating it again: %v", e, deleteErr)
+		}
+		if waitErr := retryPolicy.wait(ctx); waitErr != nil {
+			return abandonedOperationError(ctx, "creation", "", waitErr)
+		}
 	}
 
 	d.SetId(sync.ID())
@@ -276,11 +270,35 @@ func CreateResource(d *schema.ResourceData, sync ResourceCreator) error {
 	return nil
 }
 
-func ReadResource(sync ResourceReader) error {
-	if e := sync.Get(); e != nil {
+// createResourceAttempt makes a single attempt to create the resource. failedState is set if the resource was created
+// but ended up in the FAILED state.
+func createResourceAttempt(ctx context.Context, d *schema.ResourceData, sync ResourceCreator, timeout time.Duration) (failedState bool, err error) {
+	if e := sync.Create(ctx); e != nil {
+		return false, abandonedOperationError(ctx, "creation", d.Id(), e)
+	}
+
+	if e := waitForResourceWorkRequest(ctx, sync, timeout, createOperation); e != nil {
+		return false, abandonedOperationError(ctx, "creation", d.Id(), e)
+	}
+
+	// ID is required for state refresh
+	d.SetId(sync.ID())
+
+	if stateful, ok := sync.(StatefullyCreatedResource); ok {
+		if e := waitForStateRefresh(ctx, stateful, timeout, "creation", stateful.CreatedPending(), stateful.CreatedTarget()); e != nil {
+			return stateful.State() == FAILED, e
+		}
+	}
+
+	return false, nil
+}
+
+func ReadResource(ctx context.Context, sync ResourceReader) error {
+	setOperationContext(ctx, sync)
+	if e := sync.Get(ctx); e != nil {
 		log.Printf("ERROR IN GET: %v\n", e.Error())
 		handleMissingResourceError(sync, &e)
-		return e
+		return abandonedOperationError(ctx, "read", "", e)
 	}
 
 	if e := sync.SetData(); e != nil {
@@ -300,7 +318,7 @@ func ReadResource(sync ResourceReader) error {
 	return nil
 }
 
-func UpdateResource(d *schema.ResourceData, sync ResourceUpdater) error {
+func UpdateResource(ctx context.Context, d *schema.ResourceData, sync ResourceUpdater) error {
 	if synchronizedResource, ok := sync.(SynchronizedResource); ok {
 		if mutex := synchronizedResource.GetMutex(); mutex != nil {
 			mutex.Lock()
@@ -308,14 +326,18 @@ func UpdateResource(d *schema.ResourceData, sync ResourceUpdater) error {
 		}
 	}
 
+	setOperationContext(ctx, sync)
 	d.Partial(true)
-	if e := sync.Update(); e != nil {
-		return e
+	if e := sync.Update(ctx); e != nil {
+		return abandonedOperationError(ctx, "update", d.Id(), e)
+	}
+	if e := waitForResourceWorkRequest(ctx, sync, d.Timeout(schema.TimeoutUpdate), updateOperation); e != nil {
+		return abandonedOperationError(ctx, "update", d.Id(), e)
 	}
 	d.Partial(false)
 
 	if stateful, ok := sync.(StatefullyUpdatedResource); ok {
-		if e := waitForStateRefresh(stateful, d.Timeout(schema.TimeoutUpdate), "update", stateful.UpdatedPending(), stateful.UpdatedTarget()); e != nil {
+		if e := waitForStateRefresh(ctx, stateful, d.Timeout(schema.TimeoutUpdate), "update", stateful.UpdatedPending(), stateful.UpdatedTarget()); e != nil {
 			return e
 		}
 	}
@@ -331,7 +353,7 @@ func UpdateResource(d *schema.ResourceData, sync ResourceUpdater) error {
 // statefully (not immediately), poll State to ensure:
 // () -> Pending -> Deleted.
 // Finally, sets the ResourceData state to empty.
-func DeleteResource(d *schema.ResourceData, sync ResourceDeleter) error {
+func DeleteResource(ctx context.Context, d *schema.ResourceData, sync ResourceDeleter) error {
 	if synchronizedResource, ok := sync.(SynchronizedResource); ok {
 		if mutex := synchronizedResource.GetMutex(); mutex != nil {
 			mutex.Lock()
@@ -339,13 +361,22 @@ func DeleteResource(d *schema.ResourceData, sync ResourceDeleter) error {
 		}
 	}
 
-	if e := sync.Delete(); e != nil {
+	return deleteResource(ctx, d, sync)
+}
+
+func deleteResource(ctx context.Context, d *schema.ResourceData, sync ResourceDeleter) error {
+	setOperationContext(ctx, sync)
+	if e := sync.Delete(ctx); e != nil {
 		handleMissingResourceError(sync, &e)
-		return e
+		return abandonedOperationError(ctx, "deletion", d.Id(), e)
+	}
+
+	if e := waitForResourceWorkRequest(ctx, sync, d.Timeout(schema.TimeoutDelete), deleteOperation); e != nil {
+		return abandonedOperationError(ctx, "deletion", d.Id(), e)
 	}
 
 	if stateful, ok := sync.(StatefullyDeletedResource); ok {
-		if e := waitForStateRefresh(stateful, d.Timeout(schema.TimeoutDelete), "deletion", stateful.DeletedPending(), stateful.DeletedTarget()); e != nil {
+		if e := waitForStateRefresh(ctx, stateful, d.Timeout(schema.TimeoutDelete), "deletion", stateful.DeletedPending(), stateful.DeletedTarget()); e != nil {
 			handleMissingResourceError(sync, &e)
 			return e
 		}
@@ -360,9 +391,13 @@ func DeleteResource(d *schema.ResourceData, sync ResourceDeleter) error {
 	return nil
 }
 
-func stateRefreshFunc(sync StatefulResource) resource.StateRefreshFunc {
+func stateRefreshFunc(ctx context.Context, sync StatefulResource) resource.StateRefreshFunc {
 	return func() (res interface{}, s string, e error) {
-		if e = sync.Get(); e != nil {
+		// Stop polling right away once Terraform stops the provider
+		if e = ctx.Err(); e != nil {
+			return nil, "", e
+		}
+		if e = sync.Get(ctx); e != nil {
 			return nil, "", e
 		}
 		// We don't set all the state here, because not found errors are handled elsewhere.
@@ -376,9 +411,9 @@ func stateRefreshFunc(sync StatefulResource) resource.StateRefreshFunc {
 
 // Helper function to wait for update to reach terminal state before doing another update
 // Useful in situations where more than one update is needed and prior update needs to complete
-func waitForUpdatedState(d *schema.ResourceData, sync ResourceUpdater) error {
+func waitForUpdatedState(ctx context.Context, d *schema.ResourceData, sync ResourceUpdater) error {
 	if stateful, ok := sync.(StatefullyUpdatedResource); ok {
-		if e := waitForStateRefresh(stateful, d.Timeout(schema.TimeoutUpdate), "update", stateful.UpdatedPending(), stateful.UpdatedTarget()); e != nil {
+		if e := waitForStateRefresh(ctx, stateful, d.Timeout(schema.TimeoutUpdate), "update", stateful.UpdatedPending(), stateful.UpdatedTarget()); e != nil {
 			return e
 		}
 	}
@@ -386,22 +421,22 @@ func waitForUpdatedState(d *schema.ResourceData, sync ResourceUpdater) error {
 	return nil
 }
 
-// waitForStateRefresh takes a StatefulResource, a timeout duration, a list of states to treat as Pending, and a list of states to treat as Target. It uses those to wrap resource.StateChangeConf.WaitForState(). If the resource returns a missing status, it will not be treated as an error.
+// waitForStateRefresh takes a context, a StatefulResource, a timeout duration, a list of states to treat as Pending, and a list of states to treat as Target. It uses those to wrap resource.StateChangeConf.WaitForState(). If the resource returns a missing status, it will not be treated as an error.
 //
 // sync.D.Id must be set.
-// It does not set state from that refreshed state.
-func waitForStateRefresh(sync StatefulResource, timeout time.Duration, operationName string, pending, target []string) error {
+// It does not set state from that refreshed state. Polling stops as soon as the context is cancelled.
+func waitForStateRefresh(ctx context.Context, sync StatefulResource, timeout time.Duration, operationName string, pending, target []string) error {
 	// TODO: try to move this onto sync
 	stateConf := &resource.StateChangeConf{
 		Pending: pending,
 		Target:  target,
-		Refresh: stateRefreshFunc(sync),
+		Refresh: stateRefreshFunc(ctx, sync),
 		Timeout: timeout,
 	}
 
 	if _, e := stateConf.WaitForState(); e != nil {
 		handleMissingResourceError(sync, &e)
-		return e
+		return abandonedOperationError(ctx, operationName, "", e)
 	}
 
 	if sync.State() == FAILED {
@@ -411,6 +446,21 @@ func waitForStateRefresh(sync StatefulResource, timeout time.Duration, operation
 	return nil
 }
 
+// abandonedOperationError reports an operation that was interrupted because Terraform stopped the provider,
+// e.g. on Ctrl-C during an apply. The returned error names the operation so that it can be checked by the user.
+func abandonedOperationError(ctx context.Context, operationName string, id string, err error) error {
+	if err == nil || ctx.Err() == nil {
+		return err
+	}
+
+	if id == "" {
+		log.Printf("[WARN] Abandoned %s because Terraform is stopping the provider: %v", operationName, err)
+		return fmt.Errorf("%s was abandoned because Terraform is stopping the provider", operationName)
+	}
+	log.Printf("[WARN] Abandoned %s of %s because Terraform is stopping the provider: %v", operationName, id, err)
+	return fmt.Errorf("%s of %s was abandoned because Terraform is stopping the provider", operationName, id)
+}
+
 func FilterMissingResourceError(sync ResourceVoider, err *error) {
 	if err != nil && strings.Contains((*err).Error(), "does not exist") {
 		log.Println("[DEBUG] Object does not exist, voiding resource and nullifying error")
@@ -501,13 +551,13 @@ func NormalizeBoolString(v string) (string, error) {
 // WaitForResourceCondition polls on a resource, waiting for it to reach a specified condition. This is done with exponential
 // backoff. The terminating condition is specified as a boolean function; and this will return a timeout error if the
 // specified condition isn't reached within the specified timeout period.
-func WaitForResourceCondition(s ResourceFetcher, resourceChangedFunc func() bool, timeout time.Duration) error {
+func WaitForResourceCondition(ctx context.Context, s ResourceFetcher, resourceChangedFunc func() bool, timeout time.Duration) error {
 	backoffTime := time.Second
 	startTime := time.Now()
 	endTime := startTime.Add(timeout)
 	lastAttempt := false
 	for {
-		if err := s.Get(); err != nil {
+		if err := s.Get(ctx); err != nil {
 			return err
 		}
 
@@ -528,7 +578,11 @@ func WaitForResourceCondition(s ResourceFetcher, resourceChangedFunc func() bool
 			lastAttempt = true
 		}
 
-		time.Sleep(backoffTime)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoffTime):
+		}
 	}
 
 	return nil
@@ -3,6 +3,7 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
@@ -15,7 +16,7 @@ type TestResource struct {
 	ActualGetAttempts int
 }
 
-func (t *TestResource) Get() error {
+func (t *TestResource) Get(ctx context.Context) error {
 	t.ActualGetAttempts++
 	t.GetAttempts--
 	if t.GetAttempts <= 0 {
@@ -32,7 +33,7 @@ func TestWaitForResourceCondition_basic(t *testing.T) {
 	}
 
 	// Test normal case
-	err := WaitForResourceCondition(testResource, finalStateFunc, 0)
+	err := WaitForResourceCondition(context.Background(), testResource, finalStateFunc, 0)
 	if err != nil {
 		t.Errorf("Got unexpected error '%q' from single attempt", err)
 		return
@@ -40,7 +41,7 @@ func TestWaitForResourceCondition_basic(t *testing.T) {
 
 	// Test normal case with multiple attempts
 	testResource = &TestResource{GetError: nil, GetAttempts: 3}
-	err = WaitForResourceCondition(testResource, finalStateFunc, time.Minute)
+	err = WaitForResourceCondition(context.Background(), testResource, finalStateFunc, time.Minute)
 	if err != nil {
 		t.Errorf("Got unexpected error '%q' from multiple attempts", err)
 		return
@@ -48,7 +49,7 @@ func TestWaitForResourceCondition_basic(t *testing.T) {
 
 	// Test case where Get returns error after 1 attempt
 	testResource = &TestResource{GetError: fmt.Errorf("GetError"), GetAttempts: 1}
-	err = WaitForResourceCondition(testResource, finalStateFunc, 0)
+	err = WaitForResourceCondition(context.Background(), testResource, finalStateFunc, 0)
 	if err == nil || !strings.HasPrefix(err.Error(), "GetError") {
 		t.Errorf("Got unexpected error '%q' after single attempt, expected a GetError", err)
 		return
@@ -56,7 +57,7 @@ func TestWaitForResourceCondition_basic(t *testing.T) {
 
 	// Test case where Get returns error after multiple attempts
 	testResource = &TestResource{GetError: fmt.Errorf("GetError"), GetAttempts: 3}
-	err = WaitForResourceCondition(testResource, finalStateFunc, time.Minute)
+	err = WaitForResourceCondition(context.Background(), testResource, finalStateFunc, time.Minute)
 	if err == nil || !strings.HasPrefix(err.Error(), "GetError") {
 		t.Errorf("Got unexpected error '%q' after multiple attempts, expected a GetError", err)
 		return
@@ -64,7 +65,7 @@ func TestWaitForResourceCondition_basic(t *testing.T) {
 
 	// Test timing out with zero timeout duration
 	testResource = &TestResource{GetError: nil, GetAttempts: 10}
-	err = WaitForResourceCondition(testResource, finalStateFunc, 0)
+	err = WaitForResourceCondition(context.Background(), testResource, finalStateFunc, 0)
 	if err == nil || !strings.HasPrefix(err.Error(), "Timed out") {
 		t.Errorf("Got unexpected error '%q' after a single attempt, expected a timeout error", err)
 		return
@@ -72,7 +73,7 @@ func TestWaitForResourceCondition_basic(t *testing.T) {
 
 	// Test timing out with non-zero timeout duration, also validate that we got expected number of Get attempts due to exponential backoff
 	testResource = &TestResource{GetError: nil, GetAttempts: 10}
-	err = WaitForResourceCondition(testResource, finalStateFunc, 20*time.Second)
+	err = WaitForResourceCondition(context.Background(), testResource, finalStateFunc, 20*time.Second)
 	if err == nil || !strings.HasPrefix(err.Error(), "Timed out") {
 		t.Errorf("Got unexpected error '%q' after a single attempt, expected a timeout error", err)
 		return
@@ -84,3 +85,70 @@ func TestWaitForResourceCondition_basic(t *testing.T) {
 		return
 	}
 }
+
+func TestWaitForResourceCondition_cancelled(t *testing.T) {
+	testResource := &TestResource{GetError: nil, GetAttempts: 10}
+	finalStateFunc := func() bool {
+		return testResource.GetAttempts == 0
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	// Polling should stop once the context is cancelled, instead of waiting for the timeout
+	startTime := time.Now()
+	err := WaitForResourceCondition(ctx, testResource, finalStateFunc, time.Minute)
+	if err != context.Canceled {
+		t.Errorf("Got unexpected error '%q', expected the context to be cancelled", err)
+		return
+	}
+	if elapsed := time.Since(startTime); elapsed > 5*time.Second {
+		t.Errorf("Expected polling to stop right after cancellation, but it took %v", elapsed)
+	}
+}
+
+func TestAbandonedOperationError_basic(t *testing.T) {
+	getError := fmt.Errorf("GetError")
+	if err := abandonedOperationError(context.Background(), "creation", "ocid1.vcn.oc1..fake", getError); err != getError {
+		t.Errorf("Expected errors to be returned as is when the context is not cancelled, got '%q'", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := abandonedOperationError(ctx, "creation", "ocid1.vcn.oc1..fake", nil); err != nil {
+		t.Errorf("Expected no error when the operation succeeded, got '%q'", err)
+	}
+	err := abandonedOperationError(ctx, "creation", "ocid1.vcn.oc1..fake", getError)
+	if err == nil || err.Error() != "creation of ocid1.vcn.oc1..fake was abandoned because Terraform is stopping the provider" {
+		t.Errorf("Got unexpected error '%q' for an abandoned operation", err)
+	}
+}
+
+type operationContextTestResource struct {
+	BaseCrud
+	setDataContext context.Context
+}
+
+func (s *operationContextTestResource) Get(ctx context.Context) error {
+	return nil
+}
+
+func (s *operationContextTestResource) SetData() error {
+	s.setDataContext = s.OperationContext()
+	return nil
+}
+
+func TestReadResource_operationContext(t *testing.T) {
+	type contextKey string
+	ctx := context.WithValue(context.Background(), contextKey("operation"), "read")
+
+	// SetData() makes its service calls with the context of the operation, so that they are cancelled along with it
+	testResource := &operationContextTestResource{}
+	if err := ReadResource(ctx, testResource); err != nil {
+		t.Errorf("Got unexpected error '%q'", err)
+		return
+	}
+	if testResource.setDataContext != ctx {
+		t.Errorf("Expected SetData() to get the context of the read")
+	}
+}
@@ -3,6 +3,7 @@
 package provider
 
 import (
+	"context"
 	"sync"
 	"time"
 )
@@ -13,7 +14,8 @@ import (
 type ResourceFetcher interface {
 	// Get should update the s.Resource, and is used by ReadResource() to populate s.D
 	// Get() may expect s.D.Id() to be set, but not s.Resource, or anything else in s.D
-	Get() error
+	// The context is cancelled when Terraform stops the provider and must be used for all service calls.
+	Get(ctx context.Context) error
 }
 
 // ResourceVoider may set its ResourceData state to empty.
@@ -40,7 +42,7 @@ type ResourceCreator interface {
 	ResourceDataWriter
 	// ID identifies the resource, or a work request to create the resource.
 	ID() string
-	Create() error
+	Create(ctx context.Context) error
 }
 
 // ResourceReader get BareMetal Resource and updated ResourceData
@@ -52,7 +54,7 @@ type ResourceReader interface {
 // Updates a BareMetal entity to match ResourceData
 type ResourceUpdater interface {
 	ResourceDataWriter
-	Update() error
+	Update(ctx context.Context) error
 }
 
 // Deletes a BareMetal entity
@@ -60,7 +62,7 @@ type ResourceDeleter interface {
 	ResourceVoider
 	// ID identifies the resource, or a work request to create the resource.
 	ID() string
-	Delete() error
+	Delete(ctx context.Context) error
 }
 
 // Some resources in the oracle API are removed asynchronously, so even
@@ -96,6 +98,30 @@ type StatefullyDeletedResource interface {
 	DeletedTarget() []string
 }
 
+// WorkRequestTrackedResource is implemented by resources whose Create, Update or Delete starts a work request.
+// CreateResource, UpdateResource and DeleteResource wait for the work request to finish, and report its errors
+// and log entries if it does not succeed.
+type WorkRequestTrackedResource interface {
+	WorkRequestTracker() WorkRequestTracker
+	// WorkRequestId returns the work request started by the last Create, Update or Delete, or nil if none was started
+	WorkRequestId() *string
+	// WorkRequestSucceeded is called once the work request of the given operation has succeeded, e.g. to get the
+	// resource it created
+	WorkRequestSucceeded(ctx context.Context, operation string, workRequest *WorkRequestStatus) error
+}
+
+// WorkRequestFailureHandler may clean up after a work request that did not succeed, e.g. by deleting a
+// partially created resource. The work request is nil if its status could never be retrieved.
+type WorkRequestFailureHandler interface {
+	WorkRequestFailed(ctx context.Context, operation string, workRequest *WorkRequestStatus)
+}
+
+// OperationContextHolder keeps the context of the operation in progress for the service calls made by methods that do
+// not receive it, e.g. SetData(). CreateResource, ReadResource, UpdateResource and DeleteResource set it first.
+type OperationContextHolder interface {
+	SetOperationContext(ctx context.Context)
+}
+
 // This provides a mechanism for synchronizing CRUD operations from different resources
 // that may concurrently modify the same resource. Implementing these interfaces will
 // cause the Create/Update/Delete operations to wait on the lock before starting those
@@ -63,7 +63,7 @@ func readSingularDatabaseAutonomousDataWarehouseBackup(d *schema.ResourceData, m
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DatabaseAutonomousDataWarehouseBackupDataSourceCrud struct {
@@ -76,7 +76,7 @@ func (s *DatabaseAutonomousDataWarehouseBackupDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousDataWarehouseBackupDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousDataWarehouseBackupDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetAutonomousDataWarehouseBackupRequest{}
 
 	if autonomousDataWarehouseBackupId, ok := s.D.GetOkExists("autonomous_data_warehouse_backup_id"); ok {
@@ -86,7 +86,7 @@ func (s *DatabaseAutonomousDataWarehouseBackupDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
 
-	response, err := s.Client.GetAutonomousDataWarehouseBackup(context.Background(), request)
+	response, err := s.Client.GetAutonomousDataWarehouseBackup(ctx, request)
 	if err != nil {
 		return err
 	}
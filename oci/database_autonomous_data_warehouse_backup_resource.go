@@ -72,7 +72,7 @@ func createDatabaseAutonomousDataWarehouseBackup(d *schema.ResourceData, m inter
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readDatabaseAutonomousDataWarehouseBackup(d *schema.ResourceData, m interface{}) error {
@@ -80,7 +80,7 @@ func readDatabaseAutonomousDataWarehouseBackup(d *schema.ResourceData, m interfa
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func deleteDatabaseAutonomousDataWarehouseBackup(d *schema.ResourceData, m interface{}) error {
@@ -122,7 +122,7 @@ func (s *DatabaseAutonomousDataWarehouseBackupResourceCrud) DeletedTarget() []st
 	}
 }
 
-func (s *DatabaseAutonomousDataWarehouseBackupResourceCrud) Create() error {
+func (s *DatabaseAutonomousDataWarehouseBackupResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateAutonomousDataWarehouseBackupRequest{}
 
 	if autonomousDataWarehouseId, ok := s.D.GetOkExists("autonomous_data_warehouse_id"); ok {
@@ -137,7 +137,7 @@ func (s *DatabaseAutonomousDataWarehouseBackupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateAutonomousDataWarehouseBackup(context.Background(), request)
+	response, err := s.Client.CreateAutonomousDataWarehouseBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -146,7 +146,7 @@ func (s *DatabaseAutonomousDataWarehouseBackupResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseAutonomousDataWarehouseBackupResourceCrud) Get() error {
+func (s *DatabaseAutonomousDataWarehouseBackupResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetAutonomousDataWarehouseBackupRequest{}
 
 	tmp := s.D.Id()
@@ -154,7 +154,7 @@ func (s *DatabaseAutonomousDataWarehouseBackupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetAutonomousDataWarehouseBackup(context.Background(), request)
+	response, err := s.Client.GetAutonomousDataWarehouseBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_database "github.com/oracle/oci-go-sdk/database"
 )
 
@@ -13,7 +14,8 @@ func DatabaseAutonomousDataWarehouseBackupsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readDatabaseAutonomousDataWarehouseBackups,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"autonomous_data_warehouse_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -35,6 +37,22 @@ func DatabaseAutonomousDataWarehouseBackupsDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(DatabaseAutonomousDataWarehouseBackupResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_database.ListAutonomousDataWarehouseBackupsSortByTimecreated),
+					string(oci_database.ListAutonomousDataWarehouseBackupsSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_database.ListAutonomousDataWarehouseBackupsSortOrderAsc),
+					string(oci_database.ListAutonomousDataWarehouseBackupsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -44,7 +62,7 @@ func readDatabaseAutonomousDataWarehouseBackups(d *schema.ResourceData, m interf
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DatabaseAutonomousDataWarehouseBackupsDataSourceCrud struct {
@@ -57,7 +75,7 @@ func (s *DatabaseAutonomousDataWarehouseBackupsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousDataWarehouseBackupsDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousDataWarehouseBackupsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListAutonomousDataWarehouseBackupsRequest{}
 
 	if autonomousDataWarehouseId, ok := s.D.GetOkExists("autonomous_data_warehouse_id"); ok {
@@ -79,9 +97,18 @@ func (s *DatabaseAutonomousDataWarehouseBackupsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_database.AutonomousDataWarehouseBackupSummaryLifecycleStateEnum(state.(string))
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_database.ListAutonomousDataWarehouseBackupsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_database.ListAutonomousDataWarehouseBackupsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListAutonomousDataWarehouseBackups(context.Background(), request)
+	response, err := s.Client.ListAutonomousDataWarehouseBackups(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -89,8 +116,8 @@ func (s *DatabaseAutonomousDataWarehouseBackupsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListAutonomousDataWarehouseBackups(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListAutonomousDataWarehouseBackups(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -156,6 +183,8 @@ func (s *DatabaseAutonomousDataWarehouseBackupsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, DatabaseAutonomousDataWarehouseBackupsDataSource().Schema["autonomous_data_warehouse_backups"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("autonomous_data_warehouse_backups", resources); err != nil {
 		return err
 	}
@@ -111,7 +111,7 @@ func readSingularDatabaseAutonomousDataWarehouse(d *schema.ResourceData, m inter
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DatabaseAutonomousDataWarehouseDataSourceCrud struct {
@@ -124,7 +124,7 @@ func (s *DatabaseAutonomousDataWarehouseDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousDataWarehouseDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousDataWarehouseDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetAutonomousDataWarehouseRequest{}
 
 	if autonomousDataWarehouseId, ok := s.D.GetOkExists("autonomous_data_warehouse_id"); ok {
@@ -134,7 +134,7 @@ func (s *DatabaseAutonomousDataWarehouseDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
 
-	response, err := s.Client.GetAutonomousDataWarehouse(context.Background(), request)
+	response, err := s.Client.GetAutonomousDataWarehouse(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -136,7 +136,7 @@ func createDatabaseAutonomousDataWarehouse(d *schema.ResourceData, m interface{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readDatabaseAutonomousDataWarehouse(d *schema.ResourceData, m interface{}) error {
@@ -144,7 +144,7 @@ func readDatabaseAutonomousDataWarehouse(d *schema.ResourceData, m interface{})
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateDatabaseAutonomousDataWarehouse(d *schema.ResourceData, m interface{}) error {
@@ -152,7 +152,7 @@ func updateDatabaseAutonomousDataWarehouse(d *schema.ResourceData, m interface{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteDatabaseAutonomousDataWarehouse(d *schema.ResourceData, m interface{}) error {
@@ -161,7 +161,7 @@ func deleteDatabaseAutonomousDataWarehouse(d *schema.ResourceData, m interface{}
 	sync.Client = m.(*OracleClients).databaseClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type DatabaseAutonomousDataWarehouseResourceCrud struct {
@@ -215,7 +215,7 @@ func (s *DatabaseAutonomousDataWarehouseResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *DatabaseAutonomousDataWarehouseResourceCrud) Create() error {
+func (s *DatabaseAutonomousDataWarehouseResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateAutonomousDataWarehouseRequest{}
 
 	if adminPassword, ok := s.D.GetOkExists("admin_password"); ok {
@@ -266,7 +266,7 @@ func (s *DatabaseAutonomousDataWarehouseResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateAutonomousDataWarehouse(context.Background(), request)
+	response, err := s.Client.CreateAutonomousDataWarehouse(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -275,7 +275,7 @@ func (s *DatabaseAutonomousDataWarehouseResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseAutonomousDataWarehouseResourceCrud) Get() error {
+func (s *DatabaseAutonomousDataWarehouseResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetAutonomousDataWarehouseRequest{}
 
 	tmp := s.D.Id()
@@ -283,7 +283,7 @@ func (s *DatabaseAutonomousDataWarehouseResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetAutonomousDataWarehouse(context.Background(), request)
+	response, err := s.Client.GetAutonomousDataWarehouse(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -292,7 +292,7 @@ func (s *DatabaseAutonomousDataWarehouseResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseAutonomousDataWarehouseResourceCrud) Update() error {
+func (s *DatabaseAutonomousDataWarehouseResourceCrud) Update(ctx context.Context) error {
 	request := oci_database.UpdateAutonomousDataWarehouseRequest{}
 
 	// @CODEGEN 09/2018: Cannot update the password and scale the ATP/ADW in the same request, only include changed properties in request
@@ -331,7 +331,7 @@ func (s *DatabaseAutonomousDataWarehouseResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateAutonomousDataWarehouse(context.Background(), request)
+	response, err := s.Client.UpdateAutonomousDataWarehouse(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -340,7 +340,7 @@ func (s *DatabaseAutonomousDataWarehouseResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DatabaseAutonomousDataWarehouseResourceCrud) Delete() error {
+func (s *DatabaseAutonomousDataWarehouseResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.DeleteAutonomousDataWarehouseRequest{}
 
 	tmp := s.D.Id()
@@ -348,7 +348,7 @@ func (s *DatabaseAutonomousDataWarehouseResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteAutonomousDataWarehouse(context.Background(), request)
+	_, err := s.Client.DeleteAutonomousDataWarehouse(ctx, request)
 	return err
 }
 
@@ -39,7 +39,7 @@ func readSingularDatabaseAutonomousDataWarehouseWallet(d *schema.ResourceData, m
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DatabaseAutonomousDataWarehouseWalletDataSourceCrud struct {
@@ -52,7 +52,7 @@ func (s *DatabaseAutonomousDataWarehouseWalletDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousDataWarehouseWalletDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousDataWarehouseWalletDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GenerateAutonomousDataWarehouseWalletRequest{}
 
 	if autonomousDataWarehouseId, ok := s.D.GetOkExists("autonomous_data_warehouse_id"); ok {
@@ -67,7 +67,7 @@ func (s *DatabaseAutonomousDataWarehouseWalletDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
 
-	response, err := s.Client.GenerateAutonomousDataWarehouseWallet(context.Background(), request)
+	response, err := s.Client.GenerateAutonomousDataWarehouseWallet(ctx, request)
 	if err != nil {
 		return err
 	}
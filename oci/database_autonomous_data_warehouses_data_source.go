@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_database "github.com/oracle/oci-go-sdk/database"
 )
 
@@ -13,7 +14,8 @@ func DatabaseAutonomousDataWarehousesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readDatabaseAutonomousDataWarehouses,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -31,6 +33,22 @@ func DatabaseAutonomousDataWarehousesDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(DatabaseAutonomousDataWarehouseResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_database.ListAutonomousDataWarehousesSortByTimecreated),
+					string(oci_database.ListAutonomousDataWarehousesSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_database.ListAutonomousDataWarehousesSortOrderAsc),
+					string(oci_database.ListAutonomousDataWarehousesSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -40,7 +58,7 @@ func readDatabaseAutonomousDataWarehouses(d *schema.ResourceData, m interface{})
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DatabaseAutonomousDataWarehousesDataSourceCrud struct {
@@ -53,7 +71,7 @@ func (s *DatabaseAutonomousDataWarehousesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousDataWarehousesDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousDataWarehousesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListAutonomousDataWarehousesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -70,9 +88,18 @@ func (s *DatabaseAutonomousDataWarehousesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_database.AutonomousDataWarehouseSummaryLifecycleStateEnum(state.(string))
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_database.ListAutonomousDataWarehousesSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_database.ListAutonomousDataWarehousesSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListAutonomousDataWarehouses(context.Background(), request)
+	response, err := s.Client.ListAutonomousDataWarehouses(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -80,8 +107,8 @@ func (s *DatabaseAutonomousDataWarehousesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListAutonomousDataWarehouses(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListAutonomousDataWarehouses(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -165,6 +192,8 @@ func (s *DatabaseAutonomousDataWarehousesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, DatabaseAutonomousDataWarehousesDataSource().Schema["autonomous_data_warehouses"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("autonomous_data_warehouses", resources); err != nil {
 		return err
 	}
@@ -63,7 +63,7 @@ func readSingularDatabaseAutonomousDatabaseBackup(d *schema.ResourceData, m inte
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DatabaseAutonomousDatabaseBackupDataSourceCrud struct {
@@ -76,7 +76,7 @@ func (s *DatabaseAutonomousDatabaseBackupDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousDatabaseBackupDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousDatabaseBackupDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetAutonomousDatabaseBackupRequest{}
 
 	if autonomousDatabaseBackupId, ok := s.D.GetOkExists("autonomous_database_backup_id"); ok {
@@ -86,7 +86,7 @@ func (s *DatabaseAutonomousDatabaseBackupDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
 
-	response, err := s.Client.GetAutonomousDatabaseBackup(context.Background(), request)
+	response, err := s.Client.GetAutonomousDatabaseBackup(ctx, request)
 	if err != nil {
 		return err
 	}
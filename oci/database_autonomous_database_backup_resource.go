@@ -72,7 +72,7 @@ func createDatabaseAutonomousDatabaseBackup(d *schema.ResourceData, m interface{
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readDatabaseAutonomousDatabaseBackup(d *schema.ResourceData, m interface{}) error {
@@ -80,7 +80,7 @@ func readDatabaseAutonomousDatabaseBackup(d *schema.ResourceData, m interface{})
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func deleteDatabaseAutonomousDatabaseBackup(d *schema.ResourceData, m interface{}) error {
@@ -122,7 +122,7 @@ func (s *DatabaseAutonomousDatabaseBackupResourceCrud) DeletedTarget() []string
 	}
 }
 
-func (s *DatabaseAutonomousDatabaseBackupResourceCrud) Create() error {
+func (s *DatabaseAutonomousDatabaseBackupResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateAutonomousDatabaseBackupRequest{}
 
 	if autonomousDatabaseId, ok := s.D.GetOkExists("autonomous_database_id"); ok {
@@ -137,7 +137,7 @@ func (s *DatabaseAutonomousDatabaseBackupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateAutonomousDatabaseBackup(context.Background(), request)
+	response, err := s.Client.CreateAutonomousDatabaseBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -146,7 +146,7 @@ func (s *DatabaseAutonomousDatabaseBackupResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseAutonomousDatabaseBackupResourceCrud) Get() error {
+func (s *DatabaseAutonomousDatabaseBackupResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetAutonomousDatabaseBackupRequest{}
 
 	tmp := s.D.Id()
@@ -154,7 +154,7 @@ func (s *DatabaseAutonomousDatabaseBackupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetAutonomousDatabaseBackup(context.Background(), request)
+	response, err := s.Client.GetAutonomousDatabaseBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_database "github.com/oracle/oci-go-sdk/database"
 )
 
@@ -13,7 +14,8 @@ func DatabaseAutonomousDatabaseBackupsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readDatabaseAutonomousDatabaseBackups,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"autonomous_database_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -35,6 +37,22 @@ func DatabaseAutonomousDatabaseBackupsDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(DatabaseAutonomousDatabaseBackupResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_database.ListAutonomousDatabaseBackupsSortByTimecreated),
+					string(oci_database.ListAutonomousDatabaseBackupsSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_database.ListAutonomousDatabaseBackupsSortOrderAsc),
+					string(oci_database.ListAutonomousDatabaseBackupsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -44,7 +62,7 @@ func readDatabaseAutonomousDatabaseBackups(d *schema.ResourceData, m interface{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DatabaseAutonomousDatabaseBackupsDataSourceCrud struct {
@@ -57,7 +75,7 @@ func (s *DatabaseAutonomousDatabaseBackupsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousDatabaseBackupsDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousDatabaseBackupsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListAutonomousDatabaseBackupsRequest{}
 
 	if autonomousDatabaseId, ok := s.D.GetOkExists("autonomous_database_id"); ok {
@@ -79,9 +97,18 @@ func (s *DatabaseAutonomousDatabaseBackupsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_database.AutonomousDatabaseBackupSummaryLifecycleStateEnum(state.(string))
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_database.ListAutonomousDatabaseBackupsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_database.ListAutonomousDatabaseBackupsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListAutonomousDatabaseBackups(context.Background(), request)
+	response, err := s.Client.ListAutonomousDatabaseBackups(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -89,8 +116,8 @@ func (s *DatabaseAutonomousDatabaseBackupsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListAutonomousDatabaseBackups(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListAutonomousDatabaseBackups(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -156,6 +183,8 @@ func (s *DatabaseAutonomousDatabaseBackupsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, DatabaseAutonomousDatabaseBackupsDataSource().Schema["autonomous_database_backups"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("autonomous_database_backups", resources); err != nil {
 		return err
 	}
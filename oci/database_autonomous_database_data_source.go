@@ -119,7 +119,7 @@ func readSingularDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DatabaseAutonomousDatabaseDataSourceCrud struct {
@@ -132,7 +132,7 @@ func (s *DatabaseAutonomousDatabaseDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousDatabaseDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousDatabaseDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetAutonomousDatabaseRequest{}
 
 	if autonomousDatabaseId, ok := s.D.GetOkExists("autonomous_database_id"); ok {
@@ -142,7 +142,7 @@ func (s *DatabaseAutonomousDatabaseDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
 
-	response, err := s.Client.GetAutonomousDatabase(context.Background(), request)
+	response, err := s.Client.GetAutonomousDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
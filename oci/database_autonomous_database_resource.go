@@ -170,7 +170,7 @@ func createDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) err
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) error {
@@ -178,7 +178,7 @@ func readDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) error {
@@ -186,7 +186,7 @@ func updateDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) err
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) error {
@@ -195,7 +195,7 @@ func deleteDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) err
 	sync.Client = m.(*OracleClients).databaseClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type DatabaseAutonomousDatabaseResourceCrud struct {
@@ -249,7 +249,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *DatabaseAutonomousDatabaseResourceCrud) Create() error {
+func (s *DatabaseAutonomousDatabaseResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateAutonomousDatabaseRequest{}
 	err := s.populateTopLevelPolymorphicCreateAutonomousDatabaseRequest(&request)
 	if err != nil {
@@ -258,7 +258,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateAutonomousDatabase(context.Background(), request)
+	response, err := s.Client.CreateAutonomousDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -267,7 +267,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseAutonomousDatabaseResourceCrud) Get() error {
+func (s *DatabaseAutonomousDatabaseResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetAutonomousDatabaseRequest{}
 
 	tmp := s.D.Id()
@@ -275,7 +275,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetAutonomousDatabase(context.Background(), request)
+	response, err := s.Client.GetAutonomousDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -284,7 +284,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseAutonomousDatabaseResourceCrud) Update() error {
+func (s *DatabaseAutonomousDatabaseResourceCrud) Update(ctx context.Context) error {
 	request := oci_database.UpdateAutonomousDatabaseRequest{}
 
 	// @CODEGEN 09/2018: Cannot update the password and scale the Autonomous Transaction Processing in same request, only include changed properties in request
@@ -325,7 +325,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateAutonomousDatabase(context.Background(), request)
+	response, err := s.Client.UpdateAutonomousDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -334,7 +334,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DatabaseAutonomousDatabaseResourceCrud) Delete() error {
+func (s *DatabaseAutonomousDatabaseResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.DeleteAutonomousDatabaseRequest{}
 
 	tmp := s.D.Id()
@@ -342,7 +342,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteAutonomousDatabase(context.Background(), request)
+	_, err := s.Client.DeleteAutonomousDatabase(ctx, request)
 	return err
 }
 
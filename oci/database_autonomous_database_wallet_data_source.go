@@ -39,7 +39,7 @@ func readSingularDatabaseAutonomousDatabaseWallet(d *schema.ResourceData, m inte
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DatabaseAutonomousDatabaseWalletDataSourceCrud struct {
@@ -52,7 +52,7 @@ func (s *DatabaseAutonomousDatabaseWalletDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousDatabaseWalletDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousDatabaseWalletDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GenerateAutonomousDatabaseWalletRequest{}
 
 	if autonomousDatabaseId, ok := s.D.GetOkExists("autonomous_database_id"); ok {
@@ -67,7 +67,7 @@ func (s *DatabaseAutonomousDatabaseWalletDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
 
-	response, err := s.Client.GenerateAutonomousDatabaseWallet(context.Background(), request)
+	response, err := s.Client.GenerateAutonomousDatabaseWallet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_database "github.com/oracle/oci-go-sdk/database"
 )
 
@@ -13,7 +14,8 @@ func DatabaseAutonomousDatabasesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readDatabaseAutonomousDatabases,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -35,6 +37,22 @@ func DatabaseAutonomousDatabasesDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(DatabaseAutonomousDatabaseResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_database.ListAutonomousDatabasesSortByTimecreated),
+					string(oci_database.ListAutonomousDatabasesSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_database.ListAutonomousDatabasesSortOrderAsc),
+					string(oci_database.ListAutonomousDatabasesSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -44,7 +62,7 @@ func readDatabaseAutonomousDatabases(d *schema.ResourceData, m interface{}) erro
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DatabaseAutonomousDatabasesDataSourceCrud struct {
@@ -57,7 +75,7 @@ func (s *DatabaseAutonomousDatabasesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousDatabasesDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousDatabasesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListAutonomousDatabasesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -78,9 +96,18 @@ func (s *DatabaseAutonomousDatabasesDataSourceCrud) Get() error {
 		request.LifecycleState = oci_database.AutonomousDatabaseSummaryLifecycleStateEnum(state.(string))
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_database.ListAutonomousDatabasesSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_database.ListAutonomousDatabasesSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListAutonomousDatabases(context.Background(), request)
+	response, err := s.Client.ListAutonomousDatabases(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -88,8 +115,8 @@ func (s *DatabaseAutonomousDatabasesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListAutonomousDatabases(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListAutonomousDatabases(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -179,6 +206,8 @@ func (s *DatabaseAutonomousDatabasesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, DatabaseAutonomousDatabasesDataSource().Schema["autonomous_databases"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("autonomous_databases", resources); err != nil {
 		return err
 	}
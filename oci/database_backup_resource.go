@@ -85,7 +85,7 @@ func createDatabaseBackup(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readDatabaseBackup(d *schema.ResourceData, m interface{}) error {
@@ -93,7 +93,7 @@ func readDatabaseBackup(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func deleteDatabaseBackup(d *schema.ResourceData, m interface{}) error {
@@ -102,7 +102,7 @@ func deleteDatabaseBackup(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).databaseClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type DatabaseBackupResourceCrud struct {
@@ -141,7 +141,7 @@ func (s *DatabaseBackupResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DatabaseBackupResourceCrud) Create() error {
+func (s *DatabaseBackupResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateBackupRequest{}
 
 	if databaseId, ok := s.D.GetOkExists("database_id"); ok {
@@ -156,7 +156,7 @@ func (s *DatabaseBackupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateBackup(context.Background(), request)
+	response, err := s.Client.CreateBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -165,7 +165,7 @@ func (s *DatabaseBackupResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseBackupResourceCrud) Get() error {
+func (s *DatabaseBackupResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetBackupRequest{}
 
 	tmp := s.D.Id()
@@ -173,7 +173,7 @@ func (s *DatabaseBackupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetBackup(context.Background(), request)
+	response, err := s.Client.GetBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -182,7 +182,7 @@ func (s *DatabaseBackupResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseBackupResourceCrud) Delete() error {
+func (s *DatabaseBackupResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.DeleteBackupRequest{}
 
 	tmp := s.D.Id()
@@ -190,7 +190,7 @@ func (s *DatabaseBackupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteBackup(context.Background(), request)
+	_, err := s.Client.DeleteBackup(ctx, request)
 	return err
 }
 
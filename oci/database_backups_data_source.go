@@ -13,7 +13,8 @@ func DatabaseBackupsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readDatabaseBackups,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -36,7 +37,7 @@ func readDatabaseBackups(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DatabaseBackupsDataSourceCrud struct {
@@ -49,7 +50,7 @@ func (s *DatabaseBackupsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseBackupsDataSourceCrud) Get() error {
+func (s *DatabaseBackupsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListBackupsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -63,8 +64,9 @@ func (s *DatabaseBackupsDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListBackups(context.Background(), request)
+	response, err := s.Client.ListBackups(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -72,8 +74,8 @@ func (s *DatabaseBackupsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListBackups(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListBackups(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -146,6 +148,8 @@ func (s *DatabaseBackupsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, DatabaseBackupsDataSource().Schema["backups"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("backups", resources); err != nil {
 		return err
 	}
@@ -83,7 +83,7 @@ func readSingularDatabaseDataGuardAssociation(d *schema.ResourceData, m interfac
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DatabaseDataGuardAssociationDataSourceCrud struct {
@@ -96,7 +96,7 @@ func (s *DatabaseDataGuardAssociationDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDataGuardAssociationDataSourceCrud) Get() error {
+func (s *DatabaseDataGuardAssociationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetDataGuardAssociationRequest{}
 
 	if dataGuardAssociationId, ok := s.D.GetOkExists("data_guard_association_id"); ok {
@@ -111,7 +111,7 @@ func (s *DatabaseDataGuardAssociationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
 
-	response, err := s.Client.GetDataGuardAssociation(context.Background(), request)
+	response, err := s.Client.GetDataGuardAssociation(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -17,6 +17,9 @@ import (
 
 func DatabaseDataGuardAssociationResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importDatabaseDataGuardAssociation,
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: &TwoHours,
 			Delete: &TwoHours,
@@ -146,7 +149,7 @@ func createDatabaseDataGuardAssociation(d *schema.ResourceData, m interface{}) e
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readDatabaseDataGuardAssociation(d *schema.ResourceData, m interface{}) error {
@@ -154,7 +157,7 @@ func readDatabaseDataGuardAssociation(d *schema.ResourceData, m interface{}) err
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateDatabaseDataGuardAssociation(d *schema.ResourceData, m interface{}) error {
@@ -162,7 +165,7 @@ func updateDatabaseDataGuardAssociation(d *schema.ResourceData, m interface{}) e
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteDatabaseDataGuardAssociation(d *schema.ResourceData, m interface{}) error {
@@ -170,7 +173,21 @@ func deleteDatabaseDataGuardAssociation(d *schema.ResourceData, m interface{}) e
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
+}
+
+// importDatabaseDataGuardAssociation imports a Data Guard association of a database, with an ID of the form
+// databases/{databaseId}/dataGuardAssociations/{dataGuardAssociationId}. The admin password and the creation
+// details of the peer can not be read back.
+func importDatabaseDataGuardAssociation(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	values, err := parseCompositeId(d.Id(), "databases", "dataGuardAssociations")
+	if err != nil {
+		return nil, err
+	}
+	d.Set("database_id", values[0])
+	d.SetId(values[1])
+
+	return []*schema.ResourceData{d}, nil
 }
 
 type DatabaseDataGuardAssociationResourceCrud struct {
@@ -214,7 +231,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DatabaseDataGuardAssociationResourceCrud) Create() error {
+func (s *DatabaseDataGuardAssociationResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateDataGuardAssociationRequest{}
 	err := s.populateTopLevelPolymorphicCreateDataGuardAssociationRequest(&request)
 	if err != nil {
@@ -223,7 +240,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateDataGuardAssociation(context.Background(), request)
+	response, err := s.Client.CreateDataGuardAssociation(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -232,7 +249,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseDataGuardAssociationResourceCrud) Get() error {
+func (s *DatabaseDataGuardAssociationResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetDataGuardAssociationRequest{}
 
 	tmp := s.D.Id()
@@ -245,7 +262,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetDataGuardAssociation(context.Background(), request)
+	response, err := s.Client.GetDataGuardAssociation(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -255,11 +272,11 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Get() error {
 }
 
 //We need to have an empty delete because otherwise the added delete_standby_db_home_on_delete would have to be marked as ForceNew, which is undesireable. This way the update will pass the new value for this property from the config to the statefile.
-func (s *DatabaseDataGuardAssociationResourceCrud) Update() error {
-	return s.Get()
+func (s *DatabaseDataGuardAssociationResourceCrud) Update(ctx context.Context) error {
+	return s.Get(ctx)
 }
 
-func (s *DatabaseDataGuardAssociationResourceCrud) Delete() error {
+func (s *DatabaseDataGuardAssociationResourceCrud) Delete(ctx context.Context) error {
 	if deleteStandbyDbHomeOnDelete, ok := s.D.GetOkExists("delete_standby_db_home_on_delete"); ok {
 		tmp := deleteStandbyDbHomeOnDelete.(string)
 		if tmp != "true" {
@@ -267,7 +284,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Delete() error {
 		}
 	}
 
-	err := s.Get()
+	err := s.Get(ctx)
 	if err != nil {
 		return err
 	}
@@ -281,7 +298,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Delete() error {
 		if s.Res.PeerRole == oci_database.DataGuardAssociationPeerRoleStandby {
 			standbyDbHomeId = s.Res.PeerDbHomeId
 		} else if s.Res.Role == oci_database.DataGuardAssociationRoleStandby {
-			standbyDbHomeId, err = s.GetDbHomeIdFromDatabaseId(s.Res.DatabaseId)
+			standbyDbHomeId, err = s.GetDbHomeIdFromDatabaseId(ctx, s.Res.DatabaseId)
 			if err != nil {
 				return fmt.Errorf("could not delete the dataguard association as the standby DB Home Id could not be obtained: %v", err)
 			}
@@ -296,7 +313,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Delete() error {
 		request := oci_database.DeleteDbHomeRequest{}
 		request.DbHomeId = standbyDbHomeId
 		request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
-		_, err = s.Client.DeleteDbHome(context.Background(), request)
+		_, err = s.Client.DeleteDbHome(ctx, request)
 		if err != nil {
 			return fmt.Errorf("could not delete standby DB Home to delete the data guard association: %v", err)
 		}
@@ -304,7 +321,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Delete() error {
 		getDbHomeRequest := oci_database.GetDbHomeRequest{}
 		getDbHomeRequest.DbHomeId = standbyDbHomeId
 		getDbHomeRequest.RequestMetadata.RetryPolicy = waitForDbHomeToTerminateRetryPolicy(2 * time.Hour)
-		getDbHomeResponse, err := s.Client.GetDbHome(context.Background(), getDbHomeRequest)
+		getDbHomeResponse, err := s.Client.GetDbHome(ctx, getDbHomeRequest)
 		//Sometimed the DB Home goes from a TERMINATING Lifecycle state into AVAILABLE, this is to catch that case and report it ot the user as a failure in deleting the database
 		if getDbHomeResponse.LifecycleState == oci_database.DbHomeLifecycleStateAvailable {
 			return fmt.Errorf("could not delete the dataguard association as the dbHome could not be deleted")
@@ -316,7 +333,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Delete() error {
 		if s.Res.PeerRole == oci_database.DataGuardAssociationPeerRoleStandby {
 			standbyDbSystemId = s.Res.PeerDbSystemId
 		} else if s.Res.Role == oci_database.DataGuardAssociationRoleStandby {
-			standbyDbSystemId, err = s.GetDbSystemIdFromDatabaseId(s.Res.DatabaseId)
+			standbyDbSystemId, err = s.GetDbSystemIdFromDatabaseId(ctx, s.Res.DatabaseId)
 			if err != nil {
 				return fmt.Errorf("could not delete the dataguard association as the standby DB System Id could not be obtained: %v", err)
 			}
@@ -331,7 +348,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Delete() error {
 		request := oci_database.TerminateDbSystemRequest{}
 		request.DbSystemId = standbyDbSystemId
 		request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
-		_, err := s.Client.TerminateDbSystem(context.Background(), request)
+		_, err := s.Client.TerminateDbSystem(ctx, request)
 		if err != nil {
 			return fmt.Errorf("could not delete standby DB System to delete the data guard association: %v", err)
 		}
@@ -339,7 +356,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Delete() error {
 		getDbSystemRequest := oci_database.GetDbSystemRequest{}
 		getDbSystemRequest.DbSystemId = standbyDbSystemId
 		getDbSystemRequest.RequestMetadata.RetryPolicy = waitForDbSystemToTerminateRetryPolicy(2 * time.Hour)
-		getDbSystemResponse, err := s.Client.GetDbSystem(context.Background(), getDbSystemRequest)
+		getDbSystemResponse, err := s.Client.GetDbSystem(ctx, getDbSystemRequest)
 		//Sometimes the DB System goes from a TERMINATING Lifecycle state into AVAILABLE, this is to catch that case and report it ot the user as a failure in deleting the db System
 		if getDbSystemResponse.LifecycleState == oci_database.DbSystemLifecycleStateAvailable {
 			return fmt.Errorf("could not delete the dataguard association as the dbSystem could not be deleted")
@@ -470,36 +487,36 @@ func (s *DatabaseDataGuardAssociationResourceCrud) populateTopLevelPolymorphicCr
 	return nil
 }
 
-func (s *DatabaseDataGuardAssociationResourceCrud) GetDbHomeIdFromDatabaseId(databaseId *string) (*string, error) {
+func (s *DatabaseDataGuardAssociationResourceCrud) GetDbHomeIdFromDatabaseId(ctx context.Context, databaseId *string) (*string, error) {
 	request := oci_database.GetDatabaseRequest{}
 
 	request.DatabaseId = databaseId
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(true, "database")
 
-	response, err := s.Client.GetDatabase(context.Background(), request)
+	response, err := s.Client.GetDatabase(ctx, request)
 	if err != nil {
 		return nil, err
 	}
 	return response.DbHomeId, nil
 }
 
-func (s *DatabaseDataGuardAssociationResourceCrud) GetDbSystemIdFromDatabaseId(databaseId *string) (*string, error) {
-	dbHomeId, err := s.GetDbHomeIdFromDatabaseId(databaseId)
+func (s *DatabaseDataGuardAssociationResourceCrud) GetDbSystemIdFromDatabaseId(ctx context.Context, databaseId *string) (*string, error) {
+	dbHomeId, err := s.GetDbHomeIdFromDatabaseId(ctx, databaseId)
 	if err != nil {
 		return dbHomeId, err
 	}
-	return s.GetDbSystemIdFromDbHomeId(dbHomeId)
+	return s.GetDbSystemIdFromDbHomeId(ctx, dbHomeId)
 }
 
-func (s *DatabaseDataGuardAssociationResourceCrud) GetDbSystemIdFromDbHomeId(dbHomeId *string) (*string, error) {
+func (s *DatabaseDataGuardAssociationResourceCrud) GetDbSystemIdFromDbHomeId(ctx context.Context, dbHomeId *string) (*string, error) {
 	request := oci_database.GetDbHomeRequest{}
 
 	request.DbHomeId = dbHomeId
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(true, "database")
 
-	response, err := s.Client.GetDbHome(context.Background(), request)
+	response, err := s.Client.GetDbHome(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -269,6 +269,24 @@ func TestDatabaseDataGuardAssociationResource_basic(t *testing.T) {
 				),
 			},
 			// @CODEGEN We need to remove the import because the import step removed the dependency relationship between the DGA and the peer dbSystem and the delete will fail without it.
+			// verify resource import
+			{
+				Config:            config,
+				ImportState:       true,
+				ImportStateIdFunc: getImportCompositeIdFunc(resourceName, "databases", "database_id", "dataGuardAssociations", "id"),
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"availability_domain",
+					"creation_type",
+					"database_admin_password",
+					"delete_standby_db_home_on_delete",
+					"display_name",
+					"hostname",
+					"peer_db_system_id",
+					"subnet_id",
+				},
+				ResourceName: resourceName,
+			},
 		},
 	})
 }
@@ -13,7 +13,8 @@ func DatabaseDataGuardAssociationsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readDatabaseDataGuardAssociations,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"database_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -32,7 +33,7 @@ func readDatabaseDataGuardAssociations(d *schema.ResourceData, m interface{}) er
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DatabaseDataGuardAssociationsDataSourceCrud struct {
@@ -45,7 +46,7 @@ func (s *DatabaseDataGuardAssociationsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDataGuardAssociationsDataSourceCrud) Get() error {
+func (s *DatabaseDataGuardAssociationsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListDataGuardAssociationsRequest{}
 
 	if databaseId, ok := s.D.GetOkExists("database_id"); ok {
@@ -54,8 +55,9 @@ func (s *DatabaseDataGuardAssociationsDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListDataGuardAssociations(context.Background(), request)
+	response, err := s.Client.ListDataGuardAssociations(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -63,8 +65,8 @@ func (s *DatabaseDataGuardAssociationsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListDataGuardAssociations(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListDataGuardAssociations(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -142,6 +144,8 @@ func (s *DatabaseDataGuardAssociationsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, DatabaseDataGuardAssociationsDataSource().Schema["data_guard_associations"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("data_guard_associations", resources); err != nil {
 		return err
 	}
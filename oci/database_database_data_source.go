@@ -126,7 +126,7 @@ func readSingularDatabaseDatabase(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DatabaseDatabaseDataSourceCrud struct {
@@ -139,7 +139,7 @@ func (s *DatabaseDatabaseDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDatabaseDataSourceCrud) Get() error {
+func (s *DatabaseDatabaseDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetDatabaseRequest{}
 
 	if databaseId, ok := s.D.GetOkExists("database_id"); ok {
@@ -149,7 +149,7 @@ func (s *DatabaseDatabaseDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
 
-	response, err := s.Client.GetDatabase(context.Background(), request)
+	response, err := s.Client.GetDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_database "github.com/oracle/oci-go-sdk/database"
 )
 
@@ -13,7 +14,8 @@ func DatabaseDatabasesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readDatabaseDatabases,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -45,6 +47,22 @@ func DatabaseDatabasesDataSource() *schema.Resource {
 				Optional:   true,
 				Deprecated: FieldDeprecated("page"),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_database.ListDatabasesSortByDbname),
+					string(oci_database.ListDatabasesSortByTimecreated),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_database.ListDatabasesSortOrderAsc),
+					string(oci_database.ListDatabasesSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -54,7 +72,7 @@ func readDatabaseDatabases(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DatabaseDatabasesDataSourceCrud struct {
@@ -67,7 +85,7 @@ func (s *DatabaseDatabasesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDatabasesDataSourceCrud) Get() error {
+func (s *DatabaseDatabasesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListDatabasesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -96,9 +114,18 @@ func (s *DatabaseDatabasesDataSourceCrud) Get() error {
 
 	// @CODEGEN "page" was never wired up, omit
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_database.ListDatabasesSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_database.ListDatabasesSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListDatabases(context.Background(), request)
+	response, err := s.Client.ListDatabases(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -106,8 +133,8 @@ func (s *DatabaseDatabasesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListDatabases(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListDatabases(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -196,6 +223,8 @@ func (s *DatabaseDatabasesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, DatabaseDatabasesDataSource().Schema["databases"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("databases", resources); err != nil {
 		return err
 	}
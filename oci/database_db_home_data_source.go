@@ -56,7 +56,7 @@ func readSingularDatabaseDbHome(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DatabaseDbHomeDataSourceCrud struct {
@@ -69,7 +69,7 @@ func (s *DatabaseDbHomeDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDbHomeDataSourceCrud) Get() error {
+func (s *DatabaseDbHomeDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetDbHomeRequest{}
 
 	if dbHomeId, ok := s.D.GetOkExists("db_home_id"); ok {
@@ -83,7 +83,7 @@ func (s *DatabaseDbHomeDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
 
-	response, err := s.Client.GetDbHome(context.Background(), request)
+	response, err := s.Client.GetDbHome(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -13,7 +13,8 @@ func DatabaseDbHomePatchesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readDatabaseDbHomePatches,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"db_home_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -75,7 +76,7 @@ func readDatabaseDbHomePatches(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DatabaseDbHomePatchesDataSourceCrud struct {
@@ -88,7 +89,7 @@ func (s *DatabaseDbHomePatchesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDbHomePatchesDataSourceCrud) Get() error {
+func (s *DatabaseDbHomePatchesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListDbHomePatchesRequest{}
 
 	if dbHomeId, ok := s.D.GetOkExists("db_home_id"); ok {
@@ -97,8 +98,9 @@ func (s *DatabaseDbHomePatchesDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListDbHomePatches(context.Background(), request)
+	response, err := s.Client.ListDbHomePatches(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -106,8 +108,8 @@ func (s *DatabaseDbHomePatchesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListDbHomePatches(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListDbHomePatches(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -163,6 +165,8 @@ func (s *DatabaseDbHomePatchesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, DatabaseDbHomePatchesDataSource().Schema["patches"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("patches", resources); err != nil {
 		return err
 	}
@@ -231,7 +231,7 @@ func createDatabaseDbHome(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readDatabaseDbHome(d *schema.ResourceData, m interface{}) error {
@@ -239,7 +239,7 @@ func readDatabaseDbHome(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateDatabaseDbHome(d *schema.ResourceData, m interface{}) error {
@@ -247,7 +247,7 @@ func updateDatabaseDbHome(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteDatabaseDbHome(d *schema.ResourceData, m interface{}) error {
@@ -256,7 +256,7 @@ func deleteDatabaseDbHome(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).databaseClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type DatabaseDbHomeResourceCrud struct {
@@ -305,7 +305,7 @@ func (s *DatabaseDbHomeResourceCrud) UpdatedTarget() []string {
 	return s.CreatedTarget()
 }
 
-func (s *DatabaseDbHomeResourceCrud) Create() error {
+func (s *DatabaseDbHomeResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateDbHomeRequest{}
 	err := s.populateTopLevelPolymorphicCreateDbHomeRequest(&request)
 	if err != nil {
@@ -314,20 +314,20 @@ func (s *DatabaseDbHomeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateDbHome(context.Background(), request)
+	response, err := s.Client.CreateDbHome(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.DbHome
-	err = s.getDatabaseInfo()
+	err = s.getDatabaseInfo(ctx)
 	if err != nil {
 		log.Printf("[ERROR] Could not get Database info for the dbHome: %v", err)
 	}
 	return nil
 }
 
-func (s *DatabaseDbHomeResourceCrud) Get() error {
+func (s *DatabaseDbHomeResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetDbHomeRequest{}
 
 	tmp := s.D.Id()
@@ -335,14 +335,14 @@ func (s *DatabaseDbHomeResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetDbHome(context.Background(), request)
+	response, err := s.Client.GetDbHome(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.DbHome
 
-	err = s.getDatabaseInfo()
+	err = s.getDatabaseInfo(ctx)
 	if err != nil {
 		log.Printf("[ERROR] Could not get Database info for the dbHome: %v", err)
 	}
@@ -350,7 +350,7 @@ func (s *DatabaseDbHomeResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseDbHomeResourceCrud) getDatabaseInfo() error {
+func (s *DatabaseDbHomeResourceCrud) getDatabaseInfo(ctx context.Context) error {
 	listDatabasesRequest := oci_database.ListDatabasesRequest{}
 
 	listDatabasesRequest.CompartmentId = s.Res.CompartmentId
@@ -358,7 +358,7 @@ func (s *DatabaseDbHomeResourceCrud) getDatabaseInfo() error {
 	listDatabasesRequest.SortBy = oci_database.ListDatabasesSortByTimecreated
 	listDatabasesRequest.SortOrder = oci_database.ListDatabasesSortOrderAsc
 	listDatabasesRequest.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
-	listDatabasesResponse, err := s.Client.ListDatabases(context.Background(), listDatabasesRequest)
+	listDatabasesResponse, err := s.Client.ListDatabases(ctx, listDatabasesRequest)
 	if err != nil {
 		return err
 	}
@@ -371,7 +371,7 @@ func (s *DatabaseDbHomeResourceCrud) getDatabaseInfo() error {
 	getDatabaseRequest := oci_database.GetDatabaseRequest{}
 	getDatabaseRequest.DatabaseId = databaseId
 	getDatabaseRequest.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
-	getDatabaseResponse, err := s.Client.GetDatabase(context.Background(), getDatabaseRequest)
+	getDatabaseResponse, err := s.Client.GetDatabase(ctx, getDatabaseRequest)
 	if err != nil {
 		return err
 	}
@@ -381,13 +381,13 @@ func (s *DatabaseDbHomeResourceCrud) getDatabaseInfo() error {
 	return nil
 }
 
-func (s *DatabaseDbHomeResourceCrud) Update() error {
-	err := s.Get()
+func (s *DatabaseDbHomeResourceCrud) Update(ctx context.Context) error {
+	err := s.Get(ctx)
 	if err != nil {
 		log.Printf("[ERROR] error refreshing the dbHome information before an upate: %v", err)
 	}
 	if s.Database == nil || s.Database.Id == nil {
-		err := s.getDatabaseInfo()
+		err := s.getDatabaseInfo(ctx)
 		if err != nil {
 			return fmt.Errorf("could not perform an update as we could not get the databaseId in the dbHome: %v", err)
 		}
@@ -409,12 +409,12 @@ func (s *DatabaseDbHomeResourceCrud) Update() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
-	_, err = s.Client.UpdateDatabase(context.Background(), request)
+	_, err = s.Client.UpdateDatabase(ctx, request)
 
 	return err
 }
 
-func (s *DatabaseDbHomeResourceCrud) Delete() error {
+func (s *DatabaseDbHomeResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.DeleteDbHomeRequest{}
 
 	tmp := s.D.Id()
@@ -427,7 +427,7 @@ func (s *DatabaseDbHomeResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteDbHome(context.Background(), request)
+	_, err := s.Client.DeleteDbHome(ctx, request)
 	return err
 }
 
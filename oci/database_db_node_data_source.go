@@ -59,7 +59,7 @@ func readSingularDatabaseDbNode(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DatabaseDbNodeDataSourceCrud struct {
@@ -72,7 +72,7 @@ func (s *DatabaseDbNodeDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDbNodeDataSourceCrud) Get() error {
+func (s *DatabaseDbNodeDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetDbNodeRequest{}
 
 	if dbNodeId, ok := s.D.GetOkExists("db_node_id"); ok {
@@ -82,7 +82,7 @@ func (s *DatabaseDbNodeDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
 
-	response, err := s.Client.GetDbNode(context.Background(), request)
+	response, err := s.Client.GetDbNode(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -13,7 +13,8 @@ func DatabaseDbSystemPatchHistoryEntriesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readDatabaseDbSystemPatchHistoryEntries,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"db_system_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -68,7 +69,7 @@ func readDatabaseDbSystemPatchHistoryEntries(d *schema.ResourceData, m interface
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DatabaseDbSystemPatchHistoryEntriesDataSourceCrud struct {
@@ -81,7 +82,7 @@ func (s *DatabaseDbSystemPatchHistoryEntriesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDbSystemPatchHistoryEntriesDataSourceCrud) Get() error {
+func (s *DatabaseDbSystemPatchHistoryEntriesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListDbSystemPatchHistoryEntriesRequest{}
 
 	if dbSystemId, ok := s.D.GetOkExists("db_system_id"); ok {
@@ -90,8 +91,9 @@ func (s *DatabaseDbSystemPatchHistoryEntriesDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListDbSystemPatchHistoryEntries(context.Background(), request)
+	response, err := s.Client.ListDbSystemPatchHistoryEntries(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -99,8 +101,8 @@ func (s *DatabaseDbSystemPatchHistoryEntriesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListDbSystemPatchHistoryEntries(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListDbSystemPatchHistoryEntries(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -154,6 +156,8 @@ func (s *DatabaseDbSystemPatchHistoryEntriesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, DatabaseDbSystemPatchHistoryEntriesDataSource().Schema["patch_history_entries"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("patch_history_entries", resources); err != nil {
 		return err
 	}
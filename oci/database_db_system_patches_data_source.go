@@ -13,7 +13,8 @@ func DatabaseDbSystemPatchesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readDatabaseDbSystemPatches,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"db_system_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -75,7 +76,7 @@ func readDatabaseDbSystemPatches(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DatabaseDbSystemPatchesDataSourceCrud struct {
@@ -88,7 +89,7 @@ func (s *DatabaseDbSystemPatchesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDbSystemPatchesDataSourceCrud) Get() error {
+func (s *DatabaseDbSystemPatchesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListDbSystemPatchesRequest{}
 
 	if dbSystemId, ok := s.D.GetOkExists("db_system_id"); ok {
@@ -97,8 +98,9 @@ func (s *DatabaseDbSystemPatchesDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListDbSystemPatches(context.Background(), request)
+	response, err := s.Client.ListDbSystemPatches(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -106,8 +108,8 @@ func (s *DatabaseDbSystemPatchesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListDbSystemPatches(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListDbSystemPatches(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -163,6 +165,8 @@ func (s *DatabaseDbSystemPatchesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, DatabaseDbSystemPatchesDataSource().Schema["patches"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("patches", resources); err != nil {
 		return err
 	}
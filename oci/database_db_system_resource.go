@@ -280,6 +280,7 @@ func DatabaseDbSystemResource() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"create_retry": createRetrySchema(),
 			"data_storage_percentage": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -483,7 +484,7 @@ func createDatabaseDbSystem(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return CreateDBSystemResource(d, sync)
+	return CreateDBSystemResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readDatabaseDbSystem(d *schema.ResourceData, m interface{}) error {
@@ -491,7 +492,7 @@ func readDatabaseDbSystem(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateDatabaseDbSystem(d *schema.ResourceData, m interface{}) error {
@@ -499,7 +500,7 @@ func updateDatabaseDbSystem(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteDatabaseDbSystem(d *schema.ResourceData, m interface{}) error {
@@ -508,7 +509,7 @@ func deleteDatabaseDbSystem(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).databaseClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type DatabaseDbSystemResourceCrud struct {
@@ -560,7 +561,7 @@ func (s *DatabaseDbSystemResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DatabaseDbSystemResourceCrud) Create() error {
+func (s *DatabaseDbSystemResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.LaunchDbSystemRequest{}
 	err := s.populateTopLevelPolymorphicLaunchDbSystemRequest(&request)
 	if err != nil {
@@ -569,21 +570,21 @@ func (s *DatabaseDbSystemResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.LaunchDbSystem(context.Background(), request)
+	response, err := s.Client.LaunchDbSystem(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.DbSystem
 
-	err = s.getDbHomeInfo()
+	err = s.getDbHomeInfo(ctx)
 	if err != nil {
 		log.Printf("[ERROR] Could not get info about the first DbHome in the dbSystem: %v", err)
 	}
 	return nil
 }
 
-func (s *DatabaseDbSystemResourceCrud) Get() error {
+func (s *DatabaseDbSystemResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetDbSystemRequest{}
 
 	tmp := s.D.Id()
@@ -591,14 +592,14 @@ func (s *DatabaseDbSystemResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetDbSystem(context.Background(), request)
+	response, err := s.Client.GetDbSystem(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.DbSystem
 
-	err = s.getDbHomeInfo()
+	err = s.getDbHomeInfo(ctx)
 	if err != nil {
 		log.Printf("[ERROR] Could not get info about the first DbHome in the dbSystem: %v", err)
 	}
@@ -606,7 +607,7 @@ func (s *DatabaseDbSystemResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseDbSystemResourceCrud) getDbHomeInfo() error {
+func (s *DatabaseDbSystemResourceCrud) getDbHomeInfo(ctx context.Context) error {
 	var dbHomeId *string
 	if s.DbHome != nil && s.DbHome.Id != nil {
 		dbHomeId = s.DbHome.Id
@@ -622,7 +623,7 @@ func (s *DatabaseDbSystemResourceCrud) getDbHomeInfo() error {
 			listDbHomeRequest.SortBy = oci_database.ListDbHomesSortByTimecreated
 			listDbHomeRequest.SortOrder = oci_database.ListDbHomesSortOrderAsc
 			listDbHomeRequest.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
-			listDbHomeResponse, err := s.Client.ListDbHomes(context.Background(), listDbHomeRequest)
+			listDbHomeResponse, err := s.Client.ListDbHomes(ctx, listDbHomeRequest)
 			if err != nil {
 				return err
 			}
@@ -646,7 +647,7 @@ func (s *DatabaseDbSystemResourceCrud) getDbHomeInfo() error {
 	getDbHomeRequest := oci_database.GetDbHomeRequest{}
 	getDbHomeRequest.DbHomeId = dbHomeId
 	getDbHomeRequest.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
-	getDbHomeResponse, err := s.Client.GetDbHome(context.Background(), getDbHomeRequest)
+	getDbHomeResponse, err := s.Client.GetDbHome(ctx, getDbHomeRequest)
 	if err != nil {
 		return err
 	}
@@ -669,7 +670,7 @@ func (s *DatabaseDbSystemResourceCrud) getDbHomeInfo() error {
 			listDatabasesRequest.SortBy = oci_database.ListDatabasesSortByTimecreated
 			listDatabasesRequest.SortOrder = oci_database.ListDatabasesSortOrderAsc
 			listDatabasesRequest.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
-			listDatabasesResponse, err := s.Client.ListDatabases(context.Background(), listDatabasesRequest)
+			listDatabasesResponse, err := s.Client.ListDatabases(ctx, listDatabasesRequest)
 			if err != nil {
 				return err
 			}
@@ -688,7 +689,7 @@ func (s *DatabaseDbSystemResourceCrud) getDbHomeInfo() error {
 	getDatabaseRequest := oci_database.GetDatabaseRequest{}
 	getDatabaseRequest.DatabaseId = databaseId
 	getDatabaseRequest.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
-	getDatabaseResponse, err := s.Client.GetDatabase(context.Background(), getDatabaseRequest)
+	getDatabaseResponse, err := s.Client.GetDatabase(ctx, getDatabaseRequest)
 	if err != nil {
 		return err
 	}
@@ -708,7 +709,7 @@ func (s *DatabaseDbSystemResourceCrud) getDbHomeInfo() error {
 	return nil
 }
 
-func (s *DatabaseDbSystemResourceCrud) Update() error {
+func (s *DatabaseDbSystemResourceCrud) Update(ctx context.Context) error {
 	request := oci_database.UpdateDbSystemRequest{}
 
 	if cpuCoreCount, ok := s.D.GetOkExists("cpu_core_count"); ok {
@@ -750,7 +751,7 @@ func (s *DatabaseDbSystemResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateDbSystem(context.Background(), request)
+	response, err := s.Client.UpdateDbSystem(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -761,7 +762,7 @@ func (s *DatabaseDbSystemResourceCrud) Update() error {
 	if err != nil {
 		log.Printf("[ERROR] error setting data after dbsystem update but before database update: %v", err)
 	}
-	err = s.getDbHomeInfo()
+	err = s.getDbHomeInfo(ctx)
 	if err != nil {
 		return err
 	}
@@ -777,7 +778,7 @@ func (s *DatabaseDbSystemResourceCrud) Update() error {
 	}
 
 	updateDatabaseRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
-	updateDatabaseResponse, err := s.Client.UpdateDatabase(context.Background(), updateDatabaseRequest)
+	updateDatabaseResponse, err := s.Client.UpdateDatabase(ctx, updateDatabaseRequest)
 	if err != nil {
 		return err
 	}
@@ -786,7 +787,7 @@ func (s *DatabaseDbSystemResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DatabaseDbSystemResourceCrud) Delete() error {
+func (s *DatabaseDbSystemResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.TerminateDbSystemRequest{}
 
 	tmp := s.D.Id()
@@ -794,7 +795,7 @@ func (s *DatabaseDbSystemResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.TerminateDbSystem(context.Background(), request)
+	_, err := s.Client.TerminateDbSystem(ctx, request)
 	return err
 }
 
@@ -13,7 +13,8 @@ func DatabaseDbSystemShapesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readDatabaseDbSystemShapes,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"availability_domain": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -83,7 +84,7 @@ func readDatabaseDbSystemShapes(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DatabaseDbSystemShapesDataSourceCrud struct {
@@ -96,7 +97,7 @@ func (s *DatabaseDbSystemShapesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDbSystemShapesDataSourceCrud) Get() error {
+func (s *DatabaseDbSystemShapesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListDbSystemShapesRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -120,8 +121,9 @@ func (s *DatabaseDbSystemShapesDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListDbSystemShapes(context.Background(), request)
+	response, err := s.Client.ListDbSystemShapes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -129,8 +131,8 @@ func (s *DatabaseDbSystemShapesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListDbSystemShapes(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListDbSystemShapes(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -188,6 +190,8 @@ func (s *DatabaseDbSystemShapesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, DatabaseDbSystemShapesDataSource().Schema["db_system_shapes"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("db_system_shapes", resources); err != nil {
 		return err
 	}
@@ -13,7 +13,8 @@ func DatabaseDbVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readDatabaseDbVersions,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -70,7 +71,7 @@ func readDatabaseDbVersions(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DatabaseDbVersionsDataSourceCrud struct {
@@ -83,7 +84,7 @@ func (s *DatabaseDbVersionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDbVersionsDataSourceCrud) Get() error {
+func (s *DatabaseDbVersionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListDbVersionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -112,8 +113,9 @@ func (s *DatabaseDbVersionsDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListDbVersions(context.Background(), request)
+	response, err := s.Client.ListDbVersions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -121,8 +123,8 @@ func (s *DatabaseDbVersionsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListDbVersions(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListDbVersions(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -164,6 +166,8 @@ func (s *DatabaseDbVersionsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, DatabaseDbVersionsDataSource().Schema["db_versions"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("db_versions", resources); err != nil {
 		return err
 	}
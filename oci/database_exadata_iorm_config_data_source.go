@@ -64,7 +64,7 @@ func readSingularDatabaseExadataIormConfig(d *schema.ResourceData, m interface{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DatabaseExadataIormConfigDataSourceCrud struct {
@@ -77,7 +77,7 @@ func (s *DatabaseExadataIormConfigDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseExadataIormConfigDataSourceCrud) Get() error {
+func (s *DatabaseExadataIormConfigDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetExadataIormConfigRequest{}
 
 	if dbSystemId, ok := s.D.GetOkExists("db_system_id"); ok {
@@ -87,7 +87,7 @@ func (s *DatabaseExadataIormConfigDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "database")
 
-	response, err := s.Client.GetExadataIormConfig(context.Background(), request)
+	response, err := s.Client.GetExadataIormConfig(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -16,6 +16,9 @@ import (
 
 func DatabaseExadataIormConfigResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importDatabaseExadataIormConfig,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createDatabaseExadataIormConfig,
 		Read:     readDatabaseExadataIormConfig,
@@ -88,7 +91,7 @@ func createDatabaseExadataIormConfig(d *schema.ResourceData, m interface{}) erro
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readDatabaseExadataIormConfig(d *schema.ResourceData, m interface{}) error {
@@ -96,7 +99,7 @@ func readDatabaseExadataIormConfig(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateDatabaseExadataIormConfig(d *schema.ResourceData, m interface{}) error {
@@ -104,7 +107,7 @@ func updateDatabaseExadataIormConfig(d *schema.ResourceData, m interface{}) erro
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteDatabaseExadataIormConfig(d *schema.ResourceData, m interface{}) error {
@@ -112,7 +115,13 @@ func deleteDatabaseExadataIormConfig(d *schema.ResourceData, m interface{}) erro
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
+}
+
+// importDatabaseExadataIormConfig imports the IORM configuration of an Exadata DB system by the OCID of the DB system
+func importDatabaseExadataIormConfig(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	d.Set("db_system_id", d.Id())
+	return []*schema.ResourceData{d}, nil
 }
 
 type DatabaseExadataIormConfigResourceCrud struct {
@@ -151,7 +160,7 @@ func (s *DatabaseExadataIormConfigResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DatabaseExadataIormConfigResourceCrud) Create() error {
+func (s *DatabaseExadataIormConfigResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.GetExadataIormConfigRequest{}
 
 	if dbSystemId, ok := s.D.GetOkExists("db_system_id"); ok {
@@ -161,21 +170,21 @@ func (s *DatabaseExadataIormConfigResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	if _, err := s.Client.GetExadataIormConfig(context.Background(), request); err != nil {
+	if _, err := s.Client.GetExadataIormConfig(ctx, request); err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool {
 		return s.Res.LifecycleState == oci_database.ExadataIormConfigLifecycleStateDisabled || s.Res.LifecycleState == oci_database.ExadataIormConfigLifecycleStateEnabled
 	}
-	if err := WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate)); err != nil {
+	if err := WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate)); err != nil {
 		return err
 	}
 
-	return s.Update()
+	return s.Update(ctx)
 }
 
-func (s *DatabaseExadataIormConfigResourceCrud) Get() error {
+func (s *DatabaseExadataIormConfigResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetExadataIormConfigRequest{}
 
 	if dbSystemId, ok := s.D.GetOkExists("db_system_id"); ok {
@@ -185,7 +194,7 @@ func (s *DatabaseExadataIormConfigResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetExadataIormConfig(context.Background(), request)
+	response, err := s.Client.GetExadataIormConfig(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -194,7 +203,7 @@ func (s *DatabaseExadataIormConfigResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseExadataIormConfigResourceCrud) Update() error {
+func (s *DatabaseExadataIormConfigResourceCrud) Update(ctx context.Context) error {
 	request := oci_database.UpdateExadataIormConfigRequest{}
 
 	request.DbPlans = []oci_database.DbIormConfigUpdateDetail{}
@@ -225,15 +234,15 @@ func (s *DatabaseExadataIormConfigResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	if _, err := s.Client.UpdateExadataIormConfig(context.Background(), request); err != nil {
+	if _, err := s.Client.UpdateExadataIormConfig(ctx, request); err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_database.ExadataIormConfigLifecycleStateEnabled }
-	return WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DatabaseExadataIormConfigResourceCrud) Delete() error {
+func (s *DatabaseExadataIormConfigResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.UpdateExadataIormConfigRequest{}
 
 	if dbSystemId, ok := s.D.GetOkExists("db_system_id"); ok {
@@ -243,7 +252,7 @@ func (s *DatabaseExadataIormConfigResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateExadataIormConfig(context.Background(), request)
+	response, err := s.Client.UpdateExadataIormConfig(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -23,6 +23,9 @@ import (
 
 func DnsRecordResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importDnsRecord,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createDnsRecord,
 		Read:     readDnsRecord,
@@ -88,7 +91,7 @@ func createDnsRecord(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).dnsClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readDnsRecord(d *schema.ResourceData, m interface{}) error {
@@ -96,7 +99,7 @@ func readDnsRecord(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).dnsClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateDnsRecord(d *schema.ResourceData, m interface{}) error {
@@ -104,7 +107,7 @@ func updateDnsRecord(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).dnsClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteDnsRecord(d *schema.ResourceData, m interface{}) error {
@@ -113,7 +116,23 @@ func deleteDnsRecord(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).dnsClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
+}
+
+// importDnsRecord imports a record of a zone, with an ID of the form
+// zoneNameOrId/{zoneNameOrId}/domain/{domain}/rtype/{rtype}/recordHash/{recordHash}
+func importDnsRecord(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	values, err := parseCompositeId(d.Id(), "zoneNameOrId", "domain", "rtype", "recordHash")
+	if err != nil {
+		return nil, err
+	}
+	d.Set("zone_name_or_id", values[0])
+	d.Set("domain", values[1])
+	d.Set("rtype", values[2])
+	d.Set("record_hash", values[3])
+	d.SetId(values[3])
+
+	return []*schema.ResourceData{d}, nil
 }
 
 type DnsRecordResourceCrud struct {
@@ -127,7 +146,7 @@ func (s *DnsRecordResourceCrud) ID() string {
 	return s.D.Get("record_hash").(string)
 }
 
-func (s *DnsRecordResourceCrud) Create() error {
+func (s *DnsRecordResourceCrud) Create(ctx context.Context) error {
 	request := oci_dns.PatchRRSetRequest{}
 	ro := oci_dns.RecordOperation{Operation: oci_dns.RecordOperationOperationAdd}
 
@@ -160,7 +179,7 @@ func (s *DnsRecordResourceCrud) Create() error {
 	request.Items = []oci_dns.RecordOperation{ro}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
-	response, err := s.Client.PatchRRSet(context.Background(), request)
+	response, err := s.Client.PatchRRSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -171,7 +190,7 @@ func (s *DnsRecordResourceCrud) Create() error {
 
 	if err != nil {
 		//PatchZoneRecords only returns 50 records we need to do a Get with pagination to make sure we can't find the item
-		err = s.Get()
+		err = s.Get(ctx)
 		if err == nil {
 			return nil
 		}
@@ -191,7 +210,7 @@ func (s *DnsRecordResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DnsRecordResourceCrud) Get() error {
+func (s *DnsRecordResourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.GetRRSetRequest{}
 
 	zoneNameOrId := s.D.Get("zone_name_or_id").(string)
@@ -212,7 +231,7 @@ func (s *DnsRecordResourceCrud) Get() error {
 
 	var err error
 	for true {
-		response, err := s.Client.GetRRSet(context.Background(), request)
+		response, err := s.Client.GetRRSet(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -229,7 +248,7 @@ func (s *DnsRecordResourceCrud) Get() error {
 	return err
 }
 
-func (s *DnsRecordResourceCrud) Update() error {
+func (s *DnsRecordResourceCrud) Update(ctx context.Context) error {
 	zoneNameOrId := s.D.Get("zone_name_or_id").(string)
 	domain := s.D.Get("domain").(string)
 	rtype := s.D.Get("rtype").(string)
@@ -261,7 +280,7 @@ func (s *DnsRecordResourceCrud) Update() error {
 	request.Items = []oci_dns.RecordOperation{removeOp, addOp}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
-	response, err := s.Client.PatchRRSet(context.Background(), request)
+	response, err := s.Client.PatchRRSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -269,7 +288,7 @@ func (s *DnsRecordResourceCrud) Update() error {
 
 	if err != nil {
 		//PatchZoneRecords only returns 50 records we need to do a Get with pagination to make sure we can't find the item
-		err = s.Get()
+		err = s.Get(ctx)
 		if err == nil {
 			return nil
 		}
@@ -280,7 +299,7 @@ func (s *DnsRecordResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DnsRecordResourceCrud) Delete() error {
+func (s *DnsRecordResourceCrud) Delete(ctx context.Context) error {
 	request := oci_dns.PatchRRSetRequest{}
 	ro := oci_dns.RecordOperation{Operation: oci_dns.RecordOperationOperationRemove}
 
@@ -306,7 +325,7 @@ func (s *DnsRecordResourceCrud) Delete() error {
 	request.Items = []oci_dns.RecordOperation{ro}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
-	_, err := s.Client.PatchRRSet(context.Background(), request)
+	_, err := s.Client.PatchRRSet(ctx, request)
 	return err
 }
 
@@ -142,6 +142,17 @@ func TestDnsRecordsResource_basic(t *testing.T) {
 					},
 				),
 			},
+			// verify resource import
+			{
+				Config:            config,
+				ImportState:       true,
+				ImportStateIdFunc: getImportCompositeIdFunc(resourceName, "zoneNameOrId", "zone_name_or_id", "domain", "domain", "rtype", "rtype", "recordHash", "record_hash"),
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"compartment_id",
+				},
+				ResourceName: resourceName,
+			},
 		},
 	})
 }
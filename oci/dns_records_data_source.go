@@ -13,7 +13,8 @@ func DnsRecordsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readDnsRecords,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 
 			// Required
 			"zone_name_or_id": {
@@ -66,7 +67,7 @@ func readDnsRecords(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).dnsClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DnsRecordsDataSourceCrud struct {
@@ -79,7 +80,7 @@ func (s *DnsRecordsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DnsRecordsDataSourceCrud) Get() error {
+func (s *DnsRecordsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.GetZoneRecordsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -123,8 +124,9 @@ func (s *DnsRecordsDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "dns")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.GetZoneRecords(context.Background(), request)
+	response, err := s.Client.GetZoneRecords(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -132,8 +134,8 @@ func (s *DnsRecordsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.GetZoneRecords(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.GetZoneRecords(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -190,6 +192,8 @@ func (s *DnsRecordsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, DnsRecordsDataSource().Schema["records"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("records", resources); err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_common "github.com/oracle/oci-go-sdk/common"
 	oci_dns "github.com/oracle/oci-go-sdk/dns"
 )
@@ -16,7 +17,8 @@ func DnsSteeringPoliciesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readDnsSteeringPolicies,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -58,6 +60,23 @@ func DnsSteeringPoliciesDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(DnsSteeringPolicyResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_dns.ListSteeringPoliciesSortByDisplayname),
+					string(oci_dns.ListSteeringPoliciesSortByTimecreated),
+					string(oci_dns.ListSteeringPoliciesSortByTemplate),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_dns.ListSteeringPoliciesSortOrderAsc),
+					string(oci_dns.ListSteeringPoliciesSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -67,7 +86,7 @@ func readDnsSteeringPolicies(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).dnsClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DnsSteeringPoliciesDataSourceCrud struct {
@@ -80,7 +99,7 @@ func (s *DnsSteeringPoliciesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DnsSteeringPoliciesDataSourceCrud) Get() error {
+func (s *DnsSteeringPoliciesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.ListSteeringPoliciesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -133,9 +152,18 @@ func (s *DnsSteeringPoliciesDataSourceCrud) Get() error {
 		request.TimeCreatedLessThan = &oci_common.SDKTime{Time: tmp}
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_dns.ListSteeringPoliciesSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_dns.ListSteeringPoliciesSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "dns")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListSteeringPolicies(context.Background(), request)
+	response, err := s.Client.ListSteeringPolicies(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -143,8 +171,8 @@ func (s *DnsSteeringPoliciesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListSteeringPolicies(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListSteeringPolicies(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -210,6 +238,8 @@ func (s *DnsSteeringPoliciesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, DnsSteeringPoliciesDataSource().Schema["steering_policies"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("steering_policies", resources); err != nil {
 		return err
 	}
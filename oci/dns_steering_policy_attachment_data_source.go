@@ -66,7 +66,7 @@ func readSingularDnsSteeringPolicyAttachment(d *schema.ResourceData, m interface
 	sync.D = d
 	sync.Client = m.(*OracleClients).dnsClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DnsSteeringPolicyAttachmentDataSourceCrud struct {
@@ -79,7 +79,7 @@ func (s *DnsSteeringPolicyAttachmentDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DnsSteeringPolicyAttachmentDataSourceCrud) Get() error {
+func (s *DnsSteeringPolicyAttachmentDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.GetSteeringPolicyAttachmentRequest{}
 
 	if steeringPolicyAttachmentId, ok := s.D.GetOkExists("steering_policy_attachment_id"); ok {
@@ -89,7 +89,7 @@ func (s *DnsSteeringPolicyAttachmentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "dns")
 
-	response, err := s.Client.GetSteeringPolicyAttachment(context.Background(), request)
+	response, err := s.Client.GetSteeringPolicyAttachment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -77,7 +77,7 @@ func createDnsSteeringPolicyAttachment(d *schema.ResourceData, m interface{}) er
 	sync.D = d
 	sync.Client = m.(*OracleClients).dnsClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readDnsSteeringPolicyAttachment(d *schema.ResourceData, m interface{}) error {
@@ -85,7 +85,7 @@ func readDnsSteeringPolicyAttachment(d *schema.ResourceData, m interface{}) erro
 	sync.D = d
 	sync.Client = m.(*OracleClients).dnsClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateDnsSteeringPolicyAttachment(d *schema.ResourceData, m interface{}) error {
@@ -93,7 +93,7 @@ func updateDnsSteeringPolicyAttachment(d *schema.ResourceData, m interface{}) er
 	sync.D = d
 	sync.Client = m.(*OracleClients).dnsClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteDnsSteeringPolicyAttachment(d *schema.ResourceData, m interface{}) error {
@@ -102,7 +102,7 @@ func deleteDnsSteeringPolicyAttachment(d *schema.ResourceData, m interface{}) er
 	sync.Client = m.(*OracleClients).dnsClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type DnsSteeringPolicyAttachmentResourceCrud struct {
@@ -138,7 +138,7 @@ func (s *DnsSteeringPolicyAttachmentResourceCrud) DeletedTarget() []string {
 	return []string{}
 }
 
-func (s *DnsSteeringPolicyAttachmentResourceCrud) Create() error {
+func (s *DnsSteeringPolicyAttachmentResourceCrud) Create(ctx context.Context) error {
 	request := oci_dns.CreateSteeringPolicyAttachmentRequest{}
 
 	if displayName, ok := s.D.GetOkExists("display_name"); ok {
@@ -163,7 +163,7 @@ func (s *DnsSteeringPolicyAttachmentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.CreateSteeringPolicyAttachment(context.Background(), request)
+	response, err := s.Client.CreateSteeringPolicyAttachment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -172,7 +172,7 @@ func (s *DnsSteeringPolicyAttachmentResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DnsSteeringPolicyAttachmentResourceCrud) Get() error {
+func (s *DnsSteeringPolicyAttachmentResourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.GetSteeringPolicyAttachmentRequest{}
 
 	tmp := s.D.Id()
@@ -180,7 +180,7 @@ func (s *DnsSteeringPolicyAttachmentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.GetSteeringPolicyAttachment(context.Background(), request)
+	response, err := s.Client.GetSteeringPolicyAttachment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -189,7 +189,7 @@ func (s *DnsSteeringPolicyAttachmentResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DnsSteeringPolicyAttachmentResourceCrud) Update() error {
+func (s *DnsSteeringPolicyAttachmentResourceCrud) Update(ctx context.Context) error {
 	request := oci_dns.UpdateSteeringPolicyAttachmentRequest{}
 
 	if displayName, ok := s.D.GetOkExists("display_name"); ok {
@@ -202,7 +202,7 @@ func (s *DnsSteeringPolicyAttachmentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateSteeringPolicyAttachment(context.Background(), request)
+	response, err := s.Client.UpdateSteeringPolicyAttachment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -211,7 +211,7 @@ func (s *DnsSteeringPolicyAttachmentResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DnsSteeringPolicyAttachmentResourceCrud) Delete() error {
+func (s *DnsSteeringPolicyAttachmentResourceCrud) Delete(ctx context.Context) error {
 	request := oci_dns.DeleteSteeringPolicyAttachmentRequest{}
 
 	tmp := s.D.Id()
@@ -219,7 +219,7 @@ func (s *DnsSteeringPolicyAttachmentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.DeleteSteeringPolicyAttachment(context.Background(), request)
+	_, err := s.Client.DeleteSteeringPolicyAttachment(ctx, request)
 	return err
 }
 
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_common "github.com/oracle/oci-go-sdk/common"
 	oci_dns "github.com/oracle/oci-go-sdk/dns"
 )
@@ -15,7 +16,8 @@ func DnsSteeringPolicyAttachmentsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readDnsSteeringPolicyAttachments,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -61,6 +63,23 @@ func DnsSteeringPolicyAttachmentsDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(DnsSteeringPolicyAttachmentResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_dns.ListSteeringPolicyAttachmentsSortByDisplayname),
+					string(oci_dns.ListSteeringPolicyAttachmentsSortByTimecreated),
+					string(oci_dns.ListSteeringPolicyAttachmentsSortByDomainname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_dns.ListSteeringPolicyAttachmentsSortOrderAsc),
+					string(oci_dns.ListSteeringPolicyAttachmentsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -70,7 +89,7 @@ func readDnsSteeringPolicyAttachments(d *schema.ResourceData, m interface{}) err
 	sync.D = d
 	sync.Client = m.(*OracleClients).dnsClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DnsSteeringPolicyAttachmentsDataSourceCrud struct {
@@ -83,7 +102,7 @@ func (s *DnsSteeringPolicyAttachmentsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DnsSteeringPolicyAttachmentsDataSourceCrud) Get() error {
+func (s *DnsSteeringPolicyAttachmentsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.ListSteeringPolicyAttachmentsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -141,9 +160,18 @@ func (s *DnsSteeringPolicyAttachmentsDataSourceCrud) Get() error {
 		request.ZoneId = &tmp
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_dns.ListSteeringPolicyAttachmentsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_dns.ListSteeringPolicyAttachmentsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "dns")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListSteeringPolicyAttachments(context.Background(), request)
+	response, err := s.Client.ListSteeringPolicyAttachments(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -151,8 +179,8 @@ func (s *DnsSteeringPolicyAttachmentsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListSteeringPolicyAttachments(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListSteeringPolicyAttachments(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -216,6 +244,8 @@ func (s *DnsSteeringPolicyAttachmentsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, DnsSteeringPolicyAttachmentsDataSource().Schema["steering_policy_attachments"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("steering_policy_attachments", resources); err != nil {
 		return err
 	}
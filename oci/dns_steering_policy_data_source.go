@@ -222,7 +222,7 @@ func readSingularDnsSteeringPolicy(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).dnsClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DnsSteeringPolicyDataSourceCrud struct {
@@ -235,7 +235,7 @@ func (s *DnsSteeringPolicyDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DnsSteeringPolicyDataSourceCrud) Get() error {
+func (s *DnsSteeringPolicyDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.GetSteeringPolicyRequest{}
 
 	if steeringPolicyId, ok := s.D.GetOkExists("steering_policy_id"); ok {
@@ -245,7 +245,7 @@ func (s *DnsSteeringPolicyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "dns")
 
-	response, err := s.Client.GetSteeringPolicy(context.Background(), request)
+	response, err := s.Client.GetSteeringPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -261,7 +261,7 @@ func createDnsSteeringPolicy(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).dnsClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readDnsSteeringPolicy(d *schema.ResourceData, m interface{}) error {
@@ -269,7 +269,7 @@ func readDnsSteeringPolicy(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).dnsClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateDnsSteeringPolicy(d *schema.ResourceData, m interface{}) error {
@@ -277,7 +277,7 @@ func updateDnsSteeringPolicy(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).dnsClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteDnsSteeringPolicy(d *schema.ResourceData, m interface{}) error {
@@ -286,7 +286,7 @@ func deleteDnsSteeringPolicy(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).dnsClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type DnsSteeringPolicyResourceCrud struct {
@@ -324,7 +324,7 @@ func (s *DnsSteeringPolicyResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DnsSteeringPolicyResourceCrud) Create() error {
+func (s *DnsSteeringPolicyResourceCrud) Create(ctx context.Context) error {
 	request := oci_dns.CreateSteeringPolicyRequest{}
 
 	request.Answers = []oci_dns.SteeringPolicyAnswer{}
@@ -397,7 +397,7 @@ func (s *DnsSteeringPolicyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.CreateSteeringPolicy(context.Background(), request)
+	response, err := s.Client.CreateSteeringPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -406,7 +406,7 @@ func (s *DnsSteeringPolicyResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DnsSteeringPolicyResourceCrud) Get() error {
+func (s *DnsSteeringPolicyResourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.GetSteeringPolicyRequest{}
 
 	tmp := s.D.Id()
@@ -414,7 +414,7 @@ func (s *DnsSteeringPolicyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.GetSteeringPolicy(context.Background(), request)
+	response, err := s.Client.GetSteeringPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -423,7 +423,7 @@ func (s *DnsSteeringPolicyResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DnsSteeringPolicyResourceCrud) Update() error {
+func (s *DnsSteeringPolicyResourceCrud) Update(ctx context.Context) error {
 	request := oci_dns.UpdateSteeringPolicyRequest{}
 
 	/* Do not uncomment until we get a solution to the terraform deficiency
@@ -501,7 +501,7 @@ func (s *DnsSteeringPolicyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateSteeringPolicy(context.Background(), request)
+	response, err := s.Client.UpdateSteeringPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -510,7 +510,7 @@ func (s *DnsSteeringPolicyResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DnsSteeringPolicyResourceCrud) Delete() error {
+func (s *DnsSteeringPolicyResourceCrud) Delete(ctx context.Context) error {
 	request := oci_dns.DeleteSteeringPolicyRequest{}
 
 	tmp := s.D.Id()
@@ -518,7 +518,7 @@ func (s *DnsSteeringPolicyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.DeleteSteeringPolicy(context.Background(), request)
+	_, err := s.Client.DeleteSteeringPolicy(ctx, request)
 	return err
 }
 
@@ -156,7 +156,7 @@ func createDnsZone(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).dnsClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readDnsZone(d *schema.ResourceData, m interface{}) error {
@@ -164,7 +164,7 @@ func readDnsZone(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).dnsClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateDnsZone(d *schema.ResourceData, m interface{}) error {
@@ -172,7 +172,7 @@ func updateDnsZone(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).dnsClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteDnsZone(d *schema.ResourceData, m interface{}) error {
@@ -181,7 +181,7 @@ func deleteDnsZone(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).dnsClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type DnsZoneResourceCrud struct {
@@ -195,7 +195,7 @@ func (s *DnsZoneResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *DnsZoneResourceCrud) Create() error {
+func (s *DnsZoneResourceCrud) Create(ctx context.Context) error {
 	request := oci_dns.CreateZoneRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -242,7 +242,7 @@ func (s *DnsZoneResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.CreateZone(context.Background(), request)
+	response, err := s.Client.CreateZone(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -251,7 +251,7 @@ func (s *DnsZoneResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DnsZoneResourceCrud) Get() error {
+func (s *DnsZoneResourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.GetZoneRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -264,7 +264,7 @@ func (s *DnsZoneResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.GetZone(context.Background(), request)
+	response, err := s.Client.GetZone(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -273,7 +273,7 @@ func (s *DnsZoneResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DnsZoneResourceCrud) Update() error {
+func (s *DnsZoneResourceCrud) Update(ctx context.Context) error {
 	request := oci_dns.UpdateZoneRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -314,7 +314,7 @@ func (s *DnsZoneResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateZone(context.Background(), request)
+	response, err := s.Client.UpdateZone(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -323,7 +323,7 @@ func (s *DnsZoneResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DnsZoneResourceCrud) Delete() error {
+func (s *DnsZoneResourceCrud) Delete(ctx context.Context) error {
 	request := oci_dns.DeleteZoneRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -335,7 +335,7 @@ func (s *DnsZoneResourceCrud) Delete() error {
 	request.ZoneNameOrId = &tmp
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
-	_, err := s.Client.DeleteZone(context.Background(), request)
+	_, err := s.Client.DeleteZone(ctx, request)
 	return err
 }
 
@@ -15,7 +15,8 @@ func DnsZonesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readDnsZones,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 
 			// Required
 			"compartment_id": {
@@ -72,7 +73,7 @@ func readDnsZones(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).dnsClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DnsZonesDataSourceCrud struct {
@@ -85,7 +86,7 @@ func (s *DnsZonesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DnsZonesDataSourceCrud) Get() error {
+func (s *DnsZonesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.ListZonesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -138,8 +139,9 @@ func (s *DnsZonesDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "dns")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListZones(context.Background(), request)
+	response, err := s.Client.ListZones(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -147,8 +149,8 @@ func (s *DnsZonesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListZones(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListZones(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -210,6 +212,8 @@ func (s *DnsZonesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, DnsZonesDataSource().Schema["zones"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("zones", resources); err != nil {
 		return err
 	}
@@ -57,7 +57,7 @@ func readSingularEmailSender(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).emailClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type EmailSenderDataSourceCrud struct {
@@ -70,7 +70,7 @@ func (s *EmailSenderDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *EmailSenderDataSourceCrud) Get() error {
+func (s *EmailSenderDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_email.GetSenderRequest{}
 
 	if senderId, ok := s.D.GetOkExists("sender_id"); ok {
@@ -80,7 +80,7 @@ func (s *EmailSenderDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "email")
 
-	response, err := s.Client.GetSender(context.Background(), request)
+	response, err := s.Client.GetSender(ctx, request)
 	if err != nil {
 		return err
 	}
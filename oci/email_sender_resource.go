@@ -70,7 +70,7 @@ func createEmailSender(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).emailClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readEmailSender(d *schema.ResourceData, m interface{}) error {
@@ -78,7 +78,7 @@ func readEmailSender(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).emailClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateEmailSender(d *schema.ResourceData, m interface{}) error {
@@ -86,7 +86,7 @@ func updateEmailSender(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).emailClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteEmailSender(d *schema.ResourceData, m interface{}) error {
@@ -95,7 +95,7 @@ func deleteEmailSender(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).emailClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type EmailSenderResourceCrud struct {
@@ -133,7 +133,7 @@ func (s *EmailSenderResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *EmailSenderResourceCrud) Create() error {
+func (s *EmailSenderResourceCrud) Create(ctx context.Context) error {
 	request := oci_email.CreateSenderRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -160,7 +160,7 @@ func (s *EmailSenderResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.CreateSender(context.Background(), request)
+	response, err := s.Client.CreateSender(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -169,7 +169,7 @@ func (s *EmailSenderResourceCrud) Create() error {
 	return nil
 }
 
-func (s *EmailSenderResourceCrud) Get() error {
+func (s *EmailSenderResourceCrud) Get(ctx context.Context) error {
 	request := oci_email.GetSenderRequest{}
 
 	tmp := s.D.Id()
@@ -177,7 +177,7 @@ func (s *EmailSenderResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.GetSender(context.Background(), request)
+	response, err := s.Client.GetSender(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -186,7 +186,7 @@ func (s *EmailSenderResourceCrud) Get() error {
 	return nil
 }
 
-func (s *EmailSenderResourceCrud) Update() error {
+func (s *EmailSenderResourceCrud) Update(ctx context.Context) error {
 	request := oci_email.UpdateSenderRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -206,7 +206,7 @@ func (s *EmailSenderResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.UpdateSender(context.Background(), request)
+	response, err := s.Client.UpdateSender(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -215,7 +215,7 @@ func (s *EmailSenderResourceCrud) Update() error {
 	return nil
 }
 
-func (s *EmailSenderResourceCrud) Delete() error {
+func (s *EmailSenderResourceCrud) Delete(ctx context.Context) error {
 	request := oci_email.DeleteSenderRequest{}
 
 	tmp := s.D.Id()
@@ -223,7 +223,7 @@ func (s *EmailSenderResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	_, err := s.Client.DeleteSender(context.Background(), request)
+	_, err := s.Client.DeleteSender(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_email "github.com/oracle/oci-go-sdk/email"
 )
 
@@ -13,7 +14,8 @@ func EmailSendersDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readEmailSenders,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -31,6 +33,22 @@ func EmailSendersDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(EmailSenderResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_email.ListSendersSortByTimecreated),
+					string(oci_email.ListSendersSortByEmailaddress),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_email.ListSendersSortOrderAsc),
+					string(oci_email.ListSendersSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -40,7 +58,7 @@ func readEmailSenders(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).emailClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type EmailSendersDataSourceCrud struct {
@@ -53,7 +71,7 @@ func (s *EmailSendersDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *EmailSendersDataSourceCrud) Get() error {
+func (s *EmailSendersDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_email.ListSendersRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -70,9 +88,18 @@ func (s *EmailSendersDataSourceCrud) Get() error {
 		request.LifecycleState = oci_email.SenderLifecycleStateEnum(state.(string))
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_email.ListSendersSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_email.ListSendersSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "email")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListSenders(context.Background(), request)
+	response, err := s.Client.ListSenders(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -80,8 +107,8 @@ func (s *EmailSendersDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListSenders(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListSenders(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -133,6 +160,8 @@ func (s *EmailSendersDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, EmailSendersDataSource().Schema["senders"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("senders", resources); err != nil {
 		return err
 	}
@@ -43,7 +43,7 @@ func readSingularEmailSuppression(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).emailClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type EmailSuppressionDataSourceCrud struct {
@@ -56,7 +56,7 @@ func (s *EmailSuppressionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *EmailSuppressionDataSourceCrud) Get() error {
+func (s *EmailSuppressionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_email.GetSuppressionRequest{}
 
 	if suppressionId, ok := s.D.GetOkExists("suppression_id"); ok {
@@ -66,7 +66,7 @@ func (s *EmailSuppressionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "email")
 
-	response, err := s.Client.GetSuppression(context.Background(), request)
+	response, err := s.Client.GetSuppression(ctx, request)
 	if err != nil {
 		return err
 	}
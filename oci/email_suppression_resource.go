@@ -52,7 +52,7 @@ func createEmailSuppression(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).emailClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readEmailSuppression(d *schema.ResourceData, m interface{}) error {
@@ -60,7 +60,7 @@ func readEmailSuppression(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).emailClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func deleteEmailSuppression(d *schema.ResourceData, m interface{}) error {
@@ -69,7 +69,7 @@ func deleteEmailSuppression(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).emailClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type EmailSuppressionResourceCrud struct {
@@ -83,7 +83,7 @@ func (s *EmailSuppressionResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *EmailSuppressionResourceCrud) Create() error {
+func (s *EmailSuppressionResourceCrud) Create(ctx context.Context) error {
 	request := oci_email.CreateSuppressionRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -98,7 +98,7 @@ func (s *EmailSuppressionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.CreateSuppression(context.Background(), request)
+	response, err := s.Client.CreateSuppression(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -107,7 +107,7 @@ func (s *EmailSuppressionResourceCrud) Create() error {
 	return nil
 }
 
-func (s *EmailSuppressionResourceCrud) Get() error {
+func (s *EmailSuppressionResourceCrud) Get(ctx context.Context) error {
 	request := oci_email.GetSuppressionRequest{}
 
 	tmp := s.D.Id()
@@ -115,7 +115,7 @@ func (s *EmailSuppressionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.GetSuppression(context.Background(), request)
+	response, err := s.Client.GetSuppression(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -124,7 +124,7 @@ func (s *EmailSuppressionResourceCrud) Get() error {
 	return nil
 }
 
-func (s *EmailSuppressionResourceCrud) Delete() error {
+func (s *EmailSuppressionResourceCrud) Delete(ctx context.Context) error {
 	request := oci_email.DeleteSuppressionRequest{}
 
 	tmp := s.D.Id()
@@ -132,7 +132,7 @@ func (s *EmailSuppressionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	_, err := s.Client.DeleteSuppression(context.Background(), request)
+	_, err := s.Client.DeleteSuppression(ctx, request)
 	return err
 }
 
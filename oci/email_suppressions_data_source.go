@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_common "github.com/oracle/oci-go-sdk/common"
 	oci_email "github.com/oracle/oci-go-sdk/email"
 )
@@ -15,7 +16,8 @@ func EmailSuppressionsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readEmailSuppressions,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -37,6 +39,22 @@ func EmailSuppressionsDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(EmailSuppressionResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_email.ListSuppressionsSortByTimecreated),
+					string(oci_email.ListSuppressionsSortByEmailaddress),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_email.ListSuppressionsSortOrderAsc),
+					string(oci_email.ListSuppressionsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -46,7 +64,7 @@ func readEmailSuppressions(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).emailClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type EmailSuppressionsDataSourceCrud struct {
@@ -59,7 +77,7 @@ func (s *EmailSuppressionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *EmailSuppressionsDataSourceCrud) Get() error {
+func (s *EmailSuppressionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_email.ListSuppressionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -88,9 +106,18 @@ func (s *EmailSuppressionsDataSourceCrud) Get() error {
 		request.TimeCreatedLessThan = &oci_common.SDKTime{Time: tmp}
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_email.ListSuppressionsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_email.ListSuppressionsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "email")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListSuppressions(context.Background(), request)
+	response, err := s.Client.ListSuppressions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -98,8 +125,8 @@ func (s *EmailSuppressionsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListSuppressions(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListSuppressions(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -145,6 +172,8 @@ func (s *EmailSuppressionsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, EmailSuppressionsDataSource().Schema["suppressions"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("suppressions", resources); err != nil {
 		return err
 	}
@@ -107,7 +107,7 @@ func createFileStorageExport(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).fileStorageClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readFileStorageExport(d *schema.ResourceData, m interface{}) error {
@@ -115,7 +115,7 @@ func readFileStorageExport(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).fileStorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateFileStorageExport(d *schema.ResourceData, m interface{}) error {
@@ -123,7 +123,7 @@ func updateFileStorageExport(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).fileStorageClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteFileStorageExport(d *schema.ResourceData, m interface{}) error {
@@ -132,7 +132,7 @@ func deleteFileStorageExport(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).fileStorageClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type FileStorageExportResourceCrud struct {
@@ -170,7 +170,7 @@ func (s *FileStorageExportResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *FileStorageExportResourceCrud) Create() error {
+func (s *FileStorageExportResourceCrud) Create(ctx context.Context) error {
 	request := oci_file_storage.CreateExportRequest{}
 
 	request.ExportOptions = []oci_file_storage.ClientOptions{}
@@ -206,7 +206,7 @@ func (s *FileStorageExportResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.CreateExport(context.Background(), request)
+	response, err := s.Client.CreateExport(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -215,7 +215,7 @@ func (s *FileStorageExportResourceCrud) Create() error {
 	return nil
 }
 
-func (s *FileStorageExportResourceCrud) Get() error {
+func (s *FileStorageExportResourceCrud) Get(ctx context.Context) error {
 	request := oci_file_storage.GetExportRequest{}
 
 	tmp := s.D.Id()
@@ -223,7 +223,7 @@ func (s *FileStorageExportResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.GetExport(context.Background(), request)
+	response, err := s.Client.GetExport(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -232,7 +232,7 @@ func (s *FileStorageExportResourceCrud) Get() error {
 	return nil
 }
 
-func (s *FileStorageExportResourceCrud) Update() error {
+func (s *FileStorageExportResourceCrud) Update(ctx context.Context) error {
 	request := oci_file_storage.UpdateExportRequest{}
 
 	tmp := s.D.Id()
@@ -256,7 +256,7 @@ func (s *FileStorageExportResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.UpdateExport(context.Background(), request)
+	response, err := s.Client.UpdateExport(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -265,7 +265,7 @@ func (s *FileStorageExportResourceCrud) Update() error {
 	return nil
 }
 
-func (s *FileStorageExportResourceCrud) Delete() error {
+func (s *FileStorageExportResourceCrud) Delete(ctx context.Context) error {
 	request := oci_file_storage.DeleteExportRequest{}
 
 	tmp := s.D.Id()
@@ -273,7 +273,7 @@ func (s *FileStorageExportResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	_, err := s.Client.DeleteExport(context.Background(), request)
+	_, err := s.Client.DeleteExport(ctx, request)
 	return err
 }
 
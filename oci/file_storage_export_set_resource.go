@@ -81,7 +81,7 @@ func createFileStorageExportSet(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).fileStorageClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readFileStorageExportSet(d *schema.ResourceData, m interface{}) error {
@@ -89,7 +89,7 @@ func readFileStorageExportSet(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).fileStorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateFileStorageExportSet(d *schema.ResourceData, m interface{}) error {
@@ -97,7 +97,7 @@ func updateFileStorageExportSet(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).fileStorageClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteFileStorageExportSet(d *schema.ResourceData, m interface{}) error {
@@ -116,7 +116,7 @@ func (s *FileStorageExportSetResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *FileStorageExportSetResourceCrud) Create() error {
+func (s *FileStorageExportSetResourceCrud) Create(ctx context.Context) error {
 	// We can't really create an ExportSet. We need to get the exportSetId from the MountTarget it is attached to.
 	if mountTargetId, ok := s.D.GetOkExists("mount_target_id"); ok {
 		tmp := mountTargetId.(string)
@@ -126,7 +126,7 @@ func (s *FileStorageExportSetResourceCrud) Create() error {
 
 		request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-		response, err := s.Client.GetMountTarget(context.Background(), request)
+		response, err := s.Client.GetMountTarget(ctx, request)
 		if err != nil {
 			return fmt.Errorf("getting mount target details failed with error: %s", err.Error())
 		}
@@ -138,13 +138,13 @@ func (s *FileStorageExportSetResourceCrud) Create() error {
 		}
 
 		s.D.SetId(*exportSetId)
-		return s.Update()
+		return s.Update(ctx)
 	}
 
 	return fmt.Errorf("no mount_target_id value could be found")
 }
 
-func (s *FileStorageExportSetResourceCrud) Get() error {
+func (s *FileStorageExportSetResourceCrud) Get(ctx context.Context) error {
 	request := oci_file_storage.GetExportSetRequest{}
 
 	tmp := s.D.Id()
@@ -152,7 +152,7 @@ func (s *FileStorageExportSetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.GetExportSet(context.Background(), request)
+	response, err := s.Client.GetExportSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -161,7 +161,7 @@ func (s *FileStorageExportSetResourceCrud) Get() error {
 	return nil
 }
 
-func (s *FileStorageExportSetResourceCrud) Update() error {
+func (s *FileStorageExportSetResourceCrud) Update(ctx context.Context) error {
 	request := oci_file_storage.UpdateExportSetRequest{}
 
 	if displayName, ok := s.D.GetOkExists("display_name"); ok {
@@ -192,7 +192,7 @@ func (s *FileStorageExportSetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.UpdateExportSet(context.Background(), request)
+	response, err := s.Client.UpdateExportSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -201,7 +201,7 @@ func (s *FileStorageExportSetResourceCrud) Update() error {
 	return nil
 }
 
-func (s *FileStorageExportSetResourceCrud) Delete() error {
+func (s *FileStorageExportSetResourceCrud) Delete(ctx context.Context) error {
 	// Export set is deleted when a mount target is deleted.
 	return nil
 }
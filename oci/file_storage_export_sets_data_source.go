@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_file_storage "github.com/oracle/oci-go-sdk/filestorage"
 )
 
@@ -13,7 +14,8 @@ func FileStorageExportSetsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readFileStorageExportSets,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"availability_domain": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -39,6 +41,22 @@ func FileStorageExportSetsDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(FileStorageExportSetResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_file_storage.ListExportSetsSortByTimecreated),
+					string(oci_file_storage.ListExportSetsSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_file_storage.ListExportSetsSortOrderAsc),
+					string(oci_file_storage.ListExportSetsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -48,7 +66,7 @@ func readFileStorageExportSets(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).fileStorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type FileStorageExportSetsDataSourceCrud struct {
@@ -61,7 +79,7 @@ func (s *FileStorageExportSetsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *FileStorageExportSetsDataSourceCrud) Get() error {
+func (s *FileStorageExportSetsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_file_storage.ListExportSetsRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -88,9 +106,18 @@ func (s *FileStorageExportSetsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_file_storage.ListExportSetsLifecycleStateEnum(state.(string))
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_file_storage.ListExportSetsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_file_storage.ListExportSetsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "file_storage")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListExportSets(context.Background(), request)
+	response, err := s.Client.ListExportSets(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -98,8 +125,8 @@ func (s *FileStorageExportSetsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListExportSets(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListExportSets(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -150,6 +177,8 @@ func (s *FileStorageExportSetsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, FileStorageExportSetsDataSource().Schema["export_sets"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("export_sets", resources); err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_file_storage "github.com/oracle/oci-go-sdk/filestorage"
 )
 
@@ -13,7 +14,8 @@ func FileStorageExportsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readFileStorageExports,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -39,6 +41,22 @@ func FileStorageExportsDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(FileStorageExportResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_file_storage.ListExportsSortByTimecreated),
+					string(oci_file_storage.ListExportsSortByPath),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_file_storage.ListExportsSortOrderAsc),
+					string(oci_file_storage.ListExportsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -48,7 +66,7 @@ func readFileStorageExports(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).fileStorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type FileStorageExportsDataSourceCrud struct {
@@ -61,7 +79,7 @@ func (s *FileStorageExportsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *FileStorageExportsDataSourceCrud) Get() error {
+func (s *FileStorageExportsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_file_storage.ListExportsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -88,9 +106,18 @@ func (s *FileStorageExportsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_file_storage.ListExportsLifecycleStateEnum(state.(string))
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_file_storage.ListExportsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_file_storage.ListExportsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "file_storage")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListExports(context.Background(), request)
+	response, err := s.Client.ListExports(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -98,8 +125,8 @@ func (s *FileStorageExportsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListExports(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListExports(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -151,6 +178,8 @@ func (s *FileStorageExportsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, FileStorageExportsDataSource().Schema["exports"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("exports", resources); err != nil {
 		return err
 	}
@@ -76,7 +76,7 @@ func createFileStorageFileSystem(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).fileStorageClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readFileStorageFileSystem(d *schema.ResourceData, m interface{}) error {
@@ -84,7 +84,7 @@ func readFileStorageFileSystem(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).fileStorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateFileStorageFileSystem(d *schema.ResourceData, m interface{}) error {
@@ -92,7 +92,7 @@ func updateFileStorageFileSystem(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).fileStorageClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteFileStorageFileSystem(d *schema.ResourceData, m interface{}) error {
@@ -101,7 +101,7 @@ func deleteFileStorageFileSystem(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).fileStorageClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type FileStorageFileSystemResourceCrud struct {
@@ -139,7 +139,7 @@ func (s *FileStorageFileSystemResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *FileStorageFileSystemResourceCrud) Create() error {
+func (s *FileStorageFileSystemResourceCrud) Create(ctx context.Context) error {
 	request := oci_file_storage.CreateFileSystemRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -171,7 +171,7 @@ func (s *FileStorageFileSystemResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.CreateFileSystem(context.Background(), request)
+	response, err := s.Client.CreateFileSystem(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -180,7 +180,7 @@ func (s *FileStorageFileSystemResourceCrud) Create() error {
 	return nil
 }
 
-func (s *FileStorageFileSystemResourceCrud) Get() error {
+func (s *FileStorageFileSystemResourceCrud) Get(ctx context.Context) error {
 	request := oci_file_storage.GetFileSystemRequest{}
 
 	tmp := s.D.Id()
@@ -188,7 +188,7 @@ func (s *FileStorageFileSystemResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.GetFileSystem(context.Background(), request)
+	response, err := s.Client.GetFileSystem(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -197,7 +197,7 @@ func (s *FileStorageFileSystemResourceCrud) Get() error {
 	return nil
 }
 
-func (s *FileStorageFileSystemResourceCrud) Update() error {
+func (s *FileStorageFileSystemResourceCrud) Update(ctx context.Context) error {
 	request := oci_file_storage.UpdateFileSystemRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -222,7 +222,7 @@ func (s *FileStorageFileSystemResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.UpdateFileSystem(context.Background(), request)
+	response, err := s.Client.UpdateFileSystem(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -231,7 +231,7 @@ func (s *FileStorageFileSystemResourceCrud) Update() error {
 	return nil
 }
 
-func (s *FileStorageFileSystemResourceCrud) Delete() error {
+func (s *FileStorageFileSystemResourceCrud) Delete(ctx context.Context) error {
 	request := oci_file_storage.DeleteFileSystemRequest{}
 
 	tmp := s.D.Id()
@@ -239,7 +239,7 @@ func (s *FileStorageFileSystemResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	_, err := s.Client.DeleteFileSystem(context.Background(), request)
+	_, err := s.Client.DeleteFileSystem(ctx, request)
 	return err
 }
 
@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_file_storage "github.com/oracle/oci-go-sdk/filestorage"
 )
 
@@ -14,7 +15,8 @@ func FileStorageFileSystemsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readFileStorageFileSystems,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"availability_domain": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -40,6 +42,22 @@ func FileStorageFileSystemsDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(FileStorageFileSystemResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_file_storage.ListFileSystemsSortByTimecreated),
+					string(oci_file_storage.ListFileSystemsSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_file_storage.ListFileSystemsSortOrderAsc),
+					string(oci_file_storage.ListFileSystemsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -49,7 +67,7 @@ func readFileStorageFileSystems(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).fileStorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type FileStorageFileSystemsDataSourceCrud struct {
@@ -62,7 +80,7 @@ func (s *FileStorageFileSystemsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *FileStorageFileSystemsDataSourceCrud) Get() error {
+func (s *FileStorageFileSystemsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_file_storage.ListFileSystemsRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -89,9 +107,18 @@ func (s *FileStorageFileSystemsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_file_storage.ListFileSystemsLifecycleStateEnum(state.(string))
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_file_storage.ListFileSystemsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_file_storage.ListFileSystemsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "file_storage")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListFileSystems(context.Background(), request)
+	response, err := s.Client.ListFileSystems(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -99,8 +126,8 @@ func (s *FileStorageFileSystemsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListFileSystems(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListFileSystems(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -151,6 +178,8 @@ func (s *FileStorageFileSystemsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, FileStorageFileSystemsDataSource().Schema["file_systems"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("file_systems", resources); err != nil {
 		return err
 	}
@@ -103,7 +103,7 @@ func createFileStorageMountTarget(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).fileStorageClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readFileStorageMountTarget(d *schema.ResourceData, m interface{}) error {
@@ -111,7 +111,7 @@ func readFileStorageMountTarget(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).fileStorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateFileStorageMountTarget(d *schema.ResourceData, m interface{}) error {
@@ -119,7 +119,7 @@ func updateFileStorageMountTarget(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).fileStorageClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteFileStorageMountTarget(d *schema.ResourceData, m interface{}) error {
@@ -128,7 +128,7 @@ func deleteFileStorageMountTarget(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).fileStorageClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type FileStorageMountTargetResourceCrud struct {
@@ -168,7 +168,7 @@ func (s *FileStorageMountTargetResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *FileStorageMountTargetResourceCrud) Create() error {
+func (s *FileStorageMountTargetResourceCrud) Create(ctx context.Context) error {
 	request := oci_file_storage.CreateMountTargetRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -215,7 +215,7 @@ func (s *FileStorageMountTargetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.CreateMountTarget(context.Background(), request)
+	response, err := s.Client.CreateMountTarget(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -224,7 +224,7 @@ func (s *FileStorageMountTargetResourceCrud) Create() error {
 	return nil
 }
 
-func (s *FileStorageMountTargetResourceCrud) Get() error {
+func (s *FileStorageMountTargetResourceCrud) Get(ctx context.Context) error {
 	request := oci_file_storage.GetMountTargetRequest{}
 
 	tmp := s.D.Id()
@@ -232,7 +232,7 @@ func (s *FileStorageMountTargetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.GetMountTarget(context.Background(), request)
+	response, err := s.Client.GetMountTarget(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -241,7 +241,7 @@ func (s *FileStorageMountTargetResourceCrud) Get() error {
 	return nil
 }
 
-func (s *FileStorageMountTargetResourceCrud) Update() error {
+func (s *FileStorageMountTargetResourceCrud) Update(ctx context.Context) error {
 	request := oci_file_storage.UpdateMountTargetRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -266,7 +266,7 @@ func (s *FileStorageMountTargetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.UpdateMountTarget(context.Background(), request)
+	response, err := s.Client.UpdateMountTarget(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -275,7 +275,7 @@ func (s *FileStorageMountTargetResourceCrud) Update() error {
 	return nil
 }
 
-func (s *FileStorageMountTargetResourceCrud) Delete() error {
+func (s *FileStorageMountTargetResourceCrud) Delete(ctx context.Context) error {
 	request := oci_file_storage.DeleteMountTargetRequest{}
 
 	tmp := s.D.Id()
@@ -283,7 +283,7 @@ func (s *FileStorageMountTargetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	_, err := s.Client.DeleteMountTarget(context.Background(), request)
+	_, err := s.Client.DeleteMountTarget(ctx, request)
 	return err
 }
 
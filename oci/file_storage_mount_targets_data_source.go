@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_file_storage "github.com/oracle/oci-go-sdk/filestorage"
 )
 
@@ -13,7 +14,8 @@ func FileStorageMountTargetsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readFileStorageMountTargets,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"availability_domain": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -43,6 +45,22 @@ func FileStorageMountTargetsDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(FileStorageMountTargetResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_file_storage.ListMountTargetsSortByTimecreated),
+					string(oci_file_storage.ListMountTargetsSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_file_storage.ListMountTargetsSortOrderAsc),
+					string(oci_file_storage.ListMountTargetsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -52,7 +70,7 @@ func readFileStorageMountTargets(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).fileStorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type FileStorageMountTargetsDataSourceCrud struct {
@@ -65,7 +83,7 @@ func (s *FileStorageMountTargetsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *FileStorageMountTargetsDataSourceCrud) Get() error {
+func (s *FileStorageMountTargetsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_file_storage.ListMountTargetsRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -97,9 +115,18 @@ func (s *FileStorageMountTargetsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_file_storage.ListMountTargetsLifecycleStateEnum(state.(string))
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_file_storage.ListMountTargetsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_file_storage.ListMountTargetsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "file_storage")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListMountTargets(context.Background(), request)
+	response, err := s.Client.ListMountTargets(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -107,8 +134,8 @@ func (s *FileStorageMountTargetsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListMountTargets(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListMountTargets(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -165,6 +192,8 @@ func (s *FileStorageMountTargetsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, FileStorageMountTargetsDataSource().Schema["mount_targets"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("mount_targets", resources); err != nil {
 		return err
 	}
@@ -23,7 +23,7 @@ func readSingularFileStorageSnapshot(d *schema.ResourceData, m interface{}) erro
 	sync.D = d
 	sync.Client = m.(*OracleClients).fileStorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type FileStorageSnapshotDataSourceCrud struct {
@@ -36,7 +36,7 @@ func (s *FileStorageSnapshotDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *FileStorageSnapshotDataSourceCrud) Get() error {
+func (s *FileStorageSnapshotDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_file_storage.GetSnapshotRequest{}
 
 	if snapshotId, ok := s.D.GetOkExists("snapshot_id"); ok {
@@ -46,7 +46,7 @@ func (s *FileStorageSnapshotDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "file_storage")
 
-	response, err := s.Client.GetSnapshot(context.Background(), request)
+	response, err := s.Client.GetSnapshot(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -66,7 +66,7 @@ func createFileStorageSnapshot(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).fileStorageClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readFileStorageSnapshot(d *schema.ResourceData, m interface{}) error {
@@ -74,7 +74,7 @@ func readFileStorageSnapshot(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).fileStorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateFileStorageSnapshot(d *schema.ResourceData, m interface{}) error {
@@ -82,7 +82,7 @@ func updateFileStorageSnapshot(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).fileStorageClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteFileStorageSnapshot(d *schema.ResourceData, m interface{}) error {
@@ -91,7 +91,7 @@ func deleteFileStorageSnapshot(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).fileStorageClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type FileStorageSnapshotResourceCrud struct {
@@ -129,7 +129,7 @@ func (s *FileStorageSnapshotResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *FileStorageSnapshotResourceCrud) Create() error {
+func (s *FileStorageSnapshotResourceCrud) Create(ctx context.Context) error {
 	request := oci_file_storage.CreateSnapshotRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -156,7 +156,7 @@ func (s *FileStorageSnapshotResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.CreateSnapshot(context.Background(), request)
+	response, err := s.Client.CreateSnapshot(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -165,7 +165,7 @@ func (s *FileStorageSnapshotResourceCrud) Create() error {
 	return nil
 }
 
-func (s *FileStorageSnapshotResourceCrud) Get() error {
+func (s *FileStorageSnapshotResourceCrud) Get(ctx context.Context) error {
 	request := oci_file_storage.GetSnapshotRequest{}
 
 	tmp := s.D.Id()
@@ -173,7 +173,7 @@ func (s *FileStorageSnapshotResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.GetSnapshot(context.Background(), request)
+	response, err := s.Client.GetSnapshot(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -182,7 +182,7 @@ func (s *FileStorageSnapshotResourceCrud) Get() error {
 	return nil
 }
 
-func (s *FileStorageSnapshotResourceCrud) Update() error {
+func (s *FileStorageSnapshotResourceCrud) Update(ctx context.Context) error {
 	request := oci_file_storage.UpdateSnapshotRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -202,7 +202,7 @@ func (s *FileStorageSnapshotResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.UpdateSnapshot(context.Background(), request)
+	response, err := s.Client.UpdateSnapshot(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -211,7 +211,7 @@ func (s *FileStorageSnapshotResourceCrud) Update() error {
 	return nil
 }
 
-func (s *FileStorageSnapshotResourceCrud) Delete() error {
+func (s *FileStorageSnapshotResourceCrud) Delete(ctx context.Context) error {
 	request := oci_file_storage.DeleteSnapshotRequest{}
 
 	tmp := s.D.Id()
@@ -219,7 +219,7 @@ func (s *FileStorageSnapshotResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	_, err := s.Client.DeleteSnapshot(context.Background(), request)
+	_, err := s.Client.DeleteSnapshot(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_file_storage "github.com/oracle/oci-go-sdk/filestorage"
 )
 
@@ -13,7 +14,8 @@ func FileStorageSnapshotsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readFileStorageSnapshots,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"file_system_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -31,6 +33,14 @@ func FileStorageSnapshotsDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(FileStorageSnapshotResource()),
 			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_file_storage.ListSnapshotsSortOrderAsc),
+					string(oci_file_storage.ListSnapshotsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -40,7 +50,7 @@ func readFileStorageSnapshots(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).fileStorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type FileStorageSnapshotsDataSourceCrud struct {
@@ -53,7 +63,7 @@ func (s *FileStorageSnapshotsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *FileStorageSnapshotsDataSourceCrud) Get() error {
+func (s *FileStorageSnapshotsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_file_storage.ListSnapshotsRequest{}
 
 	if fileSystemId, ok := s.D.GetOkExists("file_system_id"); ok {
@@ -70,9 +80,14 @@ func (s *FileStorageSnapshotsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_file_storage.ListSnapshotsLifecycleStateEnum(state.(string))
 	}
 
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_file_storage.ListSnapshotsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "file_storage")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListSnapshots(context.Background(), request)
+	response, err := s.Client.ListSnapshots(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -80,8 +95,8 @@ func (s *FileStorageSnapshotsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListSnapshots(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListSnapshots(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -127,6 +142,8 @@ func (s *FileStorageSnapshotsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, FileStorageSnapshotsDataSource().Schema["snapshots"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("snapshots", resources); err != nil {
 		return err
 	}
@@ -4,16 +4,41 @@ package provider
 
 import (
 	"log"
+	"net"
 	"reflect"
 	"regexp"
 	"strconv"
+	"time"
 
 	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
+// Filter operators, compared against each of the filter values
+const (
+	equalsFilterOperator    = "equals"
+	notEqualsFilterOperator = "not_equals"
+	gtFilterOperator        = "gt"
+	gteFilterOperator       = "gte"
+	ltFilterOperator        = "lt"
+	lteFilterOperator       = "lte"
+	prefixFilterOperator    = "prefix"
+	containsFilterOperator  = "contains"
+	inCidrFilterOperator    = "in_cidr"
+	existsFilterOperator    = "exists"
+	notExistsFilterOperator = "not_exists"
+)
+
+// Formats in which time properties are compared by the ordering filter operators
+var filterTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999 -0700 MST",
+	"2006-01-02",
+}
+
 func dataSourceFiltersSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeSet,
@@ -28,7 +53,7 @@ func dataSourceFiltersSchema() *schema.Schema {
 
 				"values": {
 					Type:     schema.TypeList,
-					Required: true,
+					Optional: true,
 					Elem:     &schema.Schema{Type: schema.TypeString},
 				},
 
@@ -37,11 +62,195 @@ func dataSourceFiltersSchema() *schema.Schema {
 					Optional: true,
 					Default:  false,
 				},
+
+				"operator": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  equalsFilterOperator,
+					ValidateFunc: validation.StringInSlice([]string{
+						equalsFilterOperator,
+						notEqualsFilterOperator,
+						gtFilterOperator,
+						gteFilterOperator,
+						ltFilterOperator,
+						lteFilterOperator,
+						prefixFilterOperator,
+						containsFilterOperator,
+						inCidrFilterOperator,
+						existsFilterOperator,
+						notExistsFilterOperator,
+					}, false),
+				},
 			},
 		},
 	}
 }
 
+// withFilterValidation rejects the filters of a data source whose values or regex do not fit their operator before the
+// data source is read, since such filters would otherwise silently match nothing or ignore the regex
+func withFilterValidation(dataSources map[string]*schema.Resource) map[string]*schema.Resource {
+	for _, dataSource := range dataSources {
+		if _, ok := dataSource.Schema["filter"]; !ok || dataSource.Read == nil {
+			continue
+		}
+		read := dataSource.Read
+		dataSource.Read = func(d *schema.ResourceData, m interface{}) error {
+			if filters, ok := d.GetOk("filter"); ok {
+				if err := validateFilters(filters.(*schema.Set)); err != nil {
+					return err
+				}
+			}
+			return read(d, m)
+		}
+	}
+	return dataSources
+}
+
+// validateFilters checks that the filters have values unless they test whether a property exists, and that only the
+// operators comparing values for equality are combined with regex
+func validateFilters(filters *schema.Set) error {
+	for _, f := range filters.List() {
+		fSet := f.(map[string]interface{})
+		name, _ := fSet["name"].(string)
+		operator, _ := fSet["operator"].(string)
+		if operator == "" {
+			operator = equalsFilterOperator
+		}
+		values, _ := fSet["values"].([]interface{})
+		regex, _ := fSet["regex"].(bool)
+
+		switch operator {
+		case existsFilterOperator, notExistsFilterOperator:
+		default:
+			if len(values) == 0 {
+				return fmt.Errorf("the values of the \"%s\" filter must be specified with the %s operator", name, operator)
+			}
+		}
+
+		if regex && operator != equalsFilterOperator && operator != notEqualsFilterOperator {
+			return fmt.Errorf("regex can not be used by the \"%s\" filter with the %s operator, only with %s and %s", name, operator, equalsFilterOperator, notEqualsFilterOperator)
+		}
+	}
+	return nil
+}
+
+// dataSourceMaxResultsSchema limits the number of results of a list data source, after filtering
+func dataSourceMaxResultsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+}
+
+// dataSourceListOptions tells a list data source when it can stop paging through the results
+type dataSourceListOptions struct {
+	maxResults int
+	// filtersPushedDown is set if the service applies all the filters, so that every result it returns is a match
+	filtersPushedDown bool
+}
+
+// hasAllResults returns true once enough results have been listed to satisfy max_results
+func (o dataSourceListOptions) hasAllResults(resultCount int) bool {
+	return o.maxResults > 0 && o.filtersPushedDown && resultCount >= o.maxResults
+}
+
+// Values of filters pushed down to an enum field of a list request, which the service rejects if they are not valid
+var pushedDownEnumFilterValue = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+
+// Query fields of list requests that control paging and sorting rather than filter the results
+var nonFilterRequestFields = map[string]bool{
+	"Page":      true,
+	"Limit":     true,
+	"SortBy":    true,
+	"SortOrder": true,
+}
+
+// pushDownFilters sets the fields of a list request that correspond to the data source's filters, so that the
+// service filters the results instead of the provider having to page through all of them. A filter is pushed down if
+// it compares a top level property for equality with a single value, and the request has a query field of the same
+// name that is not already set. Filters are applied to the results either way.
+func pushDownFilters(d *schema.ResourceData, request interface{}) dataSourceListOptions {
+	options := dataSourceListOptions{filtersPushedDown: true}
+	if maxResults, ok := d.GetOkExists("max_results"); ok {
+		options.maxResults = maxResults.(int)
+	}
+
+	filters, ok := d.GetOkExists("filter")
+	if !ok {
+		return options
+	}
+
+	requestValue := reflect.ValueOf(request).Elem()
+	for _, f := range filters.(*schema.Set).List() {
+		fSet := f.(map[string]interface{})
+		if !pushDownFilter(requestValue, fSet) {
+			options.filtersPushedDown = false
+		}
+	}
+
+	return options
+}
+
+func pushDownFilter(requestValue reflect.Value, fSet map[string]interface{}) bool {
+	if regex, _ := fSet["regex"].(bool); regex {
+		return false
+	}
+	if operator, _ := fSet["operator"].(string); operator != "" && operator != equalsFilterOperator {
+		return false
+	}
+	values, _ := fSet["values"].([]interface{})
+	if len(values) != 1 {
+		return false
+	}
+	name := fSet["name"].(string)
+	value, _ := values[0].(string)
+
+	fieldName := "LifecycleState"
+	if name != "state" {
+		fieldName = snakeCaseToCamelCase(name)
+	}
+	structField, ok := requestValue.Type().FieldByName(fieldName)
+	if !ok || structField.Tag.Get("contributesTo") != "query" || nonFilterRequestFields[fieldName] {
+		return false
+	}
+
+	field := requestValue.FieldByName(fieldName)
+	switch {
+	case field.Kind() == reflect.String && field.String() == "":
+		if !pushedDownEnumFilterValue.MatchString(value) {
+			return false
+		}
+		field.SetString(value)
+	case field.Kind() == reflect.Ptr && field.IsNil() && field.Type().Elem().Kind() == reflect.String:
+		field.Set(reflect.New(field.Type().Elem()))
+		field.Elem().SetString(value)
+	default:
+		return false
+	}
+
+	log.Printf("[DEBUG] Pushed down the \"%s\" filter to the %s request field", name, fieldName)
+	return true
+}
+
+func snakeCaseToCamelCase(name string) string {
+	words := strings.Split(name, "_")
+	for i, word := range words {
+		if word != "" {
+			words[i] = strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+	return strings.Join(words, "")
+}
+
+// applyMaxResults truncates the filtered results of a list data source to max_results
+func applyMaxResults(d *schema.ResourceData, items []map[string]interface{}) []map[string]interface{} {
+	if maxResults, ok := d.GetOkExists("max_results"); ok && len(items) > maxResults.(int) {
+		return items[:maxResults.(int)]
+	}
+	return items
+}
+
 var PrimitiveDataTypes = map[schema.ValueType]bool{
 	schema.TypeString: true,
 	schema.TypeBool:   true,
@@ -50,7 +259,8 @@ var PrimitiveDataTypes = map[schema.ValueType]bool{
 }
 
 // Process an entity's properties (string or array of strings) by N filter sets of
-// keyword:values, where each filter set ANDs and each keyword:values set ORs
+// keyword:values, where each filter set ANDs and each keyword:values set ORs.
+// The operator of a filter set decides how the property is compared with each of the values.
 func ApplyFilters(filters *schema.Set, items []map[string]interface{}, resourceSchema map[string]*schema.Schema) []map[string]interface{} {
 	if filters == nil || filters.Len() == 0 {
 		return items
@@ -71,6 +281,14 @@ func ApplyFilters(filters *schema.Set, items []map[string]interface{}, resourceS
 			isReg = regex.(bool)
 		}
 
+		operator := equalsFilterOperator
+		if op, opOk := fSet["operator"].(string); opOk && op != "" {
+			operator = op
+		}
+
+		values, _ := fSet["values"].([]interface{})
+		fieldType := getFieldType(resourceSchema, pathElements)
+
 		// create a string equality check strategy based on this filters "regex" flag
 		stringsEqual := func(propertyVal string, filterVal string) bool {
 			if isReg {
@@ -91,7 +309,7 @@ func ApplyFilters(filters *schema.Set, items []map[string]interface{}, resourceS
 		res := make([]map[string]interface{}, 0)
 		for _, item := range items {
 			targetVal, targetValOk := getValueFromPath(item, pathElements)
-			if targetValOk && orComparator(targetVal, fSet["values"].([]interface{}), stringsEqual) {
+			if filterMatches(operator, targetVal, targetValOk, values, fieldType, stringsEqual) {
 				res = append(res, item)
 			}
 		}
@@ -227,6 +445,185 @@ func isValidSchemaType(fieldSchema *schema.Schema) bool {
 	return true
 }
 
+// getFieldType returns the type of the property at the given path, or schema.TypeInvalid if the schema does not
+// describe it. The elements of lists, sets and maps of primitives are reported with their own type.
+func getFieldType(resourceSchema map[string]*schema.Schema, pathElements []string) schema.ValueType {
+	currentSchema := resourceSchema
+	for index, pathElement := range pathElements {
+		fieldSchema, ok := currentSchema[pathElement]
+		if !ok || fieldSchema == nil {
+			return schema.TypeInvalid
+		}
+
+		if nestedResource, isResource := fieldSchema.Elem.(*schema.Resource); isResource {
+			currentSchema = nestedResource.Schema
+			continue
+		}
+
+		if index < len(pathElements)-1 && fieldSchema.Type != schema.TypeMap {
+			return schema.TypeInvalid
+		}
+
+		switch fieldSchema.Type {
+		case schema.TypeList, schema.TypeSet, schema.TypeMap:
+			if elemSchema, isSchema := fieldSchema.Elem.(*schema.Schema); isSchema {
+				return elemSchema.Type
+			}
+			return schema.TypeString
+		default:
+			return fieldSchema.Type
+		}
+	}
+
+	return schema.TypeInvalid
+}
+
+// filterMatches returns true if the target property satisfies the filter operator for any of the filter values
+func filterMatches(operator string, target interface{}, targetOk bool, filters []interface{}, fieldType schema.ValueType, stringsEqual StringCheck) bool {
+	exists := targetOk && !isEmptyFilterProperty(target)
+
+	switch operator {
+	case existsFilterOperator:
+		return exists
+	case notExistsFilterOperator:
+		return !exists
+	case notEqualsFilterOperator:
+		// Properties that are not set are not equal to any value
+		return !exists || !orComparator(target, filters, stringsEqual)
+	}
+
+	if !exists {
+		return false
+	}
+
+	switch operator {
+	case gtFilterOperator, gteFilterOperator, ltFilterOperator, lteFilterOperator:
+		return anyComparator(target, filters, orderedCheck(operator, fieldType))
+	case prefixFilterOperator:
+		return anyComparator(target, filters, strings.HasPrefix)
+	case containsFilterOperator:
+		return anyComparator(target, filters, strings.Contains)
+	case inCidrFilterOperator:
+		return anyComparator(target, filters, inCidr)
+	default:
+		return orComparator(target, filters, stringsEqual)
+	}
+}
+
+func isEmptyFilterProperty(target interface{}) bool {
+	if target == nil {
+		return true
+	}
+
+	val := reflect.ValueOf(target)
+	switch val.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+		return val.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return val.IsNil()
+	}
+	return false
+}
+
+// orderedCheck compares the property with the filter value as numbers, or as times if they are not numbers
+func orderedCheck(operator string, fieldType schema.ValueType) StringCheck {
+	return func(propertyVal string, filterVal string) bool {
+		comparison, ok := compareOrderedFilterValues(propertyVal, filterVal, fieldType)
+		if !ok {
+			log.Printf("[WARN] Unable to compare \"%s\" with \"%s\" using the \"%s\" filter operator\n", propertyVal, filterVal, operator)
+			return false
+		}
+
+		switch operator {
+		case gtFilterOperator:
+			return comparison > 0
+		case gteFilterOperator:
+			return comparison >= 0
+		case ltFilterOperator:
+			return comparison < 0
+		default:
+			return comparison <= 0
+		}
+	}
+}
+
+// compareOrderedFilterValues returns -1, 0 or 1 if the property is less than, equal to or greater than the filter
+// value. ok is false if they cannot be compared.
+func compareOrderedFilterValues(propertyVal string, filterVal string, fieldType schema.ValueType) (comparison int, ok bool) {
+	if fieldType == schema.TypeBool {
+		return 0, false
+	}
+
+	if propertyInt, err := strconv.ParseInt(propertyVal, 10, 64); err == nil {
+		if filterInt, err := strconv.ParseInt(filterVal, 10, 64); err == nil {
+			switch {
+			case propertyInt < filterInt:
+				return -1, true
+			case propertyInt > filterInt:
+				return 1, true
+			}
+			return 0, true
+		}
+	}
+	if propertyFloat, err := strconv.ParseFloat(propertyVal, 64); err == nil {
+		if filterFloat, err := strconv.ParseFloat(filterVal, 64); err == nil {
+			switch {
+			case propertyFloat < filterFloat:
+				return -1, true
+			case propertyFloat > filterFloat:
+				return 1, true
+			}
+			return 0, true
+		}
+	}
+	if fieldType == schema.TypeInt || fieldType == schema.TypeFloat {
+		return 0, false
+	}
+
+	propertyTime, propertyTimeOk := parseFilterTime(propertyVal)
+	filterTime, filterTimeOk := parseFilterTime(filterVal)
+	if !propertyTimeOk || !filterTimeOk {
+		return 0, false
+	}
+	switch {
+	case propertyTime.Before(filterTime):
+		return -1, true
+	case propertyTime.After(filterTime):
+		return 1, true
+	}
+	return 0, true
+}
+
+func parseFilterTime(value string) (time.Time, bool) {
+	for _, layout := range filterTimeLayouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// inCidr returns true if the property, an IP address or a CIDR block, is within the CIDR block of the filter value
+func inCidr(propertyVal string, filterVal string) bool {
+	_, filterNetwork, err := net.ParseCIDR(filterVal)
+	if err != nil {
+		log.Printf(`[WARN] Invalid CIDR block "%s" for the "in_cidr" filter operator\n`, filterVal)
+		return false
+	}
+
+	if ip := net.ParseIP(propertyVal); ip != nil {
+		return filterNetwork.Contains(ip)
+	}
+
+	_, propertyNetwork, err := net.ParseCIDR(propertyVal)
+	if err != nil {
+		return false
+	}
+	filterPrefixLength, _ := filterNetwork.Mask.Size()
+	propertyPrefixLength, _ := propertyNetwork.Mask.Size()
+	return filterNetwork.Contains(propertyNetwork.IP) && propertyPrefixLength >= filterPrefixLength
+}
+
 type StringCheck func(propertyVal string, filterVal string) bool
 
 // orComparator returns true for any filter that matches the target property
@@ -285,3 +682,37 @@ func orComparator(target interface{}, filters []interface{}, stringsEqual String
 	}
 	return false
 }
+
+// anyComparator returns true if the check passes for any filter value against the target property, or against any
+// element of the target property if it is an array of strings. Numbers and booleans are checked in their string form.
+func anyComparator(target interface{}, filters []interface{}, check StringCheck) bool {
+	val := reflect.ValueOf(target)
+	valType := val.Type()
+
+	var propertyVals []string
+	switch valType.Kind() {
+	case reflect.Bool:
+		propertyVals = []string{strconv.FormatBool(val.Bool())}
+	case reflect.Int, reflect.Int64:
+		propertyVals = []string{strconv.FormatInt(val.Int(), 10)}
+	case reflect.Float64:
+		propertyVals = []string{strconv.FormatFloat(val.Float(), 'f', -1, 64)}
+	case reflect.String:
+		propertyVals = []string{val.String()}
+	case reflect.Slice, reflect.Array:
+		if valType.Elem().Kind() == reflect.String {
+			for i := 0; i < val.Len(); i++ {
+				propertyVals = append(propertyVals, val.Index(i).String())
+			}
+		}
+	}
+
+	for _, fVal := range filters {
+		for _, propertyVal := range propertyVals {
+			if check(propertyVal, fVal.(string)) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -673,6 +673,157 @@ func TestApplyFilters_ElementOrder(t *testing.T) {
 
 }
 
+func TestApplyFilters_operators(t *testing.T) {
+	items := []map[string]interface{}{
+		{
+			"display_name": "boot-volume-a",
+			"size_in_gbs":  "50",
+			"vpus_per_gb":  10,
+			"ratio":        0.5,
+			"ip_address":   "10.0.1.15",
+			"cidr_block":   "10.0.1.0/24",
+			"time_created": "2019-04-10 17:34:50.123 +0000 UTC",
+			"tags":         []string{"prod", "db"},
+		},
+		{
+			"display_name": "block-volume-b",
+			"size_in_gbs":  "1024",
+			"vpus_per_gb":  20,
+			"ratio":        1.5,
+			"ip_address":   "10.0.2.7",
+			"cidr_block":   "10.1.0.0/16",
+			"time_created": "2019-04-12 08:00:00 +0000 UTC",
+			"tags":         []string{"dev"},
+		},
+		{
+			"display_name": "block-volume-c",
+			"size_in_gbs":  "4096",
+			"vpus_per_gb":  0,
+			"ratio":        2.5,
+			"ip_address":   "192.168.0.1",
+			"cidr_block":   "10.0.0.0/8",
+		},
+	}
+
+	testSchema := map[string]*schema.Schema{
+		"display_name": {Type: schema.TypeString},
+		"size_in_gbs":  {Type: schema.TypeString},
+		"vpus_per_gb":  {Type: schema.TypeInt},
+		"ratio":        {Type: schema.TypeFloat},
+		"ip_address":   {Type: schema.TypeString},
+		"cidr_block":   {Type: schema.TypeString},
+		"time_created": {Type: schema.TypeString},
+		"tags": {
+			Type: schema.TypeList,
+			Elem: &schema.Schema{Type: schema.TypeString},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		operator string
+		values   []interface{}
+		expected []string
+	}{
+		{"display_name", "not_equals", []interface{}{"boot-volume-a"}, []string{"block-volume-b", "block-volume-c"}},
+		{"size_in_gbs", "gt", []interface{}{"100"}, []string{"block-volume-b", "block-volume-c"}},
+		{"size_in_gbs", "lte", []interface{}{"1024"}, []string{"boot-volume-a", "block-volume-b"}},
+		{"vpus_per_gb", "gte", []interface{}{"10"}, []string{"boot-volume-a", "block-volume-b"}},
+		{"vpus_per_gb", "lt", []interface{}{"not a number"}, []string{}},
+		{"ratio", "lt", []interface{}{"1.5"}, []string{"boot-volume-a"}},
+		{"time_created", "gt", []interface{}{"2019-04-11T00:00:00Z"}, []string{"block-volume-b"}},
+		{"display_name", "prefix", []interface{}{"block-"}, []string{"block-volume-b", "block-volume-c"}},
+		{"display_name", "contains", []interface{}{"volume-a", "volume-c"}, []string{"boot-volume-a", "block-volume-c"}},
+		{"tags", "prefix", []interface{}{"pr"}, []string{"boot-volume-a"}},
+		{"ip_address", "in_cidr", []interface{}{"10.0.0.0/16"}, []string{"boot-volume-a", "block-volume-b"}},
+		{"ip_address", "in_cidr", []interface{}{"192.168.0.0/24", "10.0.1.0/24"}, []string{"boot-volume-a", "block-volume-c"}},
+		{"cidr_block", "in_cidr", []interface{}{"10.0.0.0/16"}, []string{"boot-volume-a"}},
+		{"time_created", "exists", nil, []string{"boot-volume-a", "block-volume-b"}},
+		{"time_created", "not_exists", nil, []string{"block-volume-c"}},
+	}
+
+	for _, testCase := range testCases {
+		filters := &schema.Set{F: func(interface{}) int { return 1 }}
+		filters.Add(map[string]interface{}{
+			"name":     testCase.name,
+			"operator": testCase.operator,
+			"values":   testCase.values,
+		})
+
+		res := ApplyFilters(filters, items, testSchema)
+		displayNames := []string{}
+		for _, item := range res {
+			displayNames = append(displayNames, item["display_name"].(string))
+		}
+		if !reflect.DeepEqual(displayNames, testCase.expected) {
+			t.Errorf("Expected %v for %s %s %v, got %v", testCase.expected, testCase.name, testCase.operator, testCase.values, displayNames)
+		}
+	}
+}
+
+func TestPushDownFilters_basic(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, CoreInstancesDataSource().Schema, map[string]interface{}{
+		"compartment_id": "ocid1.compartment.oc1..compartment",
+		"max_results":    2,
+		"filter": []interface{}{
+			map[string]interface{}{"name": "state", "values": []interface{}{"RUNNING"}},
+			map[string]interface{}{"name": "display_name", "values": []interface{}{"web-1"}},
+			map[string]interface{}{"name": "availability_domain", "values": []interface{}{"\\w*-AD-1"}, "regex": true},
+		},
+	})
+
+	request := oci_core.ListInstancesRequest{}
+	listOptions := pushDownFilters(d, &request)
+	if request.LifecycleState != oci_core.InstanceLifecycleStateRunning {
+		t.Errorf("Expected the state filter to be pushed down, got %s", request.LifecycleState)
+	}
+	if request.DisplayName == nil || *request.DisplayName != "web-1" {
+		t.Errorf("Expected the display_name filter to be pushed down, got %v", request.DisplayName)
+	}
+	if request.AvailabilityDomain != nil {
+		t.Errorf("Expected the regex filter not to be pushed down, got %s", *request.AvailabilityDomain)
+	}
+	if listOptions.hasAllResults(5) {
+		t.Errorf("Expected paging to continue while some filters are applied by the provider only")
+	}
+}
+
+func TestPushDownFilters_maxResults(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, CoreInstancesDataSource().Schema, map[string]interface{}{
+		"compartment_id": "ocid1.compartment.oc1..compartment",
+		"display_name":   "web-1",
+		"max_results":    2,
+		"filter": []interface{}{
+			map[string]interface{}{"name": "display_name", "values": []interface{}{"web-2"}},
+			map[string]interface{}{"name": "state", "values": []interface{}{"running"}},
+		},
+	})
+
+	displayName := "web-1"
+	request := oci_core.ListInstancesRequest{DisplayName: &displayName}
+	listOptions := pushDownFilters(d, &request)
+	if *request.DisplayName != "web-1" || request.LifecycleState != "" {
+		t.Errorf("Expected set fields and invalid enum values not to be pushed down, got %s and %s", *request.DisplayName, request.LifecycleState)
+	}
+	if listOptions.hasAllResults(5) {
+		t.Errorf("Expected paging to continue while some filters are applied by the provider only")
+	}
+
+	d = schema.TestResourceDataRaw(t, CoreInstancesDataSource().Schema, map[string]interface{}{
+		"compartment_id": "ocid1.compartment.oc1..compartment",
+		"max_results":    2,
+	})
+	listOptions = pushDownFilters(d, &oci_core.ListInstancesRequest{})
+	if listOptions.hasAllResults(1) || !listOptions.hasAllResults(2) {
+		t.Errorf("Expected paging to stop once max_results results are listed")
+	}
+
+	items := []map[string]interface{}{{"letter": "a"}, {"letter": "b"}, {"letter": "c"}}
+	if res := applyMaxResults(d, items); len(res) != 2 || res[1]["letter"] != "b" {
+		t.Errorf("Expected the first 2 results, got %v", res)
+	}
+}
+
 func TestGetValue_EmptyMap(t *testing.T) {
 	item := map[string]interface{}{}
 
@@ -747,3 +898,43 @@ func TestGetPathElements_MultiLevelNonMap(t *testing.T) {
 		t.Errorf("Expected Error")
 	}
 }
+
+func TestValidateFilters_operators(t *testing.T) {
+	testCases := []struct {
+		filter      map[string]interface{}
+		expectError bool
+	}{
+		{filter: map[string]interface{}{"name": "display_name", "values": []interface{}{"web-1"}}},
+		{filter: map[string]interface{}{"name": "display_name", "values": []interface{}{"web-.*"}, "regex": true, "operator": notEqualsFilterOperator}},
+		{filter: map[string]interface{}{"name": "freeform_tags.owner", "operator": existsFilterOperator}},
+		{filter: map[string]interface{}{"name": "freeform_tags.owner", "operator": notExistsFilterOperator}},
+		{filter: map[string]interface{}{"name": "display_name"}, expectError: true},
+		{filter: map[string]interface{}{"name": "display_name", "operator": prefixFilterOperator}, expectError: true},
+		{filter: map[string]interface{}{"name": "display_name", "values": []interface{}{"web-.*"}, "regex": true, "operator": prefixFilterOperator}, expectError: true},
+		{filter: map[string]interface{}{"name": "freeform_tags.owner", "regex": true, "operator": existsFilterOperator}, expectError: true},
+	}
+
+	for _, test := range testCases {
+		d := schema.TestResourceDataRaw(t, CoreInstancesDataSource().Schema, map[string]interface{}{
+			"compartment_id": "ocid1.compartment.oc1..compartment",
+			"filter":         []interface{}{test.filter},
+		})
+		err := validateFilters(d.Get("filter").(*schema.Set))
+		if test.expectError && err == nil {
+			t.Errorf("Expected an error for the filter %v", test.filter)
+		}
+		if !test.expectError && err != nil {
+			t.Errorf("Got unexpected error '%q' for the filter %v", err, test.filter)
+		}
+	}
+
+	// The provider's data sources are not read with invalid filters
+	dataSource := Provider(nil).(*schema.Provider).DataSourcesMap["oci_core_instances"]
+	d := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{
+		"compartment_id": "ocid1.compartment.oc1..compartment",
+		"filter":         []interface{}{map[string]interface{}{"name": "display_name"}},
+	})
+	if err := dataSource.Read(d, nil); err == nil || !strings.Contains(err.Error(), "display_name") {
+		t.Errorf("Expected the data source to reject the filter without values, got '%v'", err)
+	}
+}
@@ -96,7 +96,7 @@ func readSingularHealthChecksHttpMonitor(d *schema.ResourceData, m interface{})
 	sync.D = d
 	sync.Client = m.(*OracleClients).healthChecksClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type HealthChecksHttpMonitorDataSourceCrud struct {
@@ -109,7 +109,7 @@ func (s *HealthChecksHttpMonitorDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *HealthChecksHttpMonitorDataSourceCrud) Get() error {
+func (s *HealthChecksHttpMonitorDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_health_checks.GetHttpMonitorRequest{}
 
 	if monitorId, ok := s.D.GetOkExists("monitor_id"); ok {
@@ -119,7 +119,7 @@ func (s *HealthChecksHttpMonitorDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "health_checks")
 
-	response, err := s.Client.GetHttpMonitor(context.Background(), request)
+	response, err := s.Client.GetHttpMonitor(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -115,7 +115,7 @@ func createHealthChecksHttpMonitor(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).healthChecksClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readHealthChecksHttpMonitor(d *schema.ResourceData, m interface{}) error {
@@ -123,7 +123,7 @@ func readHealthChecksHttpMonitor(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).healthChecksClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateHealthChecksHttpMonitor(d *schema.ResourceData, m interface{}) error {
@@ -131,7 +131,7 @@ func updateHealthChecksHttpMonitor(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).healthChecksClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteHealthChecksHttpMonitor(d *schema.ResourceData, m interface{}) error {
@@ -140,7 +140,7 @@ func deleteHealthChecksHttpMonitor(d *schema.ResourceData, m interface{}) error
 	sync.Client = m.(*OracleClients).healthChecksClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type HealthChecksHttpMonitorResourceCrud struct {
@@ -154,7 +154,7 @@ func (s *HealthChecksHttpMonitorResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *HealthChecksHttpMonitorResourceCrud) Create() error {
+func (s *HealthChecksHttpMonitorResourceCrud) Create(ctx context.Context) error {
 	request := oci_health_checks.CreateHttpMonitorRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -242,7 +242,7 @@ func (s *HealthChecksHttpMonitorResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.CreateHttpMonitor(context.Background(), request)
+	response, err := s.Client.CreateHttpMonitor(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -251,7 +251,7 @@ func (s *HealthChecksHttpMonitorResourceCrud) Create() error {
 	return nil
 }
 
-func (s *HealthChecksHttpMonitorResourceCrud) Get() error {
+func (s *HealthChecksHttpMonitorResourceCrud) Get(ctx context.Context) error {
 	request := oci_health_checks.GetHttpMonitorRequest{}
 
 	tmp := s.D.Id()
@@ -259,7 +259,7 @@ func (s *HealthChecksHttpMonitorResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.GetHttpMonitor(context.Background(), request)
+	response, err := s.Client.GetHttpMonitor(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -268,7 +268,7 @@ func (s *HealthChecksHttpMonitorResourceCrud) Get() error {
 	return nil
 }
 
-func (s *HealthChecksHttpMonitorResourceCrud) Update() error {
+func (s *HealthChecksHttpMonitorResourceCrud) Update(ctx context.Context) error {
 	request := oci_health_checks.UpdateHttpMonitorRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -354,7 +354,7 @@ func (s *HealthChecksHttpMonitorResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.UpdateHttpMonitor(context.Background(), request)
+	response, err := s.Client.UpdateHttpMonitor(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -363,7 +363,7 @@ func (s *HealthChecksHttpMonitorResourceCrud) Update() error {
 	return nil
 }
 
-func (s *HealthChecksHttpMonitorResourceCrud) Delete() error {
+func (s *HealthChecksHttpMonitorResourceCrud) Delete(ctx context.Context) error {
 	request := oci_health_checks.DeleteHttpMonitorRequest{}
 
 	tmp := s.D.Id()
@@ -371,7 +371,7 @@ func (s *HealthChecksHttpMonitorResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	_, err := s.Client.DeleteHttpMonitor(context.Background(), request)
+	_, err := s.Client.DeleteHttpMonitor(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_health_checks "github.com/oracle/oci-go-sdk/healthchecks"
 )
 
@@ -13,7 +14,8 @@ func HealthChecksHttpMonitorsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readHealthChecksHttpMonitors,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -27,6 +29,22 @@ func HealthChecksHttpMonitorsDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(HealthChecksHttpMonitorResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_health_checks.ListHttpMonitorsSortById),
+					string(oci_health_checks.ListHttpMonitorsSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_health_checks.ListHttpMonitorsSortOrderAsc),
+					string(oci_health_checks.ListHttpMonitorsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -36,7 +54,7 @@ func readHealthChecksHttpMonitors(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).healthChecksClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type HealthChecksHttpMonitorsDataSourceCrud struct {
@@ -49,7 +67,7 @@ func (s *HealthChecksHttpMonitorsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *HealthChecksHttpMonitorsDataSourceCrud) Get() error {
+func (s *HealthChecksHttpMonitorsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_health_checks.ListHttpMonitorsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -62,9 +80,18 @@ func (s *HealthChecksHttpMonitorsDataSourceCrud) Get() error {
 		request.DisplayName = &tmp
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_health_checks.ListHttpMonitorsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_health_checks.ListHttpMonitorsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "health_checks")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListHttpMonitors(context.Background(), request)
+	response, err := s.Client.ListHttpMonitors(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -72,8 +99,8 @@ func (s *HealthChecksHttpMonitorsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListHttpMonitors(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListHttpMonitors(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -133,6 +160,8 @@ func (s *HealthChecksHttpMonitorsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, HealthChecksHttpMonitorsDataSource().Schema["http_monitors"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("http_monitors", resources); err != nil {
 		return err
 	}
@@ -96,7 +96,7 @@ func createHealthChecksHttpProbe(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).healthChecksClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readHealthChecksHttpProbe(d *schema.ResourceData, m interface{}) error {
@@ -118,7 +118,7 @@ func (s *HealthChecksHttpProbeResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *HealthChecksHttpProbeResourceCrud) Create() error {
+func (s *HealthChecksHttpProbeResourceCrud) Create(ctx context.Context) error {
 	request := oci_health_checks.CreateOnDemandHttpProbeRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -179,7 +179,7 @@ func (s *HealthChecksHttpProbeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.CreateOnDemandHttpProbe(context.Background(), request)
+	response, err := s.Client.CreateOnDemandHttpProbe(ctx, request)
 	if err != nil {
 		return err
 	}
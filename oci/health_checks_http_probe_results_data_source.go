@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_health_checks "github.com/oracle/oci-go-sdk/healthchecks"
 )
 
@@ -13,7 +14,8 @@ func HealthChecksHttpProbeResultsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readHealthChecksHttpProbeResults,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"probe_configuration_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -188,6 +190,14 @@ func HealthChecksHttpProbeResultsDataSource() *schema.Resource {
 					},
 				},
 			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_health_checks.ListHttpProbeResultsSortOrderAsc),
+					string(oci_health_checks.ListHttpProbeResultsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -197,7 +207,7 @@ func readHealthChecksHttpProbeResults(d *schema.ResourceData, m interface{}) err
 	sync.D = d
 	sync.Client = m.(*OracleClients).healthChecksClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type HealthChecksHttpProbeResultsDataSourceCrud struct {
@@ -210,7 +220,7 @@ func (s *HealthChecksHttpProbeResultsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *HealthChecksHttpProbeResultsDataSourceCrud) Get() error {
+func (s *HealthChecksHttpProbeResultsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_health_checks.ListHttpProbeResultsRequest{}
 
 	if probeConfigurationId, ok := s.D.GetOkExists("probe_configuration_id"); ok {
@@ -233,9 +243,14 @@ func (s *HealthChecksHttpProbeResultsDataSourceCrud) Get() error {
 		request.Target = &tmp
 	}
 
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_health_checks.ListHttpProbeResultsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "health_checks")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListHttpProbeResults(context.Background(), request)
+	response, err := s.Client.ListHttpProbeResults(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -243,8 +258,8 @@ func (s *HealthChecksHttpProbeResultsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListHttpProbeResults(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListHttpProbeResults(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -372,6 +387,8 @@ func (s *HealthChecksHttpProbeResultsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, HealthChecksHttpProbeResultsDataSource().Schema["http_probe_results"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("http_probe_results", resources); err != nil {
 		return err
 	}
@@ -83,7 +83,7 @@ func readSingularHealthChecksPingMonitor(d *schema.ResourceData, m interface{})
 	sync.D = d
 	sync.Client = m.(*OracleClients).healthChecksClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type HealthChecksPingMonitorDataSourceCrud struct {
@@ -96,7 +96,7 @@ func (s *HealthChecksPingMonitorDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *HealthChecksPingMonitorDataSourceCrud) Get() error {
+func (s *HealthChecksPingMonitorDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_health_checks.GetPingMonitorRequest{}
 
 	if monitorId, ok := s.D.GetOkExists("monitor_id"); ok {
@@ -106,7 +106,7 @@ func (s *HealthChecksPingMonitorDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "health_checks")
 
-	response, err := s.Client.GetPingMonitor(context.Background(), request)
+	response, err := s.Client.GetPingMonitor(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -99,7 +99,7 @@ func createHealthChecksPingMonitor(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).healthChecksClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readHealthChecksPingMonitor(d *schema.ResourceData, m interface{}) error {
@@ -107,7 +107,7 @@ func readHealthChecksPingMonitor(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).healthChecksClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateHealthChecksPingMonitor(d *schema.ResourceData, m interface{}) error {
@@ -115,7 +115,7 @@ func updateHealthChecksPingMonitor(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).healthChecksClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteHealthChecksPingMonitor(d *schema.ResourceData, m interface{}) error {
@@ -124,7 +124,7 @@ func deleteHealthChecksPingMonitor(d *schema.ResourceData, m interface{}) error
 	sync.Client = m.(*OracleClients).healthChecksClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type HealthChecksPingMonitorResourceCrud struct {
@@ -138,7 +138,7 @@ func (s *HealthChecksPingMonitorResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *HealthChecksPingMonitorResourceCrud) Create() error {
+func (s *HealthChecksPingMonitorResourceCrud) Create(ctx context.Context) error {
 	request := oci_health_checks.CreatePingMonitorRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -213,7 +213,7 @@ func (s *HealthChecksPingMonitorResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.CreatePingMonitor(context.Background(), request)
+	response, err := s.Client.CreatePingMonitor(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -222,7 +222,7 @@ func (s *HealthChecksPingMonitorResourceCrud) Create() error {
 	return nil
 }
 
-func (s *HealthChecksPingMonitorResourceCrud) Get() error {
+func (s *HealthChecksPingMonitorResourceCrud) Get(ctx context.Context) error {
 	request := oci_health_checks.GetPingMonitorRequest{}
 
 	tmp := s.D.Id()
@@ -230,7 +230,7 @@ func (s *HealthChecksPingMonitorResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.GetPingMonitor(context.Background(), request)
+	response, err := s.Client.GetPingMonitor(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -239,7 +239,7 @@ func (s *HealthChecksPingMonitorResourceCrud) Get() error {
 	return nil
 }
 
-func (s *HealthChecksPingMonitorResourceCrud) Update() error {
+func (s *HealthChecksPingMonitorResourceCrud) Update(ctx context.Context) error {
 	request := oci_health_checks.UpdatePingMonitorRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -312,7 +312,7 @@ func (s *HealthChecksPingMonitorResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.UpdatePingMonitor(context.Background(), request)
+	response, err := s.Client.UpdatePingMonitor(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -321,7 +321,7 @@ func (s *HealthChecksPingMonitorResourceCrud) Update() error {
 	return nil
 }
 
-func (s *HealthChecksPingMonitorResourceCrud) Delete() error {
+func (s *HealthChecksPingMonitorResourceCrud) Delete(ctx context.Context) error {
 	request := oci_health_checks.DeletePingMonitorRequest{}
 
 	tmp := s.D.Id()
@@ -329,7 +329,7 @@ func (s *HealthChecksPingMonitorResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	_, err := s.Client.DeletePingMonitor(context.Background(), request)
+	_, err := s.Client.DeletePingMonitor(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_health_checks "github.com/oracle/oci-go-sdk/healthchecks"
 )
 
@@ -13,7 +14,8 @@ func HealthChecksPingMonitorsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readHealthChecksPingMonitors,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -27,6 +29,22 @@ func HealthChecksPingMonitorsDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(HealthChecksPingMonitorResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_health_checks.ListPingMonitorsSortById),
+					string(oci_health_checks.ListPingMonitorsSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_health_checks.ListPingMonitorsSortOrderAsc),
+					string(oci_health_checks.ListPingMonitorsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -36,7 +54,7 @@ func readHealthChecksPingMonitors(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).healthChecksClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type HealthChecksPingMonitorsDataSourceCrud struct {
@@ -49,7 +67,7 @@ func (s *HealthChecksPingMonitorsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *HealthChecksPingMonitorsDataSourceCrud) Get() error {
+func (s *HealthChecksPingMonitorsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_health_checks.ListPingMonitorsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -62,9 +80,18 @@ func (s *HealthChecksPingMonitorsDataSourceCrud) Get() error {
 		request.DisplayName = &tmp
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_health_checks.ListPingMonitorsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_health_checks.ListPingMonitorsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "health_checks")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListPingMonitors(context.Background(), request)
+	response, err := s.Client.ListPingMonitors(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -72,8 +99,8 @@ func (s *HealthChecksPingMonitorsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListPingMonitors(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListPingMonitors(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -133,6 +160,8 @@ func (s *HealthChecksPingMonitorsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, HealthChecksPingMonitorsDataSource().Schema["ping_monitors"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("ping_monitors", resources); err != nil {
 		return err
 	}
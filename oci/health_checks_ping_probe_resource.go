@@ -77,7 +77,7 @@ func createHealthChecksPingProbe(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).healthChecksClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readHealthChecksPingProbe(d *schema.ResourceData, m interface{}) error {
@@ -99,7 +99,7 @@ func (s *HealthChecksPingProbeResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *HealthChecksPingProbeResourceCrud) Create() error {
+func (s *HealthChecksPingProbeResourceCrud) Create(ctx context.Context) error {
 	request := oci_health_checks.CreateOnDemandPingProbeRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -147,7 +147,7 @@ func (s *HealthChecksPingProbeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.CreateOnDemandPingProbe(context.Background(), request)
+	response, err := s.Client.CreateOnDemandPingProbe(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_health_checks "github.com/oracle/oci-go-sdk/healthchecks"
 )
 
@@ -13,7 +14,8 @@ func HealthChecksPingProbeResultsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readHealthChecksPingProbeResults,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"probe_configuration_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -148,6 +150,14 @@ func HealthChecksPingProbeResultsDataSource() *schema.Resource {
 					},
 				},
 			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_health_checks.ListPingProbeResultsSortOrderAsc),
+					string(oci_health_checks.ListPingProbeResultsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -157,7 +167,7 @@ func readHealthChecksPingProbeResults(d *schema.ResourceData, m interface{}) err
 	sync.D = d
 	sync.Client = m.(*OracleClients).healthChecksClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type HealthChecksPingProbeResultsDataSourceCrud struct {
@@ -170,7 +180,7 @@ func (s *HealthChecksPingProbeResultsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *HealthChecksPingProbeResultsDataSourceCrud) Get() error {
+func (s *HealthChecksPingProbeResultsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_health_checks.ListPingProbeResultsRequest{}
 
 	if probeConfigurationId, ok := s.D.GetOkExists("probe_configuration_id"); ok {
@@ -193,9 +203,14 @@ func (s *HealthChecksPingProbeResultsDataSourceCrud) Get() error {
 		request.Target = &tmp
 	}
 
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_health_checks.ListPingProbeResultsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "health_checks")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListPingProbeResults(context.Background(), request)
+	response, err := s.Client.ListPingProbeResults(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -203,8 +218,8 @@ func (s *HealthChecksPingProbeResultsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListPingProbeResults(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListPingProbeResults(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -300,6 +315,8 @@ func (s *HealthChecksPingProbeResultsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, HealthChecksPingProbeResultsDataSource().Schema["ping_probe_results"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("ping_probe_results", resources); err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_health_checks "github.com/oracle/oci-go-sdk/healthchecks"
 )
 
@@ -13,7 +14,8 @@ func HealthChecksVantagePointsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readHealthChecksVantagePoints,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"display_name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -119,6 +121,22 @@ func HealthChecksVantagePointsDataSource() *schema.Resource {
 					},
 				},
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_health_checks.ListHealthChecksVantagePointsSortByName),
+					string(oci_health_checks.ListHealthChecksVantagePointsSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_health_checks.ListHealthChecksVantagePointsSortOrderAsc),
+					string(oci_health_checks.ListHealthChecksVantagePointsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -128,7 +146,7 @@ func readHealthChecksVantagePoints(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).healthChecksClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type HealthChecksVantagePointsDataSourceCrud struct {
@@ -141,7 +159,7 @@ func (s *HealthChecksVantagePointsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *HealthChecksVantagePointsDataSourceCrud) Get() error {
+func (s *HealthChecksVantagePointsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_health_checks.ListHealthChecksVantagePointsRequest{}
 
 	if displayName, ok := s.D.GetOkExists("display_name"); ok {
@@ -154,9 +172,18 @@ func (s *HealthChecksVantagePointsDataSourceCrud) Get() error {
 		request.Name = &tmp
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_health_checks.ListHealthChecksVantagePointsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_health_checks.ListHealthChecksVantagePointsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "health_checks")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListHealthChecksVantagePoints(context.Background(), request)
+	response, err := s.Client.ListHealthChecksVantagePoints(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -164,8 +191,8 @@ func (s *HealthChecksVantagePointsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListHealthChecksVantagePoints(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListHealthChecksVantagePoints(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -219,6 +246,8 @@ func (s *HealthChecksVantagePointsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, HealthChecksVantagePointsDataSource().Schema["health_checks_vantage_points"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("health_checks_vantage_points", resources); err != nil {
 		return err
 	}
@@ -5,6 +5,8 @@ package provider
 import (
 	"fmt"
 	"math/rand"
+	"net/url"
+	"strings"
 	"time"
 
 	"strconv"
@@ -136,3 +138,37 @@ func randomString(length int, charset string) string {
 	}
 	return string(b)
 }
+
+// getCompositeId joins alternating keys and values into the ID of a resource that has no OCID, escaping the values,
+// e.g. getCompositeId("loadBalancers", loadBalancerId, "certificates", certificateName)
+func getCompositeId(keysAndValues ...string) string {
+	parts := make([]string, len(keysAndValues))
+	for i, part := range keysAndValues {
+		if i%2 == 1 {
+			part = url.PathEscape(part)
+		}
+		parts[i] = part
+	}
+	return strings.Join(parts, "/")
+}
+
+// parseCompositeId returns the unescaped values of a composite ID made of the given keys, in the same order
+func parseCompositeId(compositeId string, keys ...string) ([]string, error) {
+	parts := strings.Split(compositeId, "/")
+	if len(parts) != 2*len(keys) {
+		return nil, fmt.Errorf("illegal compositeId %s encountered", compositeId)
+	}
+
+	values := make([]string, len(keys))
+	for i, key := range keys {
+		if parts[2*i] != key {
+			return nil, fmt.Errorf("illegal compositeId %s encountered", compositeId)
+		}
+		value, err := url.PathUnescape(parts[2*i+1])
+		if err != nil {
+			return nil, fmt.Errorf("illegal compositeId %s encountered: %v", compositeId, err)
+		}
+		values[i] = value
+	}
+	return values, nil
+}
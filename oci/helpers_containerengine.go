@@ -0,0 +1,115 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_containerengine "github.com/oracle/oci-go-sdk/containerengine"
+)
+
+// containerEngineWorkRequestShouldRetryFunc Custom retry function for containerengine service
+func containerEngineWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
+	startTime := time.Now()
+	stopTime := startTime.Add(timeout)
+	return func(response oci_common.OCIOperationResponse) bool {
+
+		//Stop after timeout has elapsed
+		if time.Now().After(stopTime) {
+			return false
+		}
+
+		//Make sure we stop on default rules
+		if shouldRetry(response, false, "containerengine", startTime) {
+			return true
+		}
+
+		// Only stop if the time Finished is set
+		if okeRes, ok := response.Response.(oci_containerengine.GetWorkRequestResponse); ok {
+			return okeRes.TimeFinished == nil
+		}
+		return false
+	}
+}
+
+// containerEngineWorkRequestTracker tracks container engine work requests for clusters and node pools
+type containerEngineWorkRequestTracker struct {
+	client                 *oci_containerengine.ContainerEngineClient
+	disableNotFoundRetries bool
+}
+
+func newContainerEngineWorkRequestTracker(client *oci_containerengine.ContainerEngineClient, disableNotFoundRetries bool) WorkRequestTracker {
+	return &containerEngineWorkRequestTracker{client: client, disableNotFoundRetries: disableNotFoundRetries}
+}
+
+func (t *containerEngineWorkRequestTracker) WorkRequestRetryPolicy(timeout time.Duration) *oci_common.RetryPolicy {
+	retryPolicy := getRetryPolicy(t.disableNotFoundRetries, "containerengine")
+	retryPolicy.ShouldRetryOperation = containerEngineWorkRequestShouldRetryFunc(timeout)
+	return retryPolicy
+}
+
+func (t *containerEngineWorkRequestTracker) GetWorkRequest(ctx context.Context, workRequestId string, retryPolicy *oci_common.RetryPolicy) (*WorkRequestStatus, error) {
+	request := oci_containerengine.GetWorkRequestRequest{}
+	request.WorkRequestId = &workRequestId
+	request.RequestMetadata.RetryPolicy = retryPolicy
+
+	response, err := t.client.GetWorkRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	wr := &response.WorkRequest
+	result := &WorkRequestStatus{
+		Id:          workRequestId,
+		Status:      string(wr.Status),
+		WorkRequest: wr,
+	}
+	for _, res := range wr.Resources {
+		if res.EntityType != nil {
+			result.Resources = append(result.Resources, WorkRequestResource{EntityType: *res.EntityType, ActionType: string(res.ActionType), Identifier: res.Identifier})
+		}
+	}
+	return result, nil
+}
+
+func (t *containerEngineWorkRequestTracker) ListWorkRequestErrors(ctx context.Context, workRequest *WorkRequestStatus) ([]string, error) {
+	request := oci_containerengine.ListWorkRequestErrorsRequest{}
+	request.WorkRequestId = &workRequest.Id
+	request.CompartmentId = workRequest.WorkRequest.(*oci_containerengine.WorkRequest).CompartmentId
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(t.disableNotFoundRetries, "containerengine")
+
+	response, err := t.client.ListWorkRequestErrors(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []string{}
+	for _, wrkErr := range response.Items {
+		if wrkErr.Message != nil {
+			result = append(result, *wrkErr.Message)
+		}
+	}
+	return result, nil
+}
+
+func (t *containerEngineWorkRequestTracker) ListWorkRequestLogEntries(ctx context.Context, workRequest *WorkRequestStatus) ([]string, error) {
+	request := oci_containerengine.ListWorkRequestLogsRequest{}
+	request.WorkRequestId = &workRequest.Id
+	request.CompartmentId = workRequest.WorkRequest.(*oci_containerengine.WorkRequest).CompartmentId
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(t.disableNotFoundRetries, "containerengine")
+
+	response, err := t.client.ListWorkRequestLogs(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []string{}
+	for _, entry := range response.Items {
+		if entry.Message != nil {
+			result = append(result, *entry.Message)
+		}
+	}
+	return result, nil
+}
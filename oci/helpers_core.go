@@ -4,13 +4,26 @@ package provider
 
 import (
 	"context"
+	"log"
+	"net/http"
 
 	"fmt"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/common"
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
+// The rules of a security list or route table are read, modified and written back by the resources managing a single
+// rule, and by the security list or route table itself when it ignores the rules it does not own. The updates made by
+// this provider are serialized by these mutexes, keyed by the ID of the security list or route table.
+var securityListMutexes SafeMutexMap
+var routeTableMutexes SafeMutexMap
+
+// The number of times the rules of a security list or route table are read, modified and written back when they were
+// changed by someone else in between
+const concurrentRuleUpdateAttempts = 5
+
 // This applies the differences between the regular schema and the one
 // we supply for default resources, and returns the schema for a default resource
 func ConvertToDefaultVcnResourceSchema(resourceSchema *schema.Resource) *schema.Resource {
@@ -61,12 +74,12 @@ func LaunchOptionsToMap(obj *oci_core.LaunchOptions) map[string]interface{} {
 	return result
 }
 
-func getBackupPolicyId(assetId *string, client *oci_core.BlockstorageClient) (*string, error) {
+func getBackupPolicyId(ctx context.Context, assetId *string, client *oci_core.BlockstorageClient) (*string, error) {
 	request := oci_core.GetVolumeBackupPolicyAssetAssignmentRequest{}
 	request.AssetId = assetId
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
 
-	response, err := client.GetVolumeBackupPolicyAssetAssignment(context.Background(), request)
+	response, err := client.GetVolumeBackupPolicyAssetAssignment(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -95,3 +108,47 @@ func (s *CoreVolumeBackupResourceCrud) createBlockStorageSourceRegionClient(regi
 
 	return nil
 }
+
+// retryConcurrentRuleUpdate runs a read-modify-write of the rules of a security list or route table, which updates them
+// only if their etag did not change, and runs it again if they were changed by someone else since they were read
+func retryConcurrentRuleUpdate(update func() error) error {
+	var err error
+	for attempt := 1; attempt <= concurrentRuleUpdateAttempts; attempt++ {
+		err = update()
+		if serviceError, ok := oci_common.IsServiceError(err); !ok || serviceError.GetHTTPStatusCode() != http.StatusPreconditionFailed {
+			return err
+		}
+		log.Printf("[DEBUG] The rules were changed concurrently, attempt %d of %d to update them failed: %v", attempt, concurrentRuleUpdateAttempts, err)
+	}
+	return err
+}
+
+// getOwnedRuleHashes returns the hashes of the rules of a set in the configuration or the state of a resource, which
+// are the rules owned by the resource
+func getOwnedRuleHashes(d *schema.ResourceData, key string) map[int]bool {
+	hashes := map[int]bool{}
+	oldRules, newRules := d.GetChange(key)
+	for _, rules := range []interface{}{oldRules, newRules} {
+		if set, ok := rules.(*schema.Set); ok {
+			for _, rule := range set.List() {
+				hashes[set.F(rule)] = true
+			}
+		}
+	}
+	return hashes
+}
+
+// filterOwnedRules returns the rules, as returned by the service, that are in the set of a resource
+func filterOwnedRules(d *schema.ResourceData, key string, rules []interface{}) []interface{} {
+	set, ok := d.Get(key).(*schema.Set)
+	if !ok {
+		return rules
+	}
+	owned := []interface{}{}
+	for _, rule := range rules {
+		if set.Contains(rule) {
+			owned = append(owned, rule)
+		}
+	}
+	return owned
+}
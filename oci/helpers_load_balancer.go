@@ -3,8 +3,13 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"sync"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_load_balancer "github.com/oracle/oci-go-sdk/loadbalancer"
 )
 
 var lbBackendSetMutexes SafeMutexMap
@@ -15,19 +20,24 @@ type SafeMutexMap struct {
 }
 
 // Given a load balancer ID and backend set name, finds a mutex. If a mutex doesn't exist, then create one for that backend set.
-//
-// We can add more generic ways of accessing this map in the future; if other resources need to use this pattern for
-// resolving concurrent resource access issues. For now, keep it specific to backend sets
 func (safeMap *SafeMutexMap) GetOrCreateBackendSetMutex(lbId string, backendSetName string) *sync.Mutex {
 	if lbId == "" || backendSetName == "" {
 		return nil
 	}
 
+	return safeMap.GetOrCreateMutex(fmt.Sprintf("%s.%s", lbId, backendSetName))
+}
+
+// Given a key, e.g. the ID of a resource shared by other resources, finds a mutex. If a mutex doesn't exist, then create
+// one for that key.
+func (safeMap *SafeMutexMap) GetOrCreateMutex(key string) *sync.Mutex {
+	if key == "" {
+		return nil
+	}
+
 	safeMap.m.Lock()
 	defer safeMap.m.Unlock()
 
-	key := fmt.Sprintf("%s.%s", lbId, backendSetName)
-
 	if safeMap.mutexes == nil {
 		safeMap.mutexes = map[string]*sync.Mutex{}
 	}
@@ -40,3 +50,56 @@ func (safeMap *SafeMutexMap) GetOrCreateBackendSetMutex(lbId string, backendSetN
 
 	return m
 }
+
+// loadBalancerWorkRequestTracker tracks load balancer work requests, which report their errors and log messages
+// as part of the work request itself
+type loadBalancerWorkRequestTracker struct {
+	client                 *oci_load_balancer.LoadBalancerClient
+	disableNotFoundRetries bool
+}
+
+func newLoadBalancerWorkRequestTracker(client *oci_load_balancer.LoadBalancerClient, disableNotFoundRetries bool) WorkRequestTracker {
+	return &loadBalancerWorkRequestTracker{client: client, disableNotFoundRetries: disableNotFoundRetries}
+}
+
+func (t *loadBalancerWorkRequestTracker) WorkRequestRetryPolicy(timeout time.Duration) *oci_common.RetryPolicy {
+	return getRetryPolicy(t.disableNotFoundRetries, "load_balancer")
+}
+
+func (t *loadBalancerWorkRequestTracker) GetWorkRequest(ctx context.Context, workRequestId string, retryPolicy *oci_common.RetryPolicy) (*WorkRequestStatus, error) {
+	request := oci_load_balancer.GetWorkRequestRequest{}
+	request.WorkRequestId = &workRequestId
+	request.RequestMetadata.RetryPolicy = retryPolicy
+
+	response, err := t.client.GetWorkRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	wr := &response.WorkRequest
+	return &WorkRequestStatus{
+		Id:     workRequestId,
+		Status: string(wr.LifecycleState),
+		Resources: []WorkRequestResource{
+			{EntityType: "loadbalancer", Identifier: wr.LoadBalancerId},
+		},
+		WorkRequest: wr,
+	}, nil
+}
+
+func (t *loadBalancerWorkRequestTracker) ListWorkRequestErrors(ctx context.Context, workRequest *WorkRequestStatus) ([]string, error) {
+	result := []string{}
+	for _, wrkErr := range workRequest.WorkRequest.(*oci_load_balancer.WorkRequest).ErrorDetails {
+		if wrkErr.Message != nil {
+			result = append(result, fmt.Sprintf("%s: %s", wrkErr.ErrorCode, *wrkErr.Message))
+		}
+	}
+	return result, nil
+}
+
+func (t *loadBalancerWorkRequestTracker) ListWorkRequestLogEntries(ctx context.Context, workRequest *WorkRequestStatus) ([]string, error) {
+	if message := workRequest.WorkRequest.(*oci_load_balancer.WorkRequest).Message; message != nil && *message != "" {
+		return []string{*message}, nil
+	}
+	return nil, nil
+}
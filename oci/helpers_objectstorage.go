@@ -5,16 +5,24 @@ package provider
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
+	"net/http"
 	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/oracle/oci-go-sdk/common"
 	oci_common "github.com/oracle/oci-go-sdk/common"
 	oci_object_storage "github.com/oracle/oci-go-sdk/objectstorage"
@@ -22,8 +30,20 @@ import (
 
 const defaultFilePartSize int64 = 128 * 1024 * 1024 // 128MB
 const defaultNumberOfGoroutines = 10
+const minPartSize int64 = 10 * 1024 * 1024
 const maxPartSize int64 = 50 * 1024 * 1024 * 1024
 const maxCount int64 = 10000
+const maxNumberOfGoroutines = 100
+
+// maxPartAttemptsWithoutResponse bounds the attempts to upload a part that fail before getting a response, e.g. because
+// the connection is reset, which the retry policies do not retry
+const maxPartAttemptsWithoutResponse = 5
+
+// resumableUploadsDir keeps a record of the multipart uploads left in place to be resumed, see multipartUploadRecord
+var resumableUploadsDir = filepath.Join(os.TempDir(), "terraform-provider-oci-uploads")
+
+// objectDownloadsDir keeps a record of the objects downloaded to files, see objectDownloadRecord
+var objectDownloadsDir = filepath.Join(os.TempDir(), "terraform-provider-oci-downloads")
 
 type MultipartUploadData struct {
 	NamespaceName       *string                                 `mandatory:"true"`
@@ -39,6 +59,10 @@ type MultipartUploadData struct {
 	Metadata            map[string]interface{}
 	OpcClientRequestID  *string
 	RequestMetadata     common.RequestMetadata
+	// PartSize is the size of the parts of the files larger than it, defaultFilePartSize when zero
+	PartSize int64
+	// Parallelism is the number of parts uploaded at the same time, defaultNumberOfGoroutines when zero
+	Parallelism int
 }
 
 type objectStorageUploadPartResponse struct {
@@ -54,6 +78,8 @@ type objectStorageMultiPartUploadContext struct {
 	wg                      *sync.WaitGroup
 	multipartUploadResponse oci_object_storage.CreateMultipartUploadResponse
 	multipartUploadRequest  oci_object_storage.CreateMultipartUploadRequest
+	// uploadedParts are the parts of a resumed upload, by part number
+	uploadedParts map[int]oci_object_storage.MultipartUploadPartSummary
 }
 
 type objectStorageSourceBlock struct {
@@ -119,6 +145,18 @@ func validateSourceValue(i interface{}, k string) (s []string, es []error) {
 	return
 }
 
+func validateMultipartPartSize(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(int)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be int", k))
+		return
+	}
+	if int64(v) < minPartSize || int64(v) > maxPartSize {
+		es = append(es, fmt.Errorf("expected %s to be between %d and %d bytes, got %d", k, minPartSize, maxPartSize, v))
+	}
+	return
+}
+
 // Borrowed from https://mijailovic.net/2017/05/09/error-handling-patterns-in-go/
 func safeClose(c io.Closer, err *error) {
 	if cerr := c.Close(); cerr != nil && *err == nil {
@@ -126,7 +164,7 @@ func safeClose(c io.Closer, err *error) {
 	}
 }
 
-func singlePartUpload(multipartUploadData MultipartUploadData) (string, error) {
+func singlePartUpload(ctx context.Context, multipartUploadData MultipartUploadData) (string, error) {
 
 	sourcePath := *multipartUploadData.SourcePath
 	sourceInfo := *multipartUploadData.SourceInfo
@@ -153,7 +191,7 @@ func singlePartUpload(multipartUploadData MultipartUploadData) (string, error) {
 	}
 	putObjectRequest.RequestMetadata.RetryPolicy = multipartUploadData.RequestMetadata.RetryPolicy
 
-	_, err = multipartUploadData.ObjectStorageClient.PutObject(context.Background(), *putObjectRequest)
+	_, err = multipartUploadData.ObjectStorageClient.PutObject(ctx, *putObjectRequest)
 	if err != nil {
 		return "", err
 	}
@@ -163,18 +201,18 @@ func singlePartUpload(multipartUploadData MultipartUploadData) (string, error) {
 	return id, nil
 }
 
-func MultiPartUpload(multipartUploadData MultipartUploadData) (string, error) {
+func MultiPartUpload(ctx context.Context, multipartUploadData MultipartUploadData) (string, error) {
 
 	sourceInfo := *multipartUploadData.SourceInfo
 
-	if sourceInfo.Size() > defaultFilePartSize {
-		return multiPartUploadImpl(multipartUploadData)
+	if sourceInfo.Size() > multipartPartSize(multipartUploadData.PartSize) {
+		return multiPartUploadImpl(ctx, multipartUploadData)
 	}
 
-	return singlePartUpload(multipartUploadData)
+	return singlePartUpload(ctx, multipartUploadData)
 }
 
-func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error) {
+func multiPartUploadImpl(ctx context.Context, multipartUploadData MultipartUploadData) (string, error) {
 
 	multipartUploadRequest := &oci_object_storage.CreateMultipartUploadRequest{
 		NamespaceName:   multipartUploadData.NamespaceName,
@@ -197,17 +235,33 @@ func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error
 	}
 	defer safeClose(file, &err)
 
-	sourceBlocks, err := objectMultiPartSplit(file)
+	sourceBlocks, err := objectMultiPartSplit(file, multipartUploadData.PartSize)
 	if err != nil {
 		return "", fmt.Errorf("error splitting source file for upload \"%v\": %s", source, err)
 	}
 
-	multipartUploadResponse, err := client.CreateMultipartUpload(context.Background(), *multipartUploadRequest)
+	// An upload of the object left by an interrupted upload is resumed, reusing the parts that are already uploaded
+	record := multipartUploadRecord{
+		path:       multipartUploadRecordPath(client.Host, multipartUploadRequest),
+		Properties: multipartUploadProperties(multipartUploadRequest, (*multipartUploadData.SourceInfo).Size(), multipartUploadData.PartSize),
+	}
+	multipartUploadResponse, uploadedParts, err := findResumableMultipartUpload(ctx, multipartUploadData, record)
 	if err != nil {
-		return "", fmt.Errorf("error creating object in the Oracle cloud \"%v\": %s", source, err)
+		return "", fmt.Errorf("error looking for an upload of \"%v\" to resume: %s", *source, err)
+	}
+	if multipartUploadResponse.UploadId != nil {
+		log.Printf("[INFO] Resuming upload %s of object %s with %d parts already uploaded", *multipartUploadResponse.UploadId, *multipartUploadData.ObjectName, len(uploadedParts))
+	} else {
+		multipartUploadResponse, err = client.CreateMultipartUpload(ctx, *multipartUploadRequest)
+		if err != nil {
+			return "", fmt.Errorf("error creating object in the Oracle cloud \"%v\": %s", source, err)
+		}
 	}
 
 	workerCount := defaultNumberOfGoroutines
+	if multipartUploadData.Parallelism > 0 {
+		workerCount = multipartUploadData.Parallelism
+	}
 
 	osUploadPartResponses := make(chan objectStorageUploadPartResponse, len(sourceBlocks))
 	sourceBlocksChan := make(chan objectStorageSourceBlock, len(sourceBlocks))
@@ -221,13 +275,14 @@ func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error
 	close(sourceBlocksChan)
 
 	for i := 0; i < workerCount; i++ {
-		go uploadPartsWorker(objectStorageMultiPartUploadContext{
+		go uploadPartsWorker(ctx, objectStorageMultiPartUploadContext{
 			client:                  *client,
 			wg:                      wg,
 			multipartUploadResponse: multipartUploadResponse,
 			multipartUploadRequest:  *multipartUploadRequest,
 			sourceBlocks:            sourceBlocksChan,
 			osUploadPartResponses:   osUploadPartResponses,
+			uploadedParts:           uploadedParts,
 		})
 	}
 
@@ -253,20 +308,17 @@ func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error
 	}
 
 	if uploadPartRespErr != nil {
-		// just aborting the multi upload for now; but the service itself will handle the same request again
-		abortMultipartUploadRequest := oci_object_storage.AbortMultipartUploadRequest{
-			NamespaceName:      multipartUploadResponse.Namespace,
-			BucketName:         multipartUploadResponse.Bucket,
-			ObjectName:         multipartUploadResponse.Object,
-			UploadId:           multipartUploadResponse.MultipartUpload.UploadId,
-			OpcClientRequestId: multipartUploadResponse.OpcClientRequestId,
-			RequestMetadata:    multipartUploadRequest.RequestMetadata,
-		}
-
-		_, err := client.AbortMultipartUpload(context.Background(), abortMultipartUploadRequest)
-
-		if err != nil {
-			log.Println("[WARN] Aborting the multi part upload failed")
+		// The upload is not aborted after a failure that may not happen again, so that the next upload of the object with
+		// the same properties resumes it rather than uploading every part again
+		if isResumableUploadFailure(uploadPartRespErr) {
+			log.Printf("[WARN] Leaving upload %s of object %s in place to be resumed by the next upload of the object", *multipartUploadResponse.UploadId, *multipartUploadResponse.Object)
+			record.UploadId = *multipartUploadResponse.UploadId
+			if err := record.write(); err != nil {
+				log.Printf("[WARN] Could not record upload %s of object %s, it will not be resumed: %v", record.UploadId, *multipartUploadResponse.Object, err)
+			}
+		} else {
+			abortMultipartUpload(ctx, client, multipartUploadResponse.MultipartUpload, multipartUploadRequest.RequestMetadata)
+			record.remove()
 		}
 
 		return "", fmt.Errorf("failed to upload object parts of \"%v\" to the Oracle cloud: %s", source, uploadPartRespErr)
@@ -282,24 +334,174 @@ func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error
 	}
 	commitMultipartUploadRequest.PartsToCommit = commitMultipartUploadPartDetails
 
-	_, err = client.CommitMultipartUpload(context.Background(), commitMultipartUploadRequest)
+	_, err = client.CommitMultipartUpload(ctx, commitMultipartUploadRequest)
 	if err != nil {
 		return "", fmt.Errorf("failed to commit multi part upload of \"%v\" to the service: %s", source, err)
 	}
+	record.remove()
 
 	id := getObjectCompositeId(*commitMultipartUploadRequest.BucketName, *commitMultipartUploadRequest.NamespaceName, *commitMultipartUploadRequest.ObjectName)
 
 	return id, nil
 }
 
-func objectMultiPartSplit(file *os.File) ([]objectStorageSourceBlock, error) {
+// multipartUploadRecord identifies the upload of an object left in place by a failed upload of the provider. As the
+// service lists neither the properties of the uploads nor the clients that created them, the upload is only resumed
+// when it is recorded with the same properties, so that the uploads of other clients are left alone.
+type multipartUploadRecord struct {
+	path       string
+	UploadId   string `json:"uploadId"`
+	Properties string `json:"properties"`
+}
+
+// multipartUploadRecordPath returns the path of the record of the uploads of an object
+func multipartUploadRecordPath(host string, request *oci_object_storage.CreateMultipartUploadRequest) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{host, *request.NamespaceName, *request.BucketName, *request.Object}, "/")))
+	return filepath.Join(resumableUploadsDir, hex.EncodeToString(sum[:])+".json")
+}
+
+// multipartUploadProperties returns a digest of the properties of an upload, and of the parts the source is split into
+func multipartUploadProperties(request *oci_object_storage.CreateMultipartUploadRequest, size int64, partSize int64) string {
+	content, _ := json.Marshal(struct {
+		Details  oci_object_storage.CreateMultipartUploadDetails
+		Size     int64
+		PartSize int64
+	}{request.CreateMultipartUploadDetails, size, multipartPartSize(partSize)})
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// read returns the ID of the recorded upload, if its properties match the ones of the record
+func (r multipartUploadRecord) read() (uploadId string, matches bool) {
+	content, err := ioutil.ReadFile(r.path)
+	if err != nil {
+		return "", false
+	}
+	recorded := multipartUploadRecord{}
+	if err := json.Unmarshal(content, &recorded); err != nil {
+		return "", false
+	}
+	return recorded.UploadId, recorded.Properties == r.Properties
+}
+
+func (r multipartUploadRecord) write() error {
+	if err := os.MkdirAll(filepath.Dir(r.path), 0700); err != nil {
+		return err
+	}
+	content, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.path, content, 0600)
+}
+
+func (r multipartUploadRecord) remove() {
+	if err := os.Remove(r.path); err != nil && !os.IsNotExist(err) {
+		log.Printf("[WARN] Could not remove the record of the upload to resume %s: %v", r.path, err)
+	}
+}
+
+// isResumableUploadFailure returns whether the upload of a part failed with an error that may not happen again, i.e.
+// without a response, with a 429 or with a 5xx
+func isResumableUploadFailure(err error) bool {
+	if serviceError, ok := oci_common.IsServiceError(err); ok {
+		statusCode := serviceError.GetHTTPStatusCode()
+		return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+	}
+	return true
+}
+
+// abortMultipartUpload aborts an upload, logging the errors as the upload is abandoned anyway
+func abortMultipartUpload(ctx context.Context, client *oci_object_storage.ObjectStorageClient, upload oci_object_storage.MultipartUpload, requestMetadata common.RequestMetadata) {
+	log.Printf("[INFO] Aborting upload %s of object %s", *upload.UploadId, *upload.Object)
+	_, err := client.AbortMultipartUpload(ctx, oci_object_storage.AbortMultipartUploadRequest{
+		NamespaceName:   upload.Namespace,
+		BucketName:      upload.Bucket,
+		ObjectName:      upload.Object,
+		UploadId:        upload.UploadId,
+		RequestMetadata: requestMetadata,
+	})
+	if err != nil && !isObjectStorageNotFound(err) {
+		log.Printf("[WARN] Could not abort upload %s of object %s: %v", *upload.UploadId, *upload.Object, err)
+	}
+}
+
+// findResumableMultipartUpload returns the upload of the object recorded by a previous upload that failed, if it is
+// neither committed nor aborted, and its parts by part number. The recorded upload is aborted when its properties do
+// not match the ones of the record.
+func findResumableMultipartUpload(ctx context.Context, multipartUploadData MultipartUploadData, record multipartUploadRecord) (oci_object_storage.CreateMultipartUploadResponse, map[int]oci_object_storage.MultipartUploadPartSummary, error) {
+	client := multipartUploadData.ObjectStorageClient
+	result := oci_object_storage.CreateMultipartUploadResponse{}
+
+	uploadId, matches := record.read()
+	if uploadId == "" {
+		return result, nil, nil
+	}
+
+	listUploadsRequest := oci_object_storage.ListMultipartUploadsRequest{
+		NamespaceName:   multipartUploadData.NamespaceName,
+		BucketName:      multipartUploadData.BucketName,
+		RequestMetadata: multipartUploadData.RequestMetadata,
+	}
+	for result.UploadId == nil {
+		listUploadsResponse, err := client.ListMultipartUploads(ctx, listUploadsRequest)
+		if err != nil {
+			return result, nil, err
+		}
+		for _, upload := range listUploadsResponse.Items {
+			if upload.Object != nil && *upload.Object == *multipartUploadData.ObjectName && upload.UploadId != nil && *upload.UploadId == uploadId {
+				result.MultipartUpload = upload
+			}
+		}
+		if listUploadsResponse.OpcNextPage == nil {
+			break
+		}
+		listUploadsRequest.Page = listUploadsResponse.OpcNextPage
+	}
+	if result.UploadId == nil || !matches {
+		if result.UploadId != nil {
+			log.Printf("[INFO] The properties of upload %s of object %s changed, it is not resumed", uploadId, *multipartUploadData.ObjectName)
+			abortMultipartUpload(ctx, client, result.MultipartUpload, multipartUploadData.RequestMetadata)
+		}
+		record.remove()
+		return oci_object_storage.CreateMultipartUploadResponse{}, nil, nil
+	}
+
+	uploadedParts := map[int]oci_object_storage.MultipartUploadPartSummary{}
+	listPartsRequest := oci_object_storage.ListMultipartUploadPartsRequest{
+		NamespaceName:   multipartUploadData.NamespaceName,
+		BucketName:      multipartUploadData.BucketName,
+		ObjectName:      multipartUploadData.ObjectName,
+		UploadId:        result.UploadId,
+		RequestMetadata: multipartUploadData.RequestMetadata,
+	}
+	for {
+		listPartsResponse, err := client.ListMultipartUploadParts(ctx, listPartsRequest)
+		if err != nil {
+			return result, nil, err
+		}
+		for _, part := range listPartsResponse.Items {
+			if part.PartNumber != nil {
+				uploadedParts[*part.PartNumber] = part
+			}
+		}
+		if listPartsResponse.OpcNextPage == nil {
+			break
+		}
+		listPartsRequest.Page = listPartsResponse.OpcNextPage
+	}
+
+	return result, uploadedParts, nil
+}
+
+func objectMultiPartSplit(file *os.File, partSize int64) ([]objectStorageSourceBlock, error) {
 
 	info, err := file.Stat()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get FileInfo for the source %q: %s", file.Name(), err)
 	}
 
-	offsets, limits, err := splitSizeToOffsetsAndLimits(info.Size())
+	offsets, limits, err := splitSizeToOffsetsAndLimits(info.Size(), partSize)
 	sourceBlocks := make([]objectStorageSourceBlock, len(offsets))
 	for index := 0; index < len(offsets); index++ {
 		tmpIndex := index + 1
@@ -312,8 +514,16 @@ func objectMultiPartSplit(file *os.File) ([]objectStorageSourceBlock, error) {
 	return sourceBlocks, nil
 }
 
-func splitSizeToOffsetsAndLimits(infoSize int64) ([]int64, []int64, error) {
-	partSize := defaultFilePartSize
+// multipartPartSize returns the size of the parts of a multipart upload, defaultFilePartSize unless configured
+func multipartPartSize(partSize int64) int64 {
+	if partSize <= 0 {
+		return defaultFilePartSize
+	}
+	return partSize
+}
+
+func splitSizeToOffsetsAndLimits(infoSize int64, partSize int64) ([]int64, []int64, error) {
+	partSize = multipartPartSize(partSize)
 	remainingPart := int64(0)
 
 	totalNumber := infoSize / partSize
@@ -355,8 +565,51 @@ func splitSizeToOffsetsAndLimits(infoSize int64) ([]int64, []int64, error) {
 	return offsets, limits, nil
 }
 
-func uploadPartsWorker(ctx objectStorageMultiPartUploadContext) {
-	for sourceBlock := range ctx.sourceBlocks {
+// isMultipartMd5 returns whether an MD5 reported by the service is the one of an object uploaded in parts, i.e. the
+// base64 MD5 of the MD5s of its parts followed by the number of parts, e.g. "oCQxU/0Lrb8oD5FtXCoV2g==-3"
+func isMultipartMd5(md5 string) bool {
+	return strings.Contains(md5, "-")
+}
+
+// computeSourceMd5 returns the MD5 of a source file in the form reported by the service for an object uploaded from it:
+// the base64 MD5 of its content, or when uploaded in parts, the MD5 of the parts of partSize MultiPartUpload splits it in
+func computeSourceMd5(source string, multipart bool, partSize int64) (result string, err error) {
+	file, err := os.Open(source)
+	if err != nil {
+		return "", err
+	}
+	defer safeClose(file, &err)
+
+	if !multipart {
+		hash := md5.New()
+		if _, err := io.Copy(hash, file); err != nil {
+			return "", fmt.Errorf("failed to read source %q: %s", source, err)
+		}
+		return base64.StdEncoding.EncodeToString(hash.Sum(nil)), nil
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return "", err
+	}
+	offsets, limits, err := splitSizeToOffsetsAndLimits(info.Size(), partSize)
+	if err != nil {
+		return "", err
+	}
+
+	partsHash := md5.New()
+	for index := range offsets {
+		hash := md5.New()
+		if _, err := io.Copy(hash, io.NewSectionReader(file, offsets[index], limits[index])); err != nil {
+			return "", fmt.Errorf("failed to read part %d of source %q: %s", index+1, source, err)
+		}
+		partsHash.Write(hash.Sum(nil))
+	}
+	return fmt.Sprintf("%s-%d", base64.StdEncoding.EncodeToString(partsHash.Sum(nil)), len(offsets)), nil
+}
+
+func uploadPartsWorker(ctx context.Context, uploadContext objectStorageMultiPartUploadContext) {
+	for sourceBlock := range uploadContext.sourceBlocks {
 
 		block := make([]byte, sourceBlock.section.Size())
 		_, err := sourceBlock.section.Read(block)
@@ -368,23 +621,39 @@ func uploadPartsWorker(ctx objectStorageMultiPartUploadContext) {
 			osUploadPartResponse := &objectStorageUploadPartResponse{
 				error: err,
 			}
-			ctx.osUploadPartResponses <- *osUploadPartResponse
-			ctx.wg.Done()
+			uploadContext.osUploadPartResponses <- *osUploadPartResponse
+			uploadContext.wg.Done()
 			continue
 		}
 		tmpLength := int64(len(block))
+		sum := md5.Sum(block)
+		tmpMd5 := base64.StdEncoding.EncodeToString(sum[:])
+
+		// The parts of a resumed upload are uploaded again only if their content changed
+		if part, ok := uploadContext.uploadedParts[*sourceBlock.blockNumber]; ok && part.Md5 != nil && *part.Md5 == tmpMd5 && part.Size != nil && *part.Size == tmpLength {
+			uploadContext.osUploadPartResponses <- objectStorageUploadPartResponse{
+				response:   oci_object_storage.UploadPartResponse{ETag: part.Etag, OpcContentMd5: part.Md5},
+				partNumber: sourceBlock.blockNumber,
+			}
+			uploadContext.wg.Done()
+			continue
+		}
+
 		uploadPartRequest := &oci_object_storage.UploadPartRequest{
-			UploadId:        ctx.multipartUploadResponse.UploadId,
-			ObjectName:      ctx.multipartUploadResponse.Object,
-			NamespaceName:   ctx.multipartUploadResponse.Namespace,
-			BucketName:      ctx.multipartUploadResponse.Bucket,
-			RequestMetadata: ctx.multipartUploadRequest.RequestMetadata,
-			ContentLength:   &tmpLength,
-			UploadPartBody:  ioutil.NopCloser(bytes.NewBuffer(block)),
-			UploadPartNum:   sourceBlock.blockNumber,
+			UploadId:      uploadContext.multipartUploadResponse.UploadId,
+			ObjectName:    uploadContext.multipartUploadResponse.Object,
+			NamespaceName: uploadContext.multipartUploadResponse.Namespace,
+			BucketName:    uploadContext.multipartUploadResponse.Bucket,
+			ContentLength: &tmpLength,
+			ContentMD5:    &tmpMd5,
+			UploadPartNum: sourceBlock.blockNumber,
 		}
 
-		uploadPartResponse, err := ctx.client.UploadPart(context.Background(), *uploadPartRequest)
+		var retryPolicy *oci_common.RetryPolicy
+		if uploadContext.multipartUploadRequest.RequestMetadata.RetryPolicy != nil {
+			retryPolicy = getRetryPolicy(true, "object_storage")
+		}
+		uploadPartResponse, err := uploadPart(ctx, uploadContext.client, *uploadPartRequest, block, retryPolicy)
 
 		osUploadPartResponse := &objectStorageUploadPartResponse{
 			response:   uploadPartResponse,
@@ -392,68 +661,398 @@ func uploadPartsWorker(ctx objectStorageMultiPartUploadContext) {
 			partNumber: uploadPartRequest.UploadPartNum,
 		}
 
-		ctx.osUploadPartResponses <- *osUploadPartResponse
-		ctx.wg.Done()
+		uploadContext.osUploadPartResponses <- *osUploadPartResponse
+		uploadContext.wg.Done()
 	}
 }
 
-func (s *ObjectStorageObjectResourceCrud) createSourceRegionClient(region string) error {
-	if s.SourceRegionClient == nil {
-		sourceObjectStorageClient, err := oci_object_storage.NewObjectStorageClientWithConfigurationProvider(*s.Client.ConfigurationProvider())
-		if err != nil {
-			return fmt.Errorf("cannot create client for the source region: %v", err)
+// uploadPart uploads a part of a multipart upload with a new body at every attempt, as the SDK would send the consumed
+// body again. The part is retried as long as its retry policy allows, which starts with the part rather than the upload,
+// as well as a few times when the request fails without a response, e.g. because the connection is reset. 404s are not
+// retried as they mean the upload was aborted.
+func uploadPart(ctx context.Context, client oci_object_storage.ObjectStorageClient, request oci_object_storage.UploadPartRequest, block []byte, retryPolicy *oci_common.RetryPolicy) (oci_object_storage.UploadPartResponse, error) {
+	for attempt := uint(1); ; attempt++ {
+		request.UploadPartBody = ioutil.NopCloser(bytes.NewReader(block))
+		response, err := client.UploadPart(ctx, request)
+		if err == nil || retryPolicy == nil || ctx.Err() != nil {
+			return response, err
 		}
-		err = configureClient(&sourceObjectStorageClient.BaseClient)
+
+		operationResponse := oci_common.NewOCIOperationResponse(response, err, attempt)
+		retry := retryPolicy.ShouldRetryOperation(operationResponse)
+		if response.HTTPResponse() == nil {
+			retry = attempt < maxPartAttemptsWithoutResponse
+		}
+		if !retry || (retryPolicy.MaximumNumberAttempts > 0 && attempt >= retryPolicy.MaximumNumberAttempts) {
+			return response, err
+		}
+
+		duration := retryPolicy.NextDuration(operationResponse)
+		log.Printf("[WARN] Retrying part %d of upload %s in %s after error: %s", *request.UploadPartNum, *request.UploadId, duration, err)
+		select {
+		case <-ctx.Done():
+			return response, ctx.Err()
+		case <-time.After(duration):
+		}
+	}
+}
+
+// deleteObjects deletes objects of a bucket with at most parallelism concurrent requests, ignoring the objects that
+// no longer exist. It stops at the first error, which it returns.
+func deleteObjects(ctx context.Context, client *oci_object_storage.ObjectStorageClient, namespace string, bucket string, objects []string, parallelism int) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	names := make(chan string, len(objects))
+	for _, object := range objects {
+		names <- object
+	}
+	close(names)
+
+	errs := make(chan error, parallelism)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < parallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for name := range names {
+				if ctx.Err() != nil {
+					return
+				}
+				request := oci_object_storage.DeleteObjectRequest{
+					NamespaceName: &namespace,
+					BucketName:    &bucket,
+					ObjectName:    oci_common.String(name),
+				}
+				request.RequestMetadata.RetryPolicy = getRetryPolicy(true, "object_storage")
+
+				if _, err := client.DeleteObject(ctx, request); err != nil && !isObjectStorageNotFound(err) {
+					errs <- fmt.Errorf("failed to delete object %s from bucket %s: %s", name, bucket, err)
+					cancel()
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	if err := <-errs; err != nil {
+		return err
+	}
+	return ctx.Err()
+}
+
+func isObjectStorageNotFound(err error) bool {
+	serviceError, ok := oci_common.IsServiceError(err)
+	return ok && serviceError.GetHTTPStatusCode() == http.StatusNotFound
+}
+
+var objectRangePattern = regexp.MustCompile(`^bytes=(\d*)-(\d*)$`)
+
+func validateObjectRange(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+	if matches := objectRangePattern.FindStringSubmatch(v); matches == nil || (matches[1] == "" && matches[2] == "") {
+		es = append(es, fmt.Errorf("expected %s to be a byte range such as bytes=0-1023, bytes=1024- or bytes=-1024, got %s", k, v))
+	}
+	return
+}
+
+// parseObjectRange returns the first and last bytes of an object of a given size in a range such as "bytes=0-1023",
+// "bytes=1024-" or "bytes=-1024"
+func parseObjectRange(value string, size int64) (int64, int64, error) {
+	matches := objectRangePattern.FindStringSubmatch(value)
+	if matches == nil || (matches[1] == "" && matches[2] == "") {
+		return 0, 0, fmt.Errorf("invalid range %s", value)
+	}
+
+	first, last := int64(0), size-1
+	if matches[1] == "" {
+		suffix, _ := strconv.ParseInt(matches[2], 10, 64)
+		if suffix < size {
+			first = size - suffix
+		}
+	} else {
+		first, _ = strconv.ParseInt(matches[1], 10, 64)
+		if matches[2] != "" {
+			last, _ = strconv.ParseInt(matches[2], 10, 64)
+		}
+	}
+	if last > size-1 {
+		last = size - 1
+	}
+	if first > last {
+		return 0, 0, fmt.Errorf("the range %s is not satisfiable for an object of %d bytes", value, size)
+	}
+	return first, last, nil
+}
+
+type ObjectDownloadData struct {
+	NamespaceName       *string                                 `mandatory:"true"`
+	BucketName          *string                                 `mandatory:"true"`
+	ObjectName          *string                                 `mandatory:"true"`
+	ObjectStorageClient *oci_object_storage.ObjectStorageClient `mandatory:"true"`
+	OutputPath          string                                  `mandatory:"true"`
+	// Head is the response of the HEAD of the object, whose ETag every request matches
+	Head oci_object_storage.HeadObjectResponse `mandatory:"true"`
+	// Range is the range of bytes of the object to download, e.g. "bytes=0-1023", the whole object when empty
+	Range string
+	// PartSize is the size of the ranges downloaded at the same time, defaultFilePartSize when zero
+	PartSize int64
+	// Parallelism is the number of ranges downloaded at the same time, one when zero
+	Parallelism int
+	RetryPolicy *oci_common.RetryPolicy
+}
+
+// downloadObject streams an object, or a range of bytes of it, to a file, with parallel ranged GETs when configured.
+// The object is written to a temporary file that replaces the output file once complete, and whose MD5 is checked
+// against the MD5 of the object when the whole object is downloaded. It returns the base64 MD5 and size of the file.
+//
+// The download is skipped when the output file already has the MD5 of the object, or when it is recorded as a download
+// of the same version and range of the object and still has the MD5 of that download, e.g. for the objects uploaded in
+// parts.
+func downloadObject(ctx context.Context, downloadData ObjectDownloadData) (outputMd5 string, size int64, err error) {
+	objectSize := int64(0)
+	if downloadData.Head.ContentLength != nil {
+		objectSize = *downloadData.Head.ContentLength
+	}
+	first, last := int64(0), objectSize-1
+	if downloadData.Range != "" {
+		if first, last, err = parseObjectRange(downloadData.Range, objectSize); err != nil {
+			return "", 0, err
+		}
+	}
+
+	// The MD5 of an object uploaded in parts can not be checked without the size of its parts
+	objectMd5 := ""
+	if downloadData.Range == "" && downloadData.Head.ContentMd5 != nil && !isMultipartMd5(*downloadData.Head.ContentMd5) && downloadData.Head.OpcMultipartMd5 == nil {
+		objectMd5 = *downloadData.Head.ContentMd5
+	}
+	record := objectDownloadRecord{
+		path:  objectDownloadRecordPath(downloadData.OutputPath),
+		Range: downloadData.Range,
+	}
+	if downloadData.Head.ETag != nil {
+		record.ETag = *downloadData.Head.ETag
+	}
+	if downloadData.Head.OpcMultipartMd5 != nil {
+		record.ObjectMd5 = *downloadData.Head.OpcMultipartMd5
+	} else if downloadData.Head.ContentMd5 != nil {
+		record.ObjectMd5 = *downloadData.Head.ContentMd5
+	}
+	if info, statErr := os.Stat(downloadData.OutputPath); statErr == nil && info.Mode().IsRegular() {
+		recorded, recordMatches := record.read()
+		if (objectMd5 != "" && info.Size() == objectSize) || (recordMatches && info.Size() == recorded.Size) {
+			if existingMd5, md5Err := computeSourceMd5(downloadData.OutputPath, false, 0); md5Err == nil && (existingMd5 == objectMd5 || (recordMatches && existingMd5 == recorded.ContentMd5)) {
+				log.Printf("[DEBUG] %s already has the content of object %s, not downloading it again", downloadData.OutputPath, *downloadData.ObjectName)
+				return existingMd5, info.Size(), nil
+			}
+		}
+	}
+
+	file, err := ioutil.TempFile(filepath.Dir(downloadData.OutputPath), "."+filepath.Base(downloadData.OutputPath)+".")
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to create a temporary file for %s: %s", downloadData.OutputPath, err)
+	}
+	defer func() {
 		if err != nil {
-			return fmt.Errorf("cannot configure client for the source region: %v", err)
+			file.Close()
+			os.Remove(file.Name())
+		}
+	}()
+
+	if last >= first {
+		if err = downloadObjectRanges(ctx, downloadData, file, first, last); err != nil {
+			return "", 0, err
 		}
-		s.SourceRegionClient = &sourceObjectStorageClient
 	}
-	s.SourceRegionClient.SetRegion(region)
 
-	return nil
+	if err = file.Sync(); err != nil {
+		return "", 0, err
+	}
+	if err = file.Close(); err != nil {
+		return "", 0, err
+	}
+
+	info, err := os.Stat(file.Name())
+	if err != nil {
+		return "", 0, err
+	}
+	if size = info.Size(); size != last-first+1 {
+		err = fmt.Errorf("downloaded %d bytes of object %s rather than %d", size, *downloadData.ObjectName, last-first+1)
+		return "", 0, err
+	}
+	if outputMd5, err = computeSourceMd5(file.Name(), false, 0); err != nil {
+		return "", 0, err
+	}
+	if objectMd5 != "" && outputMd5 != objectMd5 {
+		err = fmt.Errorf("the MD5 %s of the download of object %s does not match its MD5 %s", outputMd5, *downloadData.ObjectName, objectMd5)
+		return "", 0, err
+	}
+
+	if err = os.Rename(file.Name(), downloadData.OutputPath); err != nil {
+		return "", 0, fmt.Errorf("failed to write %s: %s", downloadData.OutputPath, err)
+	}
+
+	record.ContentMd5, record.Size = outputMd5, size
+	if recordErr := record.write(); recordErr != nil {
+		log.Printf("[WARN] Could not record the download of object %s to %s, it will be downloaded again: %v", *downloadData.ObjectName, downloadData.OutputPath, recordErr)
+	}
+	return outputMd5, size, nil
 }
 
-func copyObjectWaitForWorkRequest(wId *string, entityType string, timeout time.Duration, disableFoundRetries bool, client *oci_object_storage.ObjectStorageClient) error {
+// objectDownloadRecord identifies the version and range of an object downloaded to a file, along with the MD5 and size
+// of the file, so that the objects whose MD5 can not be checked, e.g. uploaded in parts, are not downloaded again
+// while the file is unchanged
+type objectDownloadRecord struct {
+	path       string
+	ETag       string `json:"etag"`
+	ObjectMd5  string `json:"objectMd5"`
+	Range      string `json:"range"`
+	ContentMd5 string `json:"contentMd5"`
+	Size       int64  `json:"size"`
+}
 
-	retryPolicy := getRetryPolicy(disableFoundRetries, "object_storage")
-	retryPolicy.ShouldRetryOperation = objectStorageWorkRequestShouldRetryFunc(timeout)
+// objectDownloadRecordPath returns the path of the record of the downloads to a file
+func objectDownloadRecordPath(outputPath string) string {
+	if absolutePath, err := filepath.Abs(outputPath); err == nil {
+		outputPath = absolutePath
+	}
+	sum := sha256.Sum256([]byte(outputPath))
+	return filepath.Join(objectDownloadsDir, hex.EncodeToString(sum[:])+".json")
+}
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{
-			string(oci_object_storage.WorkRequestStatusAccepted),
-			string(oci_object_storage.WorkRequestStatusInProgress),
-			string(oci_object_storage.WorkRequestStatusCanceling),
-		},
-		Target: []string{
-			string(oci_object_storage.WorkRequestSummaryStatusCompleted),
-			string(oci_object_storage.WorkRequestSummaryStatusCanceled),
-			string(oci_object_storage.WorkRequestStatusFailed),
-		},
-		Refresh: func() (interface{}, string, error) {
-			getWorkRequestRequest := oci_object_storage.GetWorkRequestRequest{}
-			getWorkRequestRequest.WorkRequestId = wId
-			getWorkRequestRequest.RequestMetadata.RetryPolicy = retryPolicy
-			workRequestResponse, err := client.GetWorkRequest(context.Background(), getWorkRequestRequest)
-			wr := &workRequestResponse.WorkRequest
-			return workRequestResponse, string(wr.Status), err
-		},
-		Timeout: timeout,
+// read returns the recorded download, and whether it is a download of the same version and range of the object
+func (r objectDownloadRecord) read() (objectDownloadRecord, bool) {
+	recorded := objectDownloadRecord{}
+	content, err := ioutil.ReadFile(r.path)
+	if err != nil {
+		return recorded, false
+	}
+	if err := json.Unmarshal(content, &recorded); err != nil {
+		return recorded, false
+	}
+	return recorded, r.ETag != "" && recorded.ETag == r.ETag && recorded.ObjectMd5 == r.ObjectMd5 && recorded.Range == r.Range
+}
+
+func (r objectDownloadRecord) write() error {
+	if err := os.MkdirAll(filepath.Dir(r.path), 0700); err != nil {
+		return err
 	}
+	content, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.path, content, 0600)
+}
+
+// downloadObjectRanges writes the bytes of an object from first to last to a file, splitting them in ranges of the part
+// size downloaded by at most parallelism goroutines, and returns the first error
+func downloadObjectRanges(ctx context.Context, downloadData ObjectDownloadData, file *os.File, first int64, last int64) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	offsets, limits, err := splitSizeToOffsetsAndLimits(last-first+1, downloadData.PartSize)
+	if err != nil {
+		return err
+	}
+	parallelism := downloadData.Parallelism
+	if parallelism <= 0 {
+		parallelism = 1
+	}
+
+	ranges := make(chan int, len(offsets))
+	for index := range offsets {
+		ranges <- index
+	}
+	close(ranges)
+
+	errs := make(chan error, parallelism)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < parallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range ranges {
+				if ctx.Err() != nil {
+					return
+				}
+				start, end := first+offsets[index], first+offsets[index]+limits[index]-1
+				if err := downloadObjectRange(ctx, downloadData, file, first, start, end); err != nil {
+					errs <- fmt.Errorf("failed to download bytes %d-%d of object %s: %s", start, end, *downloadData.ObjectName, err)
+					cancel()
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	if err := <-errs; err != nil {
+		return err
+	}
+	return ctx.Err()
+}
+
+// fileSectionWriter writes to a file from an offset, so that several sections of the file can be written at once
+type fileSectionWriter struct {
+	file   *os.File
+	offset int64
+}
+
+func (w *fileSectionWriter) Write(p []byte) (int, error) {
+	n, err := w.file.WriteAt(p, w.offset)
+	w.offset += int64(n)
+	return n, err
+}
 
-	wrr, e := stateConf.WaitForState()
-	if e != nil {
-		return fmt.Errorf("work request did not succeed, workId: %s, entity: %s. Message: %s", *wId, entityType, e)
+// downloadObjectRange writes the bytes of an object from start to end at their offset from first in a file
+func downloadObjectRange(ctx context.Context, downloadData ObjectDownloadData, file *os.File, first int64, start int64, end int64) error {
+	request := oci_object_storage.GetObjectRequest{
+		NamespaceName: downloadData.NamespaceName,
+		BucketName:    downloadData.BucketName,
+		ObjectName:    downloadData.ObjectName,
+		IfMatch:       downloadData.Head.ETag,
+		Range:         oci_common.String(fmt.Sprintf("bytes=%d-%d", start, end)),
 	}
+	request.RequestMetadata.RetryPolicy = downloadData.RetryPolicy
 
-	wr := wrr.(oci_object_storage.GetWorkRequestResponse).WorkRequest
-	if wr.Status == oci_object_storage.WorkRequestStatusFailed {
-		errorMessage, _ := getObjectStorageErrorFromWorkRequest(wId, client, disableFoundRetries)
-		return fmt.Errorf("work request did not succeed, workId: %s, entity: %s. Message: %s", *wId, entityType, errorMessage)
+	response, err := downloadData.ObjectStorageClient.GetObject(ctx, request)
+	if err != nil {
+		return err
 	}
+	defer response.Content.Close()
 
+	written, err := io.Copy(&fileSectionWriter{file: file, offset: start - first}, response.Content)
+	if err != nil {
+		return err
+	}
+	if written != end-start+1 {
+		return fmt.Errorf("received %d bytes", written)
+	}
 	return nil
+}
+
+func (s *ObjectStorageObjectResourceCrud) createSourceRegionClient(region string) error {
+	if s.SourceRegionClient == nil {
+		sourceObjectStorageClient, err := oci_object_storage.NewObjectStorageClientWithConfigurationProvider(*s.Client.ConfigurationProvider())
+		if err != nil {
+			return fmt.Errorf("cannot create client for the source region: %v", err)
+		}
+		err = configureClient(&sourceObjectStorageClient.BaseClient)
+		if err != nil {
+			return fmt.Errorf("cannot configure client for the source region: %v", err)
+		}
+		s.SourceRegionClient = &sourceObjectStorageClient
+	}
+	s.SourceRegionClient.SetRegion(region)
 
+	return nil
 }
 
 func objectStorageWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -479,21 +1078,84 @@ func objectStorageWorkRequestShouldRetryFunc(timeout time.Duration) func(respons
 	}
 }
 
-func getObjectStorageErrorFromWorkRequest(workRequestId *string, client *oci_object_storage.ObjectStorageClient, disableFoundAutoRetries bool) (string, error) {
-	req := oci_object_storage.ListWorkRequestErrorsRequest{}
-	req.WorkRequestId = workRequestId
-	req.RequestMetadata.RetryPolicy = getRetryPolicy(disableFoundAutoRetries, "object_storage")
-	res, err := client.ListWorkRequestErrors(context.Background(), req)
+// objectStorageWorkRequestTracker tracks object storage work requests, e.g. to copy an object
+type objectStorageWorkRequestTracker struct {
+	client                 *oci_object_storage.ObjectStorageClient
+	disableNotFoundRetries bool
+}
 
+func newObjectStorageWorkRequestTracker(client *oci_object_storage.ObjectStorageClient, disableNotFoundRetries bool) WorkRequestTracker {
+	return &objectStorageWorkRequestTracker{client: client, disableNotFoundRetries: disableNotFoundRetries}
+}
+
+func (t *objectStorageWorkRequestTracker) WorkRequestRetryPolicy(timeout time.Duration) *oci_common.RetryPolicy {
+	retryPolicy := getRetryPolicy(t.disableNotFoundRetries, "object_storage")
+	retryPolicy.ShouldRetryOperation = objectStorageWorkRequestShouldRetryFunc(timeout)
+	return retryPolicy
+}
+
+func (t *objectStorageWorkRequestTracker) GetWorkRequest(ctx context.Context, workRequestId string, retryPolicy *oci_common.RetryPolicy) (*WorkRequestStatus, error) {
+	request := oci_object_storage.GetWorkRequestRequest{}
+	request.WorkRequestId = &workRequestId
+	request.RequestMetadata.RetryPolicy = retryPolicy
+
+	response, err := t.client.GetWorkRequest(ctx, request)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	wr := &response.WorkRequest
+	result := &WorkRequestStatus{
+		Id:          workRequestId,
+		Status:      string(wr.Status),
+		WorkRequest: wr,
+	}
+	// Object storage work requests complete rather than succeed
+	if wr.Status == oci_object_storage.WorkRequestStatusCompleted {
+		result.Status = workRequestStatusSucceeded
 	}
+	for _, res := range wr.Resources {
+		if res.EntityType != nil {
+			result.Resources = append(result.Resources, WorkRequestResource{EntityType: *res.EntityType, ActionType: string(res.ActionType), Identifier: res.Identifier})
+		}
+	}
+	return result, nil
+}
 
-	allErrs := make([]string, 0)
-	for _, errs := range res.Items {
-		allErrs = append(allErrs, *errs.Message)
+func (t *objectStorageWorkRequestTracker) ListWorkRequestErrors(ctx context.Context, workRequest *WorkRequestStatus) ([]string, error) {
+	request := oci_object_storage.ListWorkRequestErrorsRequest{}
+	request.WorkRequestId = &workRequest.Id
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(t.disableNotFoundRetries, "object_storage")
+
+	response, err := t.client.ListWorkRequestErrors(ctx, request)
+	if err != nil {
+		return nil, err
 	}
 
-	errorMessage := strings.Join(allErrs, "\n")
-	return errorMessage, nil
+	result := []string{}
+	for _, wrkErr := range response.Items {
+		if wrkErr.Message != nil {
+			result = append(result, *wrkErr.Message)
+		}
+	}
+	return result, nil
+}
+
+func (t *objectStorageWorkRequestTracker) ListWorkRequestLogEntries(ctx context.Context, workRequest *WorkRequestStatus) ([]string, error) {
+	request := oci_object_storage.ListWorkRequestLogsRequest{}
+	request.WorkRequestId = &workRequest.Id
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(t.disableNotFoundRetries, "object_storage")
+
+	response, err := t.client.ListWorkRequestLogs(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []string{}
+	for _, entry := range response.Items {
+		if entry.Message != nil {
+			result = append(result, *entry.Message)
+		}
+	}
+	return result, nil
 }
@@ -3,66 +3,74 @@
 package provider
 
 import (
+	"context"
+	"net/http"
+	"os"
 	"testing"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_object_storage "github.com/oracle/oci-go-sdk/objectstorage"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestSafe_splitSizeToOffsetsAndLimits(t *testing.T) {
 
-	offsets, _, _ := splitSizeToOffsetsAndLimits(defaultFilePartSize*8 + 1)
+	offsets, _, _ := splitSizeToOffsetsAndLimits(defaultFilePartSize*8+1, defaultFilePartSize)
 	if len(offsets) != 9 {
 		t.Errorf("The reported %v number of parts is wrong for the size %v", len(offsets), defaultFilePartSize*8+1)
 		return
 	}
 
-	offsets, _, _ = splitSizeToOffsetsAndLimits(defaultFilePartSize * 7)
+	offsets, _, _ = splitSizeToOffsetsAndLimits(defaultFilePartSize*7, defaultFilePartSize)
 	if len(offsets) != 7 {
 		t.Errorf("The reported %v number of parts is wrong for the size %v", len(offsets), defaultFilePartSize*7)
 		return
 	}
 
-	offsets, _, _ = splitSizeToOffsetsAndLimits(defaultFilePartSize + 1)
+	offsets, _, _ = splitSizeToOffsetsAndLimits(defaultFilePartSize+1, defaultFilePartSize)
 	if len(offsets) != 2 {
 		t.Errorf("The reported %v number of parts is wrong for the size %v", len(offsets), defaultFilePartSize+1)
 		return
 	}
 
-	offsets, _, _ = splitSizeToOffsetsAndLimits(defaultFilePartSize)
+	offsets, _, _ = splitSizeToOffsetsAndLimits(defaultFilePartSize, defaultFilePartSize)
 	if len(offsets) != 1 {
 		t.Errorf("The reported %v number of parts is wrong for the size %v", len(offsets), defaultFilePartSize)
 		return
 	}
 
-	offsets, _, _ = splitSizeToOffsetsAndLimits(defaultFilePartSize / 2)
+	offsets, _, _ = splitSizeToOffsetsAndLimits(defaultFilePartSize/2, defaultFilePartSize)
 	if len(offsets) != 1 {
 		t.Errorf("The reported %v number of parts is wrong for the size %v", len(offsets), defaultFilePartSize/2)
 		return
 	}
 
-	offsets, _, _ = splitSizeToOffsetsAndLimits(defaultFilePartSize * maxCount)
+	offsets, _, _ = splitSizeToOffsetsAndLimits(defaultFilePartSize*maxCount, defaultFilePartSize)
 	if len(offsets) != int(maxCount) {
 		t.Errorf("The reported %v number of parts is wrong for the size %v", len(offsets), defaultFilePartSize*maxCount)
 		return
 	}
 
-	offsets, _, _ = splitSizeToOffsetsAndLimits(defaultFilePartSize*maxCount + 1)
+	offsets, _, _ = splitSizeToOffsetsAndLimits(defaultFilePartSize*maxCount+1, defaultFilePartSize)
 	if len(offsets) != int(maxCount) {
 		t.Errorf("The reported %v number of parts is wrong for the size %v", len(offsets), defaultFilePartSize*maxCount)
 		return
 	}
 
-	offsets, _, _ = splitSizeToOffsetsAndLimits(defaultFilePartSize * maxCount * 2)
+	offsets, _, _ = splitSizeToOffsetsAndLimits(defaultFilePartSize*maxCount*2, defaultFilePartSize)
 	if len(offsets) != int(maxCount) {
 		t.Errorf("The reported %v number of parts is wrong for the size %v", len(offsets), defaultFilePartSize*maxCount*2)
 		return
 	}
 
-	offsets, _, _ = splitSizeToOffsetsAndLimits(maxPartSize * maxCount)
+	offsets, _, _ = splitSizeToOffsetsAndLimits(maxPartSize*maxCount, defaultFilePartSize)
 	if len(offsets) != int(maxCount) {
 		t.Errorf("The reported %v number of parts is wrong for the size %v", len(offsets), maxCount*maxPartSize)
 		return
 	}
 
-	_, _, err := splitSizeToOffsetsAndLimits(maxPartSize*maxCount + 1)
+	_, _, err := splitSizeToOffsetsAndLimits(maxPartSize*maxCount+1, defaultFilePartSize)
 	if err == nil {
 		t.Errorf("The error should be returned for the too large file size")
 		return
@@ -70,3 +78,187 @@ func TestSafe_splitSizeToOffsetsAndLimits(t *testing.T) {
 
 	return
 }
+
+func TestMultiPartUpload_resume(t *testing.T) {
+	fixture := newFakeObjectStorageFixture(t)
+	defer fixture.cleanup()
+	client := fixture.client
+	defer func(dir string, retryTime time.Duration) {
+		resumableUploadsDir = dir
+		shortRetryTime = retryTime
+	}(resumableUploadsDir, shortRetryTime)
+	resumableUploadsDir = fixture.tempDir()
+	shortRetryTime = 0
+
+	content := []byte("0123456789abcdefghijABCDE")
+	source := fixture.tempFile(content)
+	sourceInfo, err := os.Stat(source)
+	assert.NoError(t, err)
+
+	// The parts uploaded are counted by part number, and the second part fails with an error that may not happen again
+	uploadedParts := map[string]int{}
+	failedPart := "2"
+	fixture.onRequest(func(request *http.Request) *fakeNetworkError {
+		partNumber := request.URL.Query().Get("uploadPartNum")
+		if request.Method != http.MethodPut || partNumber == "" {
+			return nil
+		}
+		uploadedParts[partNumber]++
+		if partNumber == failedPart {
+			return &fakeNetworkError{http.StatusServiceUnavailable, "ServiceUnavailable", "the part is refused"}
+		}
+		return nil
+	})
+
+	multipartUploadData := MultipartUploadData{
+		NamespaceName:       oci_common.String(fakeObjectStorageNamespace),
+		BucketName:          oci_common.String("bucket"),
+		ObjectName:          oci_common.String("object"),
+		ObjectStorageClient: client,
+		SourcePath:          oci_common.String(source),
+		SourceInfo:          &sourceInfo,
+		PartSize:            10,
+		Parallelism:         2,
+	}
+	multipartUploadData.RequestMetadata.RetryPolicy = getRetryPolicy(false, "object_storage")
+
+	_, err = MultiPartUpload(context.Background(), multipartUploadData)
+	assert.Error(t, err)
+	assert.Equal(t, map[string]int{"1": 1, "2": 1, "3": 1}, uploadedParts)
+	assert.NotContains(t, fixture.bucket().objects, "object")
+	assert.Len(t, fixture.bucket().uploads, 1)
+
+	// The upload is resumed, uploading only the part that failed
+	failedPart = ""
+	uploadedParts = map[string]int{}
+	_, err = MultiPartUpload(context.Background(), multipartUploadData)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"2": 1}, uploadedParts)
+	assert.Empty(t, fixture.bucket().uploads)
+	if object := fixture.bucket().objects["object"]; assert.NotNil(t, object) {
+		assert.Equal(t, content, object.content)
+		sourceMd5, err := computeSourceMd5(source, true, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, sourceMd5, object.multipartMd5)
+	}
+}
+
+func TestMultiPartUpload_abort(t *testing.T) {
+	fixture := newFakeObjectStorageFixture(t)
+	defer fixture.cleanup()
+	client := fixture.client
+	ctx := context.Background()
+	defer func(dir string, retryTime time.Duration) {
+		resumableUploadsDir = dir
+		shortRetryTime = retryTime
+	}(resumableUploadsDir, shortRetryTime)
+	resumableUploadsDir = fixture.tempDir()
+	shortRetryTime = 0
+
+	content := []byte("0123456789abcdefghijABCDE")
+	source := fixture.tempFile(content)
+	sourceInfo, err := os.Stat(source)
+	assert.NoError(t, err)
+
+	// The upload of another client is neither resumed nor aborted
+	other, err := client.CreateMultipartUpload(ctx, oci_object_storage.CreateMultipartUploadRequest{
+		NamespaceName:                oci_common.String(fakeObjectStorageNamespace),
+		BucketName:                   oci_common.String("bucket"),
+		CreateMultipartUploadDetails: oci_object_storage.CreateMultipartUploadDetails{Object: oci_common.String("object")},
+	})
+	assert.NoError(t, err)
+
+	uploadedParts := map[string]int{}
+	failure := &fakeNetworkError{http.StatusBadRequest, "InvalidParameter", "the part is refused"}
+	fixture.onRequest(func(request *http.Request) *fakeNetworkError {
+		partNumber := request.URL.Query().Get("uploadPartNum")
+		if request.Method != http.MethodPut || partNumber == "" {
+			return nil
+		}
+		uploadedParts[partNumber]++
+		if partNumber == "2" {
+			return failure
+		}
+		return nil
+	})
+
+	multipartUploadData := MultipartUploadData{
+		NamespaceName:       oci_common.String(fakeObjectStorageNamespace),
+		BucketName:          oci_common.String("bucket"),
+		ObjectName:          oci_common.String("object"),
+		ObjectStorageClient: client,
+		SourcePath:          oci_common.String(source),
+		SourceInfo:          &sourceInfo,
+		PartSize:            10,
+		Parallelism:         2,
+	}
+	multipartUploadData.RequestMetadata.RetryPolicy = getRetryPolicy(false, "object_storage")
+
+	// An upload failing with an error that happens again is aborted
+	_, err = MultiPartUpload(ctx, multipartUploadData)
+	assert.Error(t, err)
+	if assert.Len(t, fixture.bucket().uploads, 1) {
+		assert.Contains(t, fixture.bucket().uploads, *other.UploadId)
+	}
+
+	// An upload left in place is not resumed when the properties of the next upload differ, it is aborted
+	failure = &fakeNetworkError{http.StatusServiceUnavailable, "ServiceUnavailable", "the part is refused"}
+	_, err = MultiPartUpload(ctx, multipartUploadData)
+	assert.Error(t, err)
+	assert.Len(t, fixture.bucket().uploads, 2)
+
+	failure = nil
+	uploadedParts = map[string]int{}
+	multipartUploadData.ContentType = oci_common.String("text/plain")
+	_, err = MultiPartUpload(ctx, multipartUploadData)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"1": 1, "2": 1, "3": 1}, uploadedParts)
+	if assert.Len(t, fixture.bucket().uploads, 1) {
+		assert.Contains(t, fixture.bucket().uploads, *other.UploadId)
+	}
+	if object := fixture.bucket().objects["object"]; assert.NotNil(t, object) {
+		assert.Equal(t, content, object.content)
+	}
+}
+
+func TestMultiPartUpload_retryParts(t *testing.T) {
+	fixture := newFakeObjectStorageFixture(t)
+	defer fixture.cleanup()
+	client := fixture.client
+
+	content := []byte("0123456789abcdefghijABCDE")
+	source := fixture.tempFile(content)
+	sourceInfo, err := os.Stat(source)
+	assert.NoError(t, err)
+
+	// The first attempt to upload the last part fails with an error that is retried
+	attempts := 0
+	fixture.onRequest(func(request *http.Request) *fakeNetworkError {
+		if request.Method != http.MethodPut || request.URL.Query().Get("uploadPartNum") != "3" {
+			return nil
+		}
+		attempts++
+		if attempts == 1 {
+			return &fakeNetworkError{http.StatusServiceUnavailable, "ServiceUnavailable", "try again"}
+		}
+		return nil
+	})
+
+	multipartUploadData := MultipartUploadData{
+		NamespaceName:       oci_common.String(fakeObjectStorageNamespace),
+		BucketName:          oci_common.String("bucket"),
+		ObjectName:          oci_common.String("object"),
+		ObjectStorageClient: client,
+		SourcePath:          oci_common.String(source),
+		SourceInfo:          &sourceInfo,
+		PartSize:            10,
+	}
+	multipartUploadData.RequestMetadata.RetryPolicy = getRetryPolicy(false, "object_storage")
+
+	_, err = MultiPartUpload(context.Background(), multipartUploadData)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+	if object := fixture.bucket().objects["object"]; assert.NotNil(t, object) {
+		assert.Equal(t, content, object.content)
+	}
+}
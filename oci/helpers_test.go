@@ -0,0 +1,165 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// getImportCompositeIdFunc returns the composite ID used to import a resource, made of alternating keys and the
+// attributes of the resource holding their values
+func getImportCompositeIdFunc(resourceName string, keysAndAttributes ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		keysAndValues := make([]string, len(keysAndAttributes))
+		for i, part := range keysAndAttributes {
+			if i%2 == 1 {
+				part = rs.Primary.Attributes[part]
+			}
+			keysAndValues[i] = part
+		}
+		return getCompositeId(keysAndValues...), nil
+	}
+}
+
+func TestCompositeId_roundTrip(t *testing.T) {
+	compositeId := getCompositeId("n", "namespace", "b", "bucket", "o", "path/to/object with spaces")
+	assert.Equal(t, "n/namespace/b/bucket/o/path%2Fto%2Fobject%20with%20spaces", compositeId)
+
+	values, err := parseCompositeId(compositeId, "n", "b", "o")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"namespace", "bucket", "path/to/object with spaces"}, values)
+
+	bucket, namespace, object, err := parseObjectCompositeId(compositeId)
+	assert.NoError(t, err)
+	assert.Equal(t, "bucket", bucket)
+	assert.Equal(t, "namespace", namespace)
+	assert.Equal(t, "path/to/object with spaces", object)
+	assert.Equal(t, compositeId, getObjectCompositeId(bucket, namespace, object))
+
+	for _, invalid := range []string{
+		"",
+		"n/namespace/b/bucket",
+		"n/namespace/b/bucket/o/object/extra",
+		"n/namespace/x/bucket/o/object",
+		"n/namespace/b/bucket/o/%zz",
+	} {
+		_, err := parseCompositeId(invalid, "n", "b", "o")
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestCompositeId_importers(t *testing.T) {
+	resources := resourcesMap()
+	type testCase struct {
+		resourceType string
+		importId     string
+		expectedId   string
+		attributes   map[string]string
+	}
+	testCases := []testCase{
+		{
+			resourceType: "oci_load_balancer_certificate",
+			importId:     "loadBalancers/ocid1.loadbalancer.oc1..lb/certificates/example_certificate_bundle",
+			expectedId:   "example_certificate_bundle",
+			attributes:   map[string]string{"load_balancer_id": "ocid1.loadbalancer.oc1..lb", "certificate_name": "example_certificate_bundle"},
+		},
+		{
+			resourceType: "oci_dns_record",
+			importId:     "zoneNameOrId/example.com/domain/www.example.com/rtype/A/recordHash/abcdef",
+			expectedId:   "abcdef",
+			attributes:   map[string]string{"zone_name_or_id": "example.com", "domain": "www.example.com", "rtype": "A", "record_hash": "abcdef"},
+		},
+		{
+			resourceType: "oci_identity_api_key",
+			importId:     "users/ocid1.user.oc1..user/apiKeys/" + testKeyFingerPrint,
+			expectedId:   "users/ocid1.user.oc1..user/apiKeys/" + testKeyFingerPrint,
+			attributes:   map[string]string{"user_id": "ocid1.user.oc1..user", "fingerprint": testKeyFingerPrint},
+		},
+		{
+			resourceType: "oci_identity_auth_token",
+			importId:     "users/ocid1.user.oc1..user/authTokens/ocid1.credential.oc1..token",
+			expectedId:   "ocid1.credential.oc1..token",
+			attributes:   map[string]string{"user_id": "ocid1.user.oc1..user"},
+		},
+		{
+			resourceType: "oci_identity_idp_group_mapping",
+			importId:     "identityProviders/ocid1.saml2idp.oc1..idp/groupMappings/ocid1.idpgroupmapping.oc1..mapping",
+			expectedId:   "ocid1.idpgroupmapping.oc1..mapping",
+			attributes:   map[string]string{"identity_provider_id": "ocid1.saml2idp.oc1..idp"},
+		},
+		{
+			resourceType: "oci_database_data_guard_association",
+			importId:     "databases/ocid1.database.oc1..db/dataGuardAssociations/ocid1.dgassociation.oc1..dg",
+			expectedId:   "ocid1.dgassociation.oc1..dg",
+			attributes:   map[string]string{"database_id": "ocid1.database.oc1..db"},
+		},
+		{
+			resourceType: "oci_core_security_list_rule",
+			importId:     "securityLists/ocid1.securitylist.oc1..sl/egressSecurityRules/12345",
+			expectedId:   "securityLists/ocid1.securitylist.oc1..sl/egressSecurityRules/12345",
+			attributes:   map[string]string{"security_list_id": "ocid1.securitylist.oc1..sl", "direction": "EGRESS"},
+		},
+		{
+			resourceType: "oci_core_route_table_rule",
+			importId:     "routeTables/ocid1.routetable.oc1..rt/routeRules/12345",
+			expectedId:   "routeTables/ocid1.routetable.oc1..rt/routeRules/12345",
+			attributes:   map[string]string{"route_table_id": "ocid1.routetable.oc1..rt"},
+		},
+		{
+			resourceType: "oci_objectstorage_object_set",
+			importId:     "n/namespace/b/bucket/objectSets/site%2F",
+			expectedId:   "n/namespace/b/bucket/objectSets/site%2F",
+			attributes:   map[string]string{"namespace": "namespace", "bucket": "bucket", "prefix": "site/"},
+		},
+		{
+			resourceType: "oci_audit_configuration",
+			importId:     testTenancyOCID,
+			expectedId:   testTenancyOCID,
+			attributes:   map[string]string{"compartment_id": testTenancyOCID},
+		},
+	}
+
+	for _, test := range testCases {
+		resource := resources[test.resourceType]
+		if !assert.NotNil(t, resource.Importer, test.resourceType) {
+			continue
+		}
+		d := resource.Data(nil)
+		d.SetId(test.importId)
+		imported, err := resource.Importer.State(d, nil)
+		if !assert.NoError(t, err, test.resourceType) || !assert.Len(t, imported, 1) {
+			continue
+		}
+		assert.Equal(t, test.expectedId, imported[0].Id(), test.resourceType)
+		for attr, value := range test.attributes {
+			assert.Equal(t, value, imported[0].Get(attr), "%s.%s", test.resourceType, attr)
+		}
+
+		if strings.Contains(test.importId, "/") {
+			d = resource.Data(nil)
+			d.SetId("not/a/composite/id")
+			_, err = resource.Importer.State(d, nil)
+			assert.Error(t, err, test.resourceType)
+		}
+	}
+}
+
+func TestCompositeId_everyResourceImportable(t *testing.T) {
+	for name, resource := range resourcesMap() {
+		assert.NotNil(t, resource.Importer, "%s has no importer", name)
+		if resource.Importer != nil {
+			assert.NotNil(t, resource.Importer.State, "%s has no import function", name)
+		}
+	}
+}
@@ -3,13 +3,11 @@
 package provider
 
 import (
-	"fmt"
 	"strings"
 	"time"
 
 	"context"
 
-	"github.com/hashicorp/terraform/helper/resource"
 	oci_common "github.com/oracle/oci-go-sdk/common"
 	oci_waas "github.com/oracle/oci-go-sdk/waas"
 )
@@ -32,77 +30,77 @@ func waasWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_com
 		}
 
 		// Only stop if the time Finished is set
-		if okeRes, ok := response.Response.(oci_waas.GetWorkRequestResponse); ok {
-			return okeRes.TimeFinished == nil
+		if waasRes, ok := response.Response.(oci_waas.GetWorkRequestResponse); ok {
+			return waasRes.TimeFinished == nil
 		}
 		return false
 	}
 }
 
-//waasWaitForWorkRequest custom logic to extract an identifier from a workRequest
-func waasWaitForWorkRequest(wId *string, entityType string, action oci_waas.WorkRequestResourceActionTypeEnum,
-	timeout time.Duration, disableFoundRetries bool, client *oci_waas.WaasClient) (*string, error) {
-	retryPolicy := getRetryPolicy(disableFoundRetries, "waas")
+// waasWorkRequestTracker tracks WAAS work requests, which report their errors and log entries as part of the
+// work request itself
+type waasWorkRequestTracker struct {
+	client                 *oci_waas.WaasClient
+	disableNotFoundRetries bool
+}
+
+func newWaasWorkRequestTracker(client *oci_waas.WaasClient, disableNotFoundRetries bool) WorkRequestTracker {
+	return &waasWorkRequestTracker{client: client, disableNotFoundRetries: disableNotFoundRetries}
+}
+
+func (t *waasWorkRequestTracker) WorkRequestRetryPolicy(timeout time.Duration) *oci_common.RetryPolicy {
+	retryPolicy := getRetryPolicy(t.disableNotFoundRetries, "waas")
 	retryPolicy.ShouldRetryOperation = waasWorkRequestShouldRetryFunc(timeout)
+	return retryPolicy
+}
 
-	response := oci_waas.GetWorkRequestResponse{}
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{
-			string(oci_waas.WorkRequestStatusInProgress),
-			string(oci_waas.WorkRequestStatusAccepted),
-			string(oci_waas.WorkRequestStatusCanceling),
-		},
-		Target: []string{
-			string(oci_waas.WorkRequestStatusSucceeded),
-			string(oci_waas.WorkRequestStatusFailed),
-			string(oci_waas.WorkRequestStatusCanceled),
-		},
-		Refresh: func() (interface{}, string, error) {
-			var err error
-			response, err = client.GetWorkRequest(context.Background(),
-				oci_waas.GetWorkRequestRequest{
-					WorkRequestId: wId,
-					RequestMetadata: oci_common.RequestMetadata{
-						RetryPolicy: retryPolicy,
-					},
-				})
-			wr := &response.WorkRequest
-			return wr, string(wr.Status), err
-		},
-		Timeout: timeout,
-	}
-	if _, e := stateConf.WaitForState(); e != nil {
-		return nil, e
+func (t *waasWorkRequestTracker) GetWorkRequest(ctx context.Context, workRequestId string, retryPolicy *oci_common.RetryPolicy) (*WorkRequestStatus, error) {
+	request := oci_waas.GetWorkRequestRequest{}
+	request.WorkRequestId = &workRequestId
+	request.RequestMetadata.RetryPolicy = retryPolicy
+
+	response, err := t.client.GetWorkRequest(ctx, request)
+	if err != nil {
+		return nil, err
 	}
 
-	var identifier *string
-	//The work request response contains an array of objects that finished the operation
-	for _, res := range response.Resources {
-		if strings.Contains(strings.ToLower(*res.EntityType), entityType) {
-			if res.ActionType == action {
-				identifier = res.Identifier
-				break
-			}
+	wr := &response.WorkRequest
+	result := &WorkRequestStatus{
+		Id:          workRequestId,
+		Status:      string(wr.Status),
+		WorkRequest: wr,
+	}
+	for _, res := range wr.Resources {
+		if res.EntityType != nil {
+			result.Resources = append(result.Resources, WorkRequestResource{EntityType: *res.EntityType, ActionType: string(res.ActionType), Identifier: res.Identifier})
 		}
 	}
+	return result, nil
+}
 
-	// The workrequest didn't do all its intended tasks, if the errors is set; so we should check for it
-	var workRequestErr error
-	if len(response.Errors) > 0 {
-		errorMessage := getErrorFromWaasWorkRequest(response)
-		workRequestErr = fmt.Errorf("work request did not succeed, workId: %s, entity: %s, action: %s. Message: %s", *wId, entityType, action, errorMessage)
-	}
+// HasErrors reports the WAAS work requests that succeeded although some of their work failed
+func (t *waasWorkRequestTracker) HasErrors(workRequest *WorkRequestStatus) bool {
+	return len(workRequest.WorkRequest.(*oci_waas.WorkRequest).Errors) > 0
+}
 
-	return identifier, workRequestErr
+func (t *waasWorkRequestTracker) ListWorkRequestErrors(ctx context.Context, workRequest *WorkRequestStatus) ([]string, error) {
+	result := []string{}
+	for _, wrkErr := range workRequest.WorkRequest.(*oci_waas.WorkRequest).Errors {
+		if wrkErr.Message != nil {
+			result = append(result, *wrkErr.Message)
+		}
+	}
+	return result, nil
 }
 
-func getErrorFromWaasWorkRequest(response oci_waas.GetWorkRequestResponse) string {
-	allErrs := make([]string, 0)
-	for _, wrkErr := range response.Errors {
-		allErrs = append(allErrs, *wrkErr.Message)
+func (t *waasWorkRequestTracker) ListWorkRequestLogEntries(ctx context.Context, workRequest *WorkRequestStatus) ([]string, error) {
+	result := []string{}
+	for _, entry := range workRequest.WorkRequest.(*oci_waas.WorkRequest).Logs {
+		if entry.Message != nil {
+			result = append(result, *entry.Message)
+		}
 	}
-	errorMessage := strings.Join(allErrs, "\n")
-	return errorMessage
+	return result, nil
 }
 
 func getDeleteConflictRetryPolicy(disableNotFoundRetries bool, service string) *oci_common.RetryPolicy {
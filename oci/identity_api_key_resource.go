@@ -15,6 +15,9 @@ import (
 
 func IdentityApiKeyResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importIdentityApiKey,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createIdentityApiKey,
 		Read:     readIdentityApiKey,
@@ -66,7 +69,7 @@ func createIdentityApiKey(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readIdentityApiKey(d *schema.ResourceData, m interface{}) error {
@@ -74,7 +77,7 @@ func readIdentityApiKey(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func deleteIdentityApiKey(d *schema.ResourceData, m interface{}) error {
@@ -83,7 +86,19 @@ func deleteIdentityApiKey(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).identityClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
+}
+
+// importIdentityApiKey imports an API key of a user, with an ID of the form users/{userId}/apiKeys/{fingerprint}
+func importIdentityApiKey(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	values, err := parseCompositeId(d.Id(), "users", "apiKeys")
+	if err != nil {
+		return nil, err
+	}
+	d.Set("user_id", values[0])
+	d.Set("fingerprint", values[1])
+
+	return []*schema.ResourceData{d}, nil
 }
 
 type IdentityApiKeyResourceCrud struct {
@@ -125,7 +140,7 @@ func (s *IdentityApiKeyResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentityApiKeyResourceCrud) Create() error {
+func (s *IdentityApiKeyResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.UploadApiKeyRequest{}
 
 	if keyValue, ok := s.D.GetOkExists("key_value"); ok {
@@ -140,7 +155,7 @@ func (s *IdentityApiKeyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UploadApiKey(context.Background(), request)
+	response, err := s.Client.UploadApiKey(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -149,7 +164,7 @@ func (s *IdentityApiKeyResourceCrud) Create() error {
 	return nil
 }
 
-func (s *IdentityApiKeyResourceCrud) Get() error {
+func (s *IdentityApiKeyResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListApiKeysRequest{}
 
 	if userId, ok := s.D.GetOkExists("user_id"); ok {
@@ -159,7 +174,7 @@ func (s *IdentityApiKeyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ListApiKeys(context.Background(), request)
+	response, err := s.Client.ListApiKeys(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -175,7 +190,7 @@ func (s *IdentityApiKeyResourceCrud) Get() error {
 
 }
 
-func (s *IdentityApiKeyResourceCrud) Delete() error {
+func (s *IdentityApiKeyResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeleteApiKeyRequest{}
 
 	if fingerprint, ok := s.D.GetOkExists("fingerprint"); ok {
@@ -190,11 +205,16 @@ func (s *IdentityApiKeyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteApiKey(context.Background(), request)
+	_, err := s.Client.DeleteApiKey(ctx, request)
 	return err
 }
 
 func (s *IdentityApiKeyResourceCrud) SetData() error {
+	// The imported keys are identified by their user and fingerprint until they are read
+	if s.Res.KeyId != nil {
+		s.D.SetId(*s.Res.KeyId)
+	}
+
 	if s.Res.Fingerprint != nil {
 		s.D.Set("fingerprint", *s.Res.Fingerprint)
 	}
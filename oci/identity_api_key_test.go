@@ -93,6 +93,15 @@ func TestIdentityApiKeyResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(datasourceName, "api_keys.0.user_id"),
 				),
 			},
+			// verify resource import
+			{
+				Config:                  config,
+				ImportState:             true,
+				ImportStateIdFunc:       getImportCompositeIdFunc(resourceName, "users", "user_id", "apiKeys", "fingerprint"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+				ResourceName:            resourceName,
+			},
 		},
 	})
 }
@@ -33,7 +33,7 @@ func readIdentityApiKeys(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type IdentityApiKeysDataSourceCrud struct {
@@ -46,7 +46,7 @@ func (s *IdentityApiKeysDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityApiKeysDataSourceCrud) Get() error {
+func (s *IdentityApiKeysDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListApiKeysRequest{}
 
 	if userId, ok := s.D.GetOkExists("user_id"); ok {
@@ -56,7 +56,7 @@ func (s *IdentityApiKeysDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListApiKeys(context.Background(), request)
+	response, err := s.Client.ListApiKeys(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -14,6 +14,9 @@ import (
 
 func IdentityAuthTokenResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importIdentityAuthToken,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createIdentityAuthToken,
 		Read:     readIdentityAuthToken,
@@ -63,7 +66,7 @@ func createIdentityAuthToken(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readIdentityAuthToken(d *schema.ResourceData, m interface{}) error {
@@ -71,7 +74,7 @@ func readIdentityAuthToken(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateIdentityAuthToken(d *schema.ResourceData, m interface{}) error {
@@ -79,7 +82,7 @@ func updateIdentityAuthToken(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteIdentityAuthToken(d *schema.ResourceData, m interface{}) error {
@@ -88,7 +91,20 @@ func deleteIdentityAuthToken(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).identityClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
+}
+
+// importIdentityAuthToken imports an auth token of a user, with an ID of the form users/{userId}/authTokens/{id}.
+// The token is only returned on creation, so it is left empty.
+func importIdentityAuthToken(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	values, err := parseCompositeId(d.Id(), "users", "authTokens")
+	if err != nil {
+		return nil, err
+	}
+	d.Set("user_id", values[0])
+	d.SetId(values[1])
+
+	return []*schema.ResourceData{d}, nil
 }
 
 type IdentityAuthTokenResourceCrud struct {
@@ -130,7 +146,7 @@ func (s *IdentityAuthTokenResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentityAuthTokenResourceCrud) Create() error {
+func (s *IdentityAuthTokenResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateAuthTokenRequest{}
 
 	if description, ok := s.D.GetOkExists("description"); ok {
@@ -145,7 +161,7 @@ func (s *IdentityAuthTokenResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateAuthToken(context.Background(), request)
+	response, err := s.Client.CreateAuthToken(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -154,7 +170,7 @@ func (s *IdentityAuthTokenResourceCrud) Create() error {
 	return nil
 }
 
-func (s *IdentityAuthTokenResourceCrud) Get() error {
+func (s *IdentityAuthTokenResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListAuthTokensRequest{}
 
 	if userId, ok := s.D.GetOkExists("user_id"); ok {
@@ -164,7 +180,7 @@ func (s *IdentityAuthTokenResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ListAuthTokens(context.Background(), request)
+	response, err := s.Client.ListAuthTokens(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -180,7 +196,7 @@ func (s *IdentityAuthTokenResourceCrud) Get() error {
 
 }
 
-func (s *IdentityAuthTokenResourceCrud) Update() error {
+func (s *IdentityAuthTokenResourceCrud) Update(ctx context.Context) error {
 	request := oci_identity.UpdateAuthTokenRequest{}
 
 	tmp := s.D.Id()
@@ -198,7 +214,7 @@ func (s *IdentityAuthTokenResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateAuthToken(context.Background(), request)
+	response, err := s.Client.UpdateAuthToken(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -207,7 +223,7 @@ func (s *IdentityAuthTokenResourceCrud) Update() error {
 	return nil
 }
 
-func (s *IdentityAuthTokenResourceCrud) Delete() error {
+func (s *IdentityAuthTokenResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeleteAuthTokenRequest{}
 
 	tmp := s.D.Id()
@@ -220,7 +236,7 @@ func (s *IdentityAuthTokenResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteAuthToken(context.Background(), request)
+	_, err := s.Client.DeleteAuthToken(ctx, request)
 	return err
 }
 
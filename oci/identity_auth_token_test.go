@@ -95,6 +95,17 @@ func TestIdentityAuthTokenResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(datasourceName, "tokens.0.user_id"),
 				),
 			},
+			// verify resource import
+			{
+				Config:            config,
+				ImportState:       true,
+				ImportStateIdFunc: getImportCompositeIdFunc(resourceName, "users", "user_id", "authTokens", "id"),
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"token",
+				},
+				ResourceName: resourceName,
+			},
 		},
 	})
 }
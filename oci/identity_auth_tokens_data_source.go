@@ -33,7 +33,7 @@ func readIdentityAuthTokens(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type IdentityAuthTokensDataSourceCrud struct {
@@ -46,7 +46,7 @@ func (s *IdentityAuthTokensDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityAuthTokensDataSourceCrud) Get() error {
+func (s *IdentityAuthTokensDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListAuthTokensRequest{}
 
 	if userId, ok := s.D.GetOkExists("user_id"); ok {
@@ -56,7 +56,7 @@ func (s *IdentityAuthTokensDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListAuthTokens(context.Background(), request)
+	response, err := s.Client.ListAuthTokens(ctx, request)
 	if err != nil {
 		return err
 	}
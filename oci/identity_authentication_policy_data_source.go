@@ -70,7 +70,7 @@ func readSingularIdentityAuthenticationPolicy(d *schema.ResourceData, m interfac
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type IdentityAuthenticationPolicyDataSourceCrud struct {
@@ -83,7 +83,7 @@ func (s *IdentityAuthenticationPolicyDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityAuthenticationPolicyDataSourceCrud) Get() error {
+func (s *IdentityAuthenticationPolicyDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetAuthenticationPolicyRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -93,7 +93,7 @@ func (s *IdentityAuthenticationPolicyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
 
-	response, err := s.Client.GetAuthenticationPolicy(context.Background(), request)
+	response, err := s.Client.GetAuthenticationPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
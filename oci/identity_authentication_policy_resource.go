@@ -6,9 +6,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/url"
-	"regexp"
-	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -91,7 +88,7 @@ func createIdentityAuthenticationPolicy(d *schema.ResourceData, m interface{}) e
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readIdentityAuthenticationPolicy(d *schema.ResourceData, m interface{}) error {
@@ -99,7 +96,7 @@ func readIdentityAuthenticationPolicy(d *schema.ResourceData, m interface{}) err
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateIdentityAuthenticationPolicy(d *schema.ResourceData, m interface{}) error {
@@ -107,7 +104,7 @@ func updateIdentityAuthenticationPolicy(d *schema.ResourceData, m interface{}) e
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteIdentityAuthenticationPolicy(d *schema.ResourceData, m interface{}) error {
@@ -125,7 +122,7 @@ func (s *IdentityAuthenticationPolicyResourceCrud) ID() string {
 	return getAuthenticationPolicyCompositeId(s.D.Get("compartment_id").(string))
 }
 
-func (s *IdentityAuthenticationPolicyResourceCrud) Create() error {
+func (s *IdentityAuthenticationPolicyResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.UpdateAuthenticationPolicyRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -146,7 +143,7 @@ func (s *IdentityAuthenticationPolicyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateAuthenticationPolicy(context.Background(), request)
+	response, err := s.Client.UpdateAuthenticationPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -155,7 +152,7 @@ func (s *IdentityAuthenticationPolicyResourceCrud) Create() error {
 	return nil
 }
 
-func (s *IdentityAuthenticationPolicyResourceCrud) Get() error {
+func (s *IdentityAuthenticationPolicyResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetAuthenticationPolicyRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -172,7 +169,7 @@ func (s *IdentityAuthenticationPolicyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetAuthenticationPolicy(context.Background(), request)
+	response, err := s.Client.GetAuthenticationPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -181,7 +178,7 @@ func (s *IdentityAuthenticationPolicyResourceCrud) Get() error {
 	return nil
 }
 
-func (s *IdentityAuthenticationPolicyResourceCrud) Update() error {
+func (s *IdentityAuthenticationPolicyResourceCrud) Update(ctx context.Context) error {
 	request := oci_identity.UpdateAuthenticationPolicyRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -202,7 +199,7 @@ func (s *IdentityAuthenticationPolicyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateAuthenticationPolicy(context.Background(), request)
+	response, err := s.Client.UpdateAuthenticationPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -234,19 +231,15 @@ func (s *IdentityAuthenticationPolicyResourceCrud) SetData() error {
 }
 
 func getAuthenticationPolicyCompositeId(compartmentId string) string {
-	compartmentId = url.PathEscape(compartmentId)
-	compositeId := "authenticationPolicies/" + compartmentId
-	return compositeId
+	return getCompositeId("authenticationPolicies", compartmentId)
 }
 
 func parseAuthenticationPolicyCompositeId(compositeId string) (compartmentId string, err error) {
-	parts := strings.Split(compositeId, "/")
-	match, _ := regexp.MatchString("authenticationPolicies/.*", compositeId)
-	if !match || len(parts) != 2 {
-		err = fmt.Errorf("illegal compositeId %s encountered", compositeId)
+	values, err := parseCompositeId(compositeId, "authenticationPolicies")
+	if err != nil {
 		return
 	}
-	compartmentId, _ = url.PathUnescape(parts[1])
+	compartmentId = values[0]
 
 	return
 }
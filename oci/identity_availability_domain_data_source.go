@@ -47,7 +47,7 @@ func readAvailabilityDomain(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type AvailabilityDomainDataSourceCrud struct {
@@ -60,7 +60,7 @@ func (s *AvailabilityDomainDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *AvailabilityDomainDataSourceCrud) Get() error {
+func (s *AvailabilityDomainDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListAvailabilityDomainsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -70,7 +70,7 @@ func (s *AvailabilityDomainDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListAvailabilityDomains(context.Background(), request)
+	response, err := s.Client.ListAvailabilityDomains(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -54,7 +54,7 @@ func readIdentityAvailabilityDomains(d *schema.ResourceData, m interface{}) erro
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type IdentityAvailabilityDomainsDataSourceCrud struct {
@@ -67,7 +67,7 @@ func (s *IdentityAvailabilityDomainsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityAvailabilityDomainsDataSourceCrud) Get() error {
+func (s *IdentityAvailabilityDomainsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListAvailabilityDomainsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -77,7 +77,7 @@ func (s *IdentityAvailabilityDomainsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListAvailabilityDomains(context.Background(), request)
+	response, err := s.Client.ListAvailabilityDomains(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -66,7 +66,7 @@ func readSingularIdentityCompartment(d *schema.ResourceData, m interface{}) erro
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type IdentityCompartmentDataSourceCrud struct {
@@ -79,7 +79,7 @@ func (s *IdentityCompartmentDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityCompartmentDataSourceCrud) Get() error {
+func (s *IdentityCompartmentDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetCompartmentRequest{}
 
 	// API ambiguity: the GET identifier for a single compartment is "compartmentId", but on the returned object
@@ -91,7 +91,7 @@ func (s *IdentityCompartmentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
 
-	response, err := s.Client.GetCompartment(context.Background(), request)
+	response, err := s.Client.GetCompartment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -99,7 +99,7 @@ func createIdentityCompartment(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).identityClient
 	sync.Configuration = m.(*OracleClients).configuration
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readIdentityCompartment(d *schema.ResourceData, m interface{}) error {
@@ -107,7 +107,7 @@ func readIdentityCompartment(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateIdentityCompartment(d *schema.ResourceData, m interface{}) error {
@@ -115,7 +115,7 @@ func updateIdentityCompartment(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteIdentityCompartment(d *schema.ResourceData, m interface{}) error {
@@ -128,7 +128,7 @@ func deleteIdentityCompartment(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).identityClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type IdentityCompartmentResourceCrud struct {
@@ -167,7 +167,7 @@ func (s *IdentityCompartmentResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentityCompartmentResourceCrud) Create() error {
+func (s *IdentityCompartmentResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateCompartmentRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -214,7 +214,7 @@ func (s *IdentityCompartmentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateCompartment(context.Background(), request)
+	response, err := s.Client.CreateCompartment(ctx, request)
 	if err != nil {
 		if response.RawResponse != nil && response.RawResponse.StatusCode == 409 {
 
@@ -238,7 +238,7 @@ If you intended to manage an existing compartment, use terraform import instead.
 				log.Println(fmt.Sprintf("[DEBUG] The specified compartment with name '%s' may already exist, listing compartments to lookup with name instead.",
 					*request.Name))
 				dsCrud := &IdentityCompartmentsDataSourceCrud{s.D, s.Client, nil}
-				if err := dsCrud.Get(); err != nil {
+				if err := dsCrud.Get(ctx); err != nil {
 					return err
 				}
 
@@ -247,7 +247,7 @@ If you intended to manage an existing compartment, use terraform import instead.
 						s.Res = &compartment
 						//Update with correct description
 						s.D.SetId(s.ID())
-						return s.Update()
+						return s.Update(ctx)
 					}
 				}
 				// Return an error if the lookup failed, to provide user with information on which compartment id and name were used for lookup
@@ -265,7 +265,7 @@ Refer to the 'oci_identity_compartment' documentation for more information.`, er
 	return nil
 }
 
-func (s *IdentityCompartmentResourceCrud) Get() error {
+func (s *IdentityCompartmentResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetCompartmentRequest{}
 
 	tmp := s.D.Id()
@@ -273,7 +273,7 @@ func (s *IdentityCompartmentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetCompartment(context.Background(), request)
+	response, err := s.Client.GetCompartment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -282,7 +282,7 @@ func (s *IdentityCompartmentResourceCrud) Get() error {
 	return nil
 }
 
-func (s *IdentityCompartmentResourceCrud) Update() error {
+func (s *IdentityCompartmentResourceCrud) Update(ctx context.Context) error {
 	request := oci_identity.UpdateCompartmentRequest{}
 
 	tmp := s.D.Id()
@@ -312,7 +312,7 @@ func (s *IdentityCompartmentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateCompartment(context.Background(), request)
+	response, err := s.Client.UpdateCompartment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -321,7 +321,7 @@ func (s *IdentityCompartmentResourceCrud) Update() error {
 	return nil
 }
 
-func (s *IdentityCompartmentResourceCrud) Delete() error {
+func (s *IdentityCompartmentResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeleteCompartmentRequest{}
 
 	tmp := s.D.Id()
@@ -329,7 +329,7 @@ func (s *IdentityCompartmentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteCompartment(context.Background(), request)
+	_, err := s.Client.DeleteCompartment(ctx, request)
 	return err
 }
 
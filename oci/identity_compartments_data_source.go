@@ -14,7 +14,8 @@ func IdentityCompartmentsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readIdentityCompartments,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"access_level": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -41,7 +42,7 @@ func readIdentityCompartments(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type IdentityCompartmentsDataSourceCrud struct {
@@ -54,7 +55,7 @@ func (s *IdentityCompartmentsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityCompartmentsDataSourceCrud) Get() error {
+func (s *IdentityCompartmentsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListCompartmentsRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -72,8 +73,9 @@ func (s *IdentityCompartmentsDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListCompartments(context.Background(), request)
+	response, err := s.Client.ListCompartments(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -81,8 +83,8 @@ func (s *IdentityCompartmentsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListCompartments(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListCompartments(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -146,6 +148,8 @@ func (s *IdentityCompartmentsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, IdentityCompartmentsDataSource().Schema["compartments"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("compartments", resources); err != nil {
 		return err
 	}
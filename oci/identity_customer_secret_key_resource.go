@@ -14,6 +14,9 @@ import (
 
 func IdentityCustomerSecretKeyResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importIdentityCustomerSecretKey,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createIdentityCustomerSecretKey,
 		Read:     readIdentityCustomerSecretKey,
@@ -63,7 +66,7 @@ func createIdentityCustomerSecretKey(d *schema.ResourceData, m interface{}) erro
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readIdentityCustomerSecretKey(d *schema.ResourceData, m interface{}) error {
@@ -71,7 +74,7 @@ func readIdentityCustomerSecretKey(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateIdentityCustomerSecretKey(d *schema.ResourceData, m interface{}) error {
@@ -79,7 +82,7 @@ func updateIdentityCustomerSecretKey(d *schema.ResourceData, m interface{}) erro
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteIdentityCustomerSecretKey(d *schema.ResourceData, m interface{}) error {
@@ -88,7 +91,20 @@ func deleteIdentityCustomerSecretKey(d *schema.ResourceData, m interface{}) erro
 	sync.Client = m.(*OracleClients).identityClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
+}
+
+// importIdentityCustomerSecretKey imports a customer secret key of a user, with an ID of the form
+// users/{userId}/customerSecretKeys/{id}. Its key is not imported, as the service never returns it again.
+func importIdentityCustomerSecretKey(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	values, err := parseCompositeId(d.Id(), "users", "customerSecretKeys")
+	if err != nil {
+		return nil, err
+	}
+	d.Set("user_id", values[0])
+	d.SetId(values[1])
+
+	return []*schema.ResourceData{d}, nil
 }
 
 type IdentityCustomerSecretKeyResourceCrud struct {
@@ -130,7 +146,7 @@ func (s *IdentityCustomerSecretKeyResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentityCustomerSecretKeyResourceCrud) Create() error {
+func (s *IdentityCustomerSecretKeyResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateCustomerSecretKeyRequest{}
 
 	if displayName, ok := s.D.GetOkExists("display_name"); ok {
@@ -145,7 +161,7 @@ func (s *IdentityCustomerSecretKeyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateCustomerSecretKey(context.Background(), request)
+	response, err := s.Client.CreateCustomerSecretKey(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -154,7 +170,7 @@ func (s *IdentityCustomerSecretKeyResourceCrud) Create() error {
 	return nil
 }
 
-func (s *IdentityCustomerSecretKeyResourceCrud) Get() error {
+func (s *IdentityCustomerSecretKeyResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListCustomerSecretKeysRequest{}
 
 	if userId, ok := s.D.GetOkExists("user_id"); ok {
@@ -164,7 +180,7 @@ func (s *IdentityCustomerSecretKeyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ListCustomerSecretKeys(context.Background(), request)
+	response, err := s.Client.ListCustomerSecretKeys(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -180,7 +196,7 @@ func (s *IdentityCustomerSecretKeyResourceCrud) Get() error {
 
 }
 
-func (s *IdentityCustomerSecretKeyResourceCrud) Update() error {
+func (s *IdentityCustomerSecretKeyResourceCrud) Update(ctx context.Context) error {
 	request := oci_identity.UpdateCustomerSecretKeyRequest{}
 
 	tmp := s.D.Id()
@@ -198,7 +214,7 @@ func (s *IdentityCustomerSecretKeyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateCustomerSecretKey(context.Background(), request)
+	response, err := s.Client.UpdateCustomerSecretKey(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -207,7 +223,7 @@ func (s *IdentityCustomerSecretKeyResourceCrud) Update() error {
 	return nil
 }
 
-func (s *IdentityCustomerSecretKeyResourceCrud) Delete() error {
+func (s *IdentityCustomerSecretKeyResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeleteCustomerSecretKeyRequest{}
 
 	tmp := s.D.Id()
@@ -220,7 +236,7 @@ func (s *IdentityCustomerSecretKeyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteCustomerSecretKey(context.Background(), request)
+	_, err := s.Client.DeleteCustomerSecretKey(ctx, request)
 	return err
 }
 
@@ -99,6 +99,17 @@ func TestIdentityCustomerSecretKeyResource_basic(t *testing.T) {
 					TestCheckResourceAttributesEqual(datasourceName, "customer_secret_keys.0.user_id", "oci_identity_customer_secret_key.test_customer_secret_key", "user_id"),
 				),
 			},
+			// verify resource import
+			{
+				Config:            config,
+				ImportState:       true,
+				ImportStateIdFunc: getImportCompositeIdFunc(resourceName, "users", "user_id", "customerSecretKeys", "id"),
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"key",
+				},
+				ResourceName: resourceName,
+			},
 		},
 	})
 }
@@ -33,7 +33,7 @@ func readIdentityCustomerSecretKeys(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type IdentityCustomerSecretKeysDataSourceCrud struct {
@@ -46,7 +46,7 @@ func (s *IdentityCustomerSecretKeysDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityCustomerSecretKeysDataSourceCrud) Get() error {
+func (s *IdentityCustomerSecretKeysDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListCustomerSecretKeysRequest{}
 
 	if userId, ok := s.D.GetOkExists("user_id"); ok {
@@ -56,7 +56,7 @@ func (s *IdentityCustomerSecretKeysDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListCustomerSecretKeys(context.Background(), request)
+	response, err := s.Client.ListCustomerSecretKeys(ctx, request)
 	if err != nil {
 		return err
 	}
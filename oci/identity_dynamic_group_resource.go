@@ -66,7 +66,7 @@ func createIdentityDynamicGroup(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readIdentityDynamicGroup(d *schema.ResourceData, m interface{}) error {
@@ -74,7 +74,7 @@ func readIdentityDynamicGroup(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateIdentityDynamicGroup(d *schema.ResourceData, m interface{}) error {
@@ -82,7 +82,7 @@ func updateIdentityDynamicGroup(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteIdentityDynamicGroup(d *schema.ResourceData, m interface{}) error {
@@ -91,7 +91,7 @@ func deleteIdentityDynamicGroup(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).identityClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type IdentityDynamicGroupResourceCrud struct {
@@ -129,7 +129,7 @@ func (s *IdentityDynamicGroupResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentityDynamicGroupResourceCrud) Create() error {
+func (s *IdentityDynamicGroupResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateDynamicGroupRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -154,7 +154,7 @@ func (s *IdentityDynamicGroupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateDynamicGroup(context.Background(), request)
+	response, err := s.Client.CreateDynamicGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -163,7 +163,7 @@ func (s *IdentityDynamicGroupResourceCrud) Create() error {
 	return nil
 }
 
-func (s *IdentityDynamicGroupResourceCrud) Get() error {
+func (s *IdentityDynamicGroupResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetDynamicGroupRequest{}
 
 	tmp := s.D.Id()
@@ -171,7 +171,7 @@ func (s *IdentityDynamicGroupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetDynamicGroup(context.Background(), request)
+	response, err := s.Client.GetDynamicGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -180,7 +180,7 @@ func (s *IdentityDynamicGroupResourceCrud) Get() error {
 	return nil
 }
 
-func (s *IdentityDynamicGroupResourceCrud) Update() error {
+func (s *IdentityDynamicGroupResourceCrud) Update(ctx context.Context) error {
 	request := oci_identity.UpdateDynamicGroupRequest{}
 
 	if description, ok := s.D.GetOkExists("description"); ok {
@@ -198,7 +198,7 @@ func (s *IdentityDynamicGroupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateDynamicGroup(context.Background(), request)
+	response, err := s.Client.UpdateDynamicGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -207,7 +207,7 @@ func (s *IdentityDynamicGroupResourceCrud) Update() error {
 	return nil
 }
 
-func (s *IdentityDynamicGroupResourceCrud) Delete() error {
+func (s *IdentityDynamicGroupResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeleteDynamicGroupRequest{}
 
 	tmp := s.D.Id()
@@ -215,7 +215,7 @@ func (s *IdentityDynamicGroupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteDynamicGroup(context.Background(), request)
+	_, err := s.Client.DeleteDynamicGroup(ctx, request)
 	return err
 }
 
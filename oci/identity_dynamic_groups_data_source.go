@@ -14,7 +14,8 @@ func IdentityDynamicGroupsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readIdentityDynamicGroups,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -33,7 +34,7 @@ func readIdentityDynamicGroups(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type IdentityDynamicGroupsDataSourceCrud struct {
@@ -46,7 +47,7 @@ func (s *IdentityDynamicGroupsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityDynamicGroupsDataSourceCrud) Get() error {
+func (s *IdentityDynamicGroupsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListDynamicGroupsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -55,8 +56,9 @@ func (s *IdentityDynamicGroupsDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListDynamicGroups(context.Background(), request)
+	response, err := s.Client.ListDynamicGroups(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -64,8 +66,8 @@ func (s *IdentityDynamicGroupsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListDynamicGroups(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListDynamicGroups(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -123,6 +125,8 @@ func (s *IdentityDynamicGroupsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, IdentityDynamicGroupsDataSource().Schema["dynamic_groups"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("dynamic_groups", resources); err != nil {
 		return err
 	}
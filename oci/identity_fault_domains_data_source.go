@@ -60,7 +60,7 @@ func readIdentityFaultDomains(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type IdentityFaultDomainsDataSourceCrud struct {
@@ -73,7 +73,7 @@ func (s *IdentityFaultDomainsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityFaultDomainsDataSourceCrud) Get() error {
+func (s *IdentityFaultDomainsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListFaultDomainsRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -88,7 +88,7 @@ func (s *IdentityFaultDomainsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListFaultDomains(context.Background(), request)
+	response, err := s.Client.ListFaultDomains(ctx, request)
 	if err != nil {
 		return err
 	}
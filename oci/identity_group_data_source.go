@@ -24,7 +24,7 @@ func readSingularIdentityGroup(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type IdentityGroupDataSourceCrud struct {
@@ -37,7 +37,7 @@ func (s *IdentityGroupDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityGroupDataSourceCrud) Get() error {
+func (s *IdentityGroupDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetGroupRequest{}
 
 	if groupId, ok := s.D.GetOkExists("group_id"); ok {
@@ -47,7 +47,7 @@ func (s *IdentityGroupDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
 
-	response, err := s.Client.GetGroup(context.Background(), request)
+	response, err := s.Client.GetGroup(ctx, request)
 	if err != nil {
 		return err
 	}
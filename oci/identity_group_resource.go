@@ -89,7 +89,7 @@ func createIdentityGroup(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).identityClient
 	sync.Configuration = m.(*OracleClients).configuration
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readIdentityGroup(d *schema.ResourceData, m interface{}) error {
@@ -97,7 +97,7 @@ func readIdentityGroup(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateIdentityGroup(d *schema.ResourceData, m interface{}) error {
@@ -105,7 +105,7 @@ func updateIdentityGroup(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteIdentityGroup(d *schema.ResourceData, m interface{}) error {
@@ -114,7 +114,7 @@ func deleteIdentityGroup(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).identityClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type IdentityGroupResourceCrud struct {
@@ -153,7 +153,7 @@ func (s *IdentityGroupResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentityGroupResourceCrud) Create() error {
+func (s *IdentityGroupResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateGroupRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -200,7 +200,7 @@ func (s *IdentityGroupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateGroup(context.Background(), request)
+	response, err := s.Client.CreateGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -209,7 +209,7 @@ func (s *IdentityGroupResourceCrud) Create() error {
 	return nil
 }
 
-func (s *IdentityGroupResourceCrud) Get() error {
+func (s *IdentityGroupResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetGroupRequest{}
 
 	tmp := s.D.Id()
@@ -217,7 +217,7 @@ func (s *IdentityGroupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetGroup(context.Background(), request)
+	response, err := s.Client.GetGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -226,7 +226,7 @@ func (s *IdentityGroupResourceCrud) Get() error {
 	return nil
 }
 
-func (s *IdentityGroupResourceCrud) Update() error {
+func (s *IdentityGroupResourceCrud) Update(ctx context.Context) error {
 	request := oci_identity.UpdateGroupRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -251,7 +251,7 @@ func (s *IdentityGroupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateGroup(context.Background(), request)
+	response, err := s.Client.UpdateGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -260,7 +260,7 @@ func (s *IdentityGroupResourceCrud) Update() error {
 	return nil
 }
 
-func (s *IdentityGroupResourceCrud) Delete() error {
+func (s *IdentityGroupResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeleteGroupRequest{}
 
 	tmp := s.D.Id()
@@ -268,7 +268,7 @@ func (s *IdentityGroupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteGroup(context.Background(), request)
+	_, err := s.Client.DeleteGroup(ctx, request)
 	return err
 }
 
@@ -14,7 +14,8 @@ func IdentityGroupsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readIdentityGroups,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -33,7 +34,7 @@ func readIdentityGroups(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type IdentityGroupsDataSourceCrud struct {
@@ -46,7 +47,7 @@ func (s *IdentityGroupsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityGroupsDataSourceCrud) Get() error {
+func (s *IdentityGroupsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListGroupsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -55,8 +56,9 @@ func (s *IdentityGroupsDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListGroups(context.Background(), request)
+	response, err := s.Client.ListGroups(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -64,8 +66,8 @@ func (s *IdentityGroupsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListGroups(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListGroups(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -125,6 +127,8 @@ func (s *IdentityGroupsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, IdentityGroupsDataSource().Schema["groups"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("groups", resources); err != nil {
 		return err
 	}
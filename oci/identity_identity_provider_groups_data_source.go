@@ -13,7 +13,8 @@ func IdentityIdentityProviderGroupsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readIdentityIdentityProviderGroups,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"identity_provider_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -64,7 +65,7 @@ func readIdentityIdentityProviderGroups(d *schema.ResourceData, m interface{}) e
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type IdentityIdentityProviderGroupsDataSourceCrud struct {
@@ -77,7 +78,7 @@ func (s *IdentityIdentityProviderGroupsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityIdentityProviderGroupsDataSourceCrud) Get() error {
+func (s *IdentityIdentityProviderGroupsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListIdentityProviderGroupsRequest{}
 
 	if identityProviderId, ok := s.D.GetOkExists("identity_provider_id"); ok {
@@ -86,8 +87,9 @@ func (s *IdentityIdentityProviderGroupsDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListIdentityProviderGroups(context.Background(), request)
+	response, err := s.Client.ListIdentityProviderGroups(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -95,8 +97,8 @@ func (s *IdentityIdentityProviderGroupsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListIdentityProviderGroups(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListIdentityProviderGroups(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -148,6 +150,8 @@ func (s *IdentityIdentityProviderGroupsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, IdentityIdentityProviderGroupsDataSource().Schema["identity_provider_groups"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("identity_provider_groups", resources); err != nil {
 		return err
 	}
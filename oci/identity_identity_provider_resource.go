@@ -114,7 +114,7 @@ func createIdentityIdentityProvider(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readIdentityIdentityProvider(d *schema.ResourceData, m interface{}) error {
@@ -122,7 +122,7 @@ func readIdentityIdentityProvider(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateIdentityIdentityProvider(d *schema.ResourceData, m interface{}) error {
@@ -130,7 +130,7 @@ func updateIdentityIdentityProvider(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteIdentityIdentityProvider(d *schema.ResourceData, m interface{}) error {
@@ -139,7 +139,7 @@ func deleteIdentityIdentityProvider(d *schema.ResourceData, m interface{}) error
 	sync.Client = m.(*OracleClients).identityClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type IdentityIdentityProviderResourceCrud struct {
@@ -178,7 +178,7 @@ func (s *IdentityIdentityProviderResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentityIdentityProviderResourceCrud) Create() error {
+func (s *IdentityIdentityProviderResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateIdentityProviderRequest{}
 	err := s.populateTopLevelPolymorphicCreateIdentityProviderRequest(&request)
 	if err != nil {
@@ -187,7 +187,7 @@ func (s *IdentityIdentityProviderResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateIdentityProvider(context.Background(), request)
+	response, err := s.Client.CreateIdentityProvider(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -196,7 +196,7 @@ func (s *IdentityIdentityProviderResourceCrud) Create() error {
 	return nil
 }
 
-func (s *IdentityIdentityProviderResourceCrud) Get() error {
+func (s *IdentityIdentityProviderResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetIdentityProviderRequest{}
 
 	tmp := s.D.Id()
@@ -204,7 +204,7 @@ func (s *IdentityIdentityProviderResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetIdentityProvider(context.Background(), request)
+	response, err := s.Client.GetIdentityProvider(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -213,7 +213,7 @@ func (s *IdentityIdentityProviderResourceCrud) Get() error {
 	return nil
 }
 
-func (s *IdentityIdentityProviderResourceCrud) Update() error {
+func (s *IdentityIdentityProviderResourceCrud) Update(ctx context.Context) error {
 	request := oci_identity.UpdateIdentityProviderRequest{}
 	err := s.populateTopLevelPolymorphicUpdateIdentityProviderRequest(&request)
 	if err != nil {
@@ -222,7 +222,7 @@ func (s *IdentityIdentityProviderResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateIdentityProvider(context.Background(), request)
+	response, err := s.Client.UpdateIdentityProvider(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -231,7 +231,7 @@ func (s *IdentityIdentityProviderResourceCrud) Update() error {
 	return nil
 }
 
-func (s *IdentityIdentityProviderResourceCrud) Delete() error {
+func (s *IdentityIdentityProviderResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeleteIdentityProviderRequest{}
 
 	tmp := s.D.Id()
@@ -239,7 +239,7 @@ func (s *IdentityIdentityProviderResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteIdentityProvider(context.Background(), request)
+	_, err := s.Client.DeleteIdentityProvider(ctx, request)
 	return err
 }
 
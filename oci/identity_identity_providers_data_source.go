@@ -15,7 +15,8 @@ func IdentityIdentityProvidersDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readIdentityIdentityProviders,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -38,7 +39,7 @@ func readIdentityIdentityProviders(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type IdentityIdentityProvidersDataSourceCrud struct {
@@ -51,7 +52,7 @@ func (s *IdentityIdentityProvidersDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityIdentityProvidersDataSourceCrud) Get() error {
+func (s *IdentityIdentityProvidersDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListIdentityProvidersRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -64,8 +65,9 @@ func (s *IdentityIdentityProvidersDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListIdentityProviders(context.Background(), request)
+	response, err := s.Client.ListIdentityProviders(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -73,8 +75,8 @@ func (s *IdentityIdentityProvidersDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListIdentityProviders(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListIdentityProviders(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -161,6 +163,8 @@ func (s *IdentityIdentityProvidersDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, IdentityIdentityProvidersDataSource().Schema["identity_providers"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("identity_providers", resources); err != nil {
 		return err
 	}
@@ -13,6 +13,9 @@ import (
 
 func IdentityIdpGroupMappingResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importIdentityIdpGroupMapping,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createIdentityIdpGroupMapping,
 		Read:     readIdentityIdpGroupMapping,
@@ -62,7 +65,7 @@ func createIdentityIdpGroupMapping(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readIdentityIdpGroupMapping(d *schema.ResourceData, m interface{}) error {
@@ -70,7 +73,7 @@ func readIdentityIdpGroupMapping(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateIdentityIdpGroupMapping(d *schema.ResourceData, m interface{}) error {
@@ -78,7 +81,7 @@ func updateIdentityIdpGroupMapping(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteIdentityIdpGroupMapping(d *schema.ResourceData, m interface{}) error {
@@ -87,7 +90,20 @@ func deleteIdentityIdpGroupMapping(d *schema.ResourceData, m interface{}) error
 	sync.Client = m.(*OracleClients).identityClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
+}
+
+// importIdentityIdpGroupMapping imports a group mapping of an identity provider, with an ID of the form
+// identityProviders/{identityProviderId}/groupMappings/{mappingId}
+func importIdentityIdpGroupMapping(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	values, err := parseCompositeId(d.Id(), "identityProviders", "groupMappings")
+	if err != nil {
+		return nil, err
+	}
+	d.Set("identity_provider_id", values[0])
+	d.SetId(values[1])
+
+	return []*schema.ResourceData{d}, nil
 }
 
 type IdentityIdpGroupMappingResourceCrud struct {
@@ -125,7 +141,7 @@ func (s *IdentityIdpGroupMappingResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentityIdpGroupMappingResourceCrud) Create() error {
+func (s *IdentityIdpGroupMappingResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateIdpGroupMappingRequest{}
 
 	if groupId, ok := s.D.GetOkExists("group_id"); ok {
@@ -145,7 +161,7 @@ func (s *IdentityIdpGroupMappingResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateIdpGroupMapping(context.Background(), request)
+	response, err := s.Client.CreateIdpGroupMapping(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -154,7 +170,7 @@ func (s *IdentityIdpGroupMappingResourceCrud) Create() error {
 	return nil
 }
 
-func (s *IdentityIdpGroupMappingResourceCrud) Get() error {
+func (s *IdentityIdpGroupMappingResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetIdpGroupMappingRequest{}
 
 	if identityProviderId, ok := s.D.GetOkExists("identity_provider_id"); ok {
@@ -167,7 +183,7 @@ func (s *IdentityIdpGroupMappingResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetIdpGroupMapping(context.Background(), request)
+	response, err := s.Client.GetIdpGroupMapping(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -176,7 +192,7 @@ func (s *IdentityIdpGroupMappingResourceCrud) Get() error {
 	return nil
 }
 
-func (s *IdentityIdpGroupMappingResourceCrud) Update() error {
+func (s *IdentityIdpGroupMappingResourceCrud) Update(ctx context.Context) error {
 	request := oci_identity.UpdateIdpGroupMappingRequest{}
 
 	if groupId, ok := s.D.GetOkExists("group_id"); ok {
@@ -199,7 +215,7 @@ func (s *IdentityIdpGroupMappingResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateIdpGroupMapping(context.Background(), request)
+	response, err := s.Client.UpdateIdpGroupMapping(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -208,7 +224,7 @@ func (s *IdentityIdpGroupMappingResourceCrud) Update() error {
 	return nil
 }
 
-func (s *IdentityIdpGroupMappingResourceCrud) Delete() error {
+func (s *IdentityIdpGroupMappingResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeleteIdpGroupMappingRequest{}
 
 	if identityProviderId, ok := s.D.GetOkExists("identity_provider_id"); ok {
@@ -221,7 +237,7 @@ func (s *IdentityIdpGroupMappingResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteIdpGroupMapping(context.Background(), request)
+	_, err := s.Client.DeleteIdpGroupMapping(ctx, request)
 	return err
 }
 
@@ -112,6 +112,15 @@ func TestIdentityIdpGroupMappingResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(datasourceName, "idp_group_mappings.0.time_created"),
 				),
 			},
+			// verify resource import
+			{
+				Config:                  config,
+				ImportState:             true,
+				ImportStateIdFunc:       getImportCompositeIdFunc(resourceName, "identityProviders", "identity_provider_id", "groupMappings", "id"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+				ResourceName:            resourceName,
+			},
 		},
 	})
 }
@@ -14,7 +14,8 @@ func IdentityIdpGroupMappingsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readIdentityIdpGroupMappings,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"identity_provider_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -33,7 +34,7 @@ func readIdentityIdpGroupMappings(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type IdentityIdpGroupMappingsDataSourceCrud struct {
@@ -46,7 +47,7 @@ func (s *IdentityIdpGroupMappingsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityIdpGroupMappingsDataSourceCrud) Get() error {
+func (s *IdentityIdpGroupMappingsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListIdpGroupMappingsRequest{}
 
 	if identityProviderId, ok := s.D.GetOkExists("identity_provider_id"); ok {
@@ -55,8 +56,9 @@ func (s *IdentityIdpGroupMappingsDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListIdpGroupMappings(context.Background(), request)
+	response, err := s.Client.ListIdpGroupMappings(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -64,8 +66,8 @@ func (s *IdentityIdpGroupMappingsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListIdpGroupMappings(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListIdpGroupMappings(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -123,6 +125,8 @@ func (s *IdentityIdpGroupMappingsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, IdentityIdpGroupMappingsDataSource().Schema["idp_group_mappings"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("idp_group_mappings", resources); err != nil {
 		return err
 	}
@@ -14,7 +14,8 @@ func IdentityPoliciesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readIdentityPolicies,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -33,7 +34,7 @@ func readIdentityPolicies(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type IdentityPoliciesDataSourceCrud struct {
@@ -46,7 +47,7 @@ func (s *IdentityPoliciesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityPoliciesDataSourceCrud) Get() error {
+func (s *IdentityPoliciesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListPoliciesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -55,8 +56,9 @@ func (s *IdentityPoliciesDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListPolicies(context.Background(), request)
+	response, err := s.Client.ListPolicies(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -64,8 +66,8 @@ func (s *IdentityPoliciesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListPolicies(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListPolicies(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -132,6 +134,8 @@ func (s *IdentityPoliciesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, IdentityPoliciesDataSource().Schema["policies"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("policies", resources); err != nil {
 		return err
 	}
@@ -120,7 +120,7 @@ func createIdentityPolicy(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readIdentityPolicy(d *schema.ResourceData, m interface{}) error {
@@ -128,7 +128,7 @@ func readIdentityPolicy(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateIdentityPolicy(d *schema.ResourceData, m interface{}) error {
@@ -136,7 +136,7 @@ func updateIdentityPolicy(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteIdentityPolicy(d *schema.ResourceData, m interface{}) error {
@@ -145,7 +145,7 @@ func deleteIdentityPolicy(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).identityClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type IdentityPolicyResourceCrud struct {
@@ -185,7 +185,7 @@ func (s *IdentityPolicyResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentityPolicyResourceCrud) Create() error {
+func (s *IdentityPolicyResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreatePolicyRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -237,7 +237,7 @@ func (s *IdentityPolicyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreatePolicy(context.Background(), request)
+	response, err := s.Client.CreatePolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -253,7 +253,7 @@ func (s *IdentityPolicyResourceCrud) Create() error {
 	return nil
 }
 
-func (s *IdentityPolicyResourceCrud) Get() error {
+func (s *IdentityPolicyResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetPolicyRequest{}
 
 	tmp := s.D.Id()
@@ -261,7 +261,7 @@ func (s *IdentityPolicyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetPolicy(context.Background(), request)
+	response, err := s.Client.GetPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -275,7 +275,7 @@ func (s *IdentityPolicyResourceCrud) Get() error {
 	return nil
 }
 
-func (s *IdentityPolicyResourceCrud) Update() error {
+func (s *IdentityPolicyResourceCrud) Update(ctx context.Context) error {
 	request := oci_identity.UpdatePolicyRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -320,7 +320,7 @@ func (s *IdentityPolicyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdatePolicy(context.Background(), request)
+	response, err := s.Client.UpdatePolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -336,7 +336,7 @@ func (s *IdentityPolicyResourceCrud) Update() error {
 	return nil
 }
 
-func (s *IdentityPolicyResourceCrud) Delete() error {
+func (s *IdentityPolicyResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeletePolicyRequest{}
 
 	tmp := s.D.Id()
@@ -344,7 +344,7 @@ func (s *IdentityPolicyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeletePolicy(context.Background(), request)
+	_, err := s.Client.DeletePolicy(ctx, request)
 	return err
 }
 
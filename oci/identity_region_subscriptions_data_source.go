@@ -62,7 +62,7 @@ func readIdentityRegionSubscriptions(d *schema.ResourceData, m interface{}) erro
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type IdentityRegionSubscriptionsDataSourceCrud struct {
@@ -75,7 +75,7 @@ func (s *IdentityRegionSubscriptionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityRegionSubscriptionsDataSourceCrud) Get() error {
+func (s *IdentityRegionSubscriptionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListRegionSubscriptionsRequest{}
 
 	if tenancyId, ok := s.D.GetOkExists("tenancy_id"); ok {
@@ -85,7 +85,7 @@ func (s *IdentityRegionSubscriptionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListRegionSubscriptions(context.Background(), request)
+	response, err := s.Client.ListRegionSubscriptions(ctx, request)
 	if err != nil {
 		return err
 	}
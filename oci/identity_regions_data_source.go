@@ -44,7 +44,7 @@ func readIdentityRegions(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type IdentityRegionsDataSourceCrud struct {
@@ -57,12 +57,12 @@ func (s *IdentityRegionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityRegionsDataSourceCrud) Get() error {
+func (s *IdentityRegionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListRegionsRequest{}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListRegions(context.Background())
+	response, err := s.Client.ListRegions(ctx)
 	if err != nil {
 		return err
 	}
@@ -14,6 +14,9 @@ import (
 
 func IdentitySmtpCredentialResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importIdentitySmtpCredential,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createIdentitySmtpCredential,
 		Read:     readIdentitySmtpCredential,
@@ -67,7 +70,7 @@ func createIdentitySmtpCredential(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readIdentitySmtpCredential(d *schema.ResourceData, m interface{}) error {
@@ -75,7 +78,7 @@ func readIdentitySmtpCredential(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateIdentitySmtpCredential(d *schema.ResourceData, m interface{}) error {
@@ -83,7 +86,7 @@ func updateIdentitySmtpCredential(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteIdentitySmtpCredential(d *schema.ResourceData, m interface{}) error {
@@ -92,7 +95,20 @@ func deleteIdentitySmtpCredential(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).identityClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
+}
+
+// importIdentitySmtpCredential imports an SMTP credential of a user, with an ID of the form
+// users/{userId}/smtpCredentials/{id}. The username is read back, but not the password.
+func importIdentitySmtpCredential(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	values, err := parseCompositeId(d.Id(), "users", "smtpCredentials")
+	if err != nil {
+		return nil, err
+	}
+	d.Set("user_id", values[0])
+	d.SetId(values[1])
+
+	return []*schema.ResourceData{d}, nil
 }
 
 type IdentitySmtpCredentialResourceCrud struct {
@@ -134,7 +150,7 @@ func (s *IdentitySmtpCredentialResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentitySmtpCredentialResourceCrud) Create() error {
+func (s *IdentitySmtpCredentialResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateSmtpCredentialRequest{}
 
 	if description, ok := s.D.GetOkExists("description"); ok {
@@ -149,7 +165,7 @@ func (s *IdentitySmtpCredentialResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateSmtpCredential(context.Background(), request)
+	response, err := s.Client.CreateSmtpCredential(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -169,7 +185,7 @@ func fromSmtpCredentialSummary(c oci_identity.SmtpCredentialSummary) *oci_identi
 		InactiveStatus: c.InactiveStatus}
 }
 
-func (s *IdentitySmtpCredentialResourceCrud) Get() error {
+func (s *IdentitySmtpCredentialResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListSmtpCredentialsRequest{}
 
 	if userId, ok := s.D.GetOkExists("user_id"); ok {
@@ -179,7 +195,7 @@ func (s *IdentitySmtpCredentialResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ListSmtpCredentials(context.Background(), request)
+	response, err := s.Client.ListSmtpCredentials(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -195,7 +211,7 @@ func (s *IdentitySmtpCredentialResourceCrud) Get() error {
 
 }
 
-func (s *IdentitySmtpCredentialResourceCrud) Update() error {
+func (s *IdentitySmtpCredentialResourceCrud) Update(ctx context.Context) error {
 	request := oci_identity.UpdateSmtpCredentialRequest{}
 
 	if description, ok := s.D.GetOkExists("description"); ok {
@@ -213,7 +229,7 @@ func (s *IdentitySmtpCredentialResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateSmtpCredential(context.Background(), request)
+	response, err := s.Client.UpdateSmtpCredential(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -222,7 +238,7 @@ func (s *IdentitySmtpCredentialResourceCrud) Update() error {
 	return nil
 }
 
-func (s *IdentitySmtpCredentialResourceCrud) Delete() error {
+func (s *IdentitySmtpCredentialResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeleteSmtpCredentialRequest{}
 
 	tmp := s.D.Id()
@@ -235,7 +251,7 @@ func (s *IdentitySmtpCredentialResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteSmtpCredential(context.Background(), request)
+	_, err := s.Client.DeleteSmtpCredential(ctx, request)
 	return err
 }
 
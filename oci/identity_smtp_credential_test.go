@@ -100,6 +100,17 @@ func TestIdentitySmtpCredentialResource_basic(t *testing.T) {
 					TestCheckResourceAttributesEqual(datasourceName, "smtp_credentials.0.user_id", "oci_identity_smtp_credential.test_smtp_credential", "user_id"),
 				),
 			},
+			// verify resource import
+			{
+				Config:            config,
+				ImportState:       true,
+				ImportStateIdFunc: getImportCompositeIdFunc(resourceName, "users", "user_id", "smtpCredentials", "id"),
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"password",
+				},
+				ResourceName: resourceName,
+			},
 		},
 	})
 }
@@ -33,7 +33,7 @@ func readIdentitySmtpCredentials(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type IdentitySmtpCredentialsDataSourceCrud struct {
@@ -46,7 +46,7 @@ func (s *IdentitySmtpCredentialsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentitySmtpCredentialsDataSourceCrud) Get() error {
+func (s *IdentitySmtpCredentialsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListSmtpCredentialsRequest{}
 
 	if userId, ok := s.D.GetOkExists("user_id"); ok {
@@ -56,7 +56,7 @@ func (s *IdentitySmtpCredentialsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListSmtpCredentials(context.Background(), request)
+	response, err := s.Client.ListSmtpCredentials(ctx, request)
 	if err != nil {
 		return err
 	}
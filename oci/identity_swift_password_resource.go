@@ -14,6 +14,9 @@ import (
 
 func IdentitySwiftPasswordResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importIdentitySwiftPassword,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createIdentitySwiftPassword,
 		Read:     readIdentitySwiftPassword,
@@ -63,7 +66,7 @@ func createIdentitySwiftPassword(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readIdentitySwiftPassword(d *schema.ResourceData, m interface{}) error {
@@ -71,7 +74,7 @@ func readIdentitySwiftPassword(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateIdentitySwiftPassword(d *schema.ResourceData, m interface{}) error {
@@ -79,7 +82,7 @@ func updateIdentitySwiftPassword(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteIdentitySwiftPassword(d *schema.ResourceData, m interface{}) error {
@@ -88,7 +91,20 @@ func deleteIdentitySwiftPassword(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).identityClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
+}
+
+// importIdentitySwiftPassword imports a Swift password of a user, with an ID of the form
+// users/{userId}/swiftPasswords/{id}, without the password itself
+func importIdentitySwiftPassword(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	values, err := parseCompositeId(d.Id(), "users", "swiftPasswords")
+	if err != nil {
+		return nil, err
+	}
+	d.Set("user_id", values[0])
+	d.SetId(values[1])
+
+	return []*schema.ResourceData{d}, nil
 }
 
 type IdentitySwiftPasswordResourceCrud struct {
@@ -130,7 +146,7 @@ func (s *IdentitySwiftPasswordResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentitySwiftPasswordResourceCrud) Create() error {
+func (s *IdentitySwiftPasswordResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateSwiftPasswordRequest{}
 
 	if description, ok := s.D.GetOkExists("description"); ok {
@@ -145,7 +161,7 @@ func (s *IdentitySwiftPasswordResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateSwiftPassword(context.Background(), request)
+	response, err := s.Client.CreateSwiftPassword(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -154,7 +170,7 @@ func (s *IdentitySwiftPasswordResourceCrud) Create() error {
 	return nil
 }
 
-func (s *IdentitySwiftPasswordResourceCrud) Get() error {
+func (s *IdentitySwiftPasswordResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListSwiftPasswordsRequest{}
 
 	if userId, ok := s.D.GetOkExists("user_id"); ok {
@@ -164,7 +180,7 @@ func (s *IdentitySwiftPasswordResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ListSwiftPasswords(context.Background(), request)
+	response, err := s.Client.ListSwiftPasswords(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -180,7 +196,7 @@ func (s *IdentitySwiftPasswordResourceCrud) Get() error {
 
 }
 
-func (s *IdentitySwiftPasswordResourceCrud) Update() error {
+func (s *IdentitySwiftPasswordResourceCrud) Update(ctx context.Context) error {
 	request := oci_identity.UpdateSwiftPasswordRequest{}
 
 	if description, ok := s.D.GetOkExists("description"); ok {
@@ -198,7 +214,7 @@ func (s *IdentitySwiftPasswordResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateSwiftPassword(context.Background(), request)
+	response, err := s.Client.UpdateSwiftPassword(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -207,7 +223,7 @@ func (s *IdentitySwiftPasswordResourceCrud) Update() error {
 	return nil
 }
 
-func (s *IdentitySwiftPasswordResourceCrud) Delete() error {
+func (s *IdentitySwiftPasswordResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeleteSwiftPasswordRequest{}
 
 	tmp := s.D.Id()
@@ -220,7 +236,7 @@ func (s *IdentitySwiftPasswordResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteSwiftPassword(context.Background(), request)
+	_, err := s.Client.DeleteSwiftPassword(ctx, request)
 	return err
 }
 
@@ -95,6 +95,17 @@ func TestIdentitySwiftPasswordResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(datasourceName, "passwords.0.user_id"),
 				),
 			},
+			// verify resource import
+			{
+				Config:            config,
+				ImportState:       true,
+				ImportStateIdFunc: getImportCompositeIdFunc(resourceName, "users", "user_id", "swiftPasswords", "id"),
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"password",
+				},
+				ResourceName: resourceName,
+			},
 		},
 	})
 }
@@ -33,7 +33,7 @@ func readIdentitySwiftPasswords(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type IdentitySwiftPasswordsDataSourceCrud struct {
@@ -46,7 +46,7 @@ func (s *IdentitySwiftPasswordsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentitySwiftPasswordsDataSourceCrud) Get() error {
+func (s *IdentitySwiftPasswordsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListSwiftPasswordsRequest{}
 
 	if userId, ok := s.D.GetOkExists("user_id"); ok {
@@ -56,7 +56,7 @@ func (s *IdentitySwiftPasswordsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListSwiftPasswords(context.Background(), request)
+	response, err := s.Client.ListSwiftPasswords(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -55,7 +55,7 @@ func readSingularIdentityTagDefault(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type IdentityTagDefaultDataSourceCrud struct {
@@ -68,7 +68,7 @@ func (s *IdentityTagDefaultDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityTagDefaultDataSourceCrud) Get() error {
+func (s *IdentityTagDefaultDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetTagDefaultRequest{}
 
 	if tagDefaultId, ok := s.D.GetOkExists("tag_default_id"); ok {
@@ -78,7 +78,7 @@ func (s *IdentityTagDefaultDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
 
-	response, err := s.Client.GetTagDefault(context.Background(), request)
+	response, err := s.Client.GetTagDefault(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -65,7 +65,7 @@ func createIdentityTagDefault(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readIdentityTagDefault(d *schema.ResourceData, m interface{}) error {
@@ -73,7 +73,7 @@ func readIdentityTagDefault(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateIdentityTagDefault(d *schema.ResourceData, m interface{}) error {
@@ -81,7 +81,7 @@ func updateIdentityTagDefault(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteIdentityTagDefault(d *schema.ResourceData, m interface{}) error {
@@ -90,7 +90,7 @@ func deleteIdentityTagDefault(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).identityClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type IdentityTagDefaultResourceCrud struct {
@@ -122,7 +122,7 @@ func (s *IdentityTagDefaultResourceCrud) DeletedTarget() []string {
 	return []string{}
 }
 
-func (s *IdentityTagDefaultResourceCrud) Create() error {
+func (s *IdentityTagDefaultResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateTagDefaultRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -142,7 +142,7 @@ func (s *IdentityTagDefaultResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateTagDefault(context.Background(), request)
+	response, err := s.Client.CreateTagDefault(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -151,7 +151,7 @@ func (s *IdentityTagDefaultResourceCrud) Create() error {
 	return nil
 }
 
-func (s *IdentityTagDefaultResourceCrud) Get() error {
+func (s *IdentityTagDefaultResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetTagDefaultRequest{}
 
 	tmp := s.D.Id()
@@ -159,7 +159,7 @@ func (s *IdentityTagDefaultResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetTagDefault(context.Background(), request)
+	response, err := s.Client.GetTagDefault(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -168,7 +168,7 @@ func (s *IdentityTagDefaultResourceCrud) Get() error {
 	return nil
 }
 
-func (s *IdentityTagDefaultResourceCrud) Update() error {
+func (s *IdentityTagDefaultResourceCrud) Update(ctx context.Context) error {
 	request := oci_identity.UpdateTagDefaultRequest{}
 
 	tmp := s.D.Id()
@@ -181,7 +181,7 @@ func (s *IdentityTagDefaultResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateTagDefault(context.Background(), request)
+	response, err := s.Client.UpdateTagDefault(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -190,7 +190,7 @@ func (s *IdentityTagDefaultResourceCrud) Update() error {
 	return nil
 }
 
-func (s *IdentityTagDefaultResourceCrud) Delete() error {
+func (s *IdentityTagDefaultResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeleteTagDefaultRequest{}
 
 	tmp := s.D.Id()
@@ -198,7 +198,7 @@ func (s *IdentityTagDefaultResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteTagDefault(context.Background(), request)
+	_, err := s.Client.DeleteTagDefault(ctx, request)
 	return err
 }
 
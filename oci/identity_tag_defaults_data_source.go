@@ -13,7 +13,8 @@ func IdentityTagDefaultsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readIdentityTagDefaults,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -44,7 +45,7 @@ func readIdentityTagDefaults(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type IdentityTagDefaultsDataSourceCrud struct {
@@ -57,7 +58,7 @@ func (s *IdentityTagDefaultsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityTagDefaultsDataSourceCrud) Get() error {
+func (s *IdentityTagDefaultsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListTagDefaultsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -80,8 +81,9 @@ func (s *IdentityTagDefaultsDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListTagDefaults(context.Background(), request)
+	response, err := s.Client.ListTagDefaults(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -89,8 +91,8 @@ func (s *IdentityTagDefaultsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListTagDefaults(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListTagDefaults(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -150,6 +152,8 @@ func (s *IdentityTagDefaultsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, IdentityTagDefaultsDataSource().Schema["tag_defaults"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("tag_defaults", resources); err != nil {
 		return err
 	}
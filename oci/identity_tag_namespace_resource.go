@@ -75,7 +75,7 @@ func createIdentityTagNamespace(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readIdentityTagNamespace(d *schema.ResourceData, m interface{}) error {
@@ -83,7 +83,7 @@ func readIdentityTagNamespace(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateIdentityTagNamespace(d *schema.ResourceData, m interface{}) error {
@@ -91,7 +91,7 @@ func updateIdentityTagNamespace(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteIdentityTagNamespace(d *schema.ResourceData, m interface{}) error {
@@ -109,7 +109,7 @@ func (s *IdentityTagNamespaceResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *IdentityTagNamespaceResourceCrud) Create() error {
+func (s *IdentityTagNamespaceResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateTagNamespaceRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -141,13 +141,13 @@ func (s *IdentityTagNamespaceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	contextToUse := context.Background()
+	contextToUse := ctx
 	response, err := s.Client.CreateTagNamespace(contextToUse, request)
 	if err == nil {
 		s.Res = &response.TagNamespace
 		s.D.SetId(*s.Res.Id)
 		//is_retired field is currently not supported in create so update to make server state same as config
-		if updateError := s.Update(); updateError != nil {
+		if updateError := s.Update(ctx); updateError != nil {
 			return updateError
 		}
 		return nil
@@ -163,7 +163,7 @@ func (s *IdentityTagNamespaceResourceCrud) Create() error {
 		s.D.Set("name", request.Name)
 		s.D.Set("include_subcompartments", false)
 		dsCrud := &IdentityTagNamespacesDataSourceCrud{s.D, s.Client, nil}
-		if dsErr := dsCrud.Get(); dsErr != nil {
+		if dsErr := dsCrud.Get(ctx); dsErr != nil {
 			//return original error when datasource call fails
 			return err
 		}
@@ -171,8 +171,8 @@ func (s *IdentityTagNamespaceResourceCrud) Create() error {
 		for _, namespace := range dsCrud.Res.Items {
 			if strings.EqualFold(*namespace.Name, *request.Name) {
 				s.D.SetId(*namespace.Id)
-				if updateError := s.Update(); updateError != nil {
-					if getError := s.Get(); getError != nil {
+				if updateError := s.Update(ctx); updateError != nil {
+					if getError := s.Get(ctx); getError != nil {
 						//Update to tags can only be done from home region, so do get in that case
 						return getError
 					}
@@ -185,7 +185,7 @@ func (s *IdentityTagNamespaceResourceCrud) Create() error {
 	return err
 }
 
-func (s *IdentityTagNamespaceResourceCrud) Get() error {
+func (s *IdentityTagNamespaceResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetTagNamespaceRequest{}
 
 	tmp := s.D.Id()
@@ -193,7 +193,7 @@ func (s *IdentityTagNamespaceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetTagNamespace(context.Background(), request)
+	response, err := s.Client.GetTagNamespace(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -202,7 +202,7 @@ func (s *IdentityTagNamespaceResourceCrud) Get() error {
 	return nil
 }
 
-func (s *IdentityTagNamespaceResourceCrud) Update() error {
+func (s *IdentityTagNamespaceResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
@@ -214,7 +214,7 @@ func (s *IdentityTagNamespaceResourceCrud) Update() error {
 			idTmp := s.D.Id()
 			changeCompartmentRequest.TagNamespaceId = &idTmp
 
-			_, err := s.Client.ChangeTagNamespaceCompartment(context.Background(), changeCompartmentRequest)
+			_, err := s.Client.ChangeTagNamespaceCompartment(ctx, changeCompartmentRequest)
 			if err != nil {
 				return err
 			}
@@ -250,7 +250,7 @@ func (s *IdentityTagNamespaceResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateTagNamespace(context.Background(), request)
+	response, err := s.Client.UpdateTagNamespace(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -13,7 +13,8 @@ func IdentityTagNamespacesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readIdentityTagNamespaces,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -36,7 +37,7 @@ func readIdentityTagNamespaces(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type IdentityTagNamespacesDataSourceCrud struct {
@@ -49,7 +50,7 @@ func (s *IdentityTagNamespacesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityTagNamespacesDataSourceCrud) Get() error {
+func (s *IdentityTagNamespacesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListTagNamespacesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -63,8 +64,9 @@ func (s *IdentityTagNamespacesDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListTagNamespaces(context.Background(), request)
+	response, err := s.Client.ListTagNamespaces(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -72,8 +74,8 @@ func (s *IdentityTagNamespacesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListTagNamespaces(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListTagNamespaces(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -131,6 +133,8 @@ func (s *IdentityTagNamespacesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, IdentityTagNamespacesDataSource().Schema["tag_namespaces"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("tag_namespaces", resources); err != nil {
 		return err
 	}
@@ -80,7 +80,7 @@ func createIdentityTag(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readIdentityTag(d *schema.ResourceData, m interface{}) error {
@@ -88,7 +88,7 @@ func readIdentityTag(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateIdentityTag(d *schema.ResourceData, m interface{}) error {
@@ -96,7 +96,7 @@ func updateIdentityTag(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteIdentityTag(d *schema.ResourceData, m interface{}) error {
@@ -114,7 +114,7 @@ func (s *IdentityTagResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *IdentityTagResourceCrud) Create() error {
+func (s *IdentityTagResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateTagRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -151,13 +151,13 @@ func (s *IdentityTagResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	contextToUse := context.Background()
+	contextToUse := ctx
 	response, err := s.Client.CreateTag(contextToUse, request)
 	if err == nil {
 		s.Res = &response.Tag
 		s.D.SetId(*s.Res.Id)
 		//is_retired field is currently not supported in create so update to make server state same as config
-		if updateError := s.Update(); updateError != nil {
+		if updateError := s.Update(ctx); updateError != nil {
 			return updateError
 		}
 		return nil
@@ -173,7 +173,7 @@ func (s *IdentityTagResourceCrud) Create() error {
 		s.D.Set("tag_namespace_id", request.TagNamespaceId)
 		s.D.Set("name", request.Name)
 		dsCrud := &IdentityTagsDataSourceCrud{s.D, s.Client, nil}
-		if dsErr := dsCrud.Get(); dsErr != nil {
+		if dsErr := dsCrud.Get(ctx); dsErr != nil {
 			//return original error when datasource call fails
 			return err
 		}
@@ -181,9 +181,9 @@ func (s *IdentityTagResourceCrud) Create() error {
 		for _, tag := range dsCrud.Res.Items {
 			if strings.EqualFold(*tag.Name, *request.Name) {
 				s.D.SetId(*tag.Id)
-				if updateError := s.Update(); updateError != nil {
+				if updateError := s.Update(ctx); updateError != nil {
 					//Update to tags can only be done from home region, so do get in that case
-					if getError := s.Get(); getError != nil {
+					if getError := s.Get(ctx); getError != nil {
 						return getError
 					}
 				}
@@ -196,7 +196,7 @@ func (s *IdentityTagResourceCrud) Create() error {
 
 }
 
-func (s *IdentityTagResourceCrud) Get() error {
+func (s *IdentityTagResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetTagRequest{}
 
 	tagName, tagNamespaceId, parseTagCompositeIdErr := parseTagCompositeId(s.D.Id())
@@ -217,7 +217,7 @@ func (s *IdentityTagResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetTag(context.Background(), request)
+	response, err := s.Client.GetTag(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -234,7 +234,7 @@ func (s *IdentityTagResourceCrud) Get() error {
 	return nil
 }
 
-func (s *IdentityTagResourceCrud) Update() error {
+func (s *IdentityTagResourceCrud) Update(ctx context.Context) error {
 	request := oci_identity.UpdateTagRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -276,7 +276,7 @@ func (s *IdentityTagResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateTag(context.Background(), request)
+	response, err := s.Client.UpdateTag(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -13,7 +13,8 @@ func IdentityTagsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readIdentityTags,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"tag_namespace_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -32,7 +33,7 @@ func readIdentityTags(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type IdentityTagsDataSourceCrud struct {
@@ -45,7 +46,7 @@ func (s *IdentityTagsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityTagsDataSourceCrud) Get() error {
+func (s *IdentityTagsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListTagsRequest{}
 
 	if tagNamespaceId, ok := s.D.GetOkExists("tag_namespace_id"); ok {
@@ -54,8 +55,9 @@ func (s *IdentityTagsDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListTags(context.Background(), request)
+	response, err := s.Client.ListTags(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -63,8 +65,8 @@ func (s *IdentityTagsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListTags(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListTags(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -124,6 +126,8 @@ func (s *IdentityTagsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, IdentityTagsDataSource().Schema["tags"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("tags", resources); err != nil {
 		return err
 	}
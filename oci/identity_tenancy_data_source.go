@@ -49,7 +49,7 @@ func readSingularIdentityTenancy(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type IdentityTenancyDataSourceCrud struct {
@@ -62,7 +62,7 @@ func (s *IdentityTenancyDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityTenancyDataSourceCrud) Get() error {
+func (s *IdentityTenancyDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetTenancyRequest{}
 
 	if tenancyId, ok := s.D.GetOkExists("tenancy_id"); ok {
@@ -72,7 +72,7 @@ func (s *IdentityTenancyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
 
-	response, err := s.Client.GetTenancy(context.Background(), request)
+	response, err := s.Client.GetTenancy(ctx, request)
 	if err != nil {
 		return err
 	}
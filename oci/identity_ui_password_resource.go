@@ -13,6 +13,9 @@ import (
 
 func IdentityUiPasswordResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importIdentityUiPassword,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createIdentityUiPassword,
 		Read:     readIdentityUiPassword,
@@ -53,7 +56,7 @@ func createIdentityUiPassword(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readIdentityUiPassword(d *schema.ResourceData, m interface{}) error {
@@ -64,6 +67,13 @@ func deleteIdentityUiPassword(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+// importIdentityUiPassword imports the console password of a user by the OCID of the user. The password itself can
+// not be read back.
+func importIdentityUiPassword(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	d.Set("user_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
 type IdentityUiPasswordResourceCrud struct {
 	BaseCrud
 	Client                 *oci_identity.IdentityClient
@@ -99,7 +109,7 @@ func (s *IdentityUiPasswordResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentityUiPasswordResourceCrud) Create() error {
+func (s *IdentityUiPasswordResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateOrResetUIPasswordRequest{}
 
 	if userId, ok := s.D.GetOkExists("user_id"); ok {
@@ -109,7 +119,7 @@ func (s *IdentityUiPasswordResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateOrResetUIPassword(context.Background(), request)
+	response, err := s.Client.CreateOrResetUIPassword(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -41,6 +41,16 @@ func TestIdentityUiPasswordResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "user_id"),
 				),
 			},
+			// verify resource import
+			{
+				Config:            config,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"password",
+				},
+				ResourceName: resourceName,
+			},
 		},
 	})
 }
@@ -70,7 +70,7 @@ func createUserCapabilitiesManagement(d *schema.ResourceData, m interface{}) err
 	sync.Client = m.(*OracleClients).identityClient
 	sync.Configuration = m.(*OracleClients).configuration
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readUserCapabilitiesManagement(d *schema.ResourceData, m interface{}) error {
@@ -78,7 +78,7 @@ func readUserCapabilitiesManagement(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateUserCapabilitiesManagement(d *schema.ResourceData, m interface{}) error {
@@ -86,7 +86,7 @@ func updateUserCapabilitiesManagement(d *schema.ResourceData, m interface{}) err
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteUserCapabilitiesManagement(d *schema.ResourceData, m interface{}) error {
@@ -95,7 +95,7 @@ func deleteUserCapabilitiesManagement(d *schema.ResourceData, m interface{}) err
 	sync.Client = m.(*OracleClients).identityClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type UserCapabilitiesManagementResourceCrud struct {
@@ -126,7 +126,7 @@ func (s *UserCapabilitiesManagementResourceCrud) ID() string {
 	return getUserCapabilitiesCompositeId(s.D.Get("user_id").(string))
 }
 
-func (s *UserCapabilitiesManagementResourceCrud) Create() error {
+func (s *UserCapabilitiesManagementResourceCrud) Create(ctx context.Context) error {
 	userCapabilityRequest := oci_identity.UpdateUserCapabilitiesRequest{}
 
 	if userId, ok := s.D.GetOkExists("user_id"); ok {
@@ -159,7 +159,7 @@ func (s *UserCapabilitiesManagementResourceCrud) Create() error {
 		userCapabilityRequest.CanUseSmtpCredentials = &canUseSmtpCredentials
 	}
 
-	userCapabilityResponse, err := s.Client.UpdateUserCapabilities(context.Background(), userCapabilityRequest)
+	userCapabilityResponse, err := s.Client.UpdateUserCapabilities(ctx, userCapabilityRequest)
 	if err != nil {
 		return err
 	}
@@ -168,7 +168,7 @@ func (s *UserCapabilitiesManagementResourceCrud) Create() error {
 	return nil
 }
 
-func (s *UserCapabilitiesManagementResourceCrud) Get() error {
+func (s *UserCapabilitiesManagementResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetUserRequest{}
 
 	userId, err := parseUserCapabilitiesCompositeId(s.D.Id())
@@ -180,7 +180,7 @@ func (s *UserCapabilitiesManagementResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetUser(context.Background(), request)
+	response, err := s.Client.GetUser(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -189,11 +189,11 @@ func (s *UserCapabilitiesManagementResourceCrud) Get() error {
 	return nil
 }
 
-func (s *UserCapabilitiesManagementResourceCrud) Update() error {
-	return s.Create()
+func (s *UserCapabilitiesManagementResourceCrud) Update(ctx context.Context) error {
+	return s.Create(ctx)
 }
 
-func (s *UserCapabilitiesManagementResourceCrud) Delete() error {
+func (s *UserCapabilitiesManagementResourceCrud) Delete(ctx context.Context) error {
 	// We dont know the original default values, so we pretend that the existing state is to be retained
 	return nil
 }
@@ -24,7 +24,7 @@ func readSingularIdentityUser(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type IdentityUserDataSourceCrud struct {
@@ -37,7 +37,7 @@ func (s *IdentityUserDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityUserDataSourceCrud) Get() error {
+func (s *IdentityUserDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetUserRequest{}
 
 	if userId, ok := s.D.GetOkExists("user_id"); ok {
@@ -47,7 +47,7 @@ func (s *IdentityUserDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
 
-	response, err := s.Client.GetUser(context.Background(), request)
+	response, err := s.Client.GetUser(ctx, request)
 	if err != nil {
 		return err
 	}
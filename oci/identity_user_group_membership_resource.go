@@ -64,7 +64,7 @@ func createIdentityUserGroupMembership(d *schema.ResourceData, m interface{}) er
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readIdentityUserGroupMembership(d *schema.ResourceData, m interface{}) error {
@@ -72,7 +72,7 @@ func readIdentityUserGroupMembership(d *schema.ResourceData, m interface{}) erro
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func deleteIdentityUserGroupMembership(d *schema.ResourceData, m interface{}) error {
@@ -81,7 +81,7 @@ func deleteIdentityUserGroupMembership(d *schema.ResourceData, m interface{}) er
 	sync.Client = m.(*OracleClients).identityClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type IdentityUserGroupMembershipResourceCrud struct {
@@ -119,7 +119,7 @@ func (s *IdentityUserGroupMembershipResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentityUserGroupMembershipResourceCrud) Create() error {
+func (s *IdentityUserGroupMembershipResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.AddUserToGroupRequest{}
 
 	if groupId, ok := s.D.GetOkExists("group_id"); ok {
@@ -134,7 +134,7 @@ func (s *IdentityUserGroupMembershipResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.AddUserToGroup(context.Background(), request)
+	response, err := s.Client.AddUserToGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -143,7 +143,7 @@ func (s *IdentityUserGroupMembershipResourceCrud) Create() error {
 	return nil
 }
 
-func (s *IdentityUserGroupMembershipResourceCrud) Get() error {
+func (s *IdentityUserGroupMembershipResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetUserGroupMembershipRequest{}
 
 	tmp := s.D.Id()
@@ -151,7 +151,7 @@ func (s *IdentityUserGroupMembershipResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetUserGroupMembership(context.Background(), request)
+	response, err := s.Client.GetUserGroupMembership(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -160,7 +160,7 @@ func (s *IdentityUserGroupMembershipResourceCrud) Get() error {
 	return nil
 }
 
-func (s *IdentityUserGroupMembershipResourceCrud) Delete() error {
+func (s *IdentityUserGroupMembershipResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.RemoveUserFromGroupRequest{}
 
 	tmp := s.D.Id()
@@ -168,7 +168,7 @@ func (s *IdentityUserGroupMembershipResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.RemoveUserFromGroup(context.Background(), request)
+	_, err := s.Client.RemoveUserFromGroup(ctx, request)
 	return err
 }
 
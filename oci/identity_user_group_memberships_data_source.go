@@ -14,7 +14,8 @@ func IdentityUserGroupMembershipsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readIdentityUserGroupMemberships,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -41,7 +42,7 @@ func readIdentityUserGroupMemberships(d *schema.ResourceData, m interface{}) err
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type IdentityUserGroupMembershipsDataSourceCrud struct {
@@ -54,7 +55,7 @@ func (s *IdentityUserGroupMembershipsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityUserGroupMembershipsDataSourceCrud) Get() error {
+func (s *IdentityUserGroupMembershipsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListUserGroupMembershipsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -73,8 +74,9 @@ func (s *IdentityUserGroupMembershipsDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListUserGroupMemberships(context.Background(), request)
+	response, err := s.Client.ListUserGroupMemberships(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -82,8 +84,8 @@ func (s *IdentityUserGroupMembershipsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListUserGroupMemberships(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListUserGroupMemberships(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -137,6 +139,8 @@ func (s *IdentityUserGroupMembershipsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, IdentityUserGroupMembershipsDataSource().Schema["memberships"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("memberships", resources); err != nil {
 		return err
 	}
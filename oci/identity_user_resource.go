@@ -136,7 +136,7 @@ func createIdentityUser(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).identityClient
 	sync.Configuration = m.(*OracleClients).configuration
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readIdentityUser(d *schema.ResourceData, m interface{}) error {
@@ -144,7 +144,7 @@ func readIdentityUser(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateIdentityUser(d *schema.ResourceData, m interface{}) error {
@@ -152,7 +152,7 @@ func updateIdentityUser(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteIdentityUser(d *schema.ResourceData, m interface{}) error {
@@ -161,7 +161,7 @@ func deleteIdentityUser(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).identityClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type IdentityUserResourceCrud struct {
@@ -200,7 +200,7 @@ func (s *IdentityUserResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentityUserResourceCrud) Create() error {
+func (s *IdentityUserResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateUserRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -252,7 +252,7 @@ func (s *IdentityUserResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateUser(context.Background(), request)
+	response, err := s.Client.CreateUser(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -261,7 +261,7 @@ func (s *IdentityUserResourceCrud) Create() error {
 	return nil
 }
 
-func (s *IdentityUserResourceCrud) Get() error {
+func (s *IdentityUserResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetUserRequest{}
 
 	tmp := s.D.Id()
@@ -269,7 +269,7 @@ func (s *IdentityUserResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetUser(context.Background(), request)
+	response, err := s.Client.GetUser(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -278,7 +278,7 @@ func (s *IdentityUserResourceCrud) Get() error {
 	return nil
 }
 
-func (s *IdentityUserResourceCrud) Update() error {
+func (s *IdentityUserResourceCrud) Update(ctx context.Context) error {
 	request := oci_identity.UpdateUserRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -308,7 +308,7 @@ func (s *IdentityUserResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateUser(context.Background(), request)
+	response, err := s.Client.UpdateUser(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -317,7 +317,7 @@ func (s *IdentityUserResourceCrud) Update() error {
 	return nil
 }
 
-func (s *IdentityUserResourceCrud) Delete() error {
+func (s *IdentityUserResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeleteUserRequest{}
 
 	tmp := s.D.Id()
@@ -325,7 +325,7 @@ func (s *IdentityUserResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteUser(context.Background(), request)
+	_, err := s.Client.DeleteUser(ctx, request)
 	return err
 }
 
@@ -14,7 +14,8 @@ func IdentityUsersDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readIdentityUsers,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -41,7 +42,7 @@ func readIdentityUsers(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).identityClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type IdentityUsersDataSourceCrud struct {
@@ -54,7 +55,7 @@ func (s *IdentityUsersDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityUsersDataSourceCrud) Get() error {
+func (s *IdentityUsersDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListUsersRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -73,8 +74,9 @@ func (s *IdentityUsersDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListUsers(context.Background(), request)
+	response, err := s.Client.ListUsers(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -82,8 +84,8 @@ func (s *IdentityUsersDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListUsers(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListUsers(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -161,6 +163,8 @@ func (s *IdentityUsersDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, IdentityUsersDataSource().Schema["users"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("users", resources); err != nil {
 		return err
 	}
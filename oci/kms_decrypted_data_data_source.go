@@ -58,7 +58,7 @@ func readSingularDecryptedData(d *schema.ResourceData, m interface{}) error {
 	}
 	sync.Client = client
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type DecryptedDataDataSourceCrud struct {
@@ -71,7 +71,7 @@ func (s *DecryptedDataDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DecryptedDataDataSourceCrud) Get() error {
+func (s *DecryptedDataDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_kms.DecryptRequest{}
 
 	if associatedData, ok := s.D.GetOkExists("associated_data"); ok {
@@ -90,7 +90,7 @@ func (s *DecryptedDataDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "kms")
 
-	response, err := s.Client.Decrypt(context.Background(), request)
+	response, err := s.Client.Decrypt(ctx, request)
 	if err != nil {
 		return err
 	}
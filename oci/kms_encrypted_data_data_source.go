@@ -54,7 +54,7 @@ func readSingularEncryptedData(d *schema.ResourceData, m interface{}) error {
 	}
 	sync.Client = client
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type EncryptedDataDataSourceCrud struct {
@@ -67,7 +67,7 @@ func (s *EncryptedDataDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *EncryptedDataDataSourceCrud) Get() error {
+func (s *EncryptedDataDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_kms.EncryptRequest{}
 
 	if associatedData, ok := s.D.GetOkExists("associated_data"); ok {
@@ -86,7 +86,7 @@ func (s *EncryptedDataDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "kms")
 
-	response, err := s.Client.Encrypt(context.Background(), request)
+	response, err := s.Client.Encrypt(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -15,6 +15,9 @@ import (
 
 func KmsEncryptedDataResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importKmsEncryptedData,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createKmsEncryptedData,
 		Read:     readKmsEncryptedData,
@@ -67,7 +70,7 @@ func createKmsEncryptedData(d *schema.ResourceData, m interface{}) error {
 	}
 	sync.Client = client
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readKmsEncryptedData(d *schema.ResourceData, m interface{}) error {
@@ -83,13 +86,58 @@ func readKmsEncryptedData(d *schema.ResourceData, m interface{}) error {
 	}
 	sync.Client = client
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func deleteKmsEncryptedData(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+// importKmsEncryptedData imports data encrypted with a key, with an ID of the form
+// cryptoEndpoints/{cryptoEndpoint}/keys/{keyId}/ciphertexts/{ciphertext}, where the endpoint and the ciphertext are URL
+// encoded. The ciphertext is decrypted to read back the plaintext. Data encrypted with associated data can not be imported.
+func importKmsEncryptedData(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	ciphertext, decryptedData, err := decryptImportedKmsCiphertext(d, m)
+	if err != nil {
+		return nil, err
+	}
+	d.Set("plaintext", *decryptedData.Plaintext)
+
+	sync := &KmsEncryptedDataResourceCrud{}
+	sync.D = d
+	sync.Res = &oci_kms.EncryptedData{Ciphertext: &ciphertext}
+	d.SetId(sync.ID())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// decryptImportedKmsCiphertext parses the composite ID of an imported KMS resource, sets its crypto_endpoint, key_id and
+// ciphertext, and decrypts the ciphertext
+func decryptImportedKmsCiphertext(d *schema.ResourceData, m interface{}) (string, *oci_kms.DecryptedData, error) {
+	values, err := parseCompositeId(d.Id(), "cryptoEndpoints", "keys", "ciphertexts")
+	if err != nil {
+		return "", nil, err
+	}
+	cryptoEndpoint, keyId, ciphertext := values[0], values[1], values[2]
+
+	client, err := m.(*OracleClients).KmsCryptoClient(cryptoEndpoint)
+	if err != nil {
+		return "", nil, err
+	}
+	request := oci_kms.DecryptRequest{DecryptDataDetails: oci_kms.DecryptDataDetails{Ciphertext: &ciphertext, KeyId: &keyId}}
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "kms")
+	response, err := client.Decrypt(m.(*OracleClients).StopContext(), request)
+	if err != nil {
+		return "", nil, fmt.Errorf("could not decrypt the ciphertext with key %s: %v", keyId, err)
+	}
+
+	d.Set("crypto_endpoint", cryptoEndpoint)
+	d.Set("key_id", keyId)
+	d.Set("ciphertext", ciphertext)
+
+	return ciphertext, &response.DecryptedData, nil
+}
+
 type KmsEncryptedDataResourceCrud struct {
 	BaseCrud
 	Client                 *oci_kms.KmsCryptoClient
@@ -101,7 +149,7 @@ func (s *KmsEncryptedDataResourceCrud) ID() string {
 	return string(hashcode.String(*s.Res.Ciphertext))
 }
 
-func (s *KmsEncryptedDataResourceCrud) Create() error {
+func (s *KmsEncryptedDataResourceCrud) Create(ctx context.Context) error {
 	request := oci_kms.EncryptRequest{}
 
 	if associatedData, ok := s.D.GetOkExists("associated_data"); ok {
@@ -120,7 +168,7 @@ func (s *KmsEncryptedDataResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.Encrypt(context.Background(), request)
+	response, err := s.Client.Encrypt(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -129,14 +177,14 @@ func (s *KmsEncryptedDataResourceCrud) Create() error {
 	return nil
 }
 
-func (s *KmsEncryptedDataResourceCrud) Get() error {
+func (s *KmsEncryptedDataResourceCrud) Get(ctx context.Context) error {
 
 	if cipherText, ok := s.D.GetOkExists("ciphertext"); ok {
 		tmp := cipherText.(string)
 		encryptedData := oci_kms.EncryptedData{Ciphertext: &tmp}
 		s.Res = &encryptedData
 	} else {
-		return s.Create()
+		return s.Create(ctx)
 	}
 
 	return nil
@@ -62,6 +62,16 @@ func TestKmsEncryptedDataResource_basic(t *testing.T) {
 				),
 			},
 
+			// verify resource import
+			{
+				Config:                  config,
+				ImportState:             true,
+				ImportStateIdFunc:       getImportCompositeIdFunc(resourceName, "cryptoEndpoints", "crypto_endpoint", "keys", "key_id", "ciphertexts", "ciphertext"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+				ResourceName:            resourceName,
+			},
+
 			// delete before next create
 			{
 				Config: config + compartmentIdVariableStr + EncryptedDataResourceDependencies,
@@ -4,6 +4,7 @@ package provider
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -14,6 +15,9 @@ import (
 
 func KmsGeneratedKeyResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importKmsGeneratedKey,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createKmsGeneratedKey,
 		Read:     readKmsGeneratedKey,
@@ -100,7 +104,7 @@ func createKmsGeneratedKey(d *schema.ResourceData, m interface{}) error {
 	}
 	sync.Client = client
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readKmsGeneratedKey(d *schema.ResourceData, m interface{}) error {
@@ -111,6 +115,36 @@ func deleteKmsGeneratedKey(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+// importKmsGeneratedKey imports a data encryption key generated with a master key, with an ID of the form
+// cryptoEndpoints/{cryptoEndpoint}/keys/{keyId}/ciphertexts/{ciphertext}, where the endpoint and the ciphertext are URL
+// encoded. The ciphertext is decrypted to read back the plaintext key, so the imported keys have include_plaintext_key
+// set. Keys generated with associated data can not be imported.
+func importKmsGeneratedKey(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	ciphertext, decryptedData, err := decryptImportedKmsCiphertext(d, m)
+	if err != nil {
+		return nil, err
+	}
+	plaintextKey, err := base64.StdEncoding.DecodeString(*decryptedData.Plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode the plaintext key: %v", err)
+	}
+
+	d.Set("include_plaintext_key", true)
+	d.Set("key_shape", []interface{}{map[string]interface{}{
+		"algorithm": string(oci_kms.KeyShapeAlgorithmAes),
+		"length":    len(plaintextKey),
+	}})
+	d.Set("plaintext", *decryptedData.Plaintext)
+	d.Set("plaintext_checksum", *decryptedData.PlaintextChecksum)
+
+	sync := &KmsGeneratedKeyResourceCrud{}
+	sync.D = d
+	sync.Res = &oci_kms.GeneratedKey{Ciphertext: &ciphertext}
+	d.SetId(sync.ID())
+
+	return []*schema.ResourceData{d}, nil
+}
+
 type KmsGeneratedKeyResourceCrud struct {
 	BaseCrud
 	Client                 *oci_kms.KmsCryptoClient
@@ -122,7 +156,7 @@ func (s *KmsGeneratedKeyResourceCrud) ID() string {
 	return string(hashcode.String(*s.Res.Ciphertext))
 }
 
-func (s *KmsGeneratedKeyResourceCrud) Create() error {
+func (s *KmsGeneratedKeyResourceCrud) Create(ctx context.Context) error {
 	request := oci_kms.GenerateDataEncryptionKeyRequest{}
 
 	if associatedData, ok := s.D.GetOkExists("associated_data"); ok {
@@ -152,7 +186,7 @@ func (s *KmsGeneratedKeyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.GenerateDataEncryptionKey(context.Background(), request)
+	response, err := s.Client.GenerateDataEncryptionKey(ctx, request)
 	if err != nil {
 		return err
 	}
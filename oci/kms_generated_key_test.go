@@ -58,6 +58,20 @@ func TestKmsGeneratedKeyResource_basic(t *testing.T) {
 				),
 			},
 
+			// verify resource import
+			{
+				Config:            config,
+				ImportState:       true,
+				ImportStateIdFunc: getImportCompositeIdFunc(resourceName, "cryptoEndpoints", "crypto_endpoint", "keys", "key_id", "ciphertexts", "ciphertext"),
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"include_plaintext_key",
+					"plaintext",
+					"plaintext_checksum",
+				},
+				ResourceName: resourceName,
+			},
+
 			// delete before next create
 			{
 				Config: config + compartmentIdVariableStr + GeneratedKeyResourceDependencies,
@@ -99,7 +99,7 @@ func readSingularKmsKey(d *schema.ResourceData, m interface{}) error {
 	}
 	sync.Client = client
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type KmsKeyDataSourceCrud struct {
@@ -112,7 +112,7 @@ func (s *KmsKeyDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *KmsKeyDataSourceCrud) Get() error {
+func (s *KmsKeyDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_kms.GetKeyRequest{}
 
 	if keyId, ok := s.D.GetOkExists("key_id"); ok {
@@ -122,7 +122,7 @@ func (s *KmsKeyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "kms")
 
-	response, err := s.Client.GetKey(context.Background(), request)
+	response, err := s.Client.GetKey(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -127,7 +127,7 @@ func createKmsKey(d *schema.ResourceData, m interface{}) error {
 	}
 	sync.Client = client
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readKmsKey(d *schema.ResourceData, m interface{}) error {
@@ -154,7 +154,7 @@ func readKmsKey(d *schema.ResourceData, m interface{}) error {
 	}
 	sync.Client = client
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateKmsKey(d *schema.ResourceData, m interface{}) error {
@@ -170,7 +170,7 @@ func updateKmsKey(d *schema.ResourceData, m interface{}) error {
 	}
 	sync.Client = client
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteKmsKey(d *schema.ResourceData, m interface{}) error {
@@ -230,7 +230,7 @@ func (s *KmsKeyResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *KmsKeyResourceCrud) Create() error {
+func (s *KmsKeyResourceCrud) Create(ctx context.Context) error {
 	if desiredState, ok := s.D.GetOkExists("desired_state"); ok && !strings.EqualFold(desiredState.(string), "ENABLED") {
 		return fmt.Errorf("oci_kms_keys can only be created in ENABLED state")
 	}
@@ -272,7 +272,7 @@ func (s *KmsKeyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.CreateKey(context.Background(), request)
+	response, err := s.Client.CreateKey(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -281,7 +281,7 @@ func (s *KmsKeyResourceCrud) Create() error {
 	return nil
 }
 
-func (s *KmsKeyResourceCrud) Get() error {
+func (s *KmsKeyResourceCrud) Get(ctx context.Context) error {
 	request := oci_kms.GetKeyRequest{}
 
 	tmp := s.D.Id()
@@ -289,7 +289,7 @@ func (s *KmsKeyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.GetKey(context.Background(), request)
+	response, err := s.Client.GetKey(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -298,7 +298,7 @@ func (s *KmsKeyResourceCrud) Get() error {
 	return nil
 }
 
-func (s *KmsKeyResourceCrud) Update() error {
+func (s *KmsKeyResourceCrud) Update(ctx context.Context) error {
 	request := oci_kms.UpdateKeyRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -323,7 +323,7 @@ func (s *KmsKeyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.UpdateKey(context.Background(), request)
+	response, err := s.Client.UpdateKey(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -340,7 +340,7 @@ func (s *KmsKeyResourceCrud) Update() error {
 			activationRequest.KeyId = &tmpId
 
 			activationRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
-			activationResponse, err := s.Client.EnableKey(context.Background(), activationRequest)
+			activationResponse, err := s.Client.EnableKey(ctx, activationRequest)
 			if err != nil {
 				return err
 			}
@@ -351,7 +351,7 @@ func (s *KmsKeyResourceCrud) Update() error {
 			deactivationRequest.KeyId = &tmpId
 
 			deactivationRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
-			deactivationResponse, err := s.Client.DisableKey(context.Background(), deactivationRequest)
+			deactivationResponse, err := s.Client.DisableKey(ctx, deactivationRequest)
 			if err != nil {
 				return err
 			}
@@ -57,7 +57,7 @@ func readSingularKmsKeyVersion(d *schema.ResourceData, m interface{}) error {
 	}
 	sync.Client = client
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type KmsKeyVersionDataSourceCrud struct {
@@ -70,7 +70,7 @@ func (s *KmsKeyVersionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *KmsKeyVersionDataSourceCrud) Get() error {
+func (s *KmsKeyVersionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_kms.GetKeyVersionRequest{}
 
 	if keyId, ok := s.D.GetOkExists("key_id"); ok {
@@ -85,7 +85,7 @@ func (s *KmsKeyVersionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "kms")
 
-	response, err := s.Client.GetKeyVersion(context.Background(), request)
+	response, err := s.Client.GetKeyVersion(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -5,12 +5,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
 	"log"
-	"net/url"
 	"regexp"
 
 	oci_kms "github.com/oracle/oci-go-sdk/keymanagement"
@@ -74,7 +72,7 @@ func createKmsKeyVersion(d *schema.ResourceData, m interface{}) error {
 	}
 	sync.Client = client
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readKmsKeyVersion(d *schema.ResourceData, m interface{}) error {
@@ -102,7 +100,7 @@ func readKmsKeyVersion(d *schema.ResourceData, m interface{}) error {
 	}
 	sync.Client = client
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func deleteKmsKeyVersion(d *schema.ResourceData, m interface{}) error {
@@ -120,7 +118,7 @@ func (s *KmsKeyVersionResourceCrud) ID() string {
 	return getKeyVersionCompositeId(*s.Res.KeyId, *s.Res.Id)
 }
 
-func (s *KmsKeyVersionResourceCrud) Create() error {
+func (s *KmsKeyVersionResourceCrud) Create(ctx context.Context) error {
 	request := oci_kms.CreateKeyVersionRequest{}
 
 	if keyId, ok := s.D.GetOkExists("key_id"); ok {
@@ -130,7 +128,7 @@ func (s *KmsKeyVersionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.CreateKeyVersion(context.Background(), request)
+	response, err := s.Client.CreateKeyVersion(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -139,7 +137,7 @@ func (s *KmsKeyVersionResourceCrud) Create() error {
 	return nil
 }
 
-func (s *KmsKeyVersionResourceCrud) Get() error {
+func (s *KmsKeyVersionResourceCrud) Get(ctx context.Context) error {
 	request := oci_kms.GetKeyVersionRequest{}
 
 	keyId, keyVersionId, err := parseKeyVersionCompositeId(s.D.Id())
@@ -153,7 +151,7 @@ func (s *KmsKeyVersionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.GetKeyVersion(context.Background(), request)
+	response, err := s.Client.GetKeyVersion(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -192,21 +190,15 @@ func (s *KmsKeyVersionResourceCrud) SetData() error {
 }
 
 func getKeyVersionCompositeId(keyId string, keyVersionId string) string {
-	keyId = url.PathEscape(keyId)
-	keyVersionId = url.PathEscape(keyVersionId)
-	compositeId := "keys/" + keyId + "/keyVersions/" + keyVersionId
-	return compositeId
+	return getCompositeId("keys", keyId, "keyVersions", keyVersionId)
 }
 
 func parseKeyVersionCompositeId(compositeId string) (keyId string, keyVersionId string, err error) {
-	parts := strings.Split(compositeId, "/")
-	match, _ := regexp.MatchString("keys/.*/keyVersions/.*", compositeId)
-	if !match || len(parts) != 4 {
-		err = fmt.Errorf("illegal compositeId %s encountered", compositeId)
+	values, err := parseCompositeId(compositeId, "keys", "keyVersions")
+	if err != nil {
 		return
 	}
-	keyId, _ = url.PathUnescape(parts[1])
-	keyVersionId, _ = url.PathUnescape(parts[3])
+	keyId, keyVersionId = values[0], values[1]
 
 	return
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_kms "github.com/oracle/oci-go-sdk/keymanagement"
 )
 
@@ -15,7 +16,8 @@ func KmsKeyVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readKmsKeyVersions,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"key_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -29,6 +31,22 @@ func KmsKeyVersionsDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(KmsKeyVersionResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_kms.ListKeyVersionsSortByTimecreated),
+					string(oci_kms.ListKeyVersionsSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_kms.ListKeyVersionsSortOrderAsc),
+					string(oci_kms.ListKeyVersionsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -46,7 +64,7 @@ func readKmsKeyVersions(d *schema.ResourceData, m interface{}) error {
 	}
 	sync.Client = client
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type KmsKeyVersionsDataSourceCrud struct {
@@ -59,7 +77,7 @@ func (s *KmsKeyVersionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *KmsKeyVersionsDataSourceCrud) Get() error {
+func (s *KmsKeyVersionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_kms.ListKeyVersionsRequest{}
 
 	if keyId, ok := s.D.GetOkExists("key_id"); ok {
@@ -67,9 +85,18 @@ func (s *KmsKeyVersionsDataSourceCrud) Get() error {
 		request.KeyId = &tmp
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_kms.ListKeyVersionsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_kms.ListKeyVersionsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "kms")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListKeyVersions(context.Background(), request)
+	response, err := s.Client.ListKeyVersions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -77,8 +104,8 @@ func (s *KmsKeyVersionsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListKeyVersions(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListKeyVersions(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -126,6 +153,8 @@ func (s *KmsKeyVersionsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, KmsKeyVersionsDataSource().Schema["key_versions"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("key_versions", resources); err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_kms "github.com/oracle/oci-go-sdk/keymanagement"
 )
 
@@ -15,7 +16,8 @@ func KmsKeysDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readKmsKeys,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -29,6 +31,22 @@ func KmsKeysDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(KmsKeyResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_kms.ListKeysSortByTimecreated),
+					string(oci_kms.ListKeysSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_kms.ListKeysSortOrderAsc),
+					string(oci_kms.ListKeysSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -46,7 +64,7 @@ func readKmsKeys(d *schema.ResourceData, m interface{}) error {
 	}
 	sync.Client = client
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type KmsKeysDataSourceCrud struct {
@@ -59,7 +77,7 @@ func (s *KmsKeysDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *KmsKeysDataSourceCrud) Get() error {
+func (s *KmsKeysDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_kms.ListKeysRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -67,9 +85,18 @@ func (s *KmsKeysDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_kms.ListKeysSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_kms.ListKeysSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "kms")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListKeys(context.Background(), request)
+	response, err := s.Client.ListKeys(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -77,8 +104,8 @@ func (s *KmsKeysDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListKeys(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListKeys(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -134,6 +161,8 @@ func (s *KmsKeysDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, KmsKeysDataSource().Schema["keys"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("keys", resources); err != nil {
 		return err
 	}
@@ -69,7 +69,7 @@ func readSingularKmsVault(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).kmsVaultClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type KmsVaultDataSourceCrud struct {
@@ -82,7 +82,7 @@ func (s *KmsVaultDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *KmsVaultDataSourceCrud) Get() error {
+func (s *KmsVaultDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_kms.GetVaultRequest{}
 
 	if vaultId, ok := s.D.GetOkExists("vault_id"); ok {
@@ -92,7 +92,7 @@ func (s *KmsVaultDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "kms")
 
-	response, err := s.Client.GetVault(context.Background(), request)
+	response, err := s.Client.GetVault(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -82,7 +82,7 @@ func createKmsVault(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).kmsVaultClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readKmsVault(d *schema.ResourceData, m interface{}) error {
@@ -90,7 +90,7 @@ func readKmsVault(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).kmsVaultClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateKmsVault(d *schema.ResourceData, m interface{}) error {
@@ -98,7 +98,7 @@ func updateKmsVault(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).kmsVaultClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteKmsVault(d *schema.ResourceData, m interface{}) error {
@@ -106,7 +106,7 @@ func deleteKmsVault(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).kmsVaultClient
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type KmsVaultResourceCrud struct {
@@ -146,7 +146,7 @@ func (s *KmsVaultResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *KmsVaultResourceCrud) Create() error {
+func (s *KmsVaultResourceCrud) Create(ctx context.Context) error {
 	request := oci_kms.CreateVaultRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -177,7 +177,7 @@ func (s *KmsVaultResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.CreateVault(context.Background(), request)
+	response, err := s.Client.CreateVault(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -186,7 +186,7 @@ func (s *KmsVaultResourceCrud) Create() error {
 	return nil
 }
 
-func (s *KmsVaultResourceCrud) Get() error {
+func (s *KmsVaultResourceCrud) Get(ctx context.Context) error {
 	request := oci_kms.GetVaultRequest{}
 
 	tmp := s.D.Id()
@@ -194,7 +194,7 @@ func (s *KmsVaultResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.GetVault(context.Background(), request)
+	response, err := s.Client.GetVault(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -203,7 +203,7 @@ func (s *KmsVaultResourceCrud) Get() error {
 	return nil
 }
 
-func (s *KmsVaultResourceCrud) Update() error {
+func (s *KmsVaultResourceCrud) Update(ctx context.Context) error {
 	request := oci_kms.UpdateVaultRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -228,7 +228,7 @@ func (s *KmsVaultResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.UpdateVault(context.Background(), request)
+	response, err := s.Client.UpdateVault(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -237,7 +237,7 @@ func (s *KmsVaultResourceCrud) Update() error {
 	return nil
 }
 
-func (s *KmsVaultResourceCrud) Delete() error {
+func (s *KmsVaultResourceCrud) Delete(ctx context.Context) error {
 	request := oci_kms.ScheduleVaultDeletionRequest{}
 
 	tmp := s.D.Id()
@@ -245,7 +245,7 @@ func (s *KmsVaultResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	_, err := s.Client.ScheduleVaultDeletion(context.Background(), request)
+	_, err := s.Client.ScheduleVaultDeletion(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_kms "github.com/oracle/oci-go-sdk/keymanagement"
 )
 
@@ -13,7 +14,8 @@ func KmsVaultsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readKmsVaults,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -23,6 +25,22 @@ func KmsVaultsDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(KmsVaultResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_kms.ListVaultsSortByTimecreated),
+					string(oci_kms.ListVaultsSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_kms.ListVaultsSortOrderAsc),
+					string(oci_kms.ListVaultsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -32,7 +50,7 @@ func readKmsVaults(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).kmsVaultClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type KmsVaultsDataSourceCrud struct {
@@ -45,7 +63,7 @@ func (s *KmsVaultsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *KmsVaultsDataSourceCrud) Get() error {
+func (s *KmsVaultsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_kms.ListVaultsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -53,9 +71,18 @@ func (s *KmsVaultsDataSourceCrud) Get() error {
 		request.CompartmentId = &tmp
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_kms.ListVaultsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_kms.ListVaultsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "kms")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListVaults(context.Background(), request)
+	response, err := s.Client.ListVaults(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -63,8 +90,8 @@ func (s *KmsVaultsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListVaults(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListVaults(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -126,6 +153,8 @@ func (s *KmsVaultsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, KmsVaultsDataSource().Schema["vaults"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("vaults", resources); err != nil {
 		return err
 	}
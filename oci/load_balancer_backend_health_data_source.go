@@ -68,7 +68,7 @@ func readSingularLoadBalancerBackendHealth(d *schema.ResourceData, m interface{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type LoadBalancerBackendHealthDataSourceCrud struct {
@@ -81,7 +81,7 @@ func (s *LoadBalancerBackendHealthDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LoadBalancerBackendHealthDataSourceCrud) Get() error {
+func (s *LoadBalancerBackendHealthDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_load_balancer.GetBackendHealthRequest{}
 
 	if backendName, ok := s.D.GetOkExists("backend_name"); ok {
@@ -101,7 +101,7 @@ func (s *LoadBalancerBackendHealthDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "load_balancer")
 
-	response, err := s.Client.GetBackendHealth(context.Background(), request)
+	response, err := s.Client.GetBackendHealth(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -4,10 +4,7 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"net/url"
-	"regexp"
 	"strconv"
 	"strings"
 	"sync"
@@ -91,7 +88,7 @@ func createLoadBalancerBackend(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readLoadBalancerBackend(d *schema.ResourceData, m interface{}) error {
@@ -99,7 +96,7 @@ func readLoadBalancerBackend(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateLoadBalancerBackend(d *schema.ResourceData, m interface{}) error {
@@ -107,7 +104,7 @@ func updateLoadBalancerBackend(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteLoadBalancerBackend(d *schema.ResourceData, m interface{}) error {
@@ -116,7 +113,7 @@ func deleteLoadBalancerBackend(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).loadBalancerClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type LoadBalancerBackendResourceCrud struct {
@@ -178,7 +175,26 @@ func (s *LoadBalancerBackendResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *LoadBalancerBackendResourceCrud) Create() error {
+func (s *LoadBalancerBackendResourceCrud) WorkRequestTracker() WorkRequestTracker {
+	return newLoadBalancerWorkRequestTracker(s.Client, s.DisableNotFoundRetries)
+}
+
+func (s *LoadBalancerBackendResourceCrud) WorkRequestId() *string {
+	if s.WorkRequest == nil {
+		return nil
+	}
+	return s.WorkRequest.Id
+}
+
+func (s *LoadBalancerBackendResourceCrud) WorkRequestSucceeded(ctx context.Context, operation string, workRequest *WorkRequestStatus) error {
+	s.WorkRequest = workRequest.WorkRequest.(*oci_load_balancer.WorkRequest)
+	if operation == updateOperation {
+		return s.Get(ctx)
+	}
+	return nil
+}
+
+func (s *LoadBalancerBackendResourceCrud) Create(ctx context.Context) error {
 	request := oci_load_balancer.CreateBackendRequest{}
 
 	if backendsetName, ok := s.D.GetOkExists("backendset_name"); ok {
@@ -223,7 +239,7 @@ func (s *LoadBalancerBackendResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.CreateBackend(context.Background(), request)
+	response, err := s.Client.CreateBackend(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -232,20 +248,16 @@ func (s *LoadBalancerBackendResourceCrud) Create() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
-	if err != nil {
-		return err
-	}
 	return nil
 }
 
-func (s *LoadBalancerBackendResourceCrud) Get() error {
-	_, stillWorking, err := LoadBalancerResourceGet(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+func (s *LoadBalancerBackendResourceCrud) Get(ctx context.Context) error {
+	_, stillWorking, err := LoadBalancerResourceGet(ctx, s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -280,7 +292,7 @@ func (s *LoadBalancerBackendResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.GetBackend(context.Background(), request)
+	response, err := s.Client.GetBackend(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -289,7 +301,7 @@ func (s *LoadBalancerBackendResourceCrud) Get() error {
 	return nil
 }
 
-func (s *LoadBalancerBackendResourceCrud) Update() error {
+func (s *LoadBalancerBackendResourceCrud) Update(ctx context.Context) error {
 	request := oci_load_balancer.UpdateBackendRequest{}
 
 	if backendName, ok := s.D.GetOkExists("name"); ok {
@@ -329,7 +341,7 @@ func (s *LoadBalancerBackendResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.UpdateBackend(context.Background(), request)
+	response, err := s.Client.UpdateBackend(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -338,20 +350,15 @@ func (s *LoadBalancerBackendResourceCrud) Update() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
-	if err != nil {
-		return err
-	}
-
-	return s.Get()
+	return nil
 }
 
-func (s *LoadBalancerBackendResourceCrud) Delete() error {
+func (s *LoadBalancerBackendResourceCrud) Delete(ctx context.Context) error {
 	request := oci_load_balancer.DeleteBackendRequest{}
 
 	if backendName, ok := s.D.GetOkExists("name"); ok {
@@ -371,7 +378,7 @@ func (s *LoadBalancerBackendResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.DeleteBackend(context.Background(), request)
+	response, err := s.Client.DeleteBackend(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -380,15 +387,11 @@ func (s *LoadBalancerBackendResourceCrud) Delete() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
-	if err != nil {
-		return err
-	}
 	return nil
 }
 
@@ -438,23 +441,15 @@ func (s *LoadBalancerBackendResourceCrud) SetData() error {
 }
 
 func getBackendCompositeId(backendName string, backendsetName string, loadBalancerId string) string {
-	backendName = url.PathEscape(backendName)
-	backendsetName = url.PathEscape(backendsetName)
-	loadBalancerId = url.PathEscape(loadBalancerId)
-	compositeId := "loadBalancers/" + loadBalancerId + "/backendSets/" + backendsetName + "/backends/" + backendName
-	return compositeId
+	return getCompositeId("loadBalancers", loadBalancerId, "backendSets", backendsetName, "backends", backendName)
 }
 
 func parseBackendCompositeId(compositeId string) (backendName string, backendsetName string, loadBalancerId string, err error) {
-	parts := strings.Split(compositeId, "/")
-	match, _ := regexp.MatchString("loadBalancers/.*/backendSets/.*/backends/.*", compositeId)
-	if !match || len(parts) != 6 {
-		err = fmt.Errorf("illegal compositeId %s encountered", compositeId)
+	values, err := parseCompositeId(compositeId, "loadBalancers", "backendSets", "backends")
+	if err != nil {
 		return
 	}
-	loadBalancerId, _ = url.PathUnescape(parts[1])
-	backendsetName, _ = url.PathUnescape(parts[3])
-	backendName, _ = url.PathUnescape(parts[5])
+	loadBalancerId, backendsetName, backendName = values[0], values[1], values[2]
 
 	return
 }
@@ -60,7 +60,7 @@ func readSingularLoadBalancerBackendSetHealth(d *schema.ResourceData, m interfac
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type LoadBalancerBackendSetHealthDataSourceCrud struct {
@@ -73,7 +73,7 @@ func (s *LoadBalancerBackendSetHealthDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LoadBalancerBackendSetHealthDataSourceCrud) Get() error {
+func (s *LoadBalancerBackendSetHealthDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_load_balancer.GetBackendSetHealthRequest{}
 
 	if backendSetName, ok := s.D.GetOkExists("backend_set_name"); ok {
@@ -88,7 +88,7 @@ func (s *LoadBalancerBackendSetHealthDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "load_balancer")
 
-	response, err := s.Client.GetBackendSetHealth(context.Background(), request)
+	response, err := s.Client.GetBackendSetHealth(ctx, request)
 	if err != nil {
 		return err
 	}
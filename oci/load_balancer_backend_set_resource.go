@@ -7,8 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/url"
-	"regexp"
 	"strings"
 	"sync"
 
@@ -218,7 +216,7 @@ func createLoadBalancerBackendSet(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readLoadBalancerBackendSet(d *schema.ResourceData, m interface{}) error {
@@ -226,7 +224,7 @@ func readLoadBalancerBackendSet(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateLoadBalancerBackendSet(d *schema.ResourceData, m interface{}) error {
@@ -234,7 +232,7 @@ func updateLoadBalancerBackendSet(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteLoadBalancerBackendSet(d *schema.ResourceData, m interface{}) error {
@@ -243,7 +241,7 @@ func deleteLoadBalancerBackendSet(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).loadBalancerClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type LoadBalancerBackendSetResourceCrud struct {
@@ -299,7 +297,26 @@ func (s *LoadBalancerBackendSetResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *LoadBalancerBackendSetResourceCrud) Create() error {
+func (s *LoadBalancerBackendSetResourceCrud) WorkRequestTracker() WorkRequestTracker {
+	return newLoadBalancerWorkRequestTracker(s.Client, s.DisableNotFoundRetries)
+}
+
+func (s *LoadBalancerBackendSetResourceCrud) WorkRequestId() *string {
+	if s.WorkRequest == nil {
+		return nil
+	}
+	return s.WorkRequest.Id
+}
+
+func (s *LoadBalancerBackendSetResourceCrud) WorkRequestSucceeded(ctx context.Context, operation string, workRequest *WorkRequestStatus) error {
+	s.WorkRequest = workRequest.WorkRequest.(*oci_load_balancer.WorkRequest)
+	if operation == updateOperation {
+		return s.Get(ctx)
+	}
+	return nil
+}
+
+func (s *LoadBalancerBackendSetResourceCrud) Create(ctx context.Context) error {
 	request := oci_load_balancer.CreateBackendSetRequest{}
 
 	if healthChecker, ok := s.D.GetOkExists("health_checker"); ok {
@@ -352,7 +369,7 @@ func (s *LoadBalancerBackendSetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.CreateBackendSet(context.Background(), request)
+	response, err := s.Client.CreateBackendSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -361,20 +378,16 @@ func (s *LoadBalancerBackendSetResourceCrud) Create() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
-	if err != nil {
-		return err
-	}
 	return nil
 }
 
-func (s *LoadBalancerBackendSetResourceCrud) Get() error {
-	_, stillWorking, err := LoadBalancerResourceGet(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+func (s *LoadBalancerBackendSetResourceCrud) Get(ctx context.Context) error {
+	_, stillWorking, err := LoadBalancerResourceGet(ctx, s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -405,7 +418,7 @@ func (s *LoadBalancerBackendSetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.GetBackendSet(context.Background(), request)
+	response, err := s.Client.GetBackendSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -414,12 +427,12 @@ func (s *LoadBalancerBackendSetResourceCrud) Get() error {
 	return nil
 }
 
-func (s *LoadBalancerBackendSetResourceCrud) Update() error {
+func (s *LoadBalancerBackendSetResourceCrud) Update(ctx context.Context) error {
 	request := oci_load_balancer.UpdateBackendSetRequest{}
 
 	// @CODEGEN: Backends are marked computed in this resource, so will do a GET and include the results in the UPDATE, although they are not a required parameter
 	// Side-note: There is a potential for a race condition if the backend are added at the same time outside Terraform
-	err := s.Get()
+	err := s.Get(ctx)
 	if err != nil {
 		return err
 	}
@@ -493,7 +506,7 @@ func (s *LoadBalancerBackendSetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.UpdateBackendSet(context.Background(), request)
+	response, err := s.Client.UpdateBackendSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -502,20 +515,15 @@ func (s *LoadBalancerBackendSetResourceCrud) Update() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
-	if err != nil {
-		return err
-	}
-
-	return s.Get()
+	return nil
 }
 
-func (s *LoadBalancerBackendSetResourceCrud) Delete() error {
+func (s *LoadBalancerBackendSetResourceCrud) Delete(ctx context.Context) error {
 	request := oci_load_balancer.DeleteBackendSetRequest{}
 
 	if backendSetName, ok := s.D.GetOkExists("name"); ok {
@@ -530,7 +538,7 @@ func (s *LoadBalancerBackendSetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.DeleteBackendSet(context.Background(), request)
+	response, err := s.Client.DeleteBackendSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -539,15 +547,11 @@ func (s *LoadBalancerBackendSetResourceCrud) Delete() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
-	if err != nil {
-		return err
-	}
 	return nil
 }
 
@@ -600,21 +604,15 @@ func (s *LoadBalancerBackendSetResourceCrud) SetData() error {
 }
 
 func getBackendSetCompositeId(backendSetName string, loadBalancerId string) string {
-	backendSetName = url.PathEscape(backendSetName)
-	loadBalancerId = url.PathEscape(loadBalancerId)
-	compositeId := "loadBalancers/" + loadBalancerId + "/backendSets/" + backendSetName
-	return compositeId
+	return getCompositeId("loadBalancers", loadBalancerId, "backendSets", backendSetName)
 }
 
 func parseBackendSetCompositeId(compositeId string) (backendSetName string, loadBalancerId string, err error) {
-	parts := strings.Split(compositeId, "/")
-	match, _ := regexp.MatchString("loadBalancers/.*/backendSets/.*", compositeId)
-	if !match || len(parts) != 4 {
-		err = fmt.Errorf("illegal compositeId %s encountered", compositeId)
+	values, err := parseCompositeId(compositeId, "loadBalancers", "backendSets")
+	if err != nil {
 		return
 	}
-	loadBalancerId, _ = url.PathUnescape(parts[1])
-	backendSetName, _ = url.PathUnescape(parts[3])
+	loadBalancerId, backendSetName = values[0], values[1]
 
 	return
 }
@@ -32,7 +32,7 @@ func readLoadBalancerBackendSets(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type LoadBalancerBackendSetsDataSourceCrud struct {
@@ -45,7 +45,7 @@ func (s *LoadBalancerBackendSetsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LoadBalancerBackendSetsDataSourceCrud) Get() error {
+func (s *LoadBalancerBackendSetsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_load_balancer.ListBackendSetsRequest{}
 
 	if loadBalancerId, ok := s.D.GetOkExists("load_balancer_id"); ok {
@@ -55,7 +55,7 @@ func (s *LoadBalancerBackendSetsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "load_balancer")
 
-	response, err := s.Client.ListBackendSets(context.Background(), request)
+	response, err := s.Client.ListBackendSets(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -36,7 +36,7 @@ func readLoadBalancerBackends(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type LoadBalancerBackendsDataSourceCrud struct {
@@ -49,7 +49,7 @@ func (s *LoadBalancerBackendsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LoadBalancerBackendsDataSourceCrud) Get() error {
+func (s *LoadBalancerBackendsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_load_balancer.ListBackendsRequest{}
 
 	if backendsetName, ok := s.D.GetOkExists("backendset_name"); ok {
@@ -64,7 +64,7 @@ func (s *LoadBalancerBackendsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "load_balancer")
 
-	response, err := s.Client.ListBackends(context.Background(), request)
+	response, err := s.Client.ListBackends(ctx, request)
 	if err != nil {
 		return err
 	}
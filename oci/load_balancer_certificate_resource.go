@@ -13,6 +13,9 @@ import (
 
 func LoadBalancerCertificateResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importLoadBalancerCertificate,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createLoadBalancerCertificate,
 		Read:     readLoadBalancerCertificate,
@@ -73,7 +76,7 @@ func createLoadBalancerCertificate(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readLoadBalancerCertificate(d *schema.ResourceData, m interface{}) error {
@@ -81,7 +84,7 @@ func readLoadBalancerCertificate(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func deleteLoadBalancerCertificate(d *schema.ResourceData, m interface{}) error {
@@ -90,7 +93,21 @@ func deleteLoadBalancerCertificate(d *schema.ResourceData, m interface{}) error
 	sync.Client = m.(*OracleClients).loadBalancerClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
+}
+
+// importLoadBalancerCertificate imports a certificate bundle of a load balancer, with an ID of the form
+// loadBalancers/{loadBalancerId}/certificates/{certificateName}. The private key can not be read back.
+func importLoadBalancerCertificate(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	values, err := parseCompositeId(d.Id(), "loadBalancers", "certificates")
+	if err != nil {
+		return nil, err
+	}
+	d.Set("load_balancer_id", values[0])
+	d.Set("certificate_name", values[1])
+	d.SetId(values[1])
+
+	return []*schema.ResourceData{d}, nil
 }
 
 type LoadBalancerCertificateResourceCrud struct {
@@ -140,7 +157,26 @@ func (s *LoadBalancerCertificateResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *LoadBalancerCertificateResourceCrud) Create() error {
+func (s *LoadBalancerCertificateResourceCrud) WorkRequestTracker() WorkRequestTracker {
+	return newLoadBalancerWorkRequestTracker(s.Client, s.DisableNotFoundRetries)
+}
+
+func (s *LoadBalancerCertificateResourceCrud) WorkRequestId() *string {
+	if s.WorkRequest == nil {
+		return nil
+	}
+	return s.WorkRequest.Id
+}
+
+func (s *LoadBalancerCertificateResourceCrud) WorkRequestSucceeded(ctx context.Context, operation string, workRequest *WorkRequestStatus) error {
+	s.WorkRequest = workRequest.WorkRequest.(*oci_load_balancer.WorkRequest)
+	if operation == updateOperation {
+		return s.Get(ctx)
+	}
+	return nil
+}
+
+func (s *LoadBalancerCertificateResourceCrud) Create(ctx context.Context) error {
 	request := oci_load_balancer.CreateCertificateRequest{}
 
 	if caCertificate, ok := s.D.GetOkExists("ca_certificate"); ok {
@@ -175,7 +211,7 @@ func (s *LoadBalancerCertificateResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.CreateCertificate(context.Background(), request)
+	response, err := s.Client.CreateCertificate(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -184,20 +220,16 @@ func (s *LoadBalancerCertificateResourceCrud) Create() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
-	if err != nil {
-		return err
-	}
 	return nil
 }
 
-func (s *LoadBalancerCertificateResourceCrud) Get() error {
-	_, stillWorking, err := LoadBalancerResourceGet(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+func (s *LoadBalancerCertificateResourceCrud) Get(ctx context.Context) error {
+	_, stillWorking, err := LoadBalancerResourceGet(ctx, s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -213,7 +245,7 @@ func (s *LoadBalancerCertificateResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.ListCertificates(context.Background(), request)
+	response, err := s.Client.ListCertificates(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -229,7 +261,7 @@ func (s *LoadBalancerCertificateResourceCrud) Get() error {
 
 }
 
-func (s *LoadBalancerCertificateResourceCrud) Delete() error {
+func (s *LoadBalancerCertificateResourceCrud) Delete(ctx context.Context) error {
 	request := oci_load_balancer.DeleteCertificateRequest{}
 
 	if certificateName, ok := s.D.GetOkExists("certificate_name"); ok {
@@ -244,7 +276,7 @@ func (s *LoadBalancerCertificateResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.DeleteCertificate(context.Background(), request)
+	response, err := s.Client.DeleteCertificate(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -253,15 +285,11 @@ func (s *LoadBalancerCertificateResourceCrud) Delete() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
-	if err != nil {
-		return err
-	}
 	return nil
 }
 
@@ -32,7 +32,7 @@ func readLoadBalancerCertificates(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type LoadBalancerCertificatesDataSourceCrud struct {
@@ -45,7 +45,7 @@ func (s *LoadBalancerCertificatesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LoadBalancerCertificatesDataSourceCrud) Get() error {
+func (s *LoadBalancerCertificatesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_load_balancer.ListCertificatesRequest{}
 
 	if loadBalancerId, ok := s.D.GetOkExists("load_balancer_id"); ok {
@@ -55,7 +55,7 @@ func (s *LoadBalancerCertificatesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "load_balancer")
 
-	response, err := s.Client.ListCertificates(context.Background(), request)
+	response, err := s.Client.ListCertificates(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -4,10 +4,7 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"net/url"
-	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -59,7 +56,7 @@ func createLoadBalancerHostname(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readLoadBalancerHostname(d *schema.ResourceData, m interface{}) error {
@@ -67,7 +64,7 @@ func readLoadBalancerHostname(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateLoadBalancerHostname(d *schema.ResourceData, m interface{}) error {
@@ -75,7 +72,7 @@ func updateLoadBalancerHostname(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteLoadBalancerHostname(d *schema.ResourceData, m interface{}) error {
@@ -84,7 +81,7 @@ func deleteLoadBalancerHostname(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).loadBalancerClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type LoadBalancerHostnameResourceCrud struct {
@@ -134,7 +131,26 @@ func (s *LoadBalancerHostnameResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *LoadBalancerHostnameResourceCrud) Create() error {
+func (s *LoadBalancerHostnameResourceCrud) WorkRequestTracker() WorkRequestTracker {
+	return newLoadBalancerWorkRequestTracker(s.Client, s.DisableNotFoundRetries)
+}
+
+func (s *LoadBalancerHostnameResourceCrud) WorkRequestId() *string {
+	if s.WorkRequest == nil {
+		return nil
+	}
+	return s.WorkRequest.Id
+}
+
+func (s *LoadBalancerHostnameResourceCrud) WorkRequestSucceeded(ctx context.Context, operation string, workRequest *WorkRequestStatus) error {
+	s.WorkRequest = workRequest.WorkRequest.(*oci_load_balancer.WorkRequest)
+	if operation == updateOperation {
+		return s.Get(ctx)
+	}
+	return nil
+}
+
+func (s *LoadBalancerHostnameResourceCrud) Create(ctx context.Context) error {
 	request := oci_load_balancer.CreateHostnameRequest{}
 
 	if hostname, ok := s.D.GetOkExists("hostname"); ok {
@@ -154,7 +170,7 @@ func (s *LoadBalancerHostnameResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.CreateHostname(context.Background(), request)
+	response, err := s.Client.CreateHostname(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -163,20 +179,16 @@ func (s *LoadBalancerHostnameResourceCrud) Create() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
-	if err != nil {
-		return err
-	}
 	return nil
 }
 
-func (s *LoadBalancerHostnameResourceCrud) Get() error {
-	_, stillWorking, err := LoadBalancerResourceGet(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+func (s *LoadBalancerHostnameResourceCrud) Get(ctx context.Context) error {
+	_, stillWorking, err := LoadBalancerResourceGet(ctx, s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -207,7 +219,7 @@ func (s *LoadBalancerHostnameResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.GetHostname(context.Background(), request)
+	response, err := s.Client.GetHostname(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -216,7 +228,7 @@ func (s *LoadBalancerHostnameResourceCrud) Get() error {
 	return nil
 }
 
-func (s *LoadBalancerHostnameResourceCrud) Update() error {
+func (s *LoadBalancerHostnameResourceCrud) Update(ctx context.Context) error {
 	request := oci_load_balancer.UpdateHostnameRequest{}
 
 	if hostname, ok := s.D.GetOkExists("hostname"); ok {
@@ -236,7 +248,7 @@ func (s *LoadBalancerHostnameResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.UpdateHostname(context.Background(), request)
+	response, err := s.Client.UpdateHostname(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -245,20 +257,15 @@ func (s *LoadBalancerHostnameResourceCrud) Update() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
-	if err != nil {
-		return err
-	}
-
-	return s.Get()
+	return nil
 }
 
-func (s *LoadBalancerHostnameResourceCrud) Delete() error {
+func (s *LoadBalancerHostnameResourceCrud) Delete(ctx context.Context) error {
 	request := oci_load_balancer.DeleteHostnameRequest{}
 
 	if loadBalancerId, ok := s.D.GetOkExists("load_balancer_id"); ok {
@@ -273,7 +280,7 @@ func (s *LoadBalancerHostnameResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.DeleteHostname(context.Background(), request)
+	response, err := s.Client.DeleteHostname(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -282,15 +289,11 @@ func (s *LoadBalancerHostnameResourceCrud) Delete() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
-	if err != nil {
-		return err
-	}
 	return nil
 }
 
@@ -319,21 +322,15 @@ func (s *LoadBalancerHostnameResourceCrud) SetData() error {
 }
 
 func getHostnameCompositeId(loadBalancerId string, name string) string {
-	loadBalancerId = url.PathEscape(loadBalancerId)
-	name = url.PathEscape(name)
-	compositeId := "loadBalancers/" + loadBalancerId + "/hostnames/" + name
-	return compositeId
+	return getCompositeId("loadBalancers", loadBalancerId, "hostnames", name)
 }
 
 func parseHostnameCompositeId(compositeId string) (loadBalancerId string, name string, err error) {
-	parts := strings.Split(compositeId, "/")
-	match, _ := regexp.MatchString("loadBalancers/.*/hostnames/.*", compositeId)
-	if !match || len(parts) != 4 {
-		err = fmt.Errorf("illegal compositeId %s encountered", compositeId)
+	values, err := parseCompositeId(compositeId, "loadBalancers", "hostnames")
+	if err != nil {
 		return
 	}
-	loadBalancerId, _ = url.PathUnescape(parts[1])
-	name, _ = url.PathUnescape(parts[3])
+	loadBalancerId, name = values[0], values[1]
 
 	return
 }
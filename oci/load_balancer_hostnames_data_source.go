@@ -32,7 +32,7 @@ func readLoadBalancerHostnames(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type LoadBalancerHostnamesDataSourceCrud struct {
@@ -45,7 +45,7 @@ func (s *LoadBalancerHostnamesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LoadBalancerHostnamesDataSourceCrud) Get() error {
+func (s *LoadBalancerHostnamesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_load_balancer.ListHostnamesRequest{}
 
 	if loadBalancerId, ok := s.D.GetOkExists("load_balancer_id"); ok {
@@ -55,7 +55,7 @@ func (s *LoadBalancerHostnamesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "load_balancer")
 
-	response, err := s.Client.ListHostnames(context.Background(), request)
+	response, err := s.Client.ListHostnames(ctx, request)
 	if err != nil {
 		return err
 	}
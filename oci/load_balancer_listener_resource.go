@@ -6,8 +6,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/url"
-	"regexp"
 	"strconv"
 	"strings"
 
@@ -140,14 +138,14 @@ func createLoadBalancerListener(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readLoadBalancerListener(d *schema.ResourceData, m interface{}) error {
 	sync := &LoadBalancerListenerResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateLoadBalancerListener(d *schema.ResourceData, m interface{}) error {
@@ -155,7 +153,7 @@ func updateLoadBalancerListener(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteLoadBalancerListener(d *schema.ResourceData, m interface{}) error {
@@ -164,7 +162,7 @@ func deleteLoadBalancerListener(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).loadBalancerClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type LoadBalancerListenerResourceCrud struct {
@@ -214,7 +212,26 @@ func (s *LoadBalancerListenerResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *LoadBalancerListenerResourceCrud) Create() error {
+func (s *LoadBalancerListenerResourceCrud) WorkRequestTracker() WorkRequestTracker {
+	return newLoadBalancerWorkRequestTracker(s.Client, s.DisableNotFoundRetries)
+}
+
+func (s *LoadBalancerListenerResourceCrud) WorkRequestId() *string {
+	if s.WorkRequest == nil {
+		return nil
+	}
+	return s.WorkRequest.Id
+}
+
+func (s *LoadBalancerListenerResourceCrud) WorkRequestSucceeded(ctx context.Context, operation string, workRequest *WorkRequestStatus) error {
+	s.WorkRequest = workRequest.WorkRequest.(*oci_load_balancer.WorkRequest)
+	if operation == updateOperation {
+		return s.Get(ctx)
+	}
+	return nil
+}
+
+func (s *LoadBalancerListenerResourceCrud) Create(ctx context.Context) error {
 	request := oci_load_balancer.CreateListenerRequest{}
 
 	if connectionConfiguration, ok := s.D.GetOkExists("connection_configuration"); ok {
@@ -295,7 +312,7 @@ func (s *LoadBalancerListenerResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.CreateListener(context.Background(), request)
+	response, err := s.Client.CreateListener(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -304,21 +321,17 @@ func (s *LoadBalancerListenerResourceCrud) Create() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
-	if err != nil {
-		return err
-	}
 	return nil
 }
 
-func (s *LoadBalancerListenerResourceCrud) Get() (e error) {
+func (s *LoadBalancerListenerResourceCrud) Get(ctx context.Context) (e error) {
 	// key: {workRequestID} || {loadBalancerID,name}
-	_, stillWorking, err := LoadBalancerResourceGet(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	_, stillWorking, err := LoadBalancerResourceGet(ctx, s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -336,19 +349,19 @@ func (s *LoadBalancerListenerResourceCrud) Get() (e error) {
 		}
 	}
 
-	res, e := s.GetListener(s.D.Get("load_balancer_id").(string), s.D.Get("name").(string))
+	res, e := s.GetListener(ctx, s.D.Get("load_balancer_id").(string), s.D.Get("name").(string))
 	if e == nil {
 		s.Res = res
 	}
 	return
 }
 
-func (s *LoadBalancerListenerResourceCrud) GetListener(loadBalancerID, name string) (*oci_load_balancer.Listener, error) {
+func (s *LoadBalancerListenerResourceCrud) GetListener(ctx context.Context, loadBalancerID, name string) (*oci_load_balancer.Listener, error) {
 	request := oci_load_balancer.GetLoadBalancerRequest{}
 	request.LoadBalancerId = &loadBalancerID
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.GetLoadBalancer(context.Background(), request)
+	response, err := s.Client.GetLoadBalancer(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -363,7 +376,7 @@ func (s *LoadBalancerListenerResourceCrud) GetListener(loadBalancerID, name stri
 	return nil, fmt.Errorf("Listener %s on load balancer %s does not exist", name, loadBalancerID)
 }
 
-func (s *LoadBalancerListenerResourceCrud) Update() error {
+func (s *LoadBalancerListenerResourceCrud) Update(ctx context.Context) error {
 	request := oci_load_balancer.UpdateListenerRequest{}
 
 	if connectionConfiguration, ok := s.D.GetOkExists("connection_configuration"); ok {
@@ -444,7 +457,7 @@ func (s *LoadBalancerListenerResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.UpdateListener(context.Background(), request)
+	response, err := s.Client.UpdateListener(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -453,20 +466,15 @@ func (s *LoadBalancerListenerResourceCrud) Update() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
-	if err != nil {
-		return err
-	}
-
-	return s.Get()
+	return nil
 }
 
-func (s *LoadBalancerListenerResourceCrud) Delete() error {
+func (s *LoadBalancerListenerResourceCrud) Delete(ctx context.Context) error {
 	request := oci_load_balancer.DeleteListenerRequest{}
 
 	if listenerName, ok := s.D.GetOkExists("name"); ok {
@@ -481,7 +489,7 @@ func (s *LoadBalancerListenerResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.DeleteListener(context.Background(), request)
+	response, err := s.Client.DeleteListener(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -490,15 +498,11 @@ func (s *LoadBalancerListenerResourceCrud) Delete() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
-	if err != nil {
-		return err
-	}
 	return nil
 }
 
@@ -551,21 +555,15 @@ func (s *LoadBalancerListenerResourceCrud) SetData() error {
 }
 
 func getListenerCompositeId(listenerName string, loadBalancerId string) string {
-	listenerName = url.PathEscape(listenerName)
-	loadBalancerId = url.PathEscape(loadBalancerId)
-	compositeId := "loadBalancers/" + loadBalancerId + "/listeners/" + listenerName
-	return compositeId
+	return getCompositeId("loadBalancers", loadBalancerId, "listeners", listenerName)
 }
 
 func parseListenerCompositeId(compositeId string) (listenerName string, loadBalancerId string, err error) {
-	parts := strings.Split(compositeId, "/")
-	match, _ := regexp.MatchString("loadBalancers/.*/listeners/.*", compositeId)
-	if !match || len(parts) != 4 {
-		err = fmt.Errorf("illegal compositeId %s encountered", compositeId)
+	values, err := parseCompositeId(compositeId, "loadBalancers", "listeners")
+	if err != nil {
 		return
 	}
-	loadBalancerId, _ = url.PathUnescape(parts[1])
-	listenerName, _ = url.PathUnescape(parts[3])
+	loadBalancerId, listenerName = values[0], values[1]
 
 	return
 }
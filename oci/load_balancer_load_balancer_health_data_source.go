@@ -56,7 +56,7 @@ func readSingularLoadBalancerLoadBalancerHealth(d *schema.ResourceData, m interf
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type LoadBalancerLoadBalancerHealthDataSourceCrud struct {
@@ -69,7 +69,7 @@ func (s *LoadBalancerLoadBalancerHealthDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LoadBalancerLoadBalancerHealthDataSourceCrud) Get() error {
+func (s *LoadBalancerLoadBalancerHealthDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_load_balancer.GetLoadBalancerHealthRequest{}
 
 	if loadBalancerId, ok := s.D.GetOkExists("load_balancer_id"); ok {
@@ -79,7 +79,7 @@ func (s *LoadBalancerLoadBalancerHealthDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "load_balancer")
 
-	response, err := s.Client.GetLoadBalancerHealth(context.Background(), request)
+	response, err := s.Client.GetLoadBalancerHealth(ctx, request)
 	if err != nil {
 		return err
 	}
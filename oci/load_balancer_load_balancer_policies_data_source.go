@@ -44,7 +44,7 @@ func readLoadBalancerLoadBalancerPolicies(d *schema.ResourceData, m interface{})
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type LoadBalancerLoadBalancerPoliciesDataSourceCrud struct {
@@ -57,7 +57,7 @@ func (s *LoadBalancerLoadBalancerPoliciesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LoadBalancerLoadBalancerPoliciesDataSourceCrud) Get() error {
+func (s *LoadBalancerLoadBalancerPoliciesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_load_balancer.ListPoliciesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -67,7 +67,7 @@ func (s *LoadBalancerLoadBalancerPoliciesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "load_balancer")
 
-	response, err := s.Client.ListPolicies(context.Background(), request)
+	response, err := s.Client.ListPolicies(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -44,7 +44,7 @@ func readLoadBalancerLoadBalancerProtocols(d *schema.ResourceData, m interface{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type LoadBalancerLoadBalancerProtocolsDataSourceCrud struct {
@@ -57,7 +57,7 @@ func (s *LoadBalancerLoadBalancerProtocolsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LoadBalancerLoadBalancerProtocolsDataSourceCrud) Get() error {
+func (s *LoadBalancerLoadBalancerProtocolsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_load_balancer.ListProtocolsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -67,7 +67,7 @@ func (s *LoadBalancerLoadBalancerProtocolsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "load_balancer")
 
-	response, err := s.Client.ListProtocols(context.Background(), request)
+	response, err := s.Client.ListProtocols(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -113,7 +113,7 @@ func createLoadBalancerLoadBalancer(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readLoadBalancerLoadBalancer(d *schema.ResourceData, m interface{}) error {
@@ -121,7 +121,7 @@ func readLoadBalancerLoadBalancer(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateLoadBalancerLoadBalancer(d *schema.ResourceData, m interface{}) error {
@@ -129,7 +129,7 @@ func updateLoadBalancerLoadBalancer(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteLoadBalancerLoadBalancer(d *schema.ResourceData, m interface{}) error {
@@ -138,7 +138,7 @@ func deleteLoadBalancerLoadBalancer(d *schema.ResourceData, m interface{}) error
 	sync.Client = m.(*OracleClients).loadBalancerClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type LoadBalancerLoadBalancerResourceCrud struct {
@@ -191,7 +191,26 @@ func (s *LoadBalancerLoadBalancerResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *LoadBalancerLoadBalancerResourceCrud) Create() error {
+func (s *LoadBalancerLoadBalancerResourceCrud) WorkRequestTracker() WorkRequestTracker {
+	return newLoadBalancerWorkRequestTracker(s.Client, s.DisableNotFoundRetries)
+}
+
+func (s *LoadBalancerLoadBalancerResourceCrud) WorkRequestId() *string {
+	if s.WorkRequest == nil {
+		return nil
+	}
+	return s.WorkRequest.Id
+}
+
+func (s *LoadBalancerLoadBalancerResourceCrud) WorkRequestSucceeded(ctx context.Context, operation string, workRequest *WorkRequestStatus) error {
+	s.WorkRequest = workRequest.WorkRequest.(*oci_load_balancer.WorkRequest)
+	if operation == updateOperation {
+		return s.Get(ctx)
+	}
+	return nil
+}
+
+func (s *LoadBalancerLoadBalancerResourceCrud) Create(ctx context.Context) error {
 	request := oci_load_balancer.CreateLoadBalancerRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -240,7 +259,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.CreateLoadBalancer(context.Background(), request)
+	response, err := s.Client.CreateLoadBalancer(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -249,20 +268,16 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Create() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
-	if err != nil {
-		return err
-	}
 	return nil
 }
 
-func (s *LoadBalancerLoadBalancerResourceCrud) Get() error {
-	id, stillWorking, err := LoadBalancerResourceGet(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+func (s *LoadBalancerLoadBalancerResourceCrud) Get(ctx context.Context) error {
+	id, stillWorking, err := LoadBalancerResourceGet(ctx, s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -281,7 +296,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.GetLoadBalancer(context.Background(), request)
+	response, err := s.Client.GetLoadBalancer(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -290,7 +305,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Get() error {
 	return nil
 }
 
-func (s *LoadBalancerLoadBalancerResourceCrud) Update() error {
+func (s *LoadBalancerLoadBalancerResourceCrud) Update(ctx context.Context) error {
 	request := oci_load_balancer.UpdateLoadBalancerRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -315,7 +330,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.UpdateLoadBalancer(context.Background(), request)
+	response, err := s.Client.UpdateLoadBalancer(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -324,20 +339,15 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Update() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
-	if err != nil {
-		return err
-	}
-
-	return s.Get()
+	return nil
 }
 
-func (s *LoadBalancerLoadBalancerResourceCrud) Delete() error {
+func (s *LoadBalancerLoadBalancerResourceCrud) Delete(ctx context.Context) error {
 	request := oci_load_balancer.DeleteLoadBalancerRequest{}
 
 	tmp := s.D.Id()
@@ -345,7 +355,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.DeleteLoadBalancer(context.Background(), request)
+	response, err := s.Client.DeleteLoadBalancer(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -354,15 +364,11 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Delete() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
-	if err != nil {
-		return err
-	}
 	return nil
 }
 
@@ -44,7 +44,7 @@ func readLoadBalancerLoadBalancerShapes(d *schema.ResourceData, m interface{}) e
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type LoadBalancerLoadBalancerShapesDataSourceCrud struct {
@@ -57,7 +57,7 @@ func (s *LoadBalancerLoadBalancerShapesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LoadBalancerLoadBalancerShapesDataSourceCrud) Get() error {
+func (s *LoadBalancerLoadBalancerShapesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_load_balancer.ListShapesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -67,7 +67,7 @@ func (s *LoadBalancerLoadBalancerShapesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "load_balancer")
 
-	response, err := s.Client.ListShapes(context.Background(), request)
+	response, err := s.Client.ListShapes(ctx, request)
 	if err != nil {
 		return err
 	}
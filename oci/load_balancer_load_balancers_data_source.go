@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/loadbalancer"
 )
 
@@ -13,7 +14,8 @@ func LoadBalancerLoadBalancersDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readLoadBalancerLoadBalancers,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -35,6 +37,22 @@ func LoadBalancerLoadBalancersDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(LoadBalancerLoadBalancerResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_load_balancer.ListLoadBalancersSortByTimecreated),
+					string(oci_load_balancer.ListLoadBalancersSortByDisplayname),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_load_balancer.ListLoadBalancersSortOrderAsc),
+					string(oci_load_balancer.ListLoadBalancersSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -44,7 +62,7 @@ func readLoadBalancerLoadBalancers(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type LoadBalancerLoadBalancersDataSourceCrud struct {
@@ -57,7 +75,7 @@ func (s *LoadBalancerLoadBalancersDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LoadBalancerLoadBalancersDataSourceCrud) Get() error {
+func (s *LoadBalancerLoadBalancersDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_load_balancer.ListLoadBalancersRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -79,9 +97,18 @@ func (s *LoadBalancerLoadBalancersDataSourceCrud) Get() error {
 		request.LifecycleState = oci_load_balancer.LoadBalancerLifecycleStateEnum(state.(string))
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_load_balancer.ListLoadBalancersSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_load_balancer.ListLoadBalancersSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "load_balancer")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListLoadBalancers(context.Background(), request)
+	response, err := s.Client.ListLoadBalancers(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -89,8 +116,8 @@ func (s *LoadBalancerLoadBalancersDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListLoadBalancers(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListLoadBalancers(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -166,6 +193,8 @@ func (s *LoadBalancerLoadBalancersDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, LoadBalancerLoadBalancersDataSource().Schema["load_balancers"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("load_balancers", resources); err != nil {
 		return err
 	}
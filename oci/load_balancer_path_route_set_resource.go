@@ -6,8 +6,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/url"
-	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -95,7 +93,7 @@ func createLoadBalancerPathRouteSet(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readLoadBalancerPathRouteSet(d *schema.ResourceData, m interface{}) error {
@@ -103,7 +101,7 @@ func readLoadBalancerPathRouteSet(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateLoadBalancerPathRouteSet(d *schema.ResourceData, m interface{}) error {
@@ -111,7 +109,7 @@ func updateLoadBalancerPathRouteSet(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteLoadBalancerPathRouteSet(d *schema.ResourceData, m interface{}) error {
@@ -120,7 +118,7 @@ func deleteLoadBalancerPathRouteSet(d *schema.ResourceData, m interface{}) error
 	sync.Client = m.(*OracleClients).loadBalancerClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type LoadBalancerPathRouteSetResourceCrud struct {
@@ -170,7 +168,26 @@ func (s *LoadBalancerPathRouteSetResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *LoadBalancerPathRouteSetResourceCrud) Create() error {
+func (s *LoadBalancerPathRouteSetResourceCrud) WorkRequestTracker() WorkRequestTracker {
+	return newLoadBalancerWorkRequestTracker(s.Client, s.DisableNotFoundRetries)
+}
+
+func (s *LoadBalancerPathRouteSetResourceCrud) WorkRequestId() *string {
+	if s.WorkRequest == nil {
+		return nil
+	}
+	return s.WorkRequest.Id
+}
+
+func (s *LoadBalancerPathRouteSetResourceCrud) WorkRequestSucceeded(ctx context.Context, operation string, workRequest *WorkRequestStatus) error {
+	s.WorkRequest = workRequest.WorkRequest.(*oci_load_balancer.WorkRequest)
+	if operation == updateOperation {
+		return s.Get(ctx)
+	}
+	return nil
+}
+
+func (s *LoadBalancerPathRouteSetResourceCrud) Create(ctx context.Context) error {
 	request := oci_load_balancer.CreatePathRouteSetRequest{}
 
 	if loadBalancerId, ok := s.D.GetOkExists("load_balancer_id"); ok {
@@ -201,7 +218,7 @@ func (s *LoadBalancerPathRouteSetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.CreatePathRouteSet(context.Background(), request)
+	response, err := s.Client.CreatePathRouteSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -210,20 +227,16 @@ func (s *LoadBalancerPathRouteSetResourceCrud) Create() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
-	if err != nil {
-		return err
-	}
 	return nil
 }
 
-func (s *LoadBalancerPathRouteSetResourceCrud) Get() error {
-	_, stillWorking, err := LoadBalancerResourceGet(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+func (s *LoadBalancerPathRouteSetResourceCrud) Get(ctx context.Context) error {
+	_, stillWorking, err := LoadBalancerResourceGet(ctx, s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -254,7 +267,7 @@ func (s *LoadBalancerPathRouteSetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.GetPathRouteSet(context.Background(), request)
+	response, err := s.Client.GetPathRouteSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -263,7 +276,7 @@ func (s *LoadBalancerPathRouteSetResourceCrud) Get() error {
 	return nil
 }
 
-func (s *LoadBalancerPathRouteSetResourceCrud) Update() error {
+func (s *LoadBalancerPathRouteSetResourceCrud) Update(ctx context.Context) error {
 	request := oci_load_balancer.UpdatePathRouteSetRequest{}
 
 	if loadBalancerId, ok := s.D.GetOkExists("load_balancer_id"); ok {
@@ -294,7 +307,7 @@ func (s *LoadBalancerPathRouteSetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.UpdatePathRouteSet(context.Background(), request)
+	response, err := s.Client.UpdatePathRouteSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -303,20 +316,15 @@ func (s *LoadBalancerPathRouteSetResourceCrud) Update() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
-	if err != nil {
-		return err
-	}
-
-	return s.Get()
+	return nil
 }
 
-func (s *LoadBalancerPathRouteSetResourceCrud) Delete() error {
+func (s *LoadBalancerPathRouteSetResourceCrud) Delete(ctx context.Context) error {
 	request := oci_load_balancer.DeletePathRouteSetRequest{}
 
 	if loadBalancerId, ok := s.D.GetOkExists("load_balancer_id"); ok {
@@ -331,7 +339,7 @@ func (s *LoadBalancerPathRouteSetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.DeletePathRouteSet(context.Background(), request)
+	response, err := s.Client.DeletePathRouteSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -340,15 +348,11 @@ func (s *LoadBalancerPathRouteSetResourceCrud) Delete() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
-	if err != nil {
-		return err
-	}
 	return nil
 }
 
@@ -379,21 +383,15 @@ func (s *LoadBalancerPathRouteSetResourceCrud) SetData() error {
 }
 
 func getPathRouteSetCompositeId(loadBalancerId string, pathRouteSetName string) string {
-	loadBalancerId = url.PathEscape(loadBalancerId)
-	pathRouteSetName = url.PathEscape(pathRouteSetName)
-	compositeId := "loadBalancers/" + loadBalancerId + "/pathRouteSets/" + pathRouteSetName
-	return compositeId
+	return getCompositeId("loadBalancers", loadBalancerId, "pathRouteSets", pathRouteSetName)
 }
 
 func parsePathRouteSetCompositeId(compositeId string) (loadBalancerId string, pathRouteSetName string, err error) {
-	parts := strings.Split(compositeId, "/")
-	match, _ := regexp.MatchString("loadBalancers/.*/pathRouteSets/.*", compositeId)
-	if !match || len(parts) != 4 {
-		err = fmt.Errorf("illegal compositeId %s encountered", compositeId)
+	values, err := parseCompositeId(compositeId, "loadBalancers", "pathRouteSets")
+	if err != nil {
 		return
 	}
-	loadBalancerId, _ = url.PathUnescape(parts[1])
-	pathRouteSetName, _ = url.PathUnescape(parts[3])
+	loadBalancerId, pathRouteSetName = values[0], values[1]
 
 	return
 }
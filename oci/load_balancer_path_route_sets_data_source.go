@@ -32,7 +32,7 @@ func readLoadBalancerPathRouteSets(d *schema.ResourceData, m interface{}) error
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type LoadBalancerPathRouteSetsDataSourceCrud struct {
@@ -45,7 +45,7 @@ func (s *LoadBalancerPathRouteSetsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LoadBalancerPathRouteSetsDataSourceCrud) Get() error {
+func (s *LoadBalancerPathRouteSetsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_load_balancer.ListPathRouteSetsRequest{}
 
 	if loadBalancerId, ok := s.D.GetOkExists("load_balancer_id"); ok {
@@ -55,7 +55,7 @@ func (s *LoadBalancerPathRouteSetsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "load_balancer")
 
-	response, err := s.Client.ListPathRouteSets(context.Background(), request)
+	response, err := s.Client.ListPathRouteSets(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -77,7 +77,7 @@ func readSingularLoadBalancerRuleSet(d *schema.ResourceData, m interface{}) erro
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type LoadBalancerRuleSetDataSourceCrud struct {
@@ -90,7 +90,7 @@ func (s *LoadBalancerRuleSetDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LoadBalancerRuleSetDataSourceCrud) Get() error {
+func (s *LoadBalancerRuleSetDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_load_balancer.GetRuleSetRequest{}
 
 	if loadBalancerId, ok := s.D.GetOkExists("load_balancer_id"); ok {
@@ -105,7 +105,7 @@ func (s *LoadBalancerRuleSetDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "load_balancer")
 
-	response, err := s.Client.GetRuleSet(context.Background(), request)
+	response, err := s.Client.GetRuleSet(ctx, request)
 	if err != nil {
 		return err
 	}
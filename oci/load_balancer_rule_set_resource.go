@@ -7,8 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/url"
-	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
@@ -104,7 +102,7 @@ func createLoadBalancerRuleSet(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readLoadBalancerRuleSet(d *schema.ResourceData, m interface{}) error {
@@ -112,7 +110,7 @@ func readLoadBalancerRuleSet(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateLoadBalancerRuleSet(d *schema.ResourceData, m interface{}) error {
@@ -120,7 +118,7 @@ func updateLoadBalancerRuleSet(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteLoadBalancerRuleSet(d *schema.ResourceData, m interface{}) error {
@@ -129,7 +127,7 @@ func deleteLoadBalancerRuleSet(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).loadBalancerClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type LoadBalancerRuleSetResourceCrud struct {
@@ -179,7 +177,26 @@ func (s *LoadBalancerRuleSetResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *LoadBalancerRuleSetResourceCrud) Create() error {
+func (s *LoadBalancerRuleSetResourceCrud) WorkRequestTracker() WorkRequestTracker {
+	return newLoadBalancerWorkRequestTracker(s.Client, s.DisableNotFoundRetries)
+}
+
+func (s *LoadBalancerRuleSetResourceCrud) WorkRequestId() *string {
+	if s.WorkRequest == nil {
+		return nil
+	}
+	return s.WorkRequest.Id
+}
+
+func (s *LoadBalancerRuleSetResourceCrud) WorkRequestSucceeded(ctx context.Context, operation string, workRequest *WorkRequestStatus) error {
+	s.WorkRequest = workRequest.WorkRequest.(*oci_load_balancer.WorkRequest)
+	if operation == updateOperation {
+		return s.Get(ctx)
+	}
+	return nil
+}
+
+func (s *LoadBalancerRuleSetResourceCrud) Create(ctx context.Context) error {
 	request := oci_load_balancer.CreateRuleSetRequest{}
 
 	request.Items = []oci_load_balancer.Rule{}
@@ -211,7 +228,7 @@ func (s *LoadBalancerRuleSetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.CreateRuleSet(context.Background(), request)
+	response, err := s.Client.CreateRuleSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -220,20 +237,16 @@ func (s *LoadBalancerRuleSetResourceCrud) Create() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
-	if err != nil {
-		return err
-	}
 	return nil
 }
 
-func (s *LoadBalancerRuleSetResourceCrud) Get() error {
-	_, stillWorking, err := LoadBalancerResourceGet(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+func (s *LoadBalancerRuleSetResourceCrud) Get(ctx context.Context) error {
+	_, stillWorking, err := LoadBalancerResourceGet(ctx, s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -264,7 +277,7 @@ func (s *LoadBalancerRuleSetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.GetRuleSet(context.Background(), request)
+	response, err := s.Client.GetRuleSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -273,7 +286,7 @@ func (s *LoadBalancerRuleSetResourceCrud) Get() error {
 	return nil
 }
 
-func (s *LoadBalancerRuleSetResourceCrud) Update() error {
+func (s *LoadBalancerRuleSetResourceCrud) Update(ctx context.Context) error {
 	request := oci_load_balancer.UpdateRuleSetRequest{}
 
 	request.Items = []oci_load_balancer.Rule{}
@@ -305,7 +318,7 @@ func (s *LoadBalancerRuleSetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.UpdateRuleSet(context.Background(), request)
+	response, err := s.Client.UpdateRuleSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -314,20 +327,15 @@ func (s *LoadBalancerRuleSetResourceCrud) Update() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
-	if err != nil {
-		return err
-	}
-
-	return s.Get()
+	return nil
 }
 
-func (s *LoadBalancerRuleSetResourceCrud) Delete() error {
+func (s *LoadBalancerRuleSetResourceCrud) Delete(ctx context.Context) error {
 	if strings.Contains(s.D.Id(), "ocid1.loadbalancerworkrequest") {
 		return nil
 	}
@@ -345,7 +353,7 @@ func (s *LoadBalancerRuleSetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.DeleteRuleSet(context.Background(), request)
+	response, err := s.Client.DeleteRuleSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -354,15 +362,11 @@ func (s *LoadBalancerRuleSetResourceCrud) Delete() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = LoadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
-	if err != nil {
-		return err
-	}
 	return nil
 }
 
@@ -393,21 +397,15 @@ func (s *LoadBalancerRuleSetResourceCrud) SetData() error {
 }
 
 func getRuleSetCompositeId(loadBalancerId string, name string) string {
-	loadBalancerId = url.PathEscape(loadBalancerId)
-	name = url.PathEscape(name)
-	compositeId := "loadBalancers/" + loadBalancerId + "/ruleSets/" + name
-	return compositeId
+	return getCompositeId("loadBalancers", loadBalancerId, "ruleSets", name)
 }
 
 func parseRuleSetCompositeId(compositeId string) (loadBalancerId string, name string, err error) {
-	parts := strings.Split(compositeId, "/")
-	match, _ := regexp.MatchString("loadBalancers/.*/ruleSets/.*", compositeId)
-	if !match || len(parts) != 4 {
-		err = fmt.Errorf("illegal compositeId %s encountered", compositeId)
+	values, err := parseCompositeId(compositeId, "loadBalancers", "ruleSets")
+	if err != nil {
 		return
 	}
-	loadBalancerId, _ = url.PathUnescape(parts[1])
-	name, _ = url.PathUnescape(parts[3])
+	loadBalancerId, name = values[0], values[1]
 
 	return
 }
@@ -32,7 +32,7 @@ func readLoadBalancerRuleSets(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).loadBalancerClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type LoadBalancerRuleSetsDataSourceCrud struct {
@@ -45,7 +45,7 @@ func (s *LoadBalancerRuleSetsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LoadBalancerRuleSetsDataSourceCrud) Get() error {
+func (s *LoadBalancerRuleSetsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_load_balancer.ListRuleSetsRequest{}
 
 	if loadBalancerId, ok := s.D.GetOkExists("load_balancer_id"); ok {
@@ -55,7 +55,7 @@ func (s *LoadBalancerRuleSetsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "load_balancer")
 
-	response, err := s.Client.ListRuleSets(context.Background(), request)
+	response, err := s.Client.ListRuleSets(ctx, request)
 	if err != nil {
 		return err
 	}
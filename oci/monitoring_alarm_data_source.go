@@ -132,7 +132,7 @@ func readSingularMonitoringAlarm(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).monitoringClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type MonitoringAlarmDataSourceCrud struct {
@@ -145,7 +145,7 @@ func (s *MonitoringAlarmDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MonitoringAlarmDataSourceCrud) Get() error {
+func (s *MonitoringAlarmDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_monitoring.GetAlarmRequest{}
 
 	if alarmId, ok := s.D.GetOkExists("alarm_id"); ok {
@@ -155,7 +155,7 @@ func (s *MonitoringAlarmDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "monitoring")
 
-	response, err := s.Client.GetAlarm(context.Background(), request)
+	response, err := s.Client.GetAlarm(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -70,7 +70,7 @@ func readSingularMonitoringAlarmHistoryCollection(d *schema.ResourceData, m inte
 	sync.D = d
 	sync.Client = m.(*OracleClients).monitoringClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type MonitoringAlarmHistoryCollectionDataSourceCrud struct {
@@ -83,7 +83,7 @@ func (s *MonitoringAlarmHistoryCollectionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MonitoringAlarmHistoryCollectionDataSourceCrud) Get() error {
+func (s *MonitoringAlarmHistoryCollectionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_monitoring.GetAlarmHistoryRequest{}
 
 	if alarmHistorytype, ok := s.D.GetOkExists("alarm_historytype"); ok {
@@ -113,7 +113,7 @@ func (s *MonitoringAlarmHistoryCollectionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "monitoring")
 
-	response, err := s.Client.GetAlarmHistory(context.Background(), request)
+	response, err := s.Client.GetAlarmHistory(ctx, request)
 	if err != nil {
 		return err
 	}
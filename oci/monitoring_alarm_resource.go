@@ -154,7 +154,7 @@ func createMonitoringAlarm(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).monitoringClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readMonitoringAlarm(d *schema.ResourceData, m interface{}) error {
@@ -162,7 +162,7 @@ func readMonitoringAlarm(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).monitoringClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateMonitoringAlarm(d *schema.ResourceData, m interface{}) error {
@@ -170,7 +170,7 @@ func updateMonitoringAlarm(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).monitoringClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteMonitoringAlarm(d *schema.ResourceData, m interface{}) error {
@@ -179,7 +179,7 @@ func deleteMonitoringAlarm(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).monitoringClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type MonitoringAlarmResourceCrud struct {
@@ -215,7 +215,7 @@ func (s *MonitoringAlarmResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *MonitoringAlarmResourceCrud) Create() error {
+func (s *MonitoringAlarmResourceCrud) Create(ctx context.Context) error {
 	request := oci_monitoring.CreateAlarmRequest{}
 
 	if body, ok := s.D.GetOkExists("body"); ok {
@@ -314,7 +314,7 @@ func (s *MonitoringAlarmResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "monitoring")
 
-	response, err := s.Client.CreateAlarm(context.Background(), request)
+	response, err := s.Client.CreateAlarm(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -323,7 +323,7 @@ func (s *MonitoringAlarmResourceCrud) Create() error {
 	return nil
 }
 
-func (s *MonitoringAlarmResourceCrud) Get() error {
+func (s *MonitoringAlarmResourceCrud) Get(ctx context.Context) error {
 	request := oci_monitoring.GetAlarmRequest{}
 
 	tmp := s.D.Id()
@@ -331,7 +331,7 @@ func (s *MonitoringAlarmResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "monitoring")
 
-	response, err := s.Client.GetAlarm(context.Background(), request)
+	response, err := s.Client.GetAlarm(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -340,7 +340,7 @@ func (s *MonitoringAlarmResourceCrud) Get() error {
 	return nil
 }
 
-func (s *MonitoringAlarmResourceCrud) Update() error {
+func (s *MonitoringAlarmResourceCrud) Update(ctx context.Context) error {
 	request := oci_monitoring.UpdateAlarmRequest{}
 
 	tmp := s.D.Id()
@@ -442,7 +442,7 @@ func (s *MonitoringAlarmResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "monitoring")
 
-	response, err := s.Client.UpdateAlarm(context.Background(), request)
+	response, err := s.Client.UpdateAlarm(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -451,7 +451,7 @@ func (s *MonitoringAlarmResourceCrud) Update() error {
 	return nil
 }
 
-func (s *MonitoringAlarmResourceCrud) Delete() error {
+func (s *MonitoringAlarmResourceCrud) Delete(ctx context.Context) error {
 	request := oci_monitoring.DeleteAlarmRequest{}
 
 	tmp := s.D.Id()
@@ -459,7 +459,7 @@ func (s *MonitoringAlarmResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "monitoring")
 
-	_, err := s.Client.DeleteAlarm(context.Background(), request)
+	_, err := s.Client.DeleteAlarm(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_monitoring "github.com/oracle/oci-go-sdk/monitoring"
 )
 
@@ -13,7 +14,8 @@ func MonitoringAlarmStatusesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readMonitoringAlarmStatuses,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -86,6 +88,22 @@ func MonitoringAlarmStatusesDataSource() *schema.Resource {
 					},
 				},
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_monitoring.ListAlarmsStatusSortByDisplayname),
+					string(oci_monitoring.ListAlarmsStatusSortBySeverity),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_monitoring.ListAlarmsStatusSortOrderAsc),
+					string(oci_monitoring.ListAlarmsStatusSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -95,7 +113,7 @@ func readMonitoringAlarmStatuses(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).monitoringClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type MonitoringAlarmStatusesDataSourceCrud struct {
@@ -108,7 +126,7 @@ func (s *MonitoringAlarmStatusesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MonitoringAlarmStatusesDataSourceCrud) Get() error {
+func (s *MonitoringAlarmStatusesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_monitoring.ListAlarmsStatusRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -126,9 +144,18 @@ func (s *MonitoringAlarmStatusesDataSourceCrud) Get() error {
 		request.DisplayName = &tmp
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_monitoring.ListAlarmsStatusSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_monitoring.ListAlarmsStatusSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "monitoring")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListAlarmsStatus(context.Background(), request)
+	response, err := s.Client.ListAlarmsStatus(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -136,8 +163,8 @@ func (s *MonitoringAlarmStatusesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListAlarmsStatus(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListAlarmsStatus(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -189,6 +216,8 @@ func (s *MonitoringAlarmStatusesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, MonitoringAlarmStatusesDataSource().Schema["alarm_statuses"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("alarm_statuses", resources); err != nil {
 		return err
 	}
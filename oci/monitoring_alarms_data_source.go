@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_monitoring "github.com/oracle/oci-go-sdk/monitoring"
 )
 
@@ -13,7 +14,8 @@ func MonitoringAlarmsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readMonitoringAlarms,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -35,6 +37,22 @@ func MonitoringAlarmsDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(MonitoringAlarmResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_monitoring.ListAlarmsSortByDisplayname),
+					string(oci_monitoring.ListAlarmsSortBySeverity),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_monitoring.ListAlarmsSortOrderAsc),
+					string(oci_monitoring.ListAlarmsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -44,7 +62,7 @@ func readMonitoringAlarms(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).monitoringClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type MonitoringAlarmsDataSourceCrud struct {
@@ -57,7 +75,7 @@ func (s *MonitoringAlarmsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MonitoringAlarmsDataSourceCrud) Get() error {
+func (s *MonitoringAlarmsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_monitoring.ListAlarmsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -79,9 +97,18 @@ func (s *MonitoringAlarmsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_monitoring.AlarmLifecycleStateEnum(state.(string))
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_monitoring.ListAlarmsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_monitoring.ListAlarmsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "monitoring")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListAlarms(context.Background(), request)
+	response, err := s.Client.ListAlarms(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -89,8 +116,8 @@ func (s *MonitoringAlarmsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListAlarms(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListAlarms(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -164,6 +191,8 @@ func (s *MonitoringAlarmsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, MonitoringAlarmsDataSource().Schema["alarms"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("alarms", resources); err != nil {
 		return err
 	}
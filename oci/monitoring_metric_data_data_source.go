@@ -142,7 +142,7 @@ func readMonitoringMetricData(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).monitoringClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type MonitoringMetricDataDataSourceCrud struct {
@@ -155,7 +155,7 @@ func (s *MonitoringMetricDataDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MonitoringMetricDataDataSourceCrud) Get() error {
+func (s *MonitoringMetricDataDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_monitoring.SummarizeMetricsDataRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -201,7 +201,7 @@ func (s *MonitoringMetricDataDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "monitoring")
 
-	response, err := s.Client.SummarizeMetricsData(context.Background(), request)
+	response, err := s.Client.SummarizeMetricsData(ctx, request)
 	if err != nil {
 		return err
 	}
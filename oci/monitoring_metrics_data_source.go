@@ -13,7 +13,8 @@ func MonitoringMetricsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readMonitoringMetrics,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -108,7 +109,7 @@ func readMonitoringMetrics(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).monitoringClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type MonitoringMetricsDataSourceCrud struct {
@@ -121,7 +122,7 @@ func (s *MonitoringMetricsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MonitoringMetricsDataSourceCrud) Get() error {
+func (s *MonitoringMetricsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_monitoring.ListMetricsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -161,8 +162,9 @@ func (s *MonitoringMetricsDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "monitoring")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListMetrics(context.Background(), request)
+	response, err := s.Client.ListMetrics(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -170,8 +172,8 @@ func (s *MonitoringMetricsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListMetrics(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListMetrics(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -215,6 +217,8 @@ func (s *MonitoringMetricsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, MonitoringMetricsDataSource().Schema["metrics"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("metrics", resources); err != nil {
 		return err
 	}
@@ -87,7 +87,7 @@ func readSingularObjectStorageBucket(d *schema.ResourceData, m interface{}) erro
 	sync.D = d
 	sync.Client = m.(*OracleClients).objectStorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type ObjectStorageBucketDataSourceCrud struct {
@@ -100,7 +100,7 @@ func (s *ObjectStorageBucketDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ObjectStorageBucketDataSourceCrud) Get() error {
+func (s *ObjectStorageBucketDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.GetBucketRequest{}
 
 	if name, ok := s.D.GetOkExists("name"); ok {
@@ -116,7 +116,7 @@ func (s *ObjectStorageBucketDataSourceCrud) Get() error {
 	request.Fields = oci_object_storage.GetGetBucketFieldsEnumValues()
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(true, "object_storage")
 
-	response, err := s.Client.GetBucket(context.Background(), request)
+	response, err := s.Client.GetBucket(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,10 +6,7 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/url"
-	"regexp"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -56,6 +53,11 @@ func ObjectStorageBucketResource() *schema.Resource {
 				DiffSuppressFunc: definedTagsDiffSuppressFunction,
 				Elem:             schema.TypeString,
 			},
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"freeform_tags": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -113,7 +115,7 @@ func createObjectStorageBucket(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).objectStorageClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readObjectStorageBucket(d *schema.ResourceData, m interface{}) error {
@@ -121,7 +123,7 @@ func readObjectStorageBucket(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).objectStorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateObjectStorageBucket(d *schema.ResourceData, m interface{}) error {
@@ -129,7 +131,7 @@ func updateObjectStorageBucket(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).objectStorageClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteObjectStorageBucket(d *schema.ResourceData, m interface{}) error {
@@ -138,7 +140,7 @@ func deleteObjectStorageBucket(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).objectStorageClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type ObjectStorageBucketResourceCrud struct {
@@ -153,7 +155,7 @@ func (s *ObjectStorageBucketResourceCrud) ID() string {
 	return getBucketCompositeId(s.D.Get("name").(string), s.D.Get("namespace").(string))
 }
 
-func (s *ObjectStorageBucketResourceCrud) Create() error {
+func (s *ObjectStorageBucketResourceCrud) Create(ctx context.Context) error {
 	request := oci_object_storage.CreateBucketRequest{}
 
 	if accessType, ok := s.D.GetOkExists("access_type"); ok {
@@ -202,7 +204,7 @@ func (s *ObjectStorageBucketResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.CreateBucket(context.Background(), request)
+	response, err := s.Client.CreateBucket(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -211,7 +213,7 @@ func (s *ObjectStorageBucketResourceCrud) Create() error {
 	return nil
 }
 
-func (s *ObjectStorageBucketResourceCrud) Get() error {
+func (s *ObjectStorageBucketResourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.GetBucketRequest{}
 
 	if name, ok := s.D.GetOkExists("name"); ok {
@@ -235,7 +237,7 @@ func (s *ObjectStorageBucketResourceCrud) Get() error {
 	request.Fields = oci_object_storage.GetGetBucketFieldsEnumValues()
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.GetBucket(context.Background(), request)
+	response, err := s.Client.GetBucket(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -244,7 +246,7 @@ func (s *ObjectStorageBucketResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ObjectStorageBucketResourceCrud) Update() error {
+func (s *ObjectStorageBucketResourceCrud) Update(ctx context.Context) error {
 	request := oci_object_storage.UpdateBucketRequest{}
 
 	if accessType, ok := s.D.GetOkExists("access_type"); ok {
@@ -305,7 +307,7 @@ func (s *ObjectStorageBucketResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.UpdateBucket(context.Background(), request)
+	response, err := s.Client.UpdateBucket(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -314,7 +316,7 @@ func (s *ObjectStorageBucketResourceCrud) Update() error {
 	return nil
 }
 
-func (s *ObjectStorageBucketResourceCrud) Delete() error {
+func (s *ObjectStorageBucketResourceCrud) Delete(ctx context.Context) error {
 	request := oci_object_storage.DeleteBucketRequest{}
 
 	if name, ok := s.D.GetOkExists("name"); ok {
@@ -327,12 +329,138 @@ func (s *ObjectStorageBucketResourceCrud) Delete() error {
 		request.NamespaceName = &tmp
 	}
 
+	if forceDestroy, ok := s.D.GetOkExists("force_destroy"); ok && forceDestroy.(bool) {
+		if err := s.emptyBucket(ctx, *request.NamespaceName, *request.BucketName); err != nil {
+			return err
+		}
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	_, err := s.Client.DeleteBucket(context.Background(), request)
+	_, err := s.Client.DeleteBucket(ctx, request)
 	return err
 }
 
+// emptyBucket deletes the objects, the multipart uploads and the pre-authenticated requests of a bucket so that it can
+// be deleted, within the delete timeout of the resource
+func (s *ObjectStorageBucketResourceCrud) emptyBucket(ctx context.Context, namespace string, bucket string) error {
+	ctx, cancel := context.WithTimeout(ctx, s.D.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	log.Printf("[INFO] force_destroy: emptying bucket %s in namespace %s", bucket, namespace)
+
+	listObjectsRequest := oci_object_storage.ListObjectsRequest{
+		NamespaceName: &namespace,
+		BucketName:    &bucket,
+	}
+	listObjectsRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+
+	deletedObjects := 0
+	for {
+		response, err := s.Client.ListObjects(ctx, listObjectsRequest)
+		if err != nil {
+			return err
+		}
+
+		names := []string{}
+		for _, object := range response.Objects {
+			names = append(names, *object.Name)
+		}
+		if err := deleteObjects(ctx, s.Client, namespace, bucket, names, defaultNumberOfGoroutines); err != nil {
+			return err
+		}
+		deletedObjects += len(names)
+		log.Printf("[INFO] force_destroy: deleted %d objects from bucket %s", deletedObjects, bucket)
+
+		if response.NextStartWith == nil || *response.NextStartWith == "" {
+			break
+		}
+		listObjectsRequest.Start = response.NextStartWith
+	}
+
+	listUploadsRequest := oci_object_storage.ListMultipartUploadsRequest{
+		NamespaceName: &namespace,
+		BucketName:    &bucket,
+	}
+	listUploadsRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+
+	// Aborted uploads are no longer listed, so that the first page is listed until it only lists uploads that were
+	// already aborted, e.g. that are still listed after their abort failed with a 404
+	abortedUploads := map[string]bool{}
+	for {
+		response, err := s.Client.ListMultipartUploads(ctx, listUploadsRequest)
+		if err != nil {
+			return err
+		}
+
+		progress := false
+		for _, upload := range response.Items {
+			if abortedUploads[*upload.UploadId] {
+				continue
+			}
+			progress = true
+
+			request := oci_object_storage.AbortMultipartUploadRequest{
+				NamespaceName: &namespace,
+				BucketName:    &bucket,
+				ObjectName:    upload.Object,
+				UploadId:      upload.UploadId,
+			}
+			request.RequestMetadata.RetryPolicy = getRetryPolicy(true, "object_storage")
+
+			if _, err := s.Client.AbortMultipartUpload(ctx, request); err != nil && !isObjectStorageNotFound(err) {
+				return fmt.Errorf("failed to abort multipart upload %s of object %s in bucket %s: %s", *upload.UploadId, *upload.Object, bucket, err)
+			}
+			abortedUploads[*upload.UploadId] = true
+		}
+		if !progress {
+			break
+		}
+		log.Printf("[INFO] force_destroy: aborted %d multipart uploads in bucket %s", len(abortedUploads), bucket)
+	}
+
+	listParsRequest := oci_object_storage.ListPreauthenticatedRequestsRequest{
+		NamespaceName: &namespace,
+		BucketName:    &bucket,
+	}
+	listParsRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+
+	// Like the uploads, the first page is listed until it only lists pre-authenticated requests that were already deleted
+	deletedPars := map[string]bool{}
+	for {
+		response, err := s.Client.ListPreauthenticatedRequests(ctx, listParsRequest)
+		if err != nil {
+			return err
+		}
+
+		progress := false
+		for _, par := range response.Items {
+			if deletedPars[*par.Id] {
+				continue
+			}
+			progress = true
+
+			request := oci_object_storage.DeletePreauthenticatedRequestRequest{
+				NamespaceName: &namespace,
+				BucketName:    &bucket,
+				ParId:         par.Id,
+			}
+			request.RequestMetadata.RetryPolicy = getRetryPolicy(true, "object_storage")
+
+			if _, err := s.Client.DeletePreauthenticatedRequest(ctx, request); err != nil && !isObjectStorageNotFound(err) {
+				return fmt.Errorf("failed to delete pre-authenticated request %s of bucket %s: %s", *par.Id, bucket, err)
+			}
+			deletedPars[*par.Id] = true
+		}
+		if !progress {
+			break
+		}
+		log.Printf("[INFO] force_destroy: deleted %d pre-authenticated requests of bucket %s", len(deletedPars), bucket)
+	}
+
+	return nil
+}
+
 func (s *ObjectStorageBucketResourceCrud) SetData() error {
 
 	// For ImportStateVerify to keep state consistent after import
@@ -370,6 +498,11 @@ func (s *ObjectStorageBucketResourceCrud) SetData() error {
 		s.D.Set("etag", *s.Res.Etag)
 	}
 
+	// force_destroy only matters to the deletion of the bucket, and is not read from it
+	if _, ok := s.D.GetOkExists("force_destroy"); !ok {
+		s.D.Set("force_destroy", false)
+	}
+
 	s.D.Set("freeform_tags", s.Res.FreeformTags)
 
 	if s.Res.KmsKeyId != nil {
@@ -402,21 +535,15 @@ func (s *ObjectStorageBucketResourceCrud) SetData() error {
 }
 
 func getBucketCompositeId(bucket string, namespace string) string {
-	bucket = url.PathEscape(bucket)
-	namespace = url.PathEscape(namespace)
-	compositeId := "n/" + namespace + "/b/" + bucket
-	return compositeId
+	return getCompositeId("n", namespace, "b", bucket)
 }
 
 func parseBucketCompositeId(compositeId string) (bucket string, namespace string, err error) {
-	parts := strings.Split(compositeId, "/")
-	match, _ := regexp.MatchString("n/.*/b/.*", compositeId)
-	if !match || len(parts) != 4 {
-		err = fmt.Errorf("illegal compositeId %s encountered", compositeId)
+	values, err := parseCompositeId(compositeId, "n", "b")
+	if err != nil {
 		return
 	}
-	namespace, _ = url.PathUnescape(parts[1])
-	bucket, _ = url.PathUnescape(parts[3])
+	namespace, bucket = values[0], values[1]
 
 	return
 }
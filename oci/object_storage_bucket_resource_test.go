@@ -0,0 +1,103 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_object_storage "github.com/oracle/oci-go-sdk/objectstorage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectStorageBucketResource_forceDestroy(t *testing.T) {
+	// A bucket that is not empty is not retried for long
+	defer func(duration time.Duration) { shortRetryTime = duration }(shortRetryTime)
+	shortRetryTime = 0
+
+	fake := newFakeObjectStorage()
+	fake.pageSize = 4
+	clients := newFakeObjectStorageClients(t, fake)
+	client := clients.objectStorageClient
+	ctx := context.Background()
+
+	bucket := schema.TestResourceDataRaw(t, ObjectStorageBucketResource().Schema, map[string]interface{}{
+		"compartment_id": testTenancyOCID,
+		"namespace":      fakeObjectStorageNamespace,
+		"name":           "bucket",
+	})
+	assert.NoError(t, ObjectStorageBucketResource().Create(bucket, clients))
+	assert.Equal(t, false, bucket.Get("force_destroy"))
+
+	for i := 0; i < 25; i++ {
+		putFakeObject(t, client, "bucket", fmt.Sprintf("dir/object-%02d", i), []byte("content"))
+	}
+	for _, object := range []string{"first", "second"} {
+		_, err := client.CreateMultipartUpload(ctx, oci_object_storage.CreateMultipartUploadRequest{
+			NamespaceName:                oci_common.String(fakeObjectStorageNamespace),
+			BucketName:                   oci_common.String("bucket"),
+			CreateMultipartUploadDetails: oci_object_storage.CreateMultipartUploadDetails{Object: oci_common.String(object)},
+		})
+		assert.NoError(t, err)
+	}
+	_, err := client.CreatePreauthenticatedRequest(ctx, oci_object_storage.CreatePreauthenticatedRequestRequest{
+		NamespaceName: oci_common.String(fakeObjectStorageNamespace),
+		BucketName:    oci_common.String("bucket"),
+		CreatePreauthenticatedRequestDetails: oci_object_storage.CreatePreauthenticatedRequestDetails{
+			Name:        oci_common.String("par"),
+			AccessType:  oci_object_storage.CreatePreauthenticatedRequestDetailsAccessTypeAnyobjectwrite,
+			TimeExpires: &oci_common.SDKTime{Time: time.Now().Add(time.Hour)},
+		},
+	})
+	assert.NoError(t, err)
+
+	// Without force_destroy, the bucket can not be deleted
+	state := bucket.State()
+	assert.Error(t, ObjectStorageBucketResource().Delete(bucket, clients))
+	assert.Len(t, fake.buckets["fakenamespace/bucket"].objects, 25)
+
+	// Emptying the bucket stops at the first object that can not be deleted
+	fake.failRequest = func(request *http.Request) *fakeNetworkError {
+		if request.Method == http.MethodDelete && strings.HasSuffix(request.URL.Path, "/o/dir/object-10") {
+			return &fakeNetworkError{http.StatusForbidden, "NotAuthorized", "the object is protected"}
+		}
+		return nil
+	}
+	bucket = ObjectStorageBucketResource().Data(state)
+	assert.NoError(t, bucket.Set("force_destroy", true))
+	err = ObjectStorageBucketResource().Delete(bucket, clients)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "dir/object-10")
+	}
+	assert.Contains(t, fake.buckets["fakenamespace/bucket"].objects, "dir/object-10")
+	assert.Len(t, fake.buckets["fakenamespace/bucket"].uploads, 2)
+
+	// An upload that is still listed after its abort failed with a 404 is not aborted again, the bucket deletion fails
+	lists := 0
+	fake.failRequest = func(request *http.Request) *fakeNetworkError {
+		if request.Method == http.MethodGet && strings.HasSuffix(request.URL.Path, "/u") {
+			lists++
+		}
+		if request.Method == http.MethodDelete && strings.Contains(request.URL.Path, "/u/") {
+			return &fakeNetworkError{http.StatusNotFound, "NoSuchUpload", "the upload does not exist"}
+		}
+		return nil
+	}
+	bucket = ObjectStorageBucketResource().Data(state)
+	assert.NoError(t, bucket.Set("force_destroy", true))
+	assert.Error(t, ObjectStorageBucketResource().Delete(bucket, clients))
+	assert.Equal(t, 2, lists)
+	assert.Len(t, fake.buckets["fakenamespace/bucket"].uploads, 2)
+
+	// With force_destroy, the objects, uploads and pre-authenticated requests are deleted with the bucket
+	fake.failRequest = nil
+	assert.NoError(t, ObjectStorageBucketResource().Delete(bucket, clients))
+	assert.Empty(t, bucket.Id())
+	assert.Empty(t, fake.buckets)
+}
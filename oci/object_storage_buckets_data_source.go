@@ -13,7 +13,8 @@ func ObjectStorageBucketsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readObjectStorageBuckets,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -46,7 +47,7 @@ func readObjectStorageBuckets(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).objectStorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type ObjectStorageBucketsDataSourceCrud struct {
@@ -59,7 +60,7 @@ func (s *ObjectStorageBucketsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ObjectStorageBucketsDataSourceCrud) Get() error {
+func (s *ObjectStorageBucketsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.ListBucketsRequest{
 		// @CODEGEN 6/2018: Need to specify all the fields we want from the BucketSummaries
 		Fields: oci_object_storage.GetListBucketsFieldsEnumValues(),
@@ -86,8 +87,9 @@ func (s *ObjectStorageBucketsDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "object_storage")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListBuckets(context.Background(), request)
+	response, err := s.Client.ListBuckets(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -95,8 +97,8 @@ func (s *ObjectStorageBucketsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListBuckets(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListBuckets(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -151,6 +153,8 @@ func (s *ObjectStorageBucketsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, ObjectStorageBucketsDataSource().Schema["bucket_summaries"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("bucket_summaries", resources); err != nil {
 		return err
 	}
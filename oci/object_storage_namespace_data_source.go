@@ -30,7 +30,7 @@ func readSingularObjectStorageNamespace(d *schema.ResourceData, m interface{}) e
 	sync.D = d
 	sync.Client = m.(*OracleClients).objectStorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type ObjectStorageNamespaceDataSourceCrud struct {
@@ -43,12 +43,12 @@ func (s *ObjectStorageNamespaceDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ObjectStorageNamespaceDataSourceCrud) Get() error {
+func (s *ObjectStorageNamespaceDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.GetNamespaceRequest{}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "object_storage")
 
-	response, err := s.Client.GetNamespace(context.Background(), request)
+	response, err := s.Client.GetNamespace(ctx, request)
 	if err != nil {
 		return err
 	}
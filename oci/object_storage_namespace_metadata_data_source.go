@@ -37,7 +37,7 @@ func readNamespaceMetadata2(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).objectStorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type NamespaceMetadataDataSourceCrud struct {
@@ -50,7 +50,7 @@ func (s *NamespaceMetadataDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *NamespaceMetadataDataSourceCrud) Get() error {
+func (s *NamespaceMetadataDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.GetNamespaceMetadataRequest{}
 
 	tmp := s.D.Get("namespace").(string)
@@ -58,7 +58,7 @@ func (s *NamespaceMetadataDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "object_storage")
 
-	response, err := s.Client.GetNamespaceMetadata(context.Background(), request)
+	response, err := s.Client.GetNamespaceMetadata(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -11,6 +11,9 @@ import (
 
 func ObjectStorageNamespaceMetadataResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importObjectStorageNamespaceMetadata,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   readNamespaceMetadata,
 		Read:     readNamespaceMetadata,
@@ -43,7 +46,7 @@ func readNamespaceMetadata(d *schema.ResourceData, m interface{}) error {
 	sync := &NamespaceMetadataResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).objectStorageClient
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateNamespaceMetadata(d *schema.ResourceData, m interface{}) error {
@@ -51,13 +54,19 @@ func updateNamespaceMetadata(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).objectStorageClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteNamespaceMetadata(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+// importObjectStorageNamespaceMetadata imports the metadata of a namespace by its name
+func importObjectStorageNamespaceMetadata(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	d.Set("namespace", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
 type NamespaceMetadataResourceCrud struct {
 	BaseCrud
 	Client                 *oci_object_storage.ObjectStorageClient
@@ -69,7 +78,7 @@ func (s *NamespaceMetadataResourceCrud) ID() string {
 	return *s.Res.Namespace
 }
 
-func (s *NamespaceMetadataResourceCrud) Get() error {
+func (s *NamespaceMetadataResourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.GetNamespaceMetadataRequest{}
 
 	if namespace, ok := s.D.GetOkExists("namespace"); ok {
@@ -79,7 +88,7 @@ func (s *NamespaceMetadataResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.GetNamespaceMetadata(context.Background(), request)
+	response, err := s.Client.GetNamespaceMetadata(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -89,7 +98,7 @@ func (s *NamespaceMetadataResourceCrud) Get() error {
 	return nil
 }
 
-func (s *NamespaceMetadataResourceCrud) Update() error {
+func (s *NamespaceMetadataResourceCrud) Update(ctx context.Context) error {
 	request := oci_object_storage.UpdateNamespaceMetadataRequest{}
 
 	if defaultS3CompartmentId, ok := s.D.GetOkExists("default_s3compartment_id"); ok {
@@ -109,7 +118,7 @@ func (s *NamespaceMetadataResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.UpdateNamespaceMetadata(context.Background(), request)
+	response, err := s.Client.UpdateNamespaceMetadata(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -95,6 +95,14 @@ data "oci_objectstorage_namespace_metadata" "test_namespace_metadata" {
 					resource.TestCheckResourceAttrSet(datasourceName, "namespace"),
 				),
 			},
+			// verify resource import
+			{
+				Config:                  config,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+				ResourceName:            resourceName,
+			},
 		},
 	})
 }
@@ -10,6 +10,8 @@ import (
 	"strconv"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	oci_common "github.com/oracle/oci-go-sdk/common"
 	oci_object_storage "github.com/oracle/oci-go-sdk/objectstorage"
 )
 
@@ -35,6 +37,25 @@ func ObjectStorageObjectDataSource() *schema.Resource {
 				//default value is 1MB
 				Default: 1048576,
 			},
+			"download_parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, maxNumberOfGoroutines),
+			},
+			"download_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateMultipartPartSize,
+			},
+			"output_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"range": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateObjectRange,
+			},
 
 			// Computed
 			"content": {
@@ -71,24 +92,50 @@ func ObjectStorageObjectDataSource() *schema.Resource {
 }
 
 func readSingularObjectStorageObject(d *schema.ResourceData, m interface{}) error {
+	if err := validateObjectDownloadArguments(d); err != nil {
+		return err
+	}
+
 	sync := &ObjectStorageObjectDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).objectStorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
+}
+
+// validateObjectDownloadArguments rejects the arguments of a download to a file without output_path before the object
+// is read, as the schema can not require them to be set along with it
+func validateObjectDownloadArguments(d *schema.ResourceData) error {
+	if outputPath, ok := d.GetOk("output_path"); ok && outputPath.(string) != "" {
+		return nil
+	}
+	for _, key := range []string{"download_parallelism", "download_part_size", "range"} {
+		if _, ok := d.GetOk(key); ok {
+			return fmt.Errorf("%s can only be specified along with output_path", key)
+		}
+	}
+	return nil
 }
 
 type ObjectStorageObjectDataSourceCrud struct {
 	D      *schema.ResourceData
 	Client *oci_object_storage.ObjectStorageClient
 	Res    *oci_object_storage.GetObjectResponse
+	// Download is the object written to output_path, whose content is not read into the state
+	Download *ObjectStorageObjectDownload
+}
+
+type ObjectStorageObjectDownload struct {
+	Head          oci_object_storage.HeadObjectResponse
+	ContentMd5    string
+	ContentLength int64
 }
 
 func (s *ObjectStorageObjectDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ObjectStorageObjectDataSourceCrud) Get() error {
+func (s *ObjectStorageObjectDataSourceCrud) Get(ctx context.Context) error {
 
 	headObjectRequest := &oci_object_storage.HeadObjectRequest{}
 
@@ -109,11 +156,15 @@ func (s *ObjectStorageObjectDataSourceCrud) Get() error {
 
 	headObjectRequest.RequestMetadata.RetryPolicy = getRetryPolicy(false, "object_storage")
 
-	headObjectResponse, err := s.Client.HeadObject(context.Background(), *headObjectRequest)
+	headObjectResponse, err := s.Client.HeadObject(ctx, *headObjectRequest)
 	if err != nil {
 		return err
 	}
 
+	if outputPath, ok := s.D.GetOkExists("output_path"); ok && outputPath.(string) != "" {
+		return s.download(ctx, headObjectResponse, outputPath.(string))
+	}
+
 	if contentLengthLimit, ok := s.D.GetOkExists("content_length_limit"); ok {
 		tmpInt64 := int64(contentLengthLimit.(int))
 
@@ -128,7 +179,7 @@ func (s *ObjectStorageObjectDataSourceCrud) Get() error {
 	request.BucketName = headObjectRequest.BucketName
 	request.ObjectName = headObjectRequest.ObjectName
 
-	response, err := s.Client.GetObject(context.Background(), request)
+	response, err := s.Client.GetObject(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -137,7 +188,38 @@ func (s *ObjectStorageObjectDataSourceCrud) Get() error {
 	return nil
 }
 
+// download streams the object to the output path rather than reading it into the content, without any limit to its length
+func (s *ObjectStorageObjectDataSourceCrud) download(ctx context.Context, head oci_object_storage.HeadObjectResponse, outputPath string) error {
+	downloadData := ObjectDownloadData{
+		NamespaceName:       oci_common.String(s.D.Get("namespace").(string)),
+		BucketName:          oci_common.String(s.D.Get("bucket").(string)),
+		ObjectName:          oci_common.String(s.D.Get("object").(string)),
+		ObjectStorageClient: s.Client,
+		OutputPath:          outputPath,
+		Head:                head,
+		Range:               s.D.Get("range").(string),
+		PartSize:            int64(s.D.Get("download_part_size").(int)),
+		Parallelism:         s.D.Get("download_parallelism").(int),
+		RetryPolicy:         getRetryPolicy(false, "object_storage"),
+	}
+
+	contentMd5, contentLength, err := downloadObject(ctx, downloadData)
+	if err != nil {
+		return err
+	}
+
+	s.Download = &ObjectStorageObjectDownload{
+		Head:          head,
+		ContentMd5:    contentMd5,
+		ContentLength: contentLength,
+	}
+	return nil
+}
+
 func (s *ObjectStorageObjectDataSourceCrud) SetData() error {
+	if s.Download != nil {
+		return s.setDownloadData()
+	}
 	if s.Res == nil {
 		return nil
 	}
@@ -180,3 +262,32 @@ func (s *ObjectStorageObjectDataSourceCrud) SetData() error {
 
 	return nil
 }
+
+// setDownloadData sets the digest and size of the file written to output_path, along with the metadata of the object
+func (s *ObjectStorageObjectDataSourceCrud) setDownloadData() error {
+	s.D.SetId(GenerateDataSourceID())
+
+	s.D.Set("content", "")
+	s.D.Set("content_md5", s.Download.ContentMd5)
+	s.D.Set("content_length", strconv.FormatInt(s.Download.ContentLength, 10))
+
+	if s.Download.Head.ContentEncoding != nil {
+		s.D.Set("content_encoding", *s.Download.Head.ContentEncoding)
+	}
+
+	if s.Download.Head.ContentLanguage != nil {
+		s.D.Set("content_language", *s.Download.Head.ContentLanguage)
+	}
+
+	if s.Download.Head.ContentType != nil {
+		s.D.Set("content_type", *s.Download.Head.ContentType)
+	}
+
+	if s.Download.Head.OpcMeta != nil {
+		if err := s.D.Set("metadata", s.Download.Head.OpcMeta); err != nil {
+			log.Printf("unable to set 'metadata'. Error: %v", err)
+		}
+	}
+
+	return nil
+}
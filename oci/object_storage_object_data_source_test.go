@@ -0,0 +1,212 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectStorageObjectDataSource_outputPath(t *testing.T) {
+	fixture := newFakeObjectStorageFixture(t)
+	defer fixture.cleanup()
+	clients := fixture.clients
+	defer func(dir string) { objectDownloadsDir = dir }(objectDownloadsDir)
+	objectDownloadsDir = fixture.tempDir()
+	content := []byte("0123456789abcdefghijABCDE")
+	putFakeObject(t, clients.objectStorageClient, "bucket", "kernel.img", content)
+
+	outputDir := fixture.tempDir()
+	outputPath := filepath.Join(outputDir, "kernel.img")
+
+	// The ranges requested are counted
+	ranges := []string{}
+	fixture.onRequest(func(request *http.Request) *fakeNetworkError {
+		if request.Method == http.MethodGet && request.Header.Get("range") != "" {
+			ranges = append(ranges, request.Header.Get("range"))
+		}
+		return nil
+	})
+
+	raw := map[string]interface{}{
+		"namespace":            fakeObjectStorageNamespace,
+		"bucket":               "bucket",
+		"object":               "kernel.img",
+		"output_path":          outputPath,
+		"content_length_limit": 10,
+		"download_part_size":   10,
+		"download_parallelism": 3,
+	}
+	object := schema.TestResourceDataRaw(t, ObjectStorageObjectDataSource().Schema, raw)
+	assert.NoError(t, ObjectStorageObjectDataSource().Read(object, clients))
+	downloaded, err := ioutil.ReadFile(outputPath)
+	assert.NoError(t, err)
+	assert.Equal(t, content, downloaded)
+	assert.ElementsMatch(t, []string{"bytes=0-9", "bytes=10-19", "bytes=20-24"}, ranges)
+	sum := md5.Sum(content)
+	assert.Equal(t, base64.StdEncoding.EncodeToString(sum[:]), object.Get("content_md5"))
+	assert.Equal(t, "25", object.Get("content_length"))
+	assert.Empty(t, object.Get("content"))
+
+	// The object is not downloaded again while the file has its content
+	ranges = []string{}
+	object = schema.TestResourceDataRaw(t, ObjectStorageObjectDataSource().Schema, raw)
+	assert.NoError(t, ObjectStorageObjectDataSource().Read(object, clients))
+	assert.Empty(t, ranges)
+
+	// A range of the object can be downloaded
+	raw["range"] = "bytes=-15"
+	object = schema.TestResourceDataRaw(t, ObjectStorageObjectDataSource().Schema, raw)
+	assert.NoError(t, ObjectStorageObjectDataSource().Read(object, clients))
+	downloaded, err = ioutil.ReadFile(outputPath)
+	assert.NoError(t, err)
+	assert.Equal(t, content[10:], downloaded)
+	assert.Equal(t, "15", object.Get("content_length"))
+	assert.ElementsMatch(t, []string{"bytes=10-19", "bytes=20-24"}, ranges)
+
+	// And is not downloaded again either while the file has its content
+	ranges = []string{}
+	object = schema.TestResourceDataRaw(t, ObjectStorageObjectDataSource().Schema, raw)
+	assert.NoError(t, ObjectStorageObjectDataSource().Read(object, clients))
+	assert.Empty(t, ranges)
+
+	// But only to a file, which the arguments of a download require before anything is read
+	delete(raw, "output_path")
+	requests := 0
+	fixture.onRequest(func(request *http.Request) *fakeNetworkError {
+		requests++
+		return nil
+	})
+	for _, key := range []string{"range", "download_part_size", "download_parallelism"} {
+		object = schema.TestResourceDataRaw(t, ObjectStorageObjectDataSource().Schema, map[string]interface{}{
+			"namespace": fakeObjectStorageNamespace,
+			"bucket":    "bucket",
+			"object":    "kernel.img",
+			key:         raw[key],
+		})
+		if err := ObjectStorageObjectDataSource().Read(object, clients); assert.Error(t, err, key) {
+			assert.Contains(t, err.Error(), key)
+		}
+	}
+	assert.Zero(t, requests)
+
+	// Nothing is left behind but the output file
+	files, err := ioutil.ReadDir(outputDir)
+	assert.NoError(t, err)
+	assert.Len(t, files, 1)
+}
+
+func TestObjectStorageObjectDataSource_outputPathMultipart(t *testing.T) {
+	fixture := newFakeObjectStorageFixture(t)
+	defer fixture.cleanup()
+	clients := fixture.clients
+	defer func(dir string) { objectDownloadsDir = dir }(objectDownloadsDir)
+	objectDownloadsDir = fixture.tempDir()
+
+	// The object is uploaded in parts, so that its MD5 can not be checked
+	upload := func(content []byte) {
+		source := fixture.tempFile(content)
+		sourceInfo, err := os.Stat(source)
+		assert.NoError(t, err)
+		_, err = MultiPartUpload(context.Background(), MultipartUploadData{
+			NamespaceName:       oci_common.String(fakeObjectStorageNamespace),
+			BucketName:          oci_common.String("bucket"),
+			ObjectName:          oci_common.String("kernel.img"),
+			ObjectStorageClient: clients.objectStorageClient,
+			SourcePath:          oci_common.String(source),
+			SourceInfo:          &sourceInfo,
+			PartSize:            10,
+		})
+		assert.NoError(t, err)
+	}
+	content := []byte("0123456789abcdefghijABCDE")
+	upload(content)
+
+	outputPath := filepath.Join(fixture.tempDir(), "kernel.img")
+	downloads := 0
+	fixture.onRequest(func(request *http.Request) *fakeNetworkError {
+		if request.Method == http.MethodGet && request.Header.Get("range") != "" {
+			downloads++
+		}
+		return nil
+	})
+	read := func() *schema.ResourceData {
+		downloads = 0
+		object := schema.TestResourceDataRaw(t, ObjectStorageObjectDataSource().Schema, map[string]interface{}{
+			"namespace":   fakeObjectStorageNamespace,
+			"bucket":      "bucket",
+			"object":      "kernel.img",
+			"output_path": outputPath,
+		})
+		assert.NoError(t, ObjectStorageObjectDataSource().Read(object, clients))
+		return object
+	}
+
+	object := read()
+	assert.Equal(t, 1, downloads)
+	sum := md5.Sum(content)
+	assert.Equal(t, base64.StdEncoding.EncodeToString(sum[:]), object.Get("content_md5"))
+
+	// The object is not downloaded again while the file has the content of its recorded download
+	object = read()
+	assert.Zero(t, downloads)
+	assert.Equal(t, base64.StdEncoding.EncodeToString(sum[:]), object.Get("content_md5"))
+	assert.Equal(t, "25", object.Get("content_length"))
+
+	// It is when the file changes
+	assert.NoError(t, ioutil.WriteFile(outputPath, []byte("changed locally"), 0600))
+	read()
+	assert.Equal(t, 1, downloads)
+	downloaded, err := ioutil.ReadFile(outputPath)
+	assert.NoError(t, err)
+	assert.Equal(t, content, downloaded)
+
+	// Or when the object changes
+	content = []byte("ABCDEabcdefghij0123456789")
+	upload(content)
+	read()
+	assert.Equal(t, 1, downloads)
+	downloaded, err = ioutil.ReadFile(outputPath)
+	assert.NoError(t, err)
+	assert.Equal(t, content, downloaded)
+}
+
+func TestParseObjectRange(t *testing.T) {
+	testCases := []struct {
+		value       string
+		first       int64
+		last        int64
+		expectError bool
+	}{
+		{value: "bytes=0-9", first: 0, last: 9},
+		{value: "bytes=10-", first: 10, last: 24},
+		{value: "bytes=-5", first: 20, last: 24},
+		{value: "bytes=-50", first: 0, last: 24},
+		{value: "bytes=20-100", first: 20, last: 24},
+		{value: "bytes=25-", expectError: true},
+		{value: "bytes=-", expectError: true},
+		{value: "0-9", expectError: true},
+	}
+
+	for _, test := range testCases {
+		first, last, err := parseObjectRange(test.value, 25)
+		if test.expectError {
+			assert.Error(t, err, test.value)
+			continue
+		}
+		if assert.NoError(t, err, test.value) {
+			assert.Equal(t, test.first, first, test.value)
+			assert.Equal(t, test.last, last, test.value)
+		}
+	}
+}
@@ -64,7 +64,7 @@ func readObjectHead(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).objectStorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type ObjectHeadDataSourceCrud struct {
@@ -77,7 +77,7 @@ func (s *ObjectHeadDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ObjectHeadDataSourceCrud) Get() error {
+func (s *ObjectHeadDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.HeadObjectRequest{}
 
 	if namespace, ok := s.D.GetOkExists("namespace"); ok {
@@ -97,7 +97,7 @@ func (s *ObjectHeadDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "object_storage")
 
-	response, err := s.Client.HeadObject(context.Background(), request)
+	response, err := s.Client.HeadObject(ctx, request)
 	if err != nil {
 		return err
 	}
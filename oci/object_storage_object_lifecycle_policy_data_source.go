@@ -115,7 +115,7 @@ func readSingularObjectStorageObjectLifecyclePolicy(d *schema.ResourceData, m in
 	sync.D = d
 	sync.Client = m.(*OracleClients).objectStorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type ObjectStorageObjectLifecyclePolicyDataSourceCrud struct {
@@ -128,7 +128,7 @@ func (s *ObjectStorageObjectLifecyclePolicyDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ObjectStorageObjectLifecyclePolicyDataSourceCrud) Get() error {
+func (s *ObjectStorageObjectLifecyclePolicyDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.GetObjectLifecyclePolicyRequest{}
 
 	if bucket, ok := s.D.GetOkExists("bucket"); ok {
@@ -143,7 +143,7 @@ func (s *ObjectStorageObjectLifecyclePolicyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "object_storage")
 
-	response, err := s.Client.GetObjectLifecyclePolicy(context.Background(), request)
+	response, err := s.Client.GetObjectLifecyclePolicy(ctx, request)
 	if err != nil {
 		return err
 	}
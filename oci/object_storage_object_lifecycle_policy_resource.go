@@ -142,7 +142,7 @@ func createObjectStorageObjectLifecyclePolicy(d *schema.ResourceData, m interfac
 	sync.D = d
 	sync.Client = m.(*OracleClients).objectStorageClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readObjectStorageObjectLifecyclePolicy(d *schema.ResourceData, m interface{}) error {
@@ -150,7 +150,7 @@ func readObjectStorageObjectLifecyclePolicy(d *schema.ResourceData, m interface{
 	sync.D = d
 	sync.Client = m.(*OracleClients).objectStorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateObjectStorageObjectLifecyclePolicy(d *schema.ResourceData, m interface{}) error {
@@ -158,7 +158,7 @@ func updateObjectStorageObjectLifecyclePolicy(d *schema.ResourceData, m interfac
 	sync.D = d
 	sync.Client = m.(*OracleClients).objectStorageClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteObjectStorageObjectLifecyclePolicy(d *schema.ResourceData, m interface{}) error {
@@ -167,7 +167,7 @@ func deleteObjectStorageObjectLifecyclePolicy(d *schema.ResourceData, m interfac
 	sync.Client = m.(*OracleClients).objectStorageClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type ObjectStorageObjectLifecyclePolicyResourceCrud struct {
@@ -181,7 +181,7 @@ func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) ID() string {
 	return getObjectLifecyclePolicyCompositeId(s.D.Get("bucket").(string), s.D.Get("namespace").(string))
 }
 
-func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Create() error {
+func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Create(ctx context.Context) error {
 	request := oci_object_storage.PutObjectLifecyclePolicyRequest{}
 
 	if bucket, ok := s.D.GetOkExists("bucket"); ok {
@@ -213,7 +213,7 @@ func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.PutObjectLifecyclePolicy(context.Background(), request)
+	response, err := s.Client.PutObjectLifecyclePolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -222,7 +222,7 @@ func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Create() error {
 	return nil
 }
 
-func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Get() error {
+func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.GetObjectLifecyclePolicyRequest{}
 
 	if bucket, ok := s.D.GetOkExists("bucket"); ok {
@@ -245,7 +245,7 @@ func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.GetObjectLifecyclePolicy(context.Background(), request)
+	response, err := s.Client.GetObjectLifecyclePolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -254,7 +254,7 @@ func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Update() error {
+func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Update(ctx context.Context) error {
 	request := oci_object_storage.PutObjectLifecyclePolicyRequest{}
 
 	if bucket, ok := s.D.GetOkExists("bucket"); ok {
@@ -286,7 +286,7 @@ func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.PutObjectLifecyclePolicy(context.Background(), request)
+	response, err := s.Client.PutObjectLifecyclePolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -295,7 +295,7 @@ func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Update() error {
 	return nil
 }
 
-func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Delete() error {
+func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Delete(ctx context.Context) error {
 	request := oci_object_storage.DeleteObjectLifecyclePolicyRequest{}
 
 	if bucket, ok := s.D.GetOkExists("bucket"); ok {
@@ -310,7 +310,7 @@ func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	_, err := s.Client.DeleteObjectLifecyclePolicy(context.Background(), request)
+	_, err := s.Client.DeleteObjectLifecyclePolicy(ctx, request)
 	return err
 }
 
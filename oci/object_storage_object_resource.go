@@ -11,13 +11,12 @@ import (
 	"bytes"
 	"io/ioutil"
 	"log"
-	"net/url"
 	"os"
 	"regexp"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_object_storage "github.com/oracle/oci-go-sdk/objectstorage"
 )
 
@@ -114,13 +113,27 @@ func ObjectStorageObjectResource() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validateLowerCaseKeysInMetadata,
 			},
+			"multipart_parallelism": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntBetween(1, maxNumberOfGoroutines),
+				ConflictsWith: []string{"content", "source_uri_details"},
+			},
+			"multipart_part_size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				// Only used to upload the source, which is not replaced when the part size changes
+				ValidateFunc:  validateMultipartPartSize,
+				ConflictsWith: []string{"content", "source_uri_details"},
+			},
 			"source": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"content", "source_uri_details"},
-				StateFunc:     setSourceState,
-				ValidateFunc:  validateSourceValue,
+				// Changes of the content of the source are detected from its MD5, as per CustomizeDiff
+				DiffSuppressFunc: suppressLegacySourceDiff,
+				ValidateFunc:     validateSourceValue,
 			},
 			"source_uri_details": {
 				Type:          schema.TypeList,
@@ -188,6 +201,9 @@ func ObjectStorageObjectResource() *schema.Resource {
 				Computed: true,
 			},
 		},
+		// CustomizeDiff for Object resource
+		// Changes of the content of 'source', or of the object since it was uploaded from it, result in Force New
+		CustomizeDiff: customizeDiffObjectStorageObjectSource,
 	}
 }
 
@@ -196,21 +212,57 @@ func createObjectStorageObject(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).objectStorageClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
-func setSourceState(source interface{}) string {
-	sourcePath := source.(string)
-	sourceInfo, err := os.Stat(sourcePath)
+// legacySourceModTime matches the modification time of the source that was previously recorded in the state along with
+// its path, as "<path> <modification time>"
+var legacySourceModTime = regexp.MustCompile(` \d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}(\.\d+)? [+-]\d{4} \S+$`)
+
+// sourcePath returns the path of the source recorded in the state, without the modification time of legacy states
+func sourcePath(source string) string {
+	return legacySourceModTime.ReplaceAllString(source, "")
+}
+
+// suppressLegacySourceDiff ignores the modification time of the source recorded in legacy states
+func suppressLegacySourceDiff(key string, old string, new string, d *schema.ResourceData) bool {
+	return old == new || (new != "" && sourcePath(old) == new)
+}
+
+// customizeDiffObjectStorageObjectSource replaces the object when the MD5 of its source no longer matches the MD5 of
+// the object, which is refreshed from the service. This detects changes of the content of the source, whatever its
+// modification time, as well as changes of the object made outside of Terraform.
+func customizeDiffObjectStorageObjectSource(diff *schema.ResourceDiff, m interface{}) error {
+	// The source of a legacy state, recorded with its modification time, has not changed as long as its path has not
+	oldSource, newSource := diff.GetChange("source")
+	if diff.Id() == "" || sourcePath(oldSource.(string)) != newSource.(string) {
+		return nil
+	}
+	source := newSource.(string)
+	objectMd5, _ := diff.Get("content_md5").(string)
+	if source == "" || objectMd5 == "" {
+		return nil
+	}
 
+	// The MD5 of an object uploaded in parts is computed from the MD5s of its parts, of the size it was uploaded with
+	partSize, _ := diff.GetChange("multipart_part_size")
+	sourceMd5, err := computeSourceMd5(source, isMultipartMd5(objectMd5), int64(partSize.(int)))
 	if err != nil {
-		return sourcePath
+		log.Printf("[WARN] Could not compute the MD5 of source %s of object %s: %v", source, diff.Id(), err)
+		return nil
+	}
+	if sourceMd5 == objectMd5 {
+		return nil
 	}
 
-	return sourcePath + " " + sourceInfo.ModTime().String()
+	log.Printf("[DEBUG] The MD5 %s of source %s does not match the MD5 %s of object %s, replacing the object", sourceMd5, source, objectMd5, diff.Id())
+	if err := diff.SetNew("content_md5", sourceMd5); err != nil {
+		return err
+	}
+	return diff.ForceNew("content_md5")
 }
 
-func (s *ObjectStorageObjectResourceCrud) createMultiPartObject() error {
+func (s *ObjectStorageObjectResourceCrud) createMultiPartObject(ctx context.Context) error {
 	multipartUploadData := MultipartUploadData{}
 
 	source, ok := s.D.GetOkExists("source")
@@ -260,13 +312,21 @@ func (s *ObjectStorageObjectResourceCrud) createMultiPartObject() error {
 		multipartUploadData.ObjectName = &tmp
 	}
 
+	if partSize, ok := s.D.GetOkExists("multipart_part_size"); ok {
+		multipartUploadData.PartSize = int64(partSize.(int))
+	}
+
+	if parallelism, ok := s.D.GetOkExists("multipart_parallelism"); ok {
+		multipartUploadData.Parallelism = parallelism.(int)
+	}
+
 	multipartUploadData.ObjectStorageClient = s.Client
 	multipartUploadData.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
 	s.D.Set("work_request_id", "")
 	s.D.Set("state", oci_object_storage.WorkRequestStatusInProgress)
 
-	id, multipartInitErr := MultiPartUpload(multipartUploadData)
+	id, multipartInitErr := MultiPartUpload(ctx, multipartUploadData)
 	if multipartInitErr != nil {
 		return multipartInitErr
 	}
@@ -274,10 +334,10 @@ func (s *ObjectStorageObjectResourceCrud) createMultiPartObject() error {
 	s.D.SetId(id)
 	s.D.Set("state", oci_object_storage.WorkRequestStatusCompleted)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *ObjectStorageObjectResourceCrud) createCopyObject() error {
+func (s *ObjectStorageObjectResourceCrud) createCopyObject(ctx context.Context) error {
 
 	copyObjectRequest := oci_object_storage.CopyObjectRequest{}
 
@@ -363,7 +423,7 @@ func (s *ObjectStorageObjectResourceCrud) createCopyObject() error {
 	}
 
 	if workRequestId == "" {
-		copyObjectResponse, err := s.SourceRegionClient.CopyObject(context.Background(), copyObjectRequest)
+		copyObjectResponse, err := s.SourceRegionClient.CopyObject(ctx, copyObjectRequest)
 		if err != nil {
 			s.D.Set("state", string(oci_object_storage.WorkRequestStatusCanceled))
 			return err
@@ -377,14 +437,14 @@ func (s *ObjectStorageObjectResourceCrud) createCopyObject() error {
 	getWorkRequestRequest := oci_object_storage.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = &workRequestId
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
 
 	copyTimeout := *DefaultTimeout.Create
-	err = copyObjectWaitForWorkRequest(&workRequestId, "object", copyTimeout, s.DisableNotFoundRetries, s.SourceRegionClient)
+	_, err = waitForWorkRequest(ctx, newObjectStorageWorkRequestTracker(s.SourceRegionClient, s.DisableNotFoundRetries), &workRequestId, copyTimeout)
 
 	if err != nil {
 		// we are not able to verify the state of workRequest
@@ -396,7 +456,7 @@ func (s *ObjectStorageObjectResourceCrud) createCopyObject() error {
 	s.D.Set("state", string(oci_object_storage.WorkRequestStatusCompleted))
 	id := getObjectCompositeId(*copyObjectRequest.DestinationBucket, *copyObjectRequest.DestinationNamespace, *copyObjectRequest.DestinationObjectName)
 	s.D.SetId(id)
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func readObjectStorageObject(d *schema.ResourceData, m interface{}) error {
@@ -418,7 +478,7 @@ func readObjectStorageObject(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).objectStorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateObjectStorageObject(d *schema.ResourceData, m interface{}) error {
@@ -426,7 +486,7 @@ func updateObjectStorageObject(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).objectStorageClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteObjectStorageObject(d *schema.ResourceData, m interface{}) error {
@@ -435,7 +495,7 @@ func deleteObjectStorageObject(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).objectStorageClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 // There's no struct to represent this in SDK, so we define our own including a fake LifecycleState
@@ -461,17 +521,17 @@ func (s *ObjectStorageObjectResourceCrud) ID() string {
 	return getObjectCompositeId(s.D.Get("bucket").(string), s.D.Get("namespace").(string), s.D.Get("object").(string))
 }
 
-func (s *ObjectStorageObjectResourceCrud) Create() error {
+func (s *ObjectStorageObjectResourceCrud) Create(ctx context.Context) error {
 
 	if s.isCopyCreate() {
-		return s.createCopyObject()
+		return s.createCopyObject(ctx)
 	}
 
 	if s.isMultiPartCreate() {
-		return s.createMultiPartObject()
+		return s.createMultiPartObject(ctx)
 	}
 
-	return s.createContentObject()
+	return s.createContentObject(ctx)
 }
 
 func (s *ObjectStorageObjectResourceCrud) CreatedPending() []string {
@@ -490,7 +550,7 @@ func (s *ObjectStorageObjectResourceCrud) CreatedTarget() []string {
 	}
 }
 
-func (s *ObjectStorageObjectResourceCrud) createContentObject() error {
+func (s *ObjectStorageObjectResourceCrud) createContentObject(ctx context.Context) error {
 	request := oci_object_storage.PutObjectRequest{}
 
 	if contentEncoding, ok := s.D.GetOkExists("content_encoding"); ok {
@@ -548,7 +608,7 @@ func (s *ObjectStorageObjectResourceCrud) createContentObject() error {
 	s.D.Set("work_request_id", "")
 	s.D.Set("state", oci_object_storage.WorkRequestStatusInProgress)
 
-	_, err := s.Client.PutObject(context.Background(), request)
+	_, err := s.Client.PutObject(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -560,10 +620,10 @@ func (s *ObjectStorageObjectResourceCrud) createContentObject() error {
 	// Get() implementation to retrieve the state of the object and set its state to completed
 	s.D.Set("state", oci_object_storage.WorkRequestStatusCompleted)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *ObjectStorageObjectResourceCrud) getObjectHead() error {
+func (s *ObjectStorageObjectResourceCrud) getObjectHead(ctx context.Context) error {
 
 	headObjectRequest := &oci_object_storage.HeadObjectRequest{}
 
@@ -579,7 +639,7 @@ func (s *ObjectStorageObjectResourceCrud) getObjectHead() error {
 
 	headObjectRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	headObjectResponse, err := s.Client.HeadObject(context.Background(), *headObjectRequest)
+	headObjectResponse, err := s.Client.HeadObject(ctx, *headObjectRequest)
 	if err != nil {
 		return err
 	}
@@ -595,7 +655,7 @@ func (s *ObjectStorageObjectResourceCrud) getObjectHead() error {
 	return nil
 }
 
-func (s *ObjectStorageObjectResourceCrud) updateState() (bool, error) {
+func (s *ObjectStorageObjectResourceCrud) updateState(ctx context.Context) (bool, error) {
 	if state, ok := s.D.GetOkExists("state"); ok {
 		if state == oci_object_storage.WorkRequestStatusInProgress {
 
@@ -623,7 +683,7 @@ func (s *ObjectStorageObjectResourceCrud) updateState() (bool, error) {
 					return false, fmt.Errorf("no source_uri_details specified to verify copy state by WorkRequest")
 				}
 
-				workRequestResponse, err := s.SourceRegionClient.GetWorkRequest(context.Background(), getWorkRequestRequest)
+				workRequestResponse, err := s.SourceRegionClient.GetWorkRequest(ctx, getWorkRequestRequest)
 				if err != nil {
 					return false, err
 				}
@@ -668,9 +728,9 @@ func (s *ObjectStorageObjectResourceCrud) isCopyCreate() bool {
 	return false
 }
 
-func (s *ObjectStorageObjectResourceCrud) Get() error {
+func (s *ObjectStorageObjectResourceCrud) Get(ctx context.Context) error {
 
-	workRequestFinished, err := s.updateState()
+	workRequestFinished, err := s.updateState(ctx)
 	if err != nil {
 		return err
 	}
@@ -681,13 +741,13 @@ func (s *ObjectStorageObjectResourceCrud) Get() error {
 	}
 
 	if s.shouldUseObjectHeadForGet() {
-		return s.getObjectHead()
+		return s.getObjectHead(ctx)
 	}
 
-	return s.getObject()
+	return s.getObject(ctx)
 }
 
-func (s *ObjectStorageObjectResourceCrud) getObject() error {
+func (s *ObjectStorageObjectResourceCrud) getObject(ctx context.Context) error {
 	request := oci_object_storage.GetObjectRequest{}
 
 	bucketName, namespaceName, objectName, err := parseObjectCompositeId(s.D.Id())
@@ -704,7 +764,7 @@ func (s *ObjectStorageObjectResourceCrud) getObject() error {
 	// to call Get() all the time
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.GetObject(context.Background(), request)
+	response, err := s.Client.GetObject(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -722,10 +782,14 @@ func (s *ObjectStorageObjectResourceCrud) getObject() error {
 	return nil
 }
 
-func (s *ObjectStorageObjectResourceCrud) Update() error {
+func (s *ObjectStorageObjectResourceCrud) Update(ctx context.Context) error {
 
 	// @CODEGEN 06/2018: Update is only supported for the change in name - all others are a forceNew
 	if !s.D.HasChange("object") {
+		// The multipart settings only apply to the next upload of the source
+		if s.D.HasChange("multipart_part_size") || s.D.HasChange("multipart_parallelism") {
+			return s.Get(ctx)
+		}
 		return fmt.Errorf("unexpected change encountered")
 	}
 	request := oci_object_storage.RenameObjectRequest{}
@@ -746,17 +810,17 @@ func (s *ObjectStorageObjectResourceCrud) Update() error {
 	request.NewName = &newName
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
-	_, err := s.Client.RenameObject(context.Background(), request)
+	_, err := s.Client.RenameObject(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	updatedId := getObjectCompositeId(*request.BucketName, *request.NamespaceName, *request.NewName)
 	s.D.SetId(updatedId)
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *ObjectStorageObjectResourceCrud) Delete() error {
+func (s *ObjectStorageObjectResourceCrud) Delete(ctx context.Context) error {
 	request := oci_object_storage.DeleteObjectRequest{}
 
 	if bucket, ok := s.D.GetOkExists("bucket"); ok {
@@ -776,7 +840,7 @@ func (s *ObjectStorageObjectResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	_, err := s.Client.DeleteObject(context.Background(), request)
+	_, err := s.Client.DeleteObject(ctx, request)
 	return err
 }
 
@@ -832,23 +896,15 @@ func (s *ObjectStorageObjectResourceCrud) setDataObjectHead() error {
 // "tfobm-object-<namespace_name>/<bucket_name>/<object_name>"
 // Update - Id format updated to "n/tfobm-object-<namespace_name>/b/<bucket_name>/o/<object_name>"
 func getObjectCompositeId(bucket string, namespace string, object string) string {
-	bucket = url.PathEscape(bucket)
-	namespace = url.PathEscape(namespace)
-	object = url.PathEscape(object)
-	compositeId := "n/" + namespace + "/b/" + bucket + "/o/" + object
-	return compositeId
+	return getCompositeId("n", namespace, "b", bucket, "o", object)
 }
 
 func parseObjectCompositeId(compositeId string) (bucket string, namespace string, object string, err error) {
-	parts := strings.Split(compositeId, "/")
-	match, _ := regexp.MatchString("n/.*/b/.*/o/.*", compositeId)
-	if !match || len(parts) != 6 {
-		err = fmt.Errorf("illegal compositeId %s encountered", compositeId)
+	values, err := parseCompositeId(compositeId, "n", "b", "o")
+	if err != nil {
 		return
 	}
-	namespace, _ = url.PathUnescape(parts[1])
-	bucket, _ = url.PathUnescape(parts[3])
-	object, _ = url.PathUnescape(parts[5])
+	namespace, bucket, object = values[0], values[1], values[2]
 
 	return
 }
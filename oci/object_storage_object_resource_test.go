@@ -0,0 +1,119 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_object_storage "github.com/oracle/oci-go-sdk/objectstorage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectStorageObjectResource_sourceChanges(t *testing.T) {
+	fixture := newFakeObjectStorageFixture(t)
+	defer fixture.cleanup()
+	clients := fixture.clients
+
+	source := fixture.tempFile([]byte("first version"))
+
+	raw := map[string]interface{}{
+		"namespace": fakeObjectStorageNamespace,
+		"bucket":    "bucket",
+		"object":    "artifact",
+		"source":    source,
+	}
+	object := schema.TestResourceDataRaw(t, ObjectStorageObjectResource().Schema, raw)
+	assert.NoError(t, ObjectStorageObjectResource().Create(object, clients))
+	assert.Equal(t, source, object.Get("source"))
+	state := object.State()
+
+	rawConfig, err := config.NewRawConfig(raw)
+	assert.NoError(t, err)
+	diff := func(state *terraform.InstanceState) *terraform.InstanceDiff {
+		diff, err := ObjectStorageObjectResource().Diff(state, terraform.NewResourceConfig(rawConfig), clients)
+		assert.NoError(t, err)
+		return diff
+	}
+
+	// The modification time of the source does not matter, nor does the one recorded in the state by older versions
+	assert.NoError(t, os.Chtimes(source, time.Now(), time.Now().Add(time.Hour)))
+	assert.Nil(t, diff(state))
+	legacyState := state.DeepCopy()
+	legacyState.Attributes["source"] = source + " 2019-05-02 10:00:00 +0000 UTC"
+	assert.Nil(t, diff(legacyState))
+
+	// A change of the content of the source replaces the object, including with a legacy state
+	assert.NoError(t, ioutil.WriteFile(source, []byte("second version"), 0644))
+	for _, changedState := range []*terraform.InstanceState{state, legacyState} {
+		if changes := diff(changedState); assert.NotNil(t, changes) {
+			assert.True(t, changes.RequiresNew())
+			assert.True(t, changes.Attributes["content_md5"].RequiresNew)
+		}
+	}
+
+	// So does a change of the object outside of Terraform, once the object is refreshed
+	assert.NoError(t, ioutil.WriteFile(source, []byte("first version"), 0644))
+	assert.Nil(t, diff(state))
+	putFakeObject(t, clients.objectStorageClient, "bucket", "artifact", []byte("changed elsewhere"))
+	object = ObjectStorageObjectResource().Data(state)
+	assert.NoError(t, ObjectStorageObjectResource().Read(object, clients))
+	if changes := diff(object.State()); assert.NotNil(t, changes) {
+		assert.True(t, changes.RequiresNew())
+	}
+}
+
+func TestComputeSourceMd5_multipart(t *testing.T) {
+	fixture := newFakeObjectStorageFixture(t)
+	defer fixture.cleanup()
+	client := fixture.client
+	ctx := context.Background()
+
+	content := []byte("uploaded in a single part")
+	source := fixture.tempFile(content)
+
+	upload, err := client.CreateMultipartUpload(ctx, oci_object_storage.CreateMultipartUploadRequest{
+		NamespaceName:                oci_common.String(fakeObjectStorageNamespace),
+		BucketName:                   oci_common.String("bucket"),
+		CreateMultipartUploadDetails: oci_object_storage.CreateMultipartUploadDetails{Object: oci_common.String("object")},
+	})
+	assert.NoError(t, err)
+	part, err := client.UploadPart(ctx, oci_object_storage.UploadPartRequest{
+		NamespaceName:  oci_common.String(fakeObjectStorageNamespace),
+		BucketName:     oci_common.String("bucket"),
+		ObjectName:     oci_common.String("object"),
+		UploadId:       upload.UploadId,
+		UploadPartNum:  oci_common.Int(1),
+		ContentLength:  oci_common.Int64(int64(len(content))),
+		UploadPartBody: ioutil.NopCloser(bytes.NewReader(content)),
+	})
+	assert.NoError(t, err)
+	commit, err := client.CommitMultipartUpload(ctx, oci_object_storage.CommitMultipartUploadRequest{
+		NamespaceName: oci_common.String(fakeObjectStorageNamespace),
+		BucketName:    oci_common.String("bucket"),
+		ObjectName:    oci_common.String("object"),
+		UploadId:      upload.UploadId,
+		CommitMultipartUploadDetails: oci_object_storage.CommitMultipartUploadDetails{
+			PartsToCommit: []oci_object_storage.CommitMultipartUploadPartDetails{{PartNum: oci_common.Int(1), Etag: part.ETag}},
+		},
+	})
+	assert.NoError(t, err)
+
+	assert.True(t, isMultipartMd5(*commit.OpcMultipartMd5))
+	sourceMd5, err := computeSourceMd5(source, true, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, *commit.OpcMultipartMd5, sourceMd5)
+
+	assert.False(t, isMultipartMd5(*part.OpcContentMd5))
+	sourceMd5, err = computeSourceMd5(source, false, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, *part.OpcContentMd5, sourceMd5)
+}
@@ -0,0 +1,603 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_object_storage "github.com/oracle/oci-go-sdk/objectstorage"
+)
+
+// ObjectStorageObjectSetResource uploads the files of a local directory as the objects of a bucket under a prefix, and
+// keeps them in sync with the files. Only the files whose MD5 does not match the MD5 of their object are uploaded.
+func ObjectStorageObjectSetResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importObjectStorageObjectSet,
+		},
+		Timeouts: DefaultTimeout,
+		Create:   createObjectStorageObjectSet,
+		Read:     readObjectStorageObjectSet,
+		Update:   updateObjectStorageObjectSet,
+		Delete:   deleteObjectStorageObjectSet,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Optional
+			"delete_removed_objects": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"exclude": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateObjectSetPattern,
+				},
+			},
+			"include": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateObjectSetPattern,
+				},
+			},
+			"multipart_parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, maxNumberOfGoroutines),
+			},
+			"multipart_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateMultipartPartSize,
+			},
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+				ForceNew: true,
+			},
+
+			// Computed
+			"manifest_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"object_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+		// CustomizeDiff for ObjectSet resource
+		// The manifest of the files of the source directory is compared with the manifest of the objects, so that the
+		// object set is updated when a file changes, or when an object is changed outside of Terraform
+		CustomizeDiff: customizeDiffObjectStorageObjectSet,
+	}
+}
+
+func createObjectStorageObjectSet(d *schema.ResourceData, m interface{}) error {
+	sync := &ObjectStorageObjectSetResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).objectStorageClient
+
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
+}
+
+func readObjectStorageObjectSet(d *schema.ResourceData, m interface{}) error {
+	sync := &ObjectStorageObjectSetResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).objectStorageClient
+
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
+}
+
+func updateObjectStorageObjectSet(d *schema.ResourceData, m interface{}) error {
+	sync := &ObjectStorageObjectSetResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).objectStorageClient
+
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
+}
+
+func deleteObjectStorageObjectSet(d *schema.ResourceData, m interface{}) error {
+	sync := &ObjectStorageObjectSetResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).objectStorageClient
+	sync.DisableNotFoundRetries = true
+
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
+}
+
+// importObjectStorageObjectSet imports the objects of a bucket under a prefix, with an ID of the form
+// n/{namespaceName}/b/{bucketName}/objectSets/{prefix}
+func importObjectStorageObjectSet(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	bucket, namespace, prefix, err := parseObjectSetCompositeId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.Set("bucket", bucket)
+	d.Set("namespace", namespace)
+	d.Set("prefix", prefix)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// ObjectStorageObjectSet is the MD5 of every object of the set, by object name
+type ObjectStorageObjectSet struct {
+	Objects map[string]string
+}
+
+type ObjectStorageObjectSetResourceCrud struct {
+	BaseCrud
+	Client                 *oci_object_storage.ObjectStorageClient
+	Res                    *ObjectStorageObjectSet
+	DisableNotFoundRetries bool
+}
+
+// objectSetSourceFile is a file of the source directory of an object set, and the name of its object
+type objectSetSourceFile struct {
+	path   string
+	object string
+	info   os.FileInfo
+}
+
+// md5 returns the MD5 of the object uploaded from the file with parts of partSize, so that the objects are uploaded again
+// when the part size changes
+func (file objectSetSourceFile) md5(partSize int64) (string, error) {
+	return computeSourceMd5(file.path, file.info.Size() > multipartPartSize(partSize), partSize)
+}
+
+func (s *ObjectStorageObjectSetResourceCrud) ID() string {
+	return getObjectSetCompositeId(s.D.Get("bucket").(string), s.D.Get("namespace").(string), s.D.Get("prefix").(string))
+}
+
+func (s *ObjectStorageObjectSetResourceCrud) Create(ctx context.Context) error {
+	return s.sync(ctx)
+}
+
+func (s *ObjectStorageObjectSetResourceCrud) Update(ctx context.Context) error {
+	return s.sync(ctx)
+}
+
+// sync uploads the files whose MD5 does not match the MD5 of their object, and deletes the objects left without a file
+// when delete_removed_objects is set
+func (s *ObjectStorageObjectSetResourceCrud) sync(ctx context.Context) error {
+	files, err := listObjectSetSourceFiles(s.D.Get("source_dir").(string), s.D.Get("prefix").(string), s.patterns("include"), s.patterns("exclude"))
+	if err != nil {
+		return err
+	}
+	if err := s.Get(ctx); err != nil {
+		return err
+	}
+
+	changed := []objectSetSourceFile{}
+	for _, file := range files {
+		objectMd5, exists := s.Res.Objects[file.object]
+		if exists {
+			fileMd5, err := file.md5(int64(s.D.Get("multipart_part_size").(int)))
+			if err != nil {
+				return err
+			}
+			if fileMd5 == objectMd5 {
+				continue
+			}
+		}
+		changed = append(changed, file)
+	}
+	log.Printf("[INFO] Uploading %d of the %d files of %s to bucket %s", len(changed), len(files), s.D.Get("source_dir"), s.D.Get("bucket"))
+	if err := s.upload(ctx, changed); err != nil {
+		return err
+	}
+
+	if s.D.Get("delete_removed_objects").(bool) {
+		present := map[string]bool{}
+		for _, file := range files {
+			present[file.object] = true
+		}
+		removed := []string{}
+		for object := range s.Res.Objects {
+			if !present[object] {
+				removed = append(removed, object)
+			}
+		}
+		sort.Strings(removed)
+		log.Printf("[INFO] Deleting %d objects without a file from bucket %s", len(removed), s.D.Get("bucket"))
+		if err := deleteObjects(ctx, s.Client, s.D.Get("namespace").(string), s.D.Get("bucket").(string), removed, defaultNumberOfGoroutines); err != nil {
+			return err
+		}
+	}
+
+	return s.Get(ctx)
+}
+
+// upload uploads files with MultiPartUpload, at most defaultNumberOfGoroutines at a time, and returns the first error
+func (s *ObjectStorageObjectSetResourceCrud) upload(ctx context.Context, files []objectSetSourceFile) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pending := make(chan objectSetSourceFile, len(files))
+	for _, file := range files {
+		pending <- file
+	}
+	close(pending)
+
+	errs := make(chan error, defaultNumberOfGoroutines)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < defaultNumberOfGoroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for file := range pending {
+				if ctx.Err() != nil {
+					return
+				}
+				if _, err := MultiPartUpload(ctx, s.multipartUploadData(file)); err != nil {
+					errs <- fmt.Errorf("failed to upload %s as object %s: %s", file.path, file.object, err)
+					cancel()
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	if err := <-errs; err != nil {
+		return err
+	}
+	return ctx.Err()
+}
+
+func (s *ObjectStorageObjectSetResourceCrud) multipartUploadData(file objectSetSourceFile) MultipartUploadData {
+	multipartUploadData := MultipartUploadData{}
+
+	multipartUploadData.SourcePath = oci_common.String(file.path)
+	multipartUploadData.SourceInfo = &file.info
+	multipartUploadData.ObjectName = oci_common.String(file.object)
+
+	if contentType := mime.TypeByExtension(filepath.Ext(file.path)); contentType != "" {
+		multipartUploadData.ContentType = &contentType
+	}
+
+	if bucket, ok := s.D.GetOkExists("bucket"); ok {
+		tmp := bucket.(string)
+		multipartUploadData.BucketName = &tmp
+	}
+
+	if namespace, ok := s.D.GetOkExists("namespace"); ok {
+		tmp := namespace.(string)
+		multipartUploadData.NamespaceName = &tmp
+	}
+
+	multipartUploadData.PartSize = int64(s.D.Get("multipart_part_size").(int))
+	multipartUploadData.Parallelism = s.D.Get("multipart_parallelism").(int)
+
+	multipartUploadData.ObjectStorageClient = s.Client
+	multipartUploadData.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+
+	return multipartUploadData
+}
+
+// Get lists the objects under the prefix of the object set that match its include and exclude patterns
+func (s *ObjectStorageObjectSetResourceCrud) Get(ctx context.Context) error {
+	request := oci_object_storage.ListObjectsRequest{}
+
+	if bucket, ok := s.D.GetOkExists("bucket"); ok {
+		tmp := bucket.(string)
+		request.BucketName = &tmp
+	}
+
+	if namespace, ok := s.D.GetOkExists("namespace"); ok {
+		tmp := namespace.(string)
+		request.NamespaceName = &tmp
+	}
+
+	prefix := s.D.Get("prefix").(string)
+	if prefix != "" {
+		request.Prefix = &prefix
+	}
+
+	request.Fields = oci_common.String("name,md5")
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+
+	include, exclude := s.patterns("include"), s.patterns("exclude")
+	res := &ObjectStorageObjectSet{Objects: map[string]string{}}
+	for {
+		response, err := s.Client.ListObjects(ctx, request)
+		if err != nil {
+			return err
+		}
+
+		for _, object := range response.Objects {
+			if object.Name == nil || !matchesObjectSetPatterns(strings.TrimPrefix(*object.Name, prefix), include, exclude) {
+				continue
+			}
+			res.Objects[*object.Name] = ""
+			if object.Md5 != nil {
+				res.Objects[*object.Name] = *object.Md5
+			}
+		}
+
+		if response.NextStartWith == nil || *response.NextStartWith == "" {
+			break
+		}
+		request.Start = response.NextStartWith
+	}
+
+	s.Res = res
+	return nil
+}
+
+// Delete deletes the objects of the files of the source directory, and when delete_removed_objects is set or the files
+// can not be listed, every object of the object set
+func (s *ObjectStorageObjectSetResourceCrud) Delete(ctx context.Context) error {
+	objects := map[string]bool{}
+
+	files, err := listObjectSetSourceFiles(s.D.Get("source_dir").(string), s.D.Get("prefix").(string), s.patterns("include"), s.patterns("exclude"))
+	if err != nil {
+		log.Printf("[WARN] Could not list the files of %s, deleting all the objects of the object set: %v", s.D.Get("source_dir"), err)
+	}
+	for _, file := range files {
+		objects[file.object] = true
+	}
+
+	if err != nil || s.D.Get("delete_removed_objects").(bool) {
+		if err := s.Get(ctx); err != nil {
+			return err
+		}
+		for object := range s.Res.Objects {
+			objects[object] = true
+		}
+	}
+
+	names := []string{}
+	for object := range objects {
+		names = append(names, object)
+	}
+	sort.Strings(names)
+
+	return deleteObjects(ctx, s.Client, s.D.Get("namespace").(string), s.D.Get("bucket").(string), names, defaultNumberOfGoroutines)
+}
+
+func (s *ObjectStorageObjectSetResourceCrud) SetData() error {
+	bucket, namespace, prefix, err := parseObjectSetCompositeId(s.D.Id())
+	if err == nil {
+		s.D.Set("bucket", bucket)
+		s.D.Set("namespace", namespace)
+		s.D.Set("prefix", prefix)
+	} else {
+		log.Printf("[WARN] SetData() unable to parse current ID: %s", s.D.Id())
+	}
+
+	// The manifest has an entry for each file of the source directory, with the MD5 of its object if any, so that it
+	// matches the manifest of the files only when every file has been uploaded
+	objects := map[string]string{}
+	files, err := listObjectSetSourceFiles(s.D.Get("source_dir").(string), s.D.Get("prefix").(string), s.patterns("include"), s.patterns("exclude"))
+	if err != nil {
+		log.Printf("[WARN] Could not list the files of %s, the manifest of the object set is made of all its objects: %v", s.D.Get("source_dir"), err)
+	}
+	for _, file := range files {
+		objects[file.object] = s.Res.Objects[file.object]
+	}
+	if err != nil || s.D.Get("delete_removed_objects").(bool) {
+		for object, objectMd5 := range s.Res.Objects {
+			objects[object] = objectMd5
+		}
+	}
+
+	// The objects are counted from the manifest, like in the plan of customizeDiffObjectStorageObjectSet
+	s.D.Set("manifest_hash", objectSetManifestHash(objects))
+	s.D.Set("object_count", len(objects))
+
+	return nil
+}
+
+func (s *ObjectStorageObjectSetResourceCrud) patterns(key string) []string {
+	return objectSetPatterns(s.D.Get(key))
+}
+
+// customizeDiffObjectStorageObjectSet sets the manifest hash of the object set to the one of the files of its source
+// directory, which differs from the one of the objects when they are not in sync
+func customizeDiffObjectStorageObjectSet(diff *schema.ResourceDiff, m interface{}) error {
+	if !diff.NewValueKnown("source_dir") || !diff.NewValueKnown("prefix") || !diff.NewValueKnown("include") || !diff.NewValueKnown("exclude") {
+		return diff.SetNewComputed("manifest_hash")
+	}
+
+	files, err := listObjectSetSourceFiles(diff.Get("source_dir").(string), diff.Get("prefix").(string), objectSetPatterns(diff.Get("include")), objectSetPatterns(diff.Get("exclude")))
+	if err != nil {
+		return err
+	}
+
+	objects := map[string]string{}
+	for _, file := range files {
+		objects[file.object], err = file.md5(int64(diff.Get("multipart_part_size").(int)))
+		if err != nil {
+			return err
+		}
+	}
+
+	manifestHash := objectSetManifestHash(objects)
+	if diff.Get("manifest_hash").(string) == manifestHash {
+		return nil
+	}
+	log.Printf("[DEBUG] The objects of object set %s are not in sync with the %d files of %s", diff.Id(), len(files), diff.Get("source_dir"))
+	if err := diff.SetNew("object_count", len(objects)); err != nil {
+		return err
+	}
+	return diff.SetNew("manifest_hash", manifestHash)
+}
+
+// listObjectSetSourceFiles lists the regular files of a source directory matching the include and exclude patterns,
+// sorted by object name
+func listObjectSetSourceFiles(sourceDir string, prefix string, include []string, exclude []string) ([]objectSetSourceFile, error) {
+	if sourceDir == "" {
+		return nil, fmt.Errorf("the source directory is not specified")
+	}
+
+	files := []objectSetSourceFile{}
+	err := filepath.Walk(sourceDir, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		// Symbolic links are followed to the files they point to
+		if info.Mode()&os.ModeSymlink != 0 {
+			if info, err = os.Stat(filePath); err != nil {
+				return err
+			}
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(sourceDir, filePath)
+		if err != nil {
+			return err
+		}
+		relativePath = filepath.ToSlash(relativePath)
+		if !matchesObjectSetPatterns(relativePath, include, exclude) {
+			return nil
+		}
+
+		files = append(files, objectSetSourceFile{path: filePath, object: prefix + relativePath, info: info})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the files of the source directory %q: %s", sourceDir, err)
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].object < files[j].object
+	})
+	return files, nil
+}
+
+// matchesObjectSetPatterns returns whether the path of a file relative to the source directory matches one of the
+// include patterns, if any, and none of the exclude patterns
+func matchesObjectSetPatterns(relativePath string, include []string, exclude []string) bool {
+	for _, pattern := range exclude {
+		if matchObjectSetPattern(pattern, relativePath) {
+			return false
+		}
+	}
+	if len(include) == 0 {
+		return true
+	}
+	for _, pattern := range include {
+		if matchObjectSetPattern(pattern, relativePath) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchObjectSetPattern matches a path relative to the source directory with a pattern. Patterns without a slash match
+// the name of the file in any directory, e.g. "*.html", the others match the whole path, e.g. "css/*.css", and a pattern
+// ending with "/**" matches everything below the directories it matches, e.g. "assets/**".
+func matchObjectSetPattern(pattern string, relativePath string) bool {
+	if strings.HasSuffix(pattern, "/**") {
+		directoryPattern := strings.TrimSuffix(pattern, "/**")
+		for index, char := range relativePath {
+			if char != '/' {
+				continue
+			}
+			if matched, _ := path.Match(directoryPattern, relativePath[:index]); matched {
+				return true
+			}
+		}
+		return false
+	}
+
+	if !strings.Contains(pattern, "/") {
+		matched, _ := path.Match(pattern, path.Base(relativePath))
+		return matched
+	}
+
+	matched, _ := path.Match(pattern, relativePath)
+	return matched
+}
+
+func validateObjectSetPattern(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+	if _, err := path.Match(strings.TrimSuffix(v, "/**"), ""); err != nil {
+		es = append(es, fmt.Errorf("%s is not a valid pattern: %q", k, v))
+	}
+	return
+}
+
+func objectSetPatterns(raw interface{}) []string {
+	patterns := []string{}
+	if list, ok := raw.([]interface{}); ok {
+		for _, pattern := range list {
+			if pattern, ok := pattern.(string); ok {
+				patterns = append(patterns, pattern)
+			}
+		}
+	}
+	return patterns
+}
+
+// objectSetManifestHash returns the SHA-256 of the names and MD5s of the objects of an object set
+func objectSetManifestHash(objects map[string]string) string {
+	names := []string{}
+	for name := range objects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	hash := sha256.New()
+	for _, name := range names {
+		fmt.Fprintf(hash, "%s\t%s\n", name, objects[name])
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func getObjectSetCompositeId(bucket string, namespace string, prefix string) string {
+	return getCompositeId("n", namespace, "b", bucket, "objectSets", prefix)
+}
+
+func parseObjectSetCompositeId(compositeId string) (bucket string, namespace string, prefix string, err error) {
+	values, err := parseCompositeId(compositeId, "n", "b", "objectSets")
+	if err != nil {
+		return
+	}
+	namespace, bucket, prefix = values[0], values[1], values[2]
+
+	return
+}
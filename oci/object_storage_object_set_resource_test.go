@@ -0,0 +1,178 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectStorageObjectSetResource_sync(t *testing.T) {
+	fixture := newFakeObjectStorageFixture(t)
+	defer fixture.cleanup()
+	fixture.fake.pageSize = 2
+	clients := fixture.clients
+	putFakeObject(t, clients.objectStorageClient, "bucket", "site/stale.html", []byte("stale"))
+	putFakeObject(t, clients.objectStorageClient, "bucket", "other/index.html", []byte("not in the set"))
+
+	sourceDir := fixture.tempDir()
+	writeFile := func(name string, content string) {
+		assert.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(sourceDir, name)), 0755))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(sourceDir, name), []byte(content), 0644))
+	}
+	writeFile("index.html", "index")
+	writeFile("css/site.css", "body {}")
+	writeFile("assets/logo.png", "logo")
+	writeFile("assets/tmp/draft.png", "draft")
+	writeFile("notes.txt", "not published")
+
+	// Requests are counted to check which objects are uploaded
+	uploads := []string{}
+	fixture.onRequest(func(request *http.Request) *fakeNetworkError {
+		if request.Method == http.MethodPut && strings.Contains(request.URL.Path, "/o/") {
+			uploads = append(uploads, request.URL.Path[strings.Index(request.URL.Path, "/o/")+3:])
+		}
+		return nil
+	})
+	uploaded := func() []string {
+		fixture.mutex.Lock()
+		defer fixture.mutex.Unlock()
+		sort.Strings(uploads)
+		result := uploads
+		uploads = []string{}
+		return result
+	}
+
+	raw := map[string]interface{}{
+		"namespace":  fakeObjectStorageNamespace,
+		"bucket":     "bucket",
+		"source_dir": sourceDir,
+		"prefix":     "site/",
+		"include":    []interface{}{"*.html", "*.css", "assets/**"},
+		"exclude":    []interface{}{"assets/tmp/**"},
+	}
+	objectSet := schema.TestResourceDataRaw(t, ObjectStorageObjectSetResource().Schema, raw)
+	assert.NoError(t, ObjectStorageObjectSetResource().Create(objectSet, clients))
+	assert.Equal(t, "n/fakenamespace/b/bucket/objectSets/site%2F", objectSet.Id())
+	assert.Equal(t, []string{"site/assets/logo.png", "site/css/site.css", "site/index.html"}, uploaded())
+	// The objects that are not in the set are left alone
+	assert.Contains(t, fixture.bucket().objects, "site/stale.html")
+	assert.Equal(t, 3, objectSet.Get("object_count"))
+	state := objectSet.State()
+
+	rawConfig, err := config.NewRawConfig(raw)
+	assert.NoError(t, err)
+	diff := func(state *terraform.InstanceState) *terraform.InstanceDiff {
+		diff, err := ObjectStorageObjectSetResource().Diff(state, terraform.NewResourceConfig(rawConfig), clients)
+		assert.NoError(t, err)
+		return diff
+	}
+	assert.Nil(t, diff(state))
+
+	// Only the files that changed are uploaded again, in place
+	writeFile("css/site.css", "body { margin: 0 }")
+	writeFile("assets/tmp/draft.png", "another draft")
+	changes := diff(state)
+	if assert.NotNil(t, changes) {
+		assert.False(t, changes.RequiresNew())
+		assert.Contains(t, changes.Attributes, "manifest_hash")
+	}
+	state, err = ObjectStorageObjectSetResource().Apply(state, changes, clients)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"site/css/site.css"}, uploaded())
+	assert.Nil(t, diff(state))
+
+	// The objects changed outside of Terraform are uploaded again once refreshed
+	putFakeObject(t, clients.objectStorageClient, "bucket", "site/index.html", []byte("changed elsewhere"))
+	uploaded()
+	objectSet = ObjectStorageObjectSetResource().Data(state)
+	assert.NoError(t, ObjectStorageObjectSetResource().Read(objectSet, clients))
+	state = objectSet.State()
+	assert.NotNil(t, diff(state))
+
+	// With delete_removed_objects, the objects without a file are deleted
+	raw["delete_removed_objects"] = true
+	rawConfig, err = config.NewRawConfig(raw)
+	assert.NoError(t, err)
+	changes = diff(state)
+	state, err = ObjectStorageObjectSetResource().Apply(state, changes, clients)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"site/index.html"}, uploaded())
+	assert.NotContains(t, fixture.bucket().objects, "site/stale.html")
+	assert.Equal(t, "3", state.Attributes["object_count"])
+	assert.Nil(t, diff(state))
+
+	// Destroying the object set deletes its objects only
+	objectSet = ObjectStorageObjectSetResource().Data(state)
+	assert.NoError(t, ObjectStorageObjectSetResource().Delete(objectSet, clients))
+	assert.Empty(t, objectSet.Id())
+	objects := []string{}
+	for name := range fixture.bucket().objects {
+		objects = append(objects, name)
+	}
+	assert.Equal(t, []string{"other/index.html"}, objects)
+}
+
+func TestObjectStorageObjectSetResource_deleteWithoutSourceDir(t *testing.T) {
+	fixture := newFakeObjectStorageFixture(t)
+	defer fixture.cleanup()
+	clients := fixture.clients
+	putFakeObject(t, clients.objectStorageClient, "bucket", "site/notes.txt", []byte("not in the set"))
+	putFakeObject(t, clients.objectStorageClient, "bucket", "other/index.html", []byte("not in the set"))
+
+	sourceDir := fixture.tempDir()
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(sourceDir, "index.html"), []byte("index"), 0644))
+
+	objectSet := schema.TestResourceDataRaw(t, ObjectStorageObjectSetResource().Schema, map[string]interface{}{
+		"namespace":  fakeObjectStorageNamespace,
+		"bucket":     "bucket",
+		"source_dir": sourceDir,
+		"prefix":     "site/",
+		"include":    []interface{}{"*.html"},
+	})
+	assert.NoError(t, ObjectStorageObjectSetResource().Create(objectSet, clients))
+	assert.Contains(t, fixture.bucket().objects, "site/index.html")
+
+	// Once the source directory is gone, every object of the set is deleted, even without delete_removed_objects
+	assert.NoError(t, os.RemoveAll(sourceDir))
+	assert.NoError(t, ObjectStorageObjectSetResource().Delete(objectSet, clients))
+	objects := []string{}
+	for name := range fixture.bucket().objects {
+		objects = append(objects, name)
+	}
+	sort.Strings(objects)
+	assert.Equal(t, []string{"other/index.html", "site/notes.txt"}, objects)
+}
+
+func TestMatchObjectSetPattern(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		path    string
+		matches bool
+	}{
+		{"*.html", "index.html", true},
+		{"*.html", "docs/guide/index.html", true},
+		{"*.html", "index.htm", false},
+		{"css/*.css", "css/site.css", true},
+		{"css/*.css", "theme/css/site.css", false},
+		{"assets/**", "assets/logo.png", true},
+		{"assets/**", "assets/images/logo.png", true},
+		{"assets/**", "assets", false},
+		{"*/tmp/**", "assets/tmp/draft.png", true},
+		{"*/tmp/**", "tmp/draft.png", false},
+	}
+
+	for _, test := range testCases {
+		assert.Equal(t, test.matches, matchObjectSetPattern(test.pattern, test.path), "%s %s", test.pattern, test.path)
+	}
+}
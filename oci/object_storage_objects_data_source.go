@@ -85,7 +85,7 @@ func readObjectStorageObjects(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).objectStorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type ObjectStorageObjectsDataSourceCrud struct {
@@ -100,7 +100,7 @@ func (s *ObjectStorageObjectsDataSourceCrud) VoidState() {
 
 var listObjectsFields = "name,size,md5,timeCreated"
 
-func (s *ObjectStorageObjectsDataSourceCrud) Get() error {
+func (s *ObjectStorageObjectsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.ListObjectsRequest{
 		// @CODEGEN 2/2018: Need to specify all the fields we want from the ObjectSummaries
 		Fields: &listObjectsFields,
@@ -146,7 +146,7 @@ func (s *ObjectStorageObjectsDataSourceCrud) Get() error {
 	for {
 		request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "object_storage")
 
-		response, err := s.Client.ListObjects(context.Background(), request)
+		response, err := s.Client.ListObjects(ctx, request)
 		if err != nil {
 			return err
 		}
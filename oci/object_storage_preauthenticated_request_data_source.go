@@ -55,7 +55,7 @@ func readSingularObjectStoragePreauthenticatedRequest(d *schema.ResourceData, m
 	sync.D = d
 	sync.Client = m.(*OracleClients).objectStorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type ObjectStoragePreauthenticatedRequestDataSourceCrud struct {
@@ -68,7 +68,7 @@ func (s *ObjectStoragePreauthenticatedRequestDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ObjectStoragePreauthenticatedRequestDataSourceCrud) Get() error {
+func (s *ObjectStoragePreauthenticatedRequestDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.GetPreauthenticatedRequestRequest{}
 
 	if bucket, ok := s.D.GetOkExists("bucket"); ok {
@@ -88,7 +88,7 @@ func (s *ObjectStoragePreauthenticatedRequestDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "object_storage")
 
-	response, err := s.Client.GetPreauthenticatedRequest(context.Background(), request)
+	response, err := s.Client.GetPreauthenticatedRequest(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -4,11 +4,7 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"net/url"
-	"regexp"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -91,7 +87,7 @@ func createObjectStoragePreauthenticatedRequest(d *schema.ResourceData, m interf
 	sync.D = d
 	sync.Client = m.(*OracleClients).objectStorageClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readObjectStoragePreauthenticatedRequest(d *schema.ResourceData, m interface{}) error {
@@ -113,7 +109,7 @@ func readObjectStoragePreauthenticatedRequest(d *schema.ResourceData, m interfac
 	sync.D = d
 	sync.Client = m.(*OracleClients).objectStorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func deleteObjectStoragePreauthenticatedRequest(d *schema.ResourceData, m interface{}) error {
@@ -122,7 +118,7 @@ func deleteObjectStoragePreauthenticatedRequest(d *schema.ResourceData, m interf
 	sync.Client = m.(*OracleClients).objectStorageClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type ObjectStoragePreauthenticatedRequestResourceCrud struct {
@@ -136,7 +132,7 @@ func (s *ObjectStoragePreauthenticatedRequestResourceCrud) ID() string {
 	return getPreauthenticatedRequestCompositeId(s.D.Get("bucket").(string), s.D.Get("namespace").(string), *s.Res.Id)
 }
 
-func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Create() error {
+func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Create(ctx context.Context) error {
 	request := oci_object_storage.CreatePreauthenticatedRequestRequest{}
 
 	if accessType, ok := s.D.GetOkExists("access_type"); ok {
@@ -174,7 +170,7 @@ func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.CreatePreauthenticatedRequest(context.Background(), request)
+	response, err := s.Client.CreatePreauthenticatedRequest(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -183,7 +179,7 @@ func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Create() error {
 	return nil
 }
 
-func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Get() error {
+func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.GetPreauthenticatedRequestRequest{}
 
 	bucket, namespace, parId, err := parsePreauthenticatedRequestCompositeId(s.D.Id())
@@ -197,7 +193,7 @@ func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.GetPreauthenticatedRequest(context.Background(), request)
+	response, err := s.Client.GetPreauthenticatedRequest(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -217,7 +213,7 @@ func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Delete() error {
+func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Delete(ctx context.Context) error {
 	request := oci_object_storage.DeletePreauthenticatedRequestRequest{}
 
 	if bucket, ok := s.D.GetOkExists("bucket"); ok {
@@ -236,7 +232,7 @@ func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Delete() error {
 	}
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	_, err := s.Client.DeletePreauthenticatedRequest(context.Background(), request)
+	_, err := s.Client.DeletePreauthenticatedRequest(ctx, request)
 	return err
 }
 
@@ -278,23 +274,15 @@ func (s *ObjectStoragePreauthenticatedRequestResourceCrud) SetData() error {
 }
 
 func getPreauthenticatedRequestCompositeId(bucket string, namespace string, parId string) string {
-	bucket = url.PathEscape(bucket)
-	namespace = url.PathEscape(namespace)
-	parId = url.PathEscape(parId)
-	compositeId := "n/" + namespace + "/b/" + bucket + "/p/" + parId
-	return compositeId
+	return getCompositeId("n", namespace, "b", bucket, "p", parId)
 }
 
 func parsePreauthenticatedRequestCompositeId(compositeId string) (bucket string, namespace string, parId string, err error) {
-	parts := strings.Split(compositeId, "/")
-	match, _ := regexp.MatchString("n/.*/b/.*/p/.*", compositeId)
-	if !match || len(parts) != 6 {
-		err = fmt.Errorf("illegal compositeId %s encountered", compositeId)
+	values, err := parseCompositeId(compositeId, "n", "b", "p")
+	if err != nil {
 		return
 	}
-	namespace, _ = url.PathUnescape(parts[1])
-	bucket, _ = url.PathUnescape(parts[3])
-	parId, _ = url.PathUnescape(parts[5])
+	namespace, bucket, parId = values[0], values[1], values[2]
 
 	return
 }
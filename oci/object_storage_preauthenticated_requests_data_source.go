@@ -13,7 +13,8 @@ func ObjectStoragePreauthenticatedRequestsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readObjectStoragePreauthenticatedRequests,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"bucket": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -40,7 +41,7 @@ func readObjectStoragePreauthenticatedRequests(d *schema.ResourceData, m interfa
 	sync.D = d
 	sync.Client = m.(*OracleClients).objectStorageClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type ObjectStoragePreauthenticatedRequestsDataSourceCrud struct {
@@ -53,7 +54,7 @@ func (s *ObjectStoragePreauthenticatedRequestsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ObjectStoragePreauthenticatedRequestsDataSourceCrud) Get() error {
+func (s *ObjectStoragePreauthenticatedRequestsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.ListPreauthenticatedRequestsRequest{}
 
 	if bucket, ok := s.D.GetOkExists("bucket"); ok {
@@ -72,8 +73,9 @@ func (s *ObjectStoragePreauthenticatedRequestsDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "object_storage")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListPreauthenticatedRequests(context.Background(), request)
+	response, err := s.Client.ListPreauthenticatedRequests(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -81,8 +83,8 @@ func (s *ObjectStoragePreauthenticatedRequestsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListPreauthenticatedRequests(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListPreauthenticatedRequests(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -134,6 +136,8 @@ func (s *ObjectStoragePreauthenticatedRequestsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, ObjectStoragePreauthenticatedRequestsDataSource().Schema["preauthenticated_requests"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("preauthenticated_requests", resources); err != nil {
 		return err
 	}
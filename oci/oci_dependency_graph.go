@@ -2,98 +2,338 @@
 
 package provider
 
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// DependencyGraph maps the key of a resource type, e.g. vcn, to the names of the resource types that reference it, e.g.
+// CoreSubnet, and must be deleted first. It is derived from the *_id and *_ids attributes of the resource schemas.
 var DependencyGraph map[string][]string
 
+// dependencyGraphDependents maps the name of a resource type to the names of the resource types that reference it, like
+// the DependencyGraph, and is built along with it once
+var (
+	dependencyGraphDependents map[string][]string
+	dependencyGraphOnce       sync.Once
+)
+
+// Services of the resource types, in the order of the prefixes of their terraform types
+var dependencyGraphServices = []string{
+	"audit", "autoscaling", "budget", "containerengine", "core", "database", "dns", "email", "file_storage", "health_checks",
+	"identity", "kms", "load_balancer", "monitoring", "objectstorage", "ons", "streaming", "waas",
+}
+
+// Resource type names that are not the camel case of their terraform type
+var dependencyGraphServiceNames = map[string]string{
+	"objectstorage": "ObjectStorage",
+}
+
+// Resource types whose name or key do not follow their terraform type, e.g. the aliases of other types
+var dependencyGraphResourceOverrides = map[string]dependencyGraphResource{
+	"oci_core_ipsec": {name: "CoreIpSecConnection", key: "ipSecConnection"},
+	"oci_core_listing_resource_version_agreement": {name: "CoreAppCatalogListingResourceVersionAgreement", key: "appCatalogListingResourceVersionAgreement"},
+	"oci_core_virtual_network":                    {name: "CoreVcn", key: "vcn"},
+	"oci_load_balancer":                           {name: "LoadBalancerLoadBalancer", key: "loadBalancer"},
+	"oci_load_balancer_backendset":                {name: "LoadBalancerBackendSet", key: "backendSet"},
+	"oci_objectstorage_preauthrequest":            {name: "ObjectStoragePreauthenticatedRequest", key: "preauthenticatedRequest"},
+	"oci_ons_notification_topic":                  {name: "OnsNotificationTopic", key: "topic"},
+	"oci_waas_certificate":                        {name: "WaasCertificate", key: "waasCertificate"},
+}
+
+// Resource types referenced by attributes whose name is not the key of the type. The attributes of a single resource
+// type are qualified with the terraform type. Attributes that do not reference resource types map to nil.
+var dependencyGraphReferenceOverrides = map[string][]string{
+	"asset_id":                                     {"CoreBootVolume", "CoreVolume"},
+	"backup_policy_id":                             nil,
+	"backup_subnet_id":                             {"CoreSubnet"},
+	"database_id":                                  {"DatabaseDbHome", "DatabaseDbSystem"},
+	"default_s3compartment_id":                     {"IdentityCompartment"},
+	"default_swift_compartment_id":                 {"IdentityCompartment"},
+	"cross_connect_or_cross_connect_group_id":      {"CoreCrossConnect", "CoreCrossConnectGroup"},
+	"far_cross_connect_or_cross_connect_group_id":  {"CoreCrossConnect", "CoreCrossConnectGroup"},
+	"near_cross_connect_or_cross_connect_group_id": {"CoreCrossConnect", "CoreCrossConnectGroup"},
+	"health_check_monitor_id":                      {"HealthChecksHttpMonitor", "HealthChecksPingMonitor"},
+	"kms_key_id":                                   {"KmsKey"},
+	"listing_id":                                   nil,
+	// The default resources of a VCN are not created by terraform
+	"manage_default_resource_id": nil,
+	"metric_compartment_id":      {"IdentityCompartment"},
+	// Local peering gateways and private IPs are left out, as they depend on route tables themselves
+	"network_entity_id":     {"CoreDrg", "CoreInternetGateway", "CoreNatGateway", "CoreServiceGateway"},
+	"node_image_id":         {"CoreImage"},
+	"peer_db_system_id":     {"DatabaseDbSystem"},
+	"primary_subnet_id":     {"CoreSubnet"},
+	"provider_service_id":   nil,
+	"service_id":            nil,
+	"service_lb_subnet_ids": {"CoreSubnet"},
+	"tag_definition_id":     {"IdentityTag"},
+	"target_compartment_id": {"IdentityCompartment"},
+	"vnic_id":               {"CoreVnicAttachment"},
+	"zone_name_or_id":       {"DnsZone"},
+
+	// Resources created from a backup, an image or another resource of the same type do not depend on it once created
+	"oci_core_image.instance_id":                   nil,
+	"oci_core_volume.volume_backup_id":             nil,
+	"oci_core_volume_backup.volume_backup_id":      nil,
+	"oci_core_volume_group.volume_group_backup_id": nil,
+	"oci_core_volume_group.volume_group_id":        nil,
+	"oci_database_db_home.backup_id":               nil,
+	"oci_database_db_system.backup_id":             nil,
+
+	"oci_core_instance.source_id":                        {"CoreBootVolume", "CoreImage"},
+	"oci_core_local_peering_gateway.peer_id":             nil,
+	"oci_core_remote_peering_connection.peer_id":         nil,
+	"oci_core_virtual_circuit.gateway_id":                {"CoreDrg"},
+	"oci_core_volume_backup_policy_assignment.policy_id": nil,
+	"oci_database_autonomous_database.source_id":         {"DatabaseAutonomousDatabase"},
+	"oci_waas_waas_policy.certificate_id":                {"WaasCertificate"},
+}
+
+// Attributes that are not part of the DependencyGraph. Every resource is created in a compartment, so the compartments
+// would have to be deleted last, but the tests never delete the compartments they run in.
+var dependencyGraphIgnoredAttributes = map[string]bool{
+	"compartment_id": true,
+}
+
+// dependencyGraphResource identifies a resource type in the DependencyGraph
+type dependencyGraphResource struct {
+	// name of the type, e.g. CoreSubnet, which is also the name of its sweeper
+	name string
+	// key of the type, e.g. subnet for the subnet_id attributes referencing it
+	key     string
+	service string
+}
+
 func initDependencyGraph() {
-	DependencyGraph = make(map[string][]string)
-
-	DependencyGraph["asset"] = append(DependencyGraph["asset"], "CoreVolumeBackupPolicyAssignment")
-	DependencyGraph["autonomousDataWarehouse"] = append(DependencyGraph["autonomousDataWarehouse"], "DatabaseAutonomousDataWarehouseBackup")
-	DependencyGraph["autonomousDataWarehouse"] = append(DependencyGraph["autonomousDataWarehouse"], "DatabaseAutonomousDataWarehouseWallet")
-	DependencyGraph["autonomousDatabase"] = append(DependencyGraph["autonomousDatabase"], "DatabaseAutonomousDatabaseBackup")
-	DependencyGraph["autonomousDatabase"] = append(DependencyGraph["autonomousDatabase"], "DatabaseAutonomousDatabaseWallet")
-	DependencyGraph["backupPolicy"] = append(DependencyGraph["backupPolicy"], "CoreBootVolume")
-	DependencyGraph["backupPolicy"] = append(DependencyGraph["backupPolicy"], "CoreVolume")
-	DependencyGraph["backupSubnet"] = append(DependencyGraph["backupSubnet"], "DatabaseDbSystem")
-	DependencyGraph["bootVolume"] = append(DependencyGraph["bootVolume"], "CoreBootVolumeAttachment")
-	DependencyGraph["bootVolume"] = append(DependencyGraph["bootVolume"], "CoreBootVolumeBackup")
-	DependencyGraph["budget"] = append(DependencyGraph["budget"], "BudgetAlertRule")
-	DependencyGraph["cluster"] = append(DependencyGraph["cluster"], "ContainerengineClusterKubeConfig")
-	DependencyGraph["cluster"] = append(DependencyGraph["cluster"], "ContainerengineNodePool")
-	DependencyGraph["cpe"] = append(DependencyGraph["cpe"], "CoreIpSecConnection")
-	DependencyGraph["crossConnectGroup"] = append(DependencyGraph["crossConnectGroup"], "CoreCrossConnect")
-	DependencyGraph["database"] = append(DependencyGraph["database"], "DatabaseBackup")
-	DependencyGraph["database"] = append(DependencyGraph["database"], "DatabaseDataGuardAssociation")
-	DependencyGraph["dbSystem"] = append(DependencyGraph["dbSystem"], "DatabaseDbHome")
-	DependencyGraph["dhcpOptions"] = append(DependencyGraph["dhcpOptions"], "CoreSubnet")
-	DependencyGraph["drg"] = append(DependencyGraph["drg"], "CoreDrgAttachment")
-	DependencyGraph["drg"] = append(DependencyGraph["drg"], "CoreIpSecConnection")
-	DependencyGraph["drg"] = append(DependencyGraph["drg"], "CoreRemotePeeringConnection")
-	DependencyGraph["exportSet"] = append(DependencyGraph["exportSet"], "FileStorageExport")
-	DependencyGraph["farCrossConnectOrCrossConnectGroup"] = append(DependencyGraph["farCrossConnectOrCrossConnectGroup"], "CoreCrossConnect")
-	DependencyGraph["fileSystem"] = append(DependencyGraph["fileSystem"], "FileStorageExport")
-	DependencyGraph["fileSystem"] = append(DependencyGraph["fileSystem"], "FileStorageSnapshot")
-	DependencyGraph["gateway"] = append(DependencyGraph["gateway"], "CoreVirtualCircuit")
-	DependencyGraph["group"] = append(DependencyGraph["group"], "IdentityIdpGroupMapping")
-	DependencyGraph["group"] = append(DependencyGraph["group"], "IdentityUserGroupMembership")
-	DependencyGraph["healthCheckMonitor"] = append(DependencyGraph["healthCheckMonitor"], "DnsSteeringPolicy")
-	DependencyGraph["identityProvider"] = append(DependencyGraph["identityProvider"], "IdentityIdpGroupMapping")
-	DependencyGraph["instance"] = append(DependencyGraph["instance"], "CoreBootVolumeAttachment")
-	DependencyGraph["instance"] = append(DependencyGraph["instance"], "CoreConsoleHistory")
-	DependencyGraph["instance"] = append(DependencyGraph["instance"], "CoreInstanceConsoleConnection")
-	DependencyGraph["instance"] = append(DependencyGraph["instance"], "CoreVolumeAttachment")
-	DependencyGraph["instanceConfiguration"] = append(DependencyGraph["instanceConfiguration"], "CoreInstancePool")
-	DependencyGraph["internetGateway"] = append(DependencyGraph["internetGateway"], "CoreRouteTable")
-	DependencyGraph["key"] = append(DependencyGraph["key"], "KmsDecryptedData")
-	DependencyGraph["key"] = append(DependencyGraph["key"], "KmsEncryptedData")
-	DependencyGraph["key"] = append(DependencyGraph["key"], "KmsGeneratedKey")
-	DependencyGraph["key"] = append(DependencyGraph["key"], "KmsKeyVersion")
-	DependencyGraph["kmsKey"] = append(DependencyGraph["kmsKey"], "CoreBootVolume")
-	DependencyGraph["kmsKey"] = append(DependencyGraph["kmsKey"], "CoreVolume")
-	DependencyGraph["kmsKey"] = append(DependencyGraph["kmsKey"], "ObjectStorageBucket")
-	DependencyGraph["listing"] = append(DependencyGraph["listing"], "CoreAppCatalogSubscription")
-	DependencyGraph["loadBalancer"] = append(DependencyGraph["loadBalancer"], "LoadBalancerBackend")
-	DependencyGraph["loadBalancer"] = append(DependencyGraph["loadBalancer"], "LoadBalancerBackendSet")
-	DependencyGraph["loadBalancer"] = append(DependencyGraph["loadBalancer"], "LoadBalancerCertificate")
-	DependencyGraph["loadBalancer"] = append(DependencyGraph["loadBalancer"], "LoadBalancerHostname")
-	DependencyGraph["loadBalancer"] = append(DependencyGraph["loadBalancer"], "LoadBalancerListener")
-	DependencyGraph["loadBalancer"] = append(DependencyGraph["loadBalancer"], "LoadBalancerPathRouteSet")
-	DependencyGraph["loadBalancer"] = append(DependencyGraph["loadBalancer"], "LoadBalancerRuleSet")
-	DependencyGraph["metricCompartment"] = append(DependencyGraph["metricCompartment"], "MonitoringAlarm")
-	DependencyGraph["nearCrossConnectOrCrossConnectGroup"] = append(DependencyGraph["nearCrossConnectOrCrossConnectGroup"], "CoreCrossConnect")
-	DependencyGraph["policy"] = append(DependencyGraph["policy"], "CoreVolumeBackupPolicyAssignment")
-	DependencyGraph["privateIp"] = append(DependencyGraph["privateIp"], "CorePublicIp")
-	DependencyGraph["providerService"] = append(DependencyGraph["providerService"], "CoreVirtualCircuit")
-	DependencyGraph["routeTable"] = append(DependencyGraph["routeTable"], "CoreDrgAttachment")
-	DependencyGraph["routeTable"] = append(DependencyGraph["routeTable"], "CoreLocalPeeringGateway")
-	DependencyGraph["routeTable"] = append(DependencyGraph["routeTable"], "CoreSubnet")
-	DependencyGraph["steeringPolicy"] = append(DependencyGraph["steeringPolicy"], "DnsSteeringPolicyAttachment")
-	DependencyGraph["subnet"] = append(DependencyGraph["subnet"], "CoreInstance")
-	DependencyGraph["tagDefinition"] = append(DependencyGraph["tagDefinition"], "IdentityTagDefault")
-	DependencyGraph["tagNamespace"] = append(DependencyGraph["tagNamespace"], "IdentityTag")
-	DependencyGraph["targetCompartment"] = append(DependencyGraph["targetCompartment"], "BudgetBudget")
-	DependencyGraph["tenancy"] = append(DependencyGraph["tenancy"], "IdentityRegionSubscription")
-	DependencyGraph["topic"] = append(DependencyGraph["topic"], "OnsSubscription")
-	DependencyGraph["user"] = append(DependencyGraph["user"], "IdentityApiKey")
-	DependencyGraph["user"] = append(DependencyGraph["user"], "IdentityAuthToken")
-	DependencyGraph["user"] = append(DependencyGraph["user"], "IdentityCustomerSecretKey")
-	DependencyGraph["user"] = append(DependencyGraph["user"], "IdentitySmtpCredential")
-	DependencyGraph["user"] = append(DependencyGraph["user"], "IdentitySwiftPassword")
-	DependencyGraph["user"] = append(DependencyGraph["user"], "IdentityUiPassword")
-	DependencyGraph["user"] = append(DependencyGraph["user"], "IdentityUserGroupMembership")
-	DependencyGraph["vcn"] = append(DependencyGraph["vcn"], "ContainerengineCluster")
-	DependencyGraph["vcn"] = append(DependencyGraph["vcn"], "CoreDhcpOptions")
-	DependencyGraph["vcn"] = append(DependencyGraph["vcn"], "CoreDrgAttachment")
-	DependencyGraph["vcn"] = append(DependencyGraph["vcn"], "CoreInternetGateway")
-	DependencyGraph["vcn"] = append(DependencyGraph["vcn"], "CoreLocalPeeringGateway")
-	DependencyGraph["vcn"] = append(DependencyGraph["vcn"], "CoreNatGateway")
-	DependencyGraph["vcn"] = append(DependencyGraph["vcn"], "CoreRouteTable")
-	DependencyGraph["vcn"] = append(DependencyGraph["vcn"], "CoreSecurityList")
-	DependencyGraph["vcn"] = append(DependencyGraph["vcn"], "CoreServiceGateway")
-	DependencyGraph["vcn"] = append(DependencyGraph["vcn"], "CoreSubnet")
-	DependencyGraph["vnic"] = append(DependencyGraph["vnic"], "CorePrivateIp")
-	DependencyGraph["volume"] = append(DependencyGraph["volume"], "CoreVolumeAttachment")
-	DependencyGraph["volume"] = append(DependencyGraph["volume"], "CoreVolumeBackup")
-	DependencyGraph["volumeGroup"] = append(DependencyGraph["volumeGroup"], "CoreVolumeGroupBackup")
-	DependencyGraph["zone"] = append(DependencyGraph["zone"], "DnsSteeringPolicyAttachment")
+	dependencyGraphOnce.Do(func() {
+		var dependents map[string][]string
+		dependents, DependencyGraph, _ = buildDependencyGraphs(resourcesMap())
+		dependencyGraphDependents = dependents
+	})
+}
+
+// buildDependencyGraph returns the DependencyGraph of resource types, and the errors of the references that could not
+// be resolved to resource types, that form a cycle, or whose types share a key
+func buildDependencyGraph(resources map[string]*schema.Resource) (map[string][]string, []error) {
+	_, graph, errs := buildDependencyGraphs(resources)
+	return graph, errs
+}
+
+// buildDependencyGraphs returns the names of the resource types that reference each resource type by name, and the
+// DependencyGraph that maps their keys to them
+func buildDependencyGraphs(resources map[string]*schema.Resource) (map[string][]string, map[string][]string, []error) {
+	var errs []error
+	types := map[string]dependencyGraphResource{}
+	for terraformType := range resources {
+		resource, err := getDependencyGraphResource(terraformType)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		types[terraformType] = resource
+	}
+
+	dependentSets := map[string]map[string]bool{}
+	var terraformTypes []string
+	for terraformType := range types {
+		terraformTypes = append(terraformTypes, terraformType)
+	}
+	sort.Strings(terraformTypes)
+	for _, terraformType := range terraformTypes {
+		resource := types[terraformType]
+		walkDependencyGraphReferences(resources[terraformType].Schema, func(attribute string) {
+			referenced, err := resolveDependencyGraphReference(types, terraformType, attribute)
+			if err != nil {
+				errs = append(errs, err)
+				return
+			}
+			for _, name := range referenced {
+				if name == resource.name {
+					continue
+				}
+				if dependentSets[name] == nil {
+					dependentSets[name] = map[string]bool{}
+				}
+				dependentSets[name][resource.name] = true
+			}
+		})
+	}
+
+	dependents := map[string][]string{}
+	for name, names := range dependentSets {
+		for dependent := range names {
+			dependents[name] = append(dependents[name], dependent)
+		}
+		sort.Strings(dependents[name])
+	}
+	if err := checkDependencyGraphCycles(types, dependents); err != nil {
+		errs = append(errs, err)
+	}
+
+	// The types sharing a key, other than the aliases of a type, would share their dependents in the DependencyGraph
+	namesByKey := map[string]string{}
+	for _, terraformType := range terraformTypes {
+		resource := types[terraformType]
+		if other, ok := namesByKey[resource.key]; ok && other != resource.name {
+			errs = append(errs, fmt.Errorf("%s and %s share the key %s of the DependencyGraph, add one of them to the dependencyGraphResourceOverrides", other, resource.name, resource.key))
+			continue
+		}
+		namesByKey[resource.key] = resource.name
+	}
+	graph := map[string][]string{}
+	for key, name := range namesByKey {
+		if len(dependents[name]) > 0 {
+			graph[key] = dependents[name]
+		}
+	}
+	return dependents, graph, errs
+}
+
+// getDependencyGraphResource returns the name and key of a terraform type, e.g. CoreSubnet and subnet for oci_core_subnet
+func getDependencyGraphResource(terraformType string) (dependencyGraphResource, error) {
+	service := ""
+	for _, candidate := range dependencyGraphServices {
+		if strings.HasPrefix(terraformType, "oci_"+candidate+"_") && len(candidate) > len(service) {
+			service = candidate
+		}
+	}
+	if resource, ok := dependencyGraphResourceOverrides[terraformType]; ok {
+		resource.service = service
+		if service == "" {
+			resource.service = strings.TrimPrefix(terraformType, "oci_")
+		}
+		return resource, nil
+	}
+	if service == "" {
+		return dependencyGraphResource{}, fmt.Errorf("the service of %s is not one of the dependencyGraphServices", terraformType)
+	}
+
+	serviceName, ok := dependencyGraphServiceNames[service]
+	if !ok {
+		serviceName = snakeCaseToCamelCase(service)
+	}
+	entity := snakeCaseToCamelCase(strings.TrimPrefix(terraformType, "oci_"+service+"_"))
+	return dependencyGraphResource{
+		name:    serviceName + entity,
+		key:     strings.ToLower(entity[:1]) + entity[1:],
+		service: service,
+	}, nil
+}
+
+// walkDependencyGraphReferences calls f with the *_id and *_ids attributes of a schema and its nested blocks that are
+// set in the configurations
+func walkDependencyGraphReferences(attributes map[string]*schema.Schema, f func(attribute string)) {
+	var names []string
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		attribute := attributes[name]
+		if !attribute.Required && !attribute.Optional {
+			continue
+		}
+		if nested, ok := attribute.Elem.(*schema.Resource); ok {
+			walkDependencyGraphReferences(nested.Schema, f)
+			continue
+		}
+		if (strings.HasSuffix(name, "_id") || strings.HasSuffix(name, "_ids")) && !dependencyGraphIgnoredAttributes[name] {
+			f(name)
+		}
+	}
+}
+
+// resolveDependencyGraphReference returns the names of the resource types referenced by an attribute. The attributes
+// are resolved with the dependencyGraphReferenceOverrides, or else with the type whose key is the name of the
+// attribute, preferably of the same service when there are several.
+func resolveDependencyGraphReference(types map[string]dependencyGraphResource, terraformType string, attribute string) ([]string, error) {
+	if referenced, ok := dependencyGraphReferenceOverrides[terraformType+"."+attribute]; ok {
+		return referenced, nil
+	}
+	if referenced, ok := dependencyGraphReferenceOverrides[attribute]; ok {
+		return referenced, nil
+	}
+
+	name := strings.TrimSuffix(strings.TrimSuffix(attribute, "_ids"), "_id")
+	key := snakeCaseToCamelCase(name)
+	key = strings.ToLower(key[:1]) + key[1:]
+
+	candidates := map[string]bool{}
+	sameService := map[string]bool{}
+	for _, resource := range types {
+		if resource.key != key {
+			continue
+		}
+		candidates[resource.name] = true
+		if resource.service == types[terraformType].service {
+			sameService[resource.name] = true
+		}
+	}
+	if len(sameService) > 0 {
+		candidates = sameService
+	}
+
+	var referenced []string
+	for candidate := range candidates {
+		referenced = append(referenced, candidate)
+	}
+	sort.Strings(referenced)
+	switch {
+	case len(referenced) == 0:
+		return nil, fmt.Errorf("%s.%s does not reference a known resource type, add it to the dependencyGraphReferenceOverrides", terraformType, attribute)
+	case len(referenced) > 1:
+		return nil, fmt.Errorf("%s.%s could reference any of %s, add it to the dependencyGraphReferenceOverrides", terraformType, attribute, strings.Join(referenced, ", "))
+	}
+	return referenced, nil
+}
+
+// checkDependencyGraphCycles returns an error if a resource type must be deleted before itself, as the sweepers run
+// their dependencies recursively. The dependents are by name of the resource types.
+func checkDependencyGraphCycles(types map[string]dependencyGraphResource, dependents map[string][]string) error {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	states := map[string]int{}
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch states[name] {
+		case visiting:
+			return fmt.Errorf("the DependencyGraph has a cycle: %s", strings.Join(append(path, name), " -> "))
+		case visited:
+			return nil
+		}
+		states[name] = visiting
+		for _, dependent := range dependents[name] {
+			if err := visit(dependent, append(path, name)); err != nil {
+				return err
+			}
+		}
+		states[name] = visited
+		return nil
+	}
+
+	var names []string
+	for _, resource := range types {
+		names = append(names, resource.name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getDependencyGraphDependents returns the names of the resource types that must be deleted before the ones of a type
+func getDependencyGraphDependents(name string) []string {
+	initDependencyGraph()
+	return dependencyGraphDependents[name]
 }
@@ -0,0 +1,69 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDependencyGraph_references(t *testing.T) {
+	graph, errs := buildDependencyGraph(resourcesMap())
+	for _, err := range errs {
+		t.Error(err)
+	}
+
+	for key, dependents := range map[string][]string{
+		"vcn":          {"CoreSubnet", "CoreInternetGateway", "ContainerengineCluster"},
+		"subnet":       {"CoreInstance", "DatabaseDbSystem", "LoadBalancerLoadBalancer", "FileStorageMountTarget"},
+		"loadBalancer": {"CoreInstancePool", "LoadBalancerBackendSet", "LoadBalancerCertificate"},
+		"natGateway":   {"CoreRouteTable"},
+		"securityList": {"CoreSubnet"},
+		"topic":        {"OnsSubscription"},
+		"user":         {"IdentityApiKey", "IdentityUserGroupMembership"},
+	} {
+		for _, dependent := range dependents {
+			assert.Contains(t, graph[key], dependent, "%s should depend on %s", dependent, key)
+		}
+	}
+	assert.NotContains(t, graph["policy"], "CoreVolumeBackupPolicyAssignment", "volume backup policies are not identity policies")
+	assert.NotContains(t, graph["compartment"], "CoreVcn", "compartment_id attributes are ignored")
+	assert.Contains(t, graph["waasCertificate"], "WaasWaasPolicy")
+	assert.NotContains(t, graph["certificate"], "WaasWaasPolicy", "WAAS policies do not use load balancer certificates")
+}
+
+func TestDependencyGraph_sharedKey(t *testing.T) {
+	resources := resourcesMap()
+	resources["oci_core_certificate"] = &schema.Resource{}
+
+	_, errs := buildDependencyGraph(resources)
+	if assert.Len(t, errs, 1) {
+		assert.Contains(t, errs[0].Error(), "CoreCertificate and LoadBalancerCertificate share the key certificate")
+	}
+}
+
+func TestDependencyGraph_dependents(t *testing.T) {
+	assert.Contains(t, getDependencyGraphDependents("CoreVcn"), "CoreSubnet")
+	assert.Contains(t, getDependencyGraphDependents("WaasCertificate"), "WaasWaasPolicy")
+	assert.NotContains(t, getDependencyGraphDependents("LoadBalancerCertificate"), "WaasWaasPolicy")
+	assert.Empty(t, getDependencyGraphDependents("CoreWidget"))
+}
+
+func TestDependencyGraph_unknownReference(t *testing.T) {
+	resources := resourcesMap()
+	resources["oci_core_widget"] = &schema.Resource{Schema: map[string]*schema.Schema{
+		"gadget_id": {Type: schema.TypeString, Required: true},
+		"vcn_id":    {Type: schema.TypeString, Required: true},
+	}}
+	resources["oci_unknown_widget"] = &schema.Resource{}
+
+	graph, errs := buildDependencyGraph(resources)
+	assert.Contains(t, graph["vcn"], "CoreWidget")
+	if assert.Len(t, errs, 2) {
+		assert.Contains(t, errs[0].Error(), "oci_unknown_widget")
+		assert.Contains(t, errs[1].Error(), "oci_core_widget.gadget_id does not reference a known resource type")
+	}
+}
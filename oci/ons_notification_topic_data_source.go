@@ -65,7 +65,7 @@ func readSingularOnsNotificationTopic(d *schema.ResourceData, m interface{}) err
 	sync.D = d
 	sync.Client = m.(*OracleClients).notificationControlPlaneClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type OnsNotificationTopicDataSourceCrud struct {
@@ -78,7 +78,7 @@ func (s *OnsNotificationTopicDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OnsNotificationTopicDataSourceCrud) Get() error {
+func (s *OnsNotificationTopicDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_ons.GetTopicRequest{}
 
 	if topicId, ok := s.D.GetOkExists("topic_id"); ok {
@@ -88,7 +88,7 @@ func (s *OnsNotificationTopicDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "ons")
 
-	response, err := s.Client.GetTopic(context.Background(), request)
+	response, err := s.Client.GetTopic(ctx, request)
 	if err != nil {
 		return err
 	}
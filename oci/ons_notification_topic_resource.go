@@ -87,7 +87,7 @@ func createOnsNotificationTopic(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).notificationControlPlaneClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readOnsNotificationTopic(d *schema.ResourceData, m interface{}) error {
@@ -95,7 +95,7 @@ func readOnsNotificationTopic(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).notificationControlPlaneClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateOnsNotificationTopic(d *schema.ResourceData, m interface{}) error {
@@ -103,7 +103,7 @@ func updateOnsNotificationTopic(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).notificationControlPlaneClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteOnsNotificationTopic(d *schema.ResourceData, m interface{}) error {
@@ -112,7 +112,7 @@ func deleteOnsNotificationTopic(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).notificationControlPlaneClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type OnsNotificationTopicResourceCrud struct {
@@ -153,7 +153,7 @@ func (s *OnsNotificationTopicResourceCrud) DeletedTarget() []string {
 	return []string{}
 }
 
-func (s *OnsNotificationTopicResourceCrud) Create() error {
+func (s *OnsNotificationTopicResourceCrud) Create(ctx context.Context) error {
 	request := oci_ons.CreateTopicRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -185,7 +185,7 @@ func (s *OnsNotificationTopicResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "ons")
 
-	response, err := s.Client.CreateTopic(context.Background(), request)
+	response, err := s.Client.CreateTopic(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -194,7 +194,7 @@ func (s *OnsNotificationTopicResourceCrud) Create() error {
 	return nil
 }
 
-func (s *OnsNotificationTopicResourceCrud) Get() error {
+func (s *OnsNotificationTopicResourceCrud) Get(ctx context.Context) error {
 	request := oci_ons.GetTopicRequest{}
 
 	if topicId, ok := s.D.GetOkExists("topic_id"); ok {
@@ -207,7 +207,7 @@ func (s *OnsNotificationTopicResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "ons")
 
-	response, err := s.Client.GetTopic(context.Background(), request)
+	response, err := s.Client.GetTopic(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -216,7 +216,7 @@ func (s *OnsNotificationTopicResourceCrud) Get() error {
 	return nil
 }
 
-func (s *OnsNotificationTopicResourceCrud) Update() error {
+func (s *OnsNotificationTopicResourceCrud) Update(ctx context.Context) error {
 	request := oci_ons.UpdateTopicRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -243,7 +243,7 @@ func (s *OnsNotificationTopicResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "ons")
 
-	response, err := s.Client.UpdateTopic(context.Background(), request)
+	response, err := s.Client.UpdateTopic(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -252,7 +252,7 @@ func (s *OnsNotificationTopicResourceCrud) Update() error {
 	return nil
 }
 
-func (s *OnsNotificationTopicResourceCrud) Delete() error {
+func (s *OnsNotificationTopicResourceCrud) Delete(ctx context.Context) error {
 	request := oci_ons.DeleteTopicRequest{}
 
 	if topicId, ok := s.D.GetOkExists("topic_id"); ok {
@@ -262,7 +262,7 @@ func (s *OnsNotificationTopicResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "ons")
 
-	_, err := s.Client.DeleteTopic(context.Background(), request)
+	_, err := s.Client.DeleteTopic(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_ons "github.com/oracle/oci-go-sdk/ons"
 )
 
@@ -13,7 +14,8 @@ func OnsNotificationTopicsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readOnsNotificationTopics,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -35,6 +37,22 @@ func OnsNotificationTopicsDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(OnsNotificationTopicResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_ons.ListTopicsSortByTimecreated),
+					string(oci_ons.ListTopicsSortByLifecyclestate),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_ons.ListTopicsSortOrderAsc),
+					string(oci_ons.ListTopicsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -44,7 +62,7 @@ func readOnsNotificationTopics(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).notificationControlPlaneClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type OnsNotificationTopicsDataSourceCrud struct {
@@ -57,7 +75,7 @@ func (s *OnsNotificationTopicsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OnsNotificationTopicsDataSourceCrud) Get() error {
+func (s *OnsNotificationTopicsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_ons.ListTopicsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -79,9 +97,18 @@ func (s *OnsNotificationTopicsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_ons.NotificationTopicSummaryLifecycleStateEnum(state.(string))
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_ons.ListTopicsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_ons.ListTopicsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "ons")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListTopics(context.Background(), request)
+	response, err := s.Client.ListTopics(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -89,8 +116,8 @@ func (s *OnsNotificationTopicsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListTopics(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListTopics(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -154,6 +181,8 @@ func (s *OnsNotificationTopicsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, OnsNotificationTopicsDataSource().Schema["notification_topics"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("notification_topics", resources); err != nil {
 		return err
 	}
@@ -57,7 +57,7 @@ func readSingularOnsSubscription(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).notificationDataPlaneClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type OnsSubscriptionDataSourceCrud struct {
@@ -70,7 +70,7 @@ func (s *OnsSubscriptionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OnsSubscriptionDataSourceCrud) Get() error {
+func (s *OnsSubscriptionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_ons.GetSubscriptionRequest{}
 
 	if subscriptionId, ok := s.D.GetOkExists("subscription_id"); ok {
@@ -80,7 +80,7 @@ func (s *OnsSubscriptionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "ons")
 
-	response, err := s.Client.GetSubscription(context.Background(), request)
+	response, err := s.Client.GetSubscription(ctx, request)
 	if err != nil {
 		return err
 	}
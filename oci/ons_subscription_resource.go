@@ -14,6 +14,9 @@ import (
 
 func OnsSubscriptionResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importOnsSubscription,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createOnsSubscription,
 		Read:     readOnsSubscription,
@@ -82,7 +85,7 @@ func createOnsSubscription(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).notificationDataPlaneClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readOnsSubscription(d *schema.ResourceData, m interface{}) error {
@@ -90,7 +93,7 @@ func readOnsSubscription(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).notificationDataPlaneClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateOnsSubscription(d *schema.ResourceData, m interface{}) error {
@@ -98,7 +101,7 @@ func updateOnsSubscription(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).notificationDataPlaneClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteOnsSubscription(d *schema.ResourceData, m interface{}) error {
@@ -107,7 +110,29 @@ func deleteOnsSubscription(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).notificationDataPlaneClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
+}
+
+// importOnsSubscription imports a subscription of a topic, with an ID of the form
+// topics/{topicId}/subscriptions/{subscriptionId}. The subscriptions are in the compartment of their topic.
+func importOnsSubscription(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	values, err := parseCompositeId(d.Id(), "topics", "subscriptions")
+	if err != nil {
+		return nil, err
+	}
+
+	request := oci_ons.GetTopicRequest{TopicId: &values[0]}
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "ons")
+	response, err := m.(*OracleClients).notificationControlPlaneClient.GetTopic(m.(*OracleClients).StopContext(), request)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("compartment_id", *response.CompartmentId)
+	d.Set("topic_id", values[0])
+	d.SetId(values[1])
+
+	return []*schema.ResourceData{d}, nil
 }
 
 type OnsSubscriptionResourceCrud struct {
@@ -142,7 +167,7 @@ func (s *OnsSubscriptionResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *OnsSubscriptionResourceCrud) Create() error {
+func (s *OnsSubscriptionResourceCrud) Create(ctx context.Context) error {
 	request := oci_ons.CreateSubscriptionRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -179,7 +204,7 @@ func (s *OnsSubscriptionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "ons")
 
-	response, err := s.Client.CreateSubscription(context.Background(), request)
+	response, err := s.Client.CreateSubscription(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -188,7 +213,7 @@ func (s *OnsSubscriptionResourceCrud) Create() error {
 	return nil
 }
 
-func (s *OnsSubscriptionResourceCrud) Get() error {
+func (s *OnsSubscriptionResourceCrud) Get(ctx context.Context) error {
 	request := oci_ons.GetSubscriptionRequest{}
 
 	tmp := s.D.Id()
@@ -196,7 +221,7 @@ func (s *OnsSubscriptionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "ons")
 
-	response, err := s.Client.GetSubscription(context.Background(), request)
+	response, err := s.Client.GetSubscription(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -205,7 +230,7 @@ func (s *OnsSubscriptionResourceCrud) Get() error {
 	return nil
 }
 
-func (s *OnsSubscriptionResourceCrud) Update() error {
+func (s *OnsSubscriptionResourceCrud) Update(ctx context.Context) error {
 	request := oci_ons.UpdateSubscriptionRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -234,15 +259,15 @@ func (s *OnsSubscriptionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "ons")
 
-	_, err := s.Client.UpdateSubscription(context.Background(), request)
+	_, err := s.Client.UpdateSubscription(ctx, request)
 	if err == nil {
-		return s.Get()
+		return s.Get(ctx)
 	}
 
 	return err
 }
 
-func (s *OnsSubscriptionResourceCrud) Delete() error {
+func (s *OnsSubscriptionResourceCrud) Delete(ctx context.Context) error {
 	request := oci_ons.DeleteSubscriptionRequest{}
 
 	tmp := s.D.Id()
@@ -250,7 +275,7 @@ func (s *OnsSubscriptionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "ons")
 
-	_, err := s.Client.DeleteSubscription(context.Background(), request)
+	_, err := s.Client.DeleteSubscription(ctx, request)
 	return err
 }
 
@@ -145,6 +145,15 @@ func TestOnsSubscriptionResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(singularDatasourceName, "state", "PENDING"),
 				),
 			},
+			// verify resource import
+			{
+				Config:                  config,
+				ImportState:             true,
+				ImportStateIdFunc:       getImportCompositeIdFunc(resourceName, "topics", "topic_id", "subscriptions", "id"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+				ResourceName:            resourceName,
+			},
 		},
 	})
 }
@@ -14,7 +14,8 @@ func OnsSubscriptionsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readOnsSubscriptions,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -37,7 +38,7 @@ func readOnsSubscriptions(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).notificationDataPlaneClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type OnsSubscriptionsDataSourceCrud struct {
@@ -50,7 +51,7 @@ func (s *OnsSubscriptionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OnsSubscriptionsDataSourceCrud) Get() error {
+func (s *OnsSubscriptionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_ons.ListSubscriptionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -64,8 +65,9 @@ func (s *OnsSubscriptionsDataSourceCrud) Get() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "ons")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListSubscriptions(context.Background(), request)
+	response, err := s.Client.ListSubscriptions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -73,8 +75,8 @@ func (s *OnsSubscriptionsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListSubscriptions(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListSubscriptions(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -142,6 +144,8 @@ func (s *OnsSubscriptionsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, OnsSubscriptionsDataSource().Schema["subscriptions"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("subscriptions", resources); err != nil {
 		return err
 	}
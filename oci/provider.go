@@ -13,6 +13,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -33,6 +34,7 @@ const (
 	authAPIKeySetting                     = "ApiKey"
 	authInstancePrincipalSetting          = "InstancePrincipal"
 	authInstancePrincipalWithCertsSetting = "InstancePrincipalWithCerts"
+	authSecurityTokenSetting              = "SecurityToken"
 	requestHeaderOpcOboToken              = "opc-obo-token"
 	requestHeaderOpcHostSerial            = "opc-host-serial"
 	defaultRequestTimeout                 = 0
@@ -57,6 +59,21 @@ const (
 	disableAutoRetriesAttrName   = "disable_auto_retries"
 	retryDurationSecondsAttrName = "retry_duration_seconds"
 	oboTokenAttrName             = "obo_token"
+	retryPolicyAttrName          = "retry_policy"
+
+	rateLimitRequestsPerSecondAttrName    = "rate_limit_requests_per_second"
+	rateLimitBurstAttrName                = "rate_limit_burst"
+	circuitBreakerThresholdAttrName       = "circuit_breaker_threshold"
+	circuitBreakerCooldownSecondsAttrName = "circuit_breaker_cooldown_seconds"
+	requestLogFileAttrName                = "request_log_file"
+	configFileProfileAttrName             = "config_file_profile"
+	configFilePathAttrName                = "config_file_path"
+
+	retryPolicyServiceAttrName              = "service"
+	retryPolicyRetriableStatusCodesAttrName = "retriable_status_codes"
+	retryPolicyRetriableErrorCodesAttrName  = "retriable_error_codes"
+	retryPolicyMaxDurationSecondsAttrName   = "max_duration_seconds"
+	retryPolicyBackoffAttrName              = "backoff"
 
 	tfEnvPrefix  = "TF_VAR_"
 	ociEnvPrefix = "OCI_"
@@ -92,7 +109,7 @@ func ociVarName(attrName string) string {
 
 func init() {
 	descriptions = map[string]string{
-		authAttrName:        fmt.Sprintf("(Optional) The type of auth to use. Options are '%s' and '%s'. By default, '%s' will be used.", authAPIKeySetting, authInstancePrincipalSetting, authAPIKeySetting),
+		authAttrName:        fmt.Sprintf("(Optional) The type of auth to use. Options are '%s', '%s' and '%s'. By default, '%s' will be used.", authAPIKeySetting, authInstancePrincipalSetting, authSecurityTokenSetting, authAPIKeySetting),
 		tenancyOcidAttrName: fmt.Sprintf("(Optional) The tenancy OCID for a user. The tenancy OCID can be found at the bottom of user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s', ignored otherwise.", authAPIKeySetting),
 		userOcidAttrName:    fmt.Sprintf("(Optional) The user OCID. This can be found in user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s', ignored otherwise.", authAPIKeySetting),
 		fingerprintAttrName: fmt.Sprintf("(Optional) The fingerprint for the user's RSA key. This can be found in user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s', ignored otherwise.", authAPIKeySetting),
@@ -106,16 +123,48 @@ func init() {
 			"Automatic retries were introduced to solve some eventual consistency problems but it also introduced performance issues on destroy operations.",
 		retryDurationSecondsAttrName: "(Optional) The minimum duration (in seconds) to retry a resource operation in response to an error.\n" +
 			"The actual retry duration may be longer due to jittering of retry operations. This value is ignored if the `disable_auto_retries` field is set to true.",
+		retryPolicyAttrName: "(Optional) Retry behavior for a single service, in addition to the provider's default retry behavior.\n" +
+			"Errors matching one of the retriable status codes or service error codes are retried for up to `max_duration_seconds`. This block is ignored if the `disable_auto_retries` field is set to true.",
+		rateLimitRequestsPerSecondAttrName: "(Optional) The maximum number of requests per second sent to each service endpoint, shared by all resources.\n" +
+			"By default, requests are not rate limited.",
+		rateLimitBurstAttrName: "(Optional) The number of requests that may be sent to a service endpoint in a burst above `rate_limit_requests_per_second`.",
+		circuitBreakerThresholdAttrName: "(Optional) The number of consecutive HTTP 429 or 5xx responses from a service endpoint after which requests to that endpoint are paused.\n" +
+			"By default, requests are never paused.",
+		circuitBreakerCooldownSecondsAttrName: "(Optional) The duration (in seconds) for which requests to a service endpoint are paused once `circuit_breaker_threshold` is reached. Defaults to 30 seconds.",
+		requestLogFileAttrName: "(Optional) The path to a file to which a JSON line is appended for every request sent to a service.\n" +
+			"Each line includes the resource type, Terraform operation, status code, opc-request-id and latency of the request.",
+		configFileProfileAttrName: "(Optional) The profile of the config file from which the credentials and region that are not set in the provider block are read.\n" +
+			fmt.Sprintf("If auth is set to '%s', the session is read from this profile. Defaults to '%s'.", authSecurityTokenSetting, defaultConfigFileProfile),
+		configFilePathAttrName: "(Optional) The path to the config file from which `config_file_profile` is read. Defaults to ~/.oci/config.",
 	}
 }
 
 // Provider is the adapter for terraform, that gives access to all the resources
 func Provider(configfn schema.ConfigureFunc) terraform.ResourceProvider {
-	return &schema.Provider{
-		DataSourcesMap: dataSourcesMap(),
+	provider := &schema.Provider{
+		DataSourcesMap: withRequestLogScopes(withFilterValidation(dataSourcesMap())),
 		Schema:         schemaMap(),
-		ResourcesMap:   resourcesMap(),
-		ConfigureFunc:  configfn,
+		ResourcesMap:   withRequestLogScopes(resourcesMap()),
+	}
+	provider.ConfigureFunc = withStopContext(provider, configfn)
+	return provider
+}
+
+// withStopContext makes the provider's stop context available to the configured clients, so that service calls
+// and polling are abandoned when Terraform stops the provider. The request log is closed along with it.
+func withStopContext(provider *schema.Provider, configfn schema.ConfigureFunc) schema.ConfigureFunc {
+	if configfn == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData) (interface{}, error) {
+		clients, err := configfn(d)
+		if oracleClients, ok := clients.(*OracleClients); ok && err == nil {
+			oracleClients.stopContext = provider.StopContext()
+			if oracleClients.requestLogger != nil {
+				oracleClients.requestLogger.closeWhenDone(oracleClients.stopContext)
+			}
+		}
+		return clients, err
 	}
 }
 
@@ -126,7 +175,7 @@ func schemaMap() map[string]*schema.Schema {
 			Optional:     true,
 			Description:  descriptions[authAttrName],
 			DefaultFunc:  schema.MultiEnvDefaultFunc([]string{tfVarName(authAttrName), ociVarName(authAttrName)}, authAPIKeySetting),
-			ValidateFunc: validation.StringInSlice([]string{authAPIKeySetting, authInstancePrincipalSetting, authInstancePrincipalWithCertsSetting}, true),
+			ValidateFunc: validation.StringInSlice([]string{authAPIKeySetting, authInstancePrincipalSetting, authInstancePrincipalWithCertsSetting, authSecurityTokenSetting}, true),
 		},
 		tenancyOcidAttrName: {
 			Type:        schema.TypeString,
@@ -188,6 +237,90 @@ func schemaMap() map[string]*schema.Schema {
 			Description: descriptions[retryDurationSecondsAttrName],
 			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(retryDurationSecondsAttrName), ociVarName(retryDurationSecondsAttrName)}, nil),
 		},
+		rateLimitRequestsPerSecondAttrName: {
+			Type:        schema.TypeFloat,
+			Optional:    true,
+			Description: descriptions[rateLimitRequestsPerSecondAttrName],
+			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(rateLimitRequestsPerSecondAttrName), ociVarName(rateLimitRequestsPerSecondAttrName)}, nil),
+		},
+		rateLimitBurstAttrName: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  descriptions[rateLimitBurstAttrName],
+			DefaultFunc:  schema.MultiEnvDefaultFunc([]string{tfVarName(rateLimitBurstAttrName), ociVarName(rateLimitBurstAttrName)}, nil),
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		circuitBreakerThresholdAttrName: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  descriptions[circuitBreakerThresholdAttrName],
+			DefaultFunc:  schema.MultiEnvDefaultFunc([]string{tfVarName(circuitBreakerThresholdAttrName), ociVarName(circuitBreakerThresholdAttrName)}, nil),
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		circuitBreakerCooldownSecondsAttrName: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  descriptions[circuitBreakerCooldownSecondsAttrName],
+			DefaultFunc:  schema.MultiEnvDefaultFunc([]string{tfVarName(circuitBreakerCooldownSecondsAttrName), ociVarName(circuitBreakerCooldownSecondsAttrName)}, nil),
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		requestLogFileAttrName: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions[requestLogFileAttrName],
+			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(requestLogFileAttrName), ociVarName(requestLogFileAttrName)}, nil),
+		},
+		configFileProfileAttrName: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions[configFileProfileAttrName],
+			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(configFileProfileAttrName), ociVarName(configFileProfileAttrName)}, nil),
+		},
+		configFilePathAttrName: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions[configFilePathAttrName],
+			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(configFilePathAttrName), ociVarName(configFilePathAttrName)}, nil),
+		},
+		retryPolicyAttrName: {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: descriptions[retryPolicyAttrName],
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					retryPolicyServiceAttrName: {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(retryPolicyServices, false),
+					},
+					retryPolicyRetriableStatusCodesAttrName: {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeInt,
+							ValidateFunc: validation.IntBetween(400, 599),
+						},
+					},
+					retryPolicyRetriableErrorCodesAttrName: {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					retryPolicyMaxDurationSecondsAttrName: {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+					retryPolicyBackoffAttrName: {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      quadraticBackoffSetting,
+						ValidateFunc: validation.StringInSlice([]string{quadraticBackoffSetting, exponentialBackoffSetting, fixedBackoffSetting}, false),
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -455,9 +588,11 @@ func resourcesMap() map[string]*schema.Resource {
 		"oci_core_default_route_table":                            DefaultCoreRouteTableResource(),
 		"oci_core_route_table":                                    CoreRouteTableResource(),
 		"oci_core_route_table_attachment":                         CoreRouteTableAttachmentResource(),
+		"oci_core_route_table_rule":                               CoreRouteTableRuleResource(),
 		"oci_core_remote_peering_connection":                      CoreRemotePeeringConnectionResource(),
 		"oci_core_default_security_list":                          CoreDefaultSecurityListResource(),
 		"oci_core_security_list":                                  CoreSecurityListResource(),
+		"oci_core_security_list_rule":                             CoreSecurityListRuleResource(),
 		"oci_core_service_gateway":                                CoreServiceGatewayResource(),
 		"oci_core_subnet":                                         CoreSubnetResource(),
 		"oci_core_virtual_circuit":                                CoreVirtualCircuitResource(),
@@ -532,6 +667,7 @@ func resourcesMap() map[string]*schema.Resource {
 		"oci_objectstorage_bucket":                                ObjectStorageBucketResource(),
 		"oci_objectstorage_object_lifecycle_policy":               ObjectStorageObjectLifecyclePolicyResource(),
 		"oci_objectstorage_object":                                ObjectStorageObjectResource(),
+		"oci_objectstorage_object_set":                            ObjectStorageObjectSetResource(),
 		"oci_objectstorage_namespace_metadata":                    ObjectStorageNamespaceMetadataResource(),
 		"oci_objectstorage_preauthrequest":                        ObjectStoragePreauthenticatedRequestResource(),
 		"oci_ons_notification_topic":                              OnsNotificationTopicResource(),
@@ -571,12 +707,33 @@ func getRequiredEnvSetting(s string) string {
 	return v
 }
 
-func validateConfigForAPIKeyAuth(d *schema.ResourceData) error {
-	_, hasTenancyOCID := d.GetOkExists(tenancyOcidAttrName)
-	_, hasUserOCID := d.GetOkExists(userOcidAttrName)
-	_, hasFingerprint := d.GetOkExists(fingerprintAttrName)
-	if !hasTenancyOCID || !hasUserOCID || !hasFingerprint {
-		return fmt.Errorf("when auth is set to '%s', tenancy_ocid, user_ocid, and fingerprint are required", authAPIKeySetting)
+// validateConfigForAPIKeyAuth checks that the credentials are set either in the provider block or in the selected
+// profile of the config file, and names the ones that are missing
+func validateConfigForAPIKeyAuth(d *schema.ResourceData, configFile *configFileConfigProvider) error {
+	var missing []string
+	for attrName, configFileKey := range map[string]string{
+		tenancyOcidAttrName: configFileTenancyKey,
+		userOcidAttrName:    configFileUserKey,
+		fingerprintAttrName: configFileFingerprintKey,
+	} {
+		if _, ok := d.GetOkExists(attrName); !ok && configFile.values[configFileKey] == "" {
+			missing = append(missing, attrName)
+		}
+	}
+	_, hasPrivateKey := d.GetOk(privateKeyAttrName)
+	_, hasPrivateKeyPath := d.GetOk(privateKeyPathAttrName)
+	if !hasPrivateKey && !hasPrivateKeyPath && configFile.values[configFileKeyFileKey] == "" {
+		missing = append(missing, privateKeyPathAttrName)
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		verb := "are"
+		if len(missing) == 1 {
+			verb = "is"
+		}
+		return fmt.Errorf("when auth is set to '%s', tenancy_ocid, user_ocid, fingerprint and a private key are required, but %s %s not set in the provider block or in %s",
+			authAPIKeySetting, strings.Join(missing, ", "), verb, configFile)
 	}
 	return nil
 }
@@ -619,6 +776,13 @@ func ProviderConfig(d *schema.ResourceData) (clients interface{}, err error) {
 		configuredRetryDuration = &val
 	}
 
+	configuredServiceRetryPolicies = map[string]*serviceRetryPolicy{}
+	if !d.Get(disableAutoRetriesAttrName).(bool) {
+		if err := setServiceRetryPolicies(d); err != nil {
+			return nil, err
+		}
+	}
+
 	auth := strings.ToLower(d.Get(authAttrName).(string))
 	clients.(*OracleClients).configuration[authAttrName] = auth
 
@@ -637,11 +801,16 @@ func ProviderConfig(d *schema.ResourceData) (clients interface{}, err error) {
 		},
 	}
 
+	configFile, err := getConfigFileConfigProvider(d)
+	if err != nil {
+		return nil, err
+	}
+
 	var configProviders []oci_common.ConfigurationProvider
 
 	switch auth {
 	case strings.ToLower(authAPIKeySetting):
-		if err := validateConfigForAPIKeyAuth(d); err != nil {
+		if err := validateConfigForAPIKeyAuth(d, configFile); err != nil {
 			return nil, err
 		}
 	case strings.ToLower(authInstancePrincipalSetting):
@@ -708,23 +877,49 @@ func ProviderConfig(d *schema.ResourceData) (clients interface{}, err error) {
 			return nil, err
 		}
 		configProviders = append(configProviders, cfg)
+	case strings.ToLower(authSecurityTokenSetting):
+		apiKeyConfigVariablesToUnset, ok := checkIncompatibleAttrsForApiKeyAuth(d)
+		if !ok {
+			return nil, fmt.Errorf(`user credentials %v should be removed from the configuration`, strings.Join(apiKeyConfigVariablesToUnset, ", "))
+		}
+
+		region := ""
+		if regionValue, ok := d.GetOkExists(regionAttrName); ok {
+			region = regionValue.(string)
+		}
+
+		cfg, err := newSecurityTokenConfigProvider(configFile, region)
+		if err != nil {
+			return nil, err
+		}
+		configProviders = append(configProviders, cfg)
 	default:
-		return nil, fmt.Errorf("auth must be one of '%s' or '%s' or '%s'", authAPIKeySetting, authInstancePrincipalSetting, authInstancePrincipalWithCertsSetting)
+		return nil, fmt.Errorf("auth must be one of '%s' or '%s' or '%s' or '%s'", authAPIKeySetting, authInstancePrincipalSetting, authInstancePrincipalWithCertsSetting, authSecurityTokenSetting)
 	}
 
 	configProviders = append(configProviders, ResourceDataConfigProvider{d})
 
-	// TODO: DefaultConfigProvider will return us a composingConfigurationProvider that reads from SDK config files,
-	// and then from the environment variables ("TF_VAR" prefix). References to "TF_VAR" prefix should be removed from
-	// the SDK, since it's Terraform specific. When that happens, we need to update this to pass in the right prefix.
-	configProviders = append(configProviders, oci_common.DefaultConfigProvider())
+	// Values that are not set in the provider block are read from the selected profile of the config file
+	configProviders = append(configProviders, configFile)
 
 	officialSdkConfigProvider, err := oci_common.ComposingConfigurationProvider(configProviders)
 	if err != nil {
 		return nil, err
 	}
 
-	err = setGoSDKClients(clients.(*OracleClients), officialSdkConfigProvider, httpClient, userAgent)
+	throttler := newClientThrottler(
+		d.Get(rateLimitRequestsPerSecondAttrName).(float64),
+		d.Get(rateLimitBurstAttrName).(int),
+		d.Get(circuitBreakerThresholdAttrName).(int),
+		time.Duration(d.Get(circuitBreakerCooldownSecondsAttrName).(int))*time.Second)
+
+	requestLogger, err := newRequestLogger(d.Get(requestLogFileAttrName).(string))
+	if err != nil {
+		return nil, fmt.Errorf("can not open %s: %v", requestLogFileAttrName, err)
+	}
+	clients.(*OracleClients).requestLogger = requestLogger
+
+	err = setGoSDKClients(clients.(*OracleClients), officialSdkConfigProvider, httpClient, userAgent, throttler)
 	if err != nil {
 		return nil, err
 	}
@@ -734,13 +929,49 @@ func ProviderConfig(d *schema.ResourceData) (clients interface{}, err error) {
 	return clients, nil
 }
 
+func setServiceRetryPolicies(d *schema.ResourceData) error {
+	for _, raw := range d.Get(retryPolicyAttrName).([]interface{}) {
+		policyConfig, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		service := policyConfig[retryPolicyServiceAttrName].(string)
+		if _, exists := configuredServiceRetryPolicies[service]; exists {
+			return fmt.Errorf("%s is specified more than once for service '%s'", retryPolicyAttrName, service)
+		}
+
+		policy := &serviceRetryPolicy{
+			retriableStatusCodes: map[int]bool{},
+			backoff:              policyConfig[retryPolicyBackoffAttrName].(string),
+		}
+		for _, statusCode := range policyConfig[retryPolicyRetriableStatusCodesAttrName].([]interface{}) {
+			policy.retriableStatusCodes[statusCode.(int)] = true
+		}
+		for _, errorCode := range policyConfig[retryPolicyRetriableErrorCodesAttrName].([]interface{}) {
+			policy.retriableErrorCodes = append(policy.retriableErrorCodes, errorCode.(string))
+		}
+		if maxDurationSeconds := policyConfig[retryPolicyMaxDurationSecondsAttrName].(int); maxDurationSeconds != 0 {
+			val := time.Duration(maxDurationSeconds) * time.Second
+			if maxDurationSeconds < 0 {
+				// Retry for maximum amount of time, if a negative value was specified
+				val = time.Duration(math.MaxInt64)
+			}
+			policy.maxDuration = &val
+		}
+
+		configuredServiceRetryPolicies[service] = policy
+	}
+	return nil
+}
+
 type ResourceDataConfigProvider struct {
 	D *schema.ResourceData
 }
 
-// TODO: The error messages returned by following methods get swallowed up by the ComposingConfigurationProvider,
-// since it only checks whether an error exists or not.
-// The ComposingConfigurationProvider in SDK should log the errors as debug statements instead.
+// The error messages returned by following methods get swallowed up by the ComposingConfigurationProvider,
+// since it only checks whether an error exists or not. Required values are validated by ProviderConfig instead,
+// which names the ones that are missing.
 
 func (p ResourceDataConfigProvider) TenancyOCID() (string, error) {
 	if tenancyOCID, ok := p.D.GetOkExists(tenancyOcidAttrName); ok {
@@ -3,6 +3,7 @@
 package provider
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
@@ -37,7 +38,11 @@ type ConfigureClient func(client *oci_common.BaseClient) error
 
 var configureClient ConfigureClient
 
-func setGoSDKClients(clients *OracleClients, officialSdkConfigProvider oci_common.ConfigurationProvider, httpClient *http.Client, userAgent string) (err error) {
+// httpDispatcherWrapper wraps the dispatcher of every client when set. The acceptance tests use it to record and
+// replay the requests sent to the services.
+var httpDispatcherWrapper func(dispatcher oci_common.HTTPRequestDispatcher) oci_common.HTTPRequestDispatcher
+
+func setGoSDKClients(clients *OracleClients, officialSdkConfigProvider oci_common.ConfigurationProvider, httpClient *http.Client, userAgent string, throttler *clientThrottler) (err error) {
 	// Official Go SDK clients:
 
 	auditClient, err := oci_audit.NewAuditClientWithConfigurationProvider(officialSdkConfigProvider)
@@ -159,6 +164,18 @@ func setGoSDKClients(clients *OracleClients, officialSdkConfigProvider oci_commo
 		client.UserAgent = userAgent
 		client.Signer = requestSigner
 		client.Interceptor = func(r *http.Request) error {
+			// Calls that are not made with the provider's stop context (e.g. lookups while setting state) are
+			// still abandoned once Terraform stops the provider
+			if err := clients.StopContext().Err(); err != nil {
+				return fmt.Errorf("request to %s was abandoned because Terraform is stopping the provider: %v", r.URL.Path, err)
+			}
+
+			if throttler != nil {
+				if err := throttler.wait(r); err != nil {
+					return err
+				}
+			}
+
 			if oboToken, err := oboTokenProvider.OboToken(); err == nil && oboToken != "" {
 				r.Header.Set(requestHeaderOpcOboToken, oboToken)
 			}
@@ -213,6 +230,17 @@ func setGoSDKClients(clients *OracleClients, officialSdkConfigProvider oci_commo
 			return fmt.Errorf("both certificate location and domain name must be specified to target r1")
 		}
 
+		// Must be done last, since the http.Client may have been patched above
+		if httpDispatcherWrapper != nil {
+			client.HTTPClient = httpDispatcherWrapper(client.HTTPClient)
+		}
+		if clients.requestLogger != nil {
+			client.HTTPClient = requestLoggingDispatcher{dispatcher: client.HTTPClient, logger: clients.requestLogger}
+		}
+		if throttler != nil {
+			client.HTTPClient = throttledDispatcher{dispatcher: client.HTTPClient, throttler: throttler}
+		}
+
 		return nil
 	}
 
@@ -367,6 +395,17 @@ type OracleClients struct {
 	virtualNetworkClient           *oci_core.VirtualNetworkClient
 	waasClient                     *oci_waas.WaasClient
 	configuration                  map[string]string
+	stopContext                    context.Context
+	requestLogger                  *requestLogger
+}
+
+// StopContext returns the context used for all service calls made by resources and data sources.
+// It is cancelled when Terraform stops the provider, e.g. on an interrupted apply.
+func (m *OracleClients) StopContext() context.Context {
+	if m.stopContext == nil {
+		return context.Background()
+	}
+	return m.stopContext
 }
 
 func (m *OracleClients) KmsCryptoClient(endpoint string) (*oci_kms.KmsCryptoClient, error) {
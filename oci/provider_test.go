@@ -5,10 +5,12 @@ package provider
 import (
 	"fmt"
 	"net/http"
+	"os"
 	"runtime"
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -23,7 +25,29 @@ var requiredTestEnvVars = []string{"compartment_ocid", "compartment_id_for_creat
 var requiredKeyAuthEnvVars = []string{"tenancy_ocid", "user_ocid", "fingerprint"}
 var requiredOboTokenAuthEnvVars = []string{"tenancy_ocid", "obo_token"}
 
+// The test variables recorded with the cassettes, which the replayed tests reference instead of the environment
+var httpReplayTestEnvVars = append([]string{"tenancy_ocid", "user_ocid", "region", "source_region"}, requiredTestEnvVars...)
+
+// testHttpReplayer records or replays the requests of the acceptance tests when http_replay_mode is set
+var testHttpReplayer *httpReplayer
+
 func init() {
+	var err error
+	if testHttpReplayer, err = newHttpReplayerFromEnv(); err != nil {
+		panic(err)
+	}
+	if testHttpReplayer != nil {
+		httpDispatcherWrapper = testHttpReplayer.wrap
+		if testHttpReplayer.isReplaying() {
+			err = testHttpReplayer.loadVariables()
+		} else {
+			err = testHttpReplayer.saveVariables(httpReplayTestEnvVars)
+		}
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	testAccProvider = testProvider(func(d *schema.ResourceData) (interface{}, error) {
 		return GetTestClients(d), nil
 	}).(*schema.Provider)
@@ -36,11 +60,11 @@ func init() {
 // Provider is the adapter for terraform, that gives access to all the resources
 func testProvider(configfn schema.ConfigureFunc) terraform.ResourceProvider {
 	result := &schema.Provider{
-		DataSourcesMap: dataSourcesMap(),
+		DataSourcesMap: withRequestLogScopes(dataSourcesMap()),
 		Schema:         schemaMap(),
-		ResourcesMap:   resourcesMap(),
-		ConfigureFunc:  configfn,
+		ResourcesMap:   withRequestLogScopes(resourcesMap()),
 	}
+	result.ConfigureFunc = withStopContext(result, configfn)
 
 	// Additions for test parameters
 	result.Schema["test_time_maintenance_reboot_due"] = &schema.Schema{Type: schema.TypeString, Optional: true}
@@ -74,6 +98,19 @@ func testProviderConfig() string {
 }
 
 func testAccPreCheck(t *testing.T) {
+	if testHttpReplayer != nil {
+		if err := testHttpReplayer.start(t.Name()); err != nil {
+			if os.IsNotExist(err) {
+				t.Skipf("no requests were recorded for %s", t.Name())
+			}
+			t.Fatal(err)
+		}
+		// Replayed tests do not need credentials
+		if testHttpReplayer.isReplaying() {
+			return
+		}
+	}
+
 	envVarChecklist := []string{}
 	copy(envVarChecklist, requiredTestEnvVars)
 	if getEnvSettingWithDefault("use_obo_token", "false") != "false" {
@@ -249,7 +286,17 @@ func GetTestClients(data *schema.ResourceData) *OracleClients {
 	d.Set("tenancy_ocid", getEnvSettingWithBlankDefault("tenancy_ocid"))
 	d.Set("region", getEnvSettingWithDefault("region", "us-phoenix-1"))
 
-	if auth := getEnvSettingWithDefault("auth", authAPIKeySetting); auth == authAPIKeySetting {
+	if testHttpReplayer.isReplaying() {
+		// Replayed requests are not sent to the services, so they can be signed with a fake key
+		d.Set("auth", authAPIKeySetting)
+		d.Set("user_ocid", getEnvSettingWithDefault("user_ocid", testUserOCID))
+		d.Set("fingerprint", testKeyFingerPrint)
+		d.Set("private_key", testPrivateKey)
+		d.Set("private_key_password", "password")
+		if tenancyOcid := getEnvSettingWithBlankDefault("tenancy_ocid"); tenancyOcid == "" {
+			d.Set("tenancy_ocid", testTenancyOCID)
+		}
+	} else if auth := getEnvSettingWithDefault("auth", authAPIKeySetting); auth == authAPIKeySetting {
 		d.Set("auth", getEnvSettingWithDefault("auth", authAPIKeySetting))
 		d.Set("user_ocid", getEnvSettingWithBlankDefault("user_ocid"))
 		d.Set("fingerprint", getEnvSettingWithBlankDefault("fingerprint"))
@@ -466,6 +513,55 @@ func TestVerifyConfigForAPIKeyAuthIsNotSet_basic(t *testing.T) {
 	assert.True(t, len(apiKeyConfigVariablesToUnset) == 5, "apiKey config variables to unset: %v", apiKeyConfigVariablesToUnset)
 }
 
+func TestProviderConfig_retryPolicy(t *testing.T) {
+	r := &schema.Resource{
+		Schema: schemaMap(),
+	}
+	d := r.Data(nil)
+	d.Set(retryPolicyAttrName, []interface{}{
+		map[string]interface{}{
+			retryPolicyServiceAttrName:              identityService,
+			retryPolicyRetriableStatusCodesAttrName: []interface{}{404, 409},
+			retryPolicyRetriableErrorCodesAttrName:  []interface{}{"NotAuthorizedOrNotFound"},
+			retryPolicyMaxDurationSecondsAttrName:   120,
+			retryPolicyBackoffAttrName:              exponentialBackoffSetting,
+		},
+	})
+
+	configuredServiceRetryPolicies = map[string]*serviceRetryPolicy{}
+	defer func() {
+		configuredServiceRetryPolicies = map[string]*serviceRetryPolicy{}
+	}()
+	assert.NoError(t, setServiceRetryPolicies(d))
+
+	policy, ok := configuredServiceRetryPolicies[identityService]
+	assert.True(t, ok)
+	assert.True(t, policy.retriableStatusCodes[404])
+	assert.True(t, policy.retriableStatusCodes[409])
+	assert.Equal(t, []string{"NotAuthorizedOrNotFound"}, policy.retriableErrorCodes)
+	assert.Equal(t, 120*time.Second, *policy.maxDuration)
+	assert.Equal(t, exponentialBackoffSetting, policy.backoff)
+
+	// Specifying the same service more than once is ambiguous
+	configuredServiceRetryPolicies = map[string]*serviceRetryPolicy{}
+	d.Set(retryPolicyAttrName, []interface{}{
+		map[string]interface{}{retryPolicyServiceAttrName: identityService},
+		map[string]interface{}{retryPolicyServiceAttrName: identityService},
+	})
+	assert.Error(t, setServiceRetryPolicies(d))
+}
+
+func TestProviderConfig_retryPolicyService(t *testing.T) {
+	serviceSchema := schemaMap()[retryPolicyAttrName].Elem.(*schema.Resource).Schema[retryPolicyServiceAttrName]
+
+	_, errs := serviceSchema.ValidateFunc(objectstorageService, retryPolicyServiceAttrName)
+	assert.Empty(t, errs)
+
+	// A misspelled service would silently keep the default retry behavior
+	_, errs = serviceSchema.ValidateFunc("objectstorage", retryPolicyServiceAttrName)
+	assert.NotEmpty(t, errs)
+}
+
 /* This function is used in the test asserts to verify that an element in a set contains certain properties
  * properties is a map of nameOfProperty -> expectedValueOfProperty
  * presentProperties is an array of property names that are expected to be set in the set element but we don't care about matching the value
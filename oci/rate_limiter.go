@@ -0,0 +1,163 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"log"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+)
+
+const (
+	defaultCircuitBreakerCooldown = 30 * time.Second
+	requestHeaderDate             = "date"
+)
+
+// clientThrottler limits the rate of requests sent to each service host and stops sending requests to a host
+// for a cooldown period once it keeps responding with throttling or server errors. A single throttler is shared
+// by all the SDK clients created by the provider, so that parallel resource operations share the same knowledge.
+type clientThrottler struct {
+	requestsPerSecond float64
+	burst             float64
+	failureThreshold  int
+	cooldown          time.Duration
+
+	mutex     sync.Mutex
+	throttles map[string]*hostThrottle
+}
+
+// hostThrottle is the token bucket and circuit breaker state for a single service host
+type hostThrottle struct {
+	mutex               sync.Mutex
+	tokens              float64
+	lastRefill          time.Time
+	consecutiveFailures int
+	openUntil           time.Time
+}
+
+// throttledDispatcher records the outcome of every request in the throttler, so that the circuit breaker
+// can trip when a service host is overloaded
+type throttledDispatcher struct {
+	dispatcher oci_common.HTTPRequestDispatcher
+	throttler  *clientThrottler
+}
+
+func newClientThrottler(requestsPerSecond float64, burst int, failureThreshold int, cooldown time.Duration) *clientThrottler {
+	if requestsPerSecond <= 0 && failureThreshold <= 0 {
+		return nil
+	}
+
+	if burst <= 0 {
+		burst = int(math.Max(1, math.Ceil(requestsPerSecond)))
+	}
+	if cooldown <= 0 {
+		cooldown = defaultCircuitBreakerCooldown
+	}
+
+	return &clientThrottler{
+		requestsPerSecond: requestsPerSecond,
+		burst:             float64(burst),
+		failureThreshold:  failureThreshold,
+		cooldown:          cooldown,
+		throttles:         map[string]*hostThrottle{},
+	}
+}
+
+func (t *clientThrottler) getHostThrottle(host string) *hostThrottle {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	throttle, ok := t.throttles[host]
+	if !ok {
+		throttle = &hostThrottle{tokens: t.burst, lastRefill: time.Now()}
+		t.throttles[host] = throttle
+	}
+	return throttle
+}
+
+// reserve returns how long the caller needs to wait before sending a request to the host. A zero duration means
+// that the request may be sent right away and a token has been taken from the bucket.
+func (t *clientThrottler) reserve(throttle *hostThrottle, now time.Time) time.Duration {
+	throttle.mutex.Lock()
+	defer throttle.mutex.Unlock()
+
+	if now.Before(throttle.openUntil) {
+		return throttle.openUntil.Sub(now)
+	}
+
+	if t.requestsPerSecond <= 0 {
+		return 0
+	}
+
+	elapsed := now.Sub(throttle.lastRefill).Seconds()
+	throttle.tokens = math.Min(t.burst, throttle.tokens+elapsed*t.requestsPerSecond)
+	throttle.lastRefill = now
+
+	if throttle.tokens >= 1 {
+		throttle.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - throttle.tokens) / t.requestsPerSecond * float64(time.Second))
+}
+
+// wait blocks until the request can be sent to its service host, or until the request's context is done
+func (t *clientThrottler) wait(r *http.Request) error {
+	throttle := t.getHostThrottle(r.URL.Host)
+
+	waited := false
+	for {
+		delay := t.reserve(throttle, time.Now())
+		if delay == 0 {
+			break
+		}
+
+		waited = true
+		select {
+		case <-r.Context().Done():
+			return r.Context().Err()
+		case <-time.After(delay):
+		}
+	}
+
+	// The date header is part of the request signature and must not be stale by the time the request is sent
+	if waited {
+		r.Header.Set(requestHeaderDate, time.Now().UTC().Format(http.TimeFormat))
+	}
+	return nil
+}
+
+func (t *clientThrottler) recordResponse(host string, statusCode int) {
+	if t.failureThreshold <= 0 {
+		return
+	}
+
+	throttle := t.getHostThrottle(host)
+	throttle.mutex.Lock()
+	defer throttle.mutex.Unlock()
+
+	if statusCode != 429 && statusCode < 500 {
+		throttle.consecutiveFailures = 0
+		return
+	}
+
+	throttle.consecutiveFailures++
+	if throttle.consecutiveFailures >= t.failureThreshold {
+		throttle.openUntil = time.Now().Add(t.cooldown)
+		// Allow requests again after the cooldown, but trip again right away if the first of them fails too
+		throttle.consecutiveFailures = t.failureThreshold - 1
+		log.Printf("[WARN] %d consecutive requests to %s failed with throttling or server errors, pausing requests for %v", t.failureThreshold, host, t.cooldown)
+	}
+}
+
+func (d throttledDispatcher) Do(r *http.Request) (*http.Response, error) {
+	response, err := d.dispatcher.Do(r)
+	if response != nil {
+		d.throttler.recordResponse(r.URL.Host, response.StatusCode)
+	}
+	return response, err
+}
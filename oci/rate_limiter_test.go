@@ -0,0 +1,68 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientThrottler_disabled(t *testing.T) {
+	assert.Nil(t, newClientThrottler(0, 0, 0, 0))
+}
+
+func TestClientThrottler_tokenBucket(t *testing.T) {
+	throttler := newClientThrottler(2, 2, 0, 0)
+	throttle := throttler.getHostThrottle("iaas.us-phoenix-1.oraclecloud.com")
+	now := throttle.lastRefill
+
+	// The burst is available right away
+	assert.Equal(t, time.Duration(0), throttler.reserve(throttle, now))
+	assert.Equal(t, time.Duration(0), throttler.reserve(throttle, now))
+
+	// A token is added every half second
+	assert.Equal(t, 500*time.Millisecond, throttler.reserve(throttle, now))
+	assert.Equal(t, time.Duration(0), throttler.reserve(throttle, now.Add(500*time.Millisecond)))
+
+	// Other hosts have their own bucket
+	otherThrottle := throttler.getHostThrottle("objectstorage.us-phoenix-1.oraclecloud.com")
+	assert.Equal(t, time.Duration(0), throttler.reserve(otherThrottle, now))
+}
+
+func TestClientThrottler_circuitBreaker(t *testing.T) {
+	host := "iaas.us-phoenix-1.oraclecloud.com"
+	throttler := newClientThrottler(0, 0, 3, time.Minute)
+	throttle := throttler.getHostThrottle(host)
+
+	throttler.recordResponse(host, 429)
+	throttler.recordResponse(host, 500)
+	throttler.recordResponse(host, 200)
+	throttler.recordResponse(host, 503)
+	throttler.recordResponse(host, 429)
+	assert.Equal(t, time.Duration(0), throttler.reserve(throttle, time.Now()), "successful responses should reset the failure count")
+
+	throttler.recordResponse(host, 429)
+	assert.True(t, throttler.reserve(throttle, time.Now()) > 0, "requests should be paused after consecutive failures")
+	assert.Equal(t, time.Duration(0), throttler.reserve(throttle, time.Now().Add(time.Minute)), "requests should resume after the cooldown")
+
+	// A single failure after the cooldown trips the breaker again
+	throttler.recordResponse(host, 500)
+	assert.True(t, throttler.reserve(throttle, time.Now()) > 0)
+	assert.Equal(t, time.Duration(0), throttler.reserve(throttler.getHostThrottle("objectstorage.us-phoenix-1.oraclecloud.com"), time.Now()))
+}
+
+func TestClientThrottler_waitCancelled(t *testing.T) {
+	host := "iaas.us-phoenix-1.oraclecloud.com"
+	throttler := newClientThrottler(0, 0, 1, time.Hour)
+	throttler.recordResponse(host, 429)
+
+	request, _ := http.NewRequest(http.MethodGet, "https://"+host+"/20160918/vcns", nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	assert.Error(t, throttler.wait(request.WithContext(ctx)))
+}
@@ -0,0 +1,240 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+)
+
+const (
+	responseHeaderOpcRequestId = "opc-request-id"
+
+	createOperation = "create"
+	readOperation   = "read"
+	updateOperation = "update"
+	deleteOperation = "delete"
+)
+
+type requestLogScopeKey struct{}
+
+// requestLogger writes a JSON line for every request sent to a service, so that the opc-request-id of failed
+// requests can be handed to Oracle support without re-running Terraform with trace logging
+type requestLogger struct {
+	mutex  sync.Mutex
+	writer io.Writer
+	closed bool
+}
+
+// requestLogScope identifies the Terraform operation on whose behalf requests are sent. All the requests of a
+// single operation share the same correlation ID.
+type requestLogScope struct {
+	correlationId string
+	resourceType  string
+	operation     string
+
+	mutex    sync.Mutex
+	attempts map[string]uint
+}
+
+type requestLogEntry struct {
+	Time          string `json:"time"`
+	CorrelationId string `json:"correlation_id,omitempty"`
+	ResourceType  string `json:"resource_type,omitempty"`
+	Operation     string `json:"operation,omitempty"`
+	Method        string `json:"method"`
+	Host          string `json:"host"`
+	Path          string `json:"path"`
+	Status        int    `json:"status,omitempty"`
+	OpcRequestId  string `json:"opc_request_id,omitempty"`
+	Attempt       uint   `json:"attempt,omitempty"`
+	LatencyMs     int64  `json:"latency_ms"`
+	Error         string `json:"error,omitempty"`
+}
+
+// requestLoggingDispatcher times every request and writes its outcome to the request log
+type requestLoggingDispatcher struct {
+	dispatcher oci_common.HTTPRequestDispatcher
+	logger     *requestLogger
+}
+
+func newRequestLogger(path string) (*requestLogger, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	return &requestLogger{writer: file}, nil
+}
+
+func (l *requestLogger) write(entry requestLogEntry) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		log.Printf("[WARN] unable to write to the request log: %v", err)
+		return
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.closed {
+		return
+	}
+	if _, err := l.writer.Write(append(line, '\n')); err != nil {
+		log.Printf("[WARN] unable to write to the request log: %v", err)
+	}
+}
+
+// closeWhenDone closes the request log once the context is done. The requests still in progress at that time are
+// not logged.
+func (l *requestLogger) closeWhenDone(ctx context.Context) {
+	go func() {
+		<-ctx.Done()
+
+		l.mutex.Lock()
+		defer l.mutex.Unlock()
+
+		l.closed = true
+		if closer, ok := l.writer.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				log.Printf("[WARN] unable to close the request log: %v", err)
+			}
+		}
+	}()
+}
+
+func newRequestLogScope(resourceType string, operation string) *requestLogScope {
+	correlationId := make([]byte, 8)
+	if _, err := rand.Read(correlationId); err != nil {
+		log.Printf("[WARN] unable to generate a request log correlation ID: %v", err)
+	}
+
+	return &requestLogScope{
+		correlationId: hex.EncodeToString(correlationId),
+		resourceType:  resourceType,
+		operation:     operation,
+		attempts:      map[string]uint{},
+	}
+}
+
+func getRequestLogScope(ctx context.Context) *requestLogScope {
+	if scope, ok := ctx.Value(requestLogScopeKey{}).(*requestLogScope); ok {
+		return scope
+	}
+	return nil
+}
+
+// attempt returns the retry attempt number of the request, as recorded by the provider's retry policy
+func (s *requestLogScope) attempt(r *http.Request) uint {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if attempt, ok := s.attempts[requestLogAttemptKey(r)]; ok {
+		return attempt
+	}
+	return 1
+}
+
+func requestLogAttemptKey(r *http.Request) string {
+	return r.Method + " " + r.URL.RequestURI()
+}
+
+// recordRequestAttempt keeps track of the attempt number of retried requests, so that retries can be told apart
+// in the request log
+func recordRequestAttempt(response oci_common.OCIOperationResponse, willRetry bool) {
+	if response.Response == nil {
+		return
+	}
+	httpResponse := response.Response.HTTPResponse()
+	if httpResponse == nil || httpResponse.Request == nil {
+		return
+	}
+	scope := getRequestLogScope(httpResponse.Request.Context())
+	if scope == nil {
+		return
+	}
+
+	scope.mutex.Lock()
+	defer scope.mutex.Unlock()
+
+	key := requestLogAttemptKey(httpResponse.Request)
+	if willRetry {
+		scope.attempts[key] = response.AttemptNumber + 1
+	} else {
+		delete(scope.attempts, key)
+	}
+}
+
+func (d requestLoggingDispatcher) Do(r *http.Request) (*http.Response, error) {
+	start := time.Now()
+	response, err := d.dispatcher.Do(r)
+
+	entry := requestLogEntry{
+		Time:         start.UTC().Format(time.RFC3339Nano),
+		Method:       r.Method,
+		Host:         r.URL.Host,
+		Path:         r.URL.Path,
+		OpcRequestId: r.Header.Get(responseHeaderOpcRequestId),
+		LatencyMs:    int64(time.Since(start) / time.Millisecond),
+	}
+	if scope := getRequestLogScope(r.Context()); scope != nil {
+		entry.CorrelationId = scope.correlationId
+		entry.ResourceType = scope.resourceType
+		entry.Operation = scope.operation
+		entry.Attempt = scope.attempt(r)
+	}
+	if response != nil {
+		entry.Status = response.StatusCode
+		if opcRequestId := response.Header.Get(responseHeaderOpcRequestId); opcRequestId != "" {
+			entry.OpcRequestId = opcRequestId
+		}
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	d.logger.write(entry)
+	return response, err
+}
+
+// withRequestLogScopes tags the service calls made by each resource and data source with the resource type and
+// Terraform operation, so that they can be identified in the request log
+func withRequestLogScopes(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	for resourceType, resource := range resources {
+		resource.Read = withRequestLogScope(resourceType, readOperation, resource.Read)
+		resource.Create = withRequestLogScope(resourceType, createOperation, resource.Create)
+		resource.Update = withRequestLogScope(resourceType, updateOperation, resource.Update)
+		resource.Delete = withRequestLogScope(resourceType, deleteOperation, resource.Delete)
+	}
+	return resources
+}
+
+func withRequestLogScope(resourceType string, operation string, f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, m interface{}) error {
+		if clients, ok := m.(*OracleClients); ok && clients.requestLogger != nil {
+			scopedClients := *clients
+			scopedClients.stopContext = context.WithValue(clients.StopContext(), requestLogScopeKey{}, newRequestLogScope(resourceType, operation))
+			m = &scopedClients
+		}
+		return f(d, m)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_core "github.com/oracle/oci-go-sdk/core"
+)
+
+type testRequestDispatcher struct {
+	statusCodes []int
+}
+
+func (d *testRequestDispatcher) Do(r *http.Request) (*http.Response, error) {
+	statusCode := d.statusCodes[0]
+	d.statusCodes = d.statusCodes[1:]
+
+	response := &http.Response{StatusCode: statusCode, Header: http.Header{}, Request: r}
+	response.Header.Set(responseHeaderOpcRequestId, "requestid"+http.StatusText(statusCode))
+	return response, nil
+}
+
+func TestRequestLog_basic(t *testing.T) {
+	output := &bytes.Buffer{}
+	dispatcher := requestLoggingDispatcher{
+		dispatcher: &testRequestDispatcher{statusCodes: []int{429, 200}},
+		logger:     &requestLogger{writer: output},
+	}
+
+	var ctx context.Context
+	read := withRequestLogScope("oci_core_vcn", readOperation, func(d *schema.ResourceData, m interface{}) error {
+		ctx = m.(*OracleClients).StopContext()
+		return nil
+	})
+	assert.NoError(t, read(nil, &OracleClients{requestLogger: dispatcher.logger}))
+
+	for attempt := uint(1); attempt <= 2; attempt++ {
+		request, _ := http.NewRequest(http.MethodGet, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns/ocid1.vcn.oc1..vcn", nil)
+		response, _ := dispatcher.Do(request.WithContext(ctx))
+		recordRequestAttempt(oci_common.NewOCIOperationResponse(oci_core.GetVcnResponse{RawResponse: response}, nil, attempt), response.StatusCode == 429)
+	}
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	assert.Len(t, lines, 2)
+
+	entries := make([]requestLogEntry, len(lines))
+	for i, line := range lines {
+		assert.NoError(t, json.Unmarshal([]byte(line), &entries[i]))
+		assert.Equal(t, "oci_core_vcn", entries[i].ResourceType)
+		assert.Equal(t, readOperation, entries[i].Operation)
+		assert.Equal(t, http.MethodGet, entries[i].Method)
+		assert.Equal(t, "/20160918/vcns/ocid1.vcn.oc1..vcn", entries[i].Path)
+		assert.Equal(t, uint(i+1), entries[i].Attempt)
+	}
+	assert.Equal(t, 429, entries[0].Status)
+	assert.Equal(t, "requestidToo Many Requests", entries[0].OpcRequestId)
+	assert.Equal(t, 200, entries[1].Status)
+	assert.Equal(t, "requestidOK", entries[1].OpcRequestId)
+	assert.NotEmpty(t, entries[0].CorrelationId)
+	assert.Equal(t, entries[0].CorrelationId, entries[1].CorrelationId)
+}
+
+func TestRequestLog_disabled(t *testing.T) {
+	clients := &OracleClients{}
+	read := withRequestLogScope("oci_core_vcn", readOperation, func(d *schema.ResourceData, m interface{}) error {
+		assert.True(t, m == clients, "clients should not be copied when the request log is disabled")
+		assert.Nil(t, getRequestLogScope(m.(*OracleClients).StopContext()))
+		return nil
+	})
+	assert.NoError(t, read(nil, clients))
+	assert.Nil(t, withRequestLogScope("oci_core_vcn", createOperation, nil))
+}
+
+func TestRequestLog_closedWhenStopped(t *testing.T) {
+	file, err := ioutil.TempFile("", "request-log-")
+	assert.NoError(t, err)
+	file.Close()
+	defer os.Remove(file.Name())
+
+	logger, err := newRequestLogger(file.Name())
+	assert.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	logger.closeWhenDone(ctx)
+	logger.write(requestLogEntry{Method: http.MethodGet})
+
+	cancel()
+	closed := func() bool {
+		logger.mutex.Lock()
+		defer logger.mutex.Unlock()
+		return logger.closed
+	}
+	for deadline := time.Now().Add(time.Second); !closed() && time.Now().Before(deadline); {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.True(t, closed())
+	_, err = logger.writer.Write([]byte("{}\n"))
+	assert.Error(t, err, "the request log file should be closed")
+
+	// Requests still in progress are not logged once the log is closed
+	logger.write(requestLogEntry{Method: http.MethodGet})
+	content, err := ioutil.ReadFile(file.Name())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, strings.Count(string(content), "\n"))
+}
@@ -11,18 +11,55 @@ import (
 )
 
 const (
-	quadraticBackoffCap  = 12              // This corresponds to a 2*12*12=288 second cap on retry wait times (~5 minutes)
-	minRetryBackoff      = 1 * time.Second // Must wait for at least 1 second before retrying
-	databaseService      = "database"
-	identityService      = "identity"
-	objectstorageService = "object_storage"
+	quadraticBackoffCap   = 12               // This corresponds to a 2*12*12=288 second cap on retry wait times (~5 minutes)
+	exponentialBackoffCap = 8                // This corresponds to a 2^8=256 second cap on retry wait times (~4 minutes)
+	minRetryBackoff       = 1 * time.Second  // Must wait for at least 1 second before retrying
+	fixedRetryBackoff     = 10 * time.Second // Wait time between attempts when a fixed backoff is configured
+	databaseService       = "database"
+	identityService       = "identity"
+	objectstorageService  = "object_storage"
+
+	quadraticBackoffSetting   = "quadratic"
+	exponentialBackoffSetting = "exponential"
+	fixedBackoffSetting       = "fixed"
 )
 
 type expectedRetryDurationFn func(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, service string) time.Duration
 
+// serviceRetryPolicy is the retry behavior configured for a single service through a retry_policy block in the provider
+type serviceRetryPolicy struct {
+	retriableStatusCodes map[int]bool
+	retriableErrorCodes  []string
+	maxDuration          *time.Duration
+	backoff              string
+}
+
+// retryPolicyServices are the names of the services the resources retry requests to, which retry_policy blocks configure
+var retryPolicyServices = []string{
+	"audit",
+	"autoscaling",
+	"budget",
+	"containerengine",
+	"core",
+	databaseService,
+	"dns",
+	"email",
+	"file_storage",
+	"health_checks",
+	identityService,
+	"kms",
+	"load_balancer",
+	"monitoring",
+	objectstorageService,
+	"ons",
+	"streaming",
+	"waas",
+}
+
 var shortRetryTime = 2 * time.Minute
 var longRetryTime = 10 * time.Minute
 var configuredRetryDuration *time.Duration
+var configuredServiceRetryPolicies = map[string]*serviceRetryPolicy{}
 
 func init() {
 	rand.Seed(time.Now().UnixNano())
@@ -33,15 +70,30 @@ func getRetryBackoffDuration(response oci_common.OCIOperationResponse, disableNo
 }
 
 func getRetryBackoffDurationWithExpectedRetryDurationFn(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, service string, startTime time.Time, expectedRetryDurationFn expectedRetryDurationFn) time.Duration {
-	// Avoid having a very large retry backoff
+	var backoffDuration time.Duration
 	attempt := response.AttemptNumber
-	if attempt > quadraticBackoffCap {
-		attempt = quadraticBackoffCap
-	}
-	retryBackoffRange := time.Duration(2*attempt*attempt)*time.Second - minRetryBackoff
+	switch getServiceRetryBackoff(service) {
+	case fixedBackoffSetting:
+		backoffDuration = fixedRetryBackoff
+	case exponentialBackoffSetting:
+		// Avoid having a very large retry backoff
+		if attempt > exponentialBackoffCap {
+			attempt = exponentialBackoffCap
+		}
+		retryBackoffRange := time.Duration(1<<attempt)*time.Second - minRetryBackoff
+
+		// Jitter the backoff time. The actual backoff time might be anywhere within the minimum and exponential backoff time to avoid clustering.
+		backoffDuration = time.Duration(rand.Int63n(int64(retryBackoffRange+1))) + minRetryBackoff
+	default:
+		// Avoid having a very large retry backoff
+		if attempt > quadraticBackoffCap {
+			attempt = quadraticBackoffCap
+		}
+		retryBackoffRange := time.Duration(2*attempt*attempt)*time.Second - minRetryBackoff
 
-	// Jitter the backoff time. The actual backoff time might be anywhere within the minimum and quadratic backoff time to avoid clustering.
-	backoffDuration := time.Duration(rand.Int63n(int64(retryBackoffRange+1))) + minRetryBackoff
+		// Jitter the backoff time. The actual backoff time might be anywhere within the minimum and quadratic backoff time to avoid clustering.
+		backoffDuration = time.Duration(rand.Int63n(int64(retryBackoffRange+1))) + minRetryBackoff
+	}
 
 	// If we are about to exceed the retry duration; then reduce the backoff so that next attempt happens roughly when
 	// the entire retry duration is supposed to expire. Jitter is necessary again to avoid clustering.
@@ -73,13 +125,21 @@ func getExpectedRetryDuration(response oci_common.OCIOperationResponse, disableN
 		return 0
 	}
 
+	if statusCode == 404 && disableNotFoundRetries {
+		return 0
+	}
+
+	if policy, ok := configuredServiceRetryPolicies[service]; ok && policy.isRetriable(statusCode, e) {
+		if policy.maxDuration != nil {
+			return *policy.maxDuration
+		}
+		return longRetryTime
+	}
+
 	switch statusCode {
 	case 400, 401, 403:
 		return 0
 	case 404:
-		if disableNotFoundRetries {
-			return 0
-		}
 		if service == identityService || service == objectstorageService {
 			return longRetryTime
 		}
@@ -119,6 +179,31 @@ func getExpectedRetryDuration(response oci_common.OCIOperationResponse, disableN
 	return shortRetryTime
 }
 
+func getServiceRetryBackoff(service string) string {
+	if policy, ok := configuredServiceRetryPolicies[service]; ok && policy.backoff != "" {
+		return policy.backoff
+	}
+	return quadraticBackoffSetting
+}
+
+// isRetriable returns true if either the status code or the service error code of a failed request
+// has been configured as retriable for the service
+func (policy *serviceRetryPolicy) isRetriable(statusCode int, e error) bool {
+	if policy.retriableStatusCodes[statusCode] {
+		return true
+	}
+	serviceError, ok := oci_common.IsServiceError(e)
+	if !ok {
+		return false
+	}
+	for _, errorCode := range policy.retriableErrorCodes {
+		if serviceError.GetCode() == errorCode {
+			return true
+		}
+	}
+	return false
+}
+
 func shouldRetry(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, service string, startTime time.Time) bool {
 	return getElapsedRetryDuration(startTime) < getExpectedRetryDuration(response, disableNotFoundRetries, service)
 }
@@ -130,7 +215,9 @@ func getRetryPolicy(disableNotFoundRetries bool, service string) *oci_common.Ret
 	retryPolicy := &oci_common.RetryPolicy{
 		MaximumNumberAttempts: 0,
 		ShouldRetryOperation: func(response oci_common.OCIOperationResponse) bool {
-			return shouldRetry(response, disableNotFoundRetries, service, startTime)
+			willRetry := shouldRetry(response, disableNotFoundRetries, service, startTime)
+			recordRequestAttempt(response, willRetry)
+			return willRetry
 		},
 		NextDuration: func(response oci_common.OCIOperationResponse) time.Duration {
 			return getRetryBackoffDuration(response, disableNotFoundRetries, service, startTime)
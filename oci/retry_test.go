@@ -3,13 +3,18 @@
 package provider
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/oracle/oci-go-sdk/common"
+	"github.com/oracle/oci-go-sdk/core"
 )
 
 type TestOCIResponse struct {
@@ -128,3 +133,130 @@ func TestRetryLoop_concurrent(t *testing.T) {
 
 	waitGroup.Wait()
 }
+
+// Errors matching a configured retry policy should be retried for the policy's duration, other errors should not be affected
+func TestRetryPolicy_configuredServiceRetryPolicy(t *testing.T) {
+	shortRetryTime = 15 * time.Second
+	longRetryTime = 30 * time.Second
+	configuredRetryDuration = nil
+	maxDuration := 45 * time.Second
+	configuredServiceRetryPolicies = map[string]*serviceRetryPolicy{
+		"core": {
+			retriableStatusCodes: map[int]bool{400: true},
+			retriableErrorCodes:  []string{"IncorrectState"},
+			maxDuration:          &maxDuration,
+			backoff:              fixedBackoffSetting,
+		},
+	}
+	defer func() {
+		configuredServiceRetryPolicies = map[string]*serviceRetryPolicy{}
+	}()
+
+	response := common.NewOCIOperationResponse(TestOCIResponse{statusCode: 400}, fmt.Errorf("Retriable error"), 1)
+	if duration := getExpectedRetryDuration(response, false, "core"); duration != maxDuration {
+		t.Errorf("Expected retry duration of %v for a configured status code, but got %v", maxDuration, duration)
+	}
+	if duration := getExpectedRetryDuration(response, false, "database"); duration != 0 {
+		t.Errorf("Expected no retries for a service without a retry policy, but got %v", duration)
+	}
+
+	response = common.NewOCIOperationResponse(TestOCIResponse{statusCode: 409}, testServiceError(t, 409, "IncorrectState", "Resource is busy."), 1)
+	if duration := getExpectedRetryDuration(response, false, "core"); duration != maxDuration {
+		t.Errorf("Expected retry duration of %v for a configured error code, but got %v", maxDuration, duration)
+	}
+
+	response = common.NewOCIOperationResponse(TestOCIResponse{statusCode: 404}, fmt.Errorf("Retriable error"), 1)
+	if duration := getExpectedRetryDuration(response, false, "core"); duration != shortRetryTime {
+		t.Errorf("Expected default retry duration of %v for an error without a policy match, but got %v", shortRetryTime, duration)
+	}
+	if duration := getExpectedRetryDuration(response, true, "core"); duration != 0 {
+		t.Errorf("Expected no retries for 404 errors when not found retries are disabled, but got %v", duration)
+	}
+
+	response = common.NewOCIOperationResponse(TestOCIResponse{statusCode: 400}, fmt.Errorf("Retriable error"), 5)
+	if waitTime := getRetryBackoffDuration(response, false, "core", time.Now()); waitTime != fixedRetryBackoff {
+		t.Errorf("Expected fixed wait time of %v, but got %v", fixedRetryBackoff, waitTime)
+	}
+}
+
+// Configured error codes should only match the code of a service error, not a part of it or of the message
+func TestRetryPolicy_configuredErrorCodes(t *testing.T) {
+	shortRetryTime = 15 * time.Second
+	configuredRetryDuration = nil
+	maxDuration := 45 * time.Second
+	configuredServiceRetryPolicies = map[string]*serviceRetryPolicy{
+		"core": {
+			retriableErrorCodes: []string{"NotFound"},
+			maxDuration:         &maxDuration,
+		},
+	}
+	defer func() {
+		configuredServiceRetryPolicies = map[string]*serviceRetryPolicy{}
+	}()
+
+	response := common.NewOCIOperationResponse(TestOCIResponse{statusCode: 404}, testServiceError(t, 404, "NotFound", "Not found."), 1)
+	if duration := getExpectedRetryDuration(response, false, "core"); duration != maxDuration {
+		t.Errorf("Expected retry duration of %v for a configured error code, but got %v", maxDuration, duration)
+	}
+
+	for _, e := range []error{
+		testServiceError(t, 404, "NotAuthorizedOrNotFound", "Authorization failed or requested resource not found."),
+		testServiceError(t, 409, "Conflict", "NotFound"),
+		fmt.Errorf("Service error:NotFound. Not found."),
+	} {
+		response = common.NewOCIOperationResponse(TestOCIResponse{statusCode: 409}, e, 1)
+		if duration := getExpectedRetryDuration(response, false, "core"); duration != shortRetryTime {
+			t.Errorf("Expected default retry duration of %v for %v, but got %v", shortRetryTime, e, duration)
+		}
+	}
+}
+
+// testServiceError returns the error of a request failing with the given status and service error code
+func testServiceError(t *testing.T, statusCode int, code string, message string) error {
+	client := newFakeVirtualNetworkClients(t, testServiceErrorDispatcher{statusCode, code, message}, "").virtualNetworkClient
+	_, err := client.GetVcn(context.Background(), core.GetVcnRequest{VcnId: common.String("ocid1.vcn.oc1..test")})
+	if _, ok := common.IsServiceError(err); !ok {
+		t.Fatalf("Expected a service error, but got %v", err)
+	}
+	return err
+}
+
+// testServiceErrorDispatcher answers every request with a service error
+type testServiceErrorDispatcher fakeNetworkError
+
+func (d testServiceErrorDispatcher) Do(request *http.Request) (*http.Response, error) {
+	body, _ := json.Marshal(map[string]string{"code": d.code, "message": d.message})
+	return &http.Response{
+		StatusCode: d.status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       ioutil.NopCloser(bytes.NewReader(body)),
+		Request:    request,
+	}, nil
+}
+
+// Exponential backoff should be capped regardless of the number of attempts
+func TestRetryPolicy_exponentialBackoff(t *testing.T) {
+	longRetryTime = 30 * time.Minute
+	configuredServiceRetryPolicies = map[string]*serviceRetryPolicy{
+		"core": {
+			retriableStatusCodes: map[int]bool{400: true},
+			backoff:              exponentialBackoffSetting,
+		},
+	}
+	defer func() {
+		configuredServiceRetryPolicies = map[string]*serviceRetryPolicy{}
+	}()
+
+	for i := uint(1); i < 20; i++ {
+		response := common.NewOCIOperationResponse(TestOCIResponse{statusCode: 400}, fmt.Errorf("Retriable error"), i)
+		waitTime := getRetryBackoffDuration(response, false, "core", time.Now())
+
+		expectedWaitTimeMax := time.Duration(1<<i) * time.Second
+		if i > exponentialBackoffCap {
+			expectedWaitTimeMax = time.Duration(1<<exponentialBackoffCap) * time.Second
+		}
+		if waitTime > expectedWaitTimeMax || waitTime < minRetryBackoff {
+			t.Errorf("Expected wait time to be between %v and %v for attempt %v, but got %v", minRetryBackoff, expectedWaitTimeMax, i, waitTime)
+		}
+	}
+}
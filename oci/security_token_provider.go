@@ -0,0 +1,146 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"crypto/rsa"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+)
+
+// securityTokenConfigProvider signs requests with the session token and session key of a config file profile, as
+// created by `oci session authenticate`. The token and the key are read again whenever their files change, so that
+// sessions refreshed outside of Terraform are picked up without restarting it.
+type securityTokenConfigProvider struct {
+	tenancy     string
+	region      string
+	fingerprint string
+	passphrase  string
+
+	securityTokenFile *watchedFile
+	privateKeyFile    *watchedFile
+
+	mutex      sync.Mutex
+	privateKey *rsa.PrivateKey
+}
+
+// watchedFile caches the content of a file until its modification time changes
+type watchedFile struct {
+	path string
+
+	mutex   sync.Mutex
+	modTime time.Time
+	content []byte
+}
+
+func newSecurityTokenConfigProvider(configFile *configFileConfigProvider, region string) (*securityTokenConfigProvider, error) {
+	if configFile.values == nil {
+		return nil, fmt.Errorf("can not read %s (SecurityToken)", configFile)
+	}
+	values := configFile.values
+	if err := requireConfigFileValues(values, configFile.path, configFile.profile, configFileTenancyKey, configFileKeyFileKey, configFileSecurityTokenFileKey); err != nil {
+		return nil, err
+	}
+
+	if region == "" {
+		region = values[configFileRegionKey]
+	}
+	if region == "" {
+		return nil, fmt.Errorf("can not get %s from Terraform configuration or from %s (SecurityToken)", regionAttrName, configFile)
+	}
+
+	provider := &securityTokenConfigProvider{
+		tenancy:           values[configFileTenancyKey],
+		region:            region,
+		fingerprint:       values[configFileFingerprintKey],
+		passphrase:        values[configFilePassphraseKey],
+		securityTokenFile: &watchedFile{path: expandPath(values[configFileSecurityTokenFileKey])},
+		privateKeyFile:    &watchedFile{path: expandPath(values[configFileKeyFileKey])},
+	}
+
+	// Fail early rather than on the first request if the session was never created
+	if _, err := provider.KeyID(); err != nil {
+		return nil, err
+	}
+	if _, err := provider.PrivateRSAKey(); err != nil {
+		return nil, err
+	}
+	return provider, nil
+}
+
+// read returns the content of the file, and whether it changed since it was last read
+func (f *watchedFile) read() ([]byte, bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return nil, false, err
+	}
+	if f.content != nil && info.ModTime().Equal(f.modTime) {
+		return f.content, false, nil
+	}
+
+	content, err := ioutil.ReadFile(f.path)
+	if err != nil {
+		return nil, false, err
+	}
+	f.content = content
+	f.modTime = info.ModTime()
+	return content, true, nil
+}
+
+func (p *securityTokenConfigProvider) TenancyOCID() (string, error) {
+	return p.tenancy, nil
+}
+
+// UserOCID is empty, requests are made on behalf of the user who created the session
+func (p *securityTokenConfigProvider) UserOCID() (string, error) {
+	return "", nil
+}
+
+func (p *securityTokenConfigProvider) KeyFingerprint() (string, error) {
+	return p.fingerprint, nil
+}
+
+func (p *securityTokenConfigProvider) Region() (string, error) {
+	return p.region, nil
+}
+
+func (p *securityTokenConfigProvider) KeyID() (string, error) {
+	token, _, err := p.securityTokenFile.read()
+	if err != nil {
+		return "", fmt.Errorf("can not read security token from %s: %v", p.securityTokenFile.path, err)
+	}
+
+	securityToken := strings.TrimSpace(string(token))
+	if securityToken == "" {
+		return "", fmt.Errorf("security token file %s is empty", p.securityTokenFile.path)
+	}
+	return fmt.Sprintf("ST$%s", securityToken), nil
+}
+
+func (p *securityTokenConfigProvider) PrivateRSAKey() (*rsa.PrivateKey, error) {
+	pemFileContent, changed, err := p.privateKeyFile.read()
+	if err != nil {
+		return nil, fmt.Errorf("can not read private key from %s: %v", p.privateKeyFile.path, err)
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if changed || p.privateKey == nil {
+		privateKey, err := oci_common.PrivateKeyFromBytes(pemFileContent, &p.passphrase)
+		if err != nil {
+			return nil, fmt.Errorf("can not parse private key from %s: %v", p.privateKeyFile.path, err)
+		}
+		p.privateKey = privateKey
+	}
+	return p.privateKey, nil
+}
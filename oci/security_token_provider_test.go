@@ -0,0 +1,96 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeSecurityTokenTestFiles(t *testing.T, dir string, token string) string {
+	configFilePath := filepath.Join(dir, "config")
+	config := "[DEFAULT]\n" +
+		"user=" + testUserOCID + "\n" +
+		"\n" +
+		"# Session created by oci session authenticate\n" +
+		"[session]\n" +
+		"fingerprint=" + testKeyFingerPrint + "\n" +
+		"key_file=" + filepath.Join(dir, "oci_api_key.pem") + "\n" +
+		"pass_phrase=password\n" +
+		"tenancy=" + testTenancyOCID + "\n" +
+		"region=us-phoenix-1\n" +
+		"security_token_file=" + filepath.Join(dir, "token") + "\n"
+
+	assert.NoError(t, ioutil.WriteFile(configFilePath, []byte(config), 0600))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "oci_api_key.pem"), []byte(testPrivateKey), 0600))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "token"), []byte(token+"\n"), 0600))
+	return configFilePath
+}
+
+func TestSecurityTokenConfigProvider_basic(t *testing.T) {
+	dir, err := ioutil.TempDir("", "security-token")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	configFilePath := writeSecurityTokenTestFiles(t, dir, "token1")
+
+	configFile, err := newConfigFileConfigProvider(configFilePath, "session", "", true)
+	assert.NoError(t, err)
+	provider, err := newSecurityTokenConfigProvider(configFile, "")
+	assert.NoError(t, err)
+
+	keyId, err := provider.KeyID()
+	assert.NoError(t, err)
+	assert.Equal(t, "ST$token1", keyId)
+	region, _ := provider.Region()
+	assert.Equal(t, "us-phoenix-1", region)
+	tenancy, _ := provider.TenancyOCID()
+	assert.Equal(t, testTenancyOCID, tenancy)
+	privateKey, err := provider.PrivateRSAKey()
+	assert.NoError(t, err)
+	assert.NotNil(t, privateKey)
+
+	// The refreshed token is used for the next requests
+	tokenPath := filepath.Join(dir, "token")
+	assert.NoError(t, ioutil.WriteFile(tokenPath, []byte("token2"), 0600))
+	modTime := time.Now().Add(time.Minute)
+	assert.NoError(t, os.Chtimes(tokenPath, modTime, modTime))
+	keyId, err = provider.KeyID()
+	assert.NoError(t, err)
+	assert.Equal(t, "ST$token2", keyId)
+
+	provider, err = newSecurityTokenConfigProvider(configFile, "us-ashburn-1")
+	assert.NoError(t, err)
+	region, _ = provider.Region()
+	assert.Equal(t, "us-ashburn-1", region, "the region of the provider block should take precedence")
+}
+
+func TestSecurityTokenConfigProvider_invalidProfile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "security-token")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	configFilePath := writeSecurityTokenTestFiles(t, dir, "token1")
+
+	_, err = newConfigFileConfigProvider(configFilePath, "missing", "", true)
+	assert.EqualError(t, err, "profile missing was not found in config file "+configFilePath)
+
+	configFile, err := newConfigFileConfigProvider(configFilePath, "DEFAULT", "", true)
+	assert.NoError(t, err)
+	_, err = newSecurityTokenConfigProvider(configFile, "")
+	assert.EqualError(t, err, "profile DEFAULT of config file "+configFilePath+" is missing tenancy, key_file, security_token_file")
+
+	configFile, err = newConfigFileConfigProvider(filepath.Join(dir, "missing"), "session", "", false)
+	assert.NoError(t, err)
+	_, err = newSecurityTokenConfigProvider(configFile, "")
+	assert.Error(t, err)
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "token"), []byte(""), 0600))
+	configFile, err = newConfigFileConfigProvider(configFilePath, "session", "", true)
+	assert.NoError(t, err)
+	_, err = newSecurityTokenConfigProvider(configFile, "")
+	assert.Error(t, err)
+}
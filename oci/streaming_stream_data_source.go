@@ -69,7 +69,7 @@ func readSingularStreamingStream(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).streamAdminClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type StreamingStreamDataSourceCrud struct {
@@ -82,7 +82,7 @@ func (s *StreamingStreamDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *StreamingStreamDataSourceCrud) Get() error {
+func (s *StreamingStreamDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_streaming.GetStreamRequest{}
 
 	if streamId, ok := s.D.GetOkExists("stream_id"); ok {
@@ -92,7 +92,7 @@ func (s *StreamingStreamDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "streaming")
 
-	response, err := s.Client.GetStream(context.Background(), request)
+	response, err := s.Client.GetStream(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +85,7 @@ func createStreamingStream(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).streamAdminClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readStreamingStream(d *schema.ResourceData, m interface{}) error {
@@ -93,7 +93,7 @@ func readStreamingStream(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).streamAdminClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateStreamingStream(d *schema.ResourceData, m interface{}) error {
@@ -101,7 +101,7 @@ func updateStreamingStream(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).streamAdminClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteStreamingStream(d *schema.ResourceData, m interface{}) error {
@@ -110,7 +110,7 @@ func deleteStreamingStream(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).streamAdminClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type StreamingStreamResourceCrud struct {
@@ -148,7 +148,7 @@ func (s *StreamingStreamResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *StreamingStreamResourceCrud) Create() error {
+func (s *StreamingStreamResourceCrud) Create(ctx context.Context) error {
 	request := oci_streaming.CreateStreamRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -185,7 +185,7 @@ func (s *StreamingStreamResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.CreateStream(context.Background(), request)
+	response, err := s.Client.CreateStream(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -194,7 +194,7 @@ func (s *StreamingStreamResourceCrud) Create() error {
 	return nil
 }
 
-func (s *StreamingStreamResourceCrud) Get() error {
+func (s *StreamingStreamResourceCrud) Get(ctx context.Context) error {
 	request := oci_streaming.GetStreamRequest{}
 
 	tmp := s.D.Id()
@@ -202,7 +202,7 @@ func (s *StreamingStreamResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.GetStream(context.Background(), request)
+	response, err := s.Client.GetStream(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -211,7 +211,7 @@ func (s *StreamingStreamResourceCrud) Get() error {
 	return nil
 }
 
-func (s *StreamingStreamResourceCrud) Update() error {
+func (s *StreamingStreamResourceCrud) Update(ctx context.Context) error {
 	request := oci_streaming.UpdateStreamRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -231,7 +231,7 @@ func (s *StreamingStreamResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.UpdateStream(context.Background(), request)
+	response, err := s.Client.UpdateStream(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -240,7 +240,7 @@ func (s *StreamingStreamResourceCrud) Update() error {
 	return nil
 }
 
-func (s *StreamingStreamResourceCrud) Delete() error {
+func (s *StreamingStreamResourceCrud) Delete(ctx context.Context) error {
 	request := oci_streaming.DeleteStreamRequest{}
 
 	tmp := s.D.Id()
@@ -248,7 +248,7 @@ func (s *StreamingStreamResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	_, err := s.Client.DeleteStream(context.Background(), request)
+	_, err := s.Client.DeleteStream(ctx, request)
 	return err
 }
 
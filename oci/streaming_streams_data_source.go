@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_streaming "github.com/oracle/oci-go-sdk/streaming"
 )
 
@@ -13,7 +14,8 @@ func StreamingStreamsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readStreamingStreams,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -35,6 +37,22 @@ func StreamingStreamsDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(StreamingStreamResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_streaming.ListStreamsSortByName),
+					string(oci_streaming.ListStreamsSortByTimecreated),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_streaming.ListStreamsSortOrderAsc),
+					string(oci_streaming.ListStreamsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -44,7 +62,7 @@ func readStreamingStreams(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).streamAdminClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type StreamingStreamsDataSourceCrud struct {
@@ -57,7 +75,7 @@ func (s *StreamingStreamsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *StreamingStreamsDataSourceCrud) Get() error {
+func (s *StreamingStreamsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_streaming.ListStreamsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -79,9 +97,18 @@ func (s *StreamingStreamsDataSourceCrud) Get() error {
 		request.LifecycleState = oci_streaming.StreamLifecycleStateEnum(state.(string))
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_streaming.ListStreamsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_streaming.ListStreamsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "streaming")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListStreams(context.Background(), request)
+	response, err := s.Client.ListStreams(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -89,8 +116,8 @@ func (s *StreamingStreamsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListStreams(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListStreams(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -150,6 +177,8 @@ func (s *StreamingStreamsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, StreamingStreamsDataSource().Schema["streams"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("streams", resources); err != nil {
 		return err
 	}
@@ -0,0 +1,708 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_object_storage "github.com/oracle/oci-go-sdk/objectstorage"
+)
+
+const defaultFakeObjectStoragePageSize = 1000
+
+// fakeObjectStorage is an in-memory implementation of the ObjectStorage API for buckets, objects, multipart uploads and
+// pre-authenticated requests, against which the object storage resources can be tested without an OCI account.
+//
+// Lists are paginated, with nextStartWith for objects and opc-next-page for the others. Missing buckets, objects and
+// uploads return 404, a bucket that still holds objects, multipart uploads or pre-authenticated requests can not be
+// deleted (409), and if-match and if-none-match are checked against the etags of buckets and objects (412).
+//
+// Like fakeVirtualNetwork, the fake can be used as the HTTPClient of an SDK client or served with httptest.
+type fakeObjectStorage struct {
+	mutex    sync.Mutex
+	buckets  map[string]*fakeBucket
+	requests int
+	pageSize int
+	now      func() time.Time
+	idIndex  int
+	// failRequest, when set, is called for every request before it is handled, and fails it when it returns an error
+	failRequest func(request *http.Request) *fakeNetworkError
+}
+
+type fakeBucket struct {
+	bucket  oci_object_storage.Bucket
+	objects map[string]*fakeObject
+	uploads map[string]*fakeMultipartUpload
+	pars    map[string]oci_object_storage.PreauthenticatedRequest
+}
+
+type fakeObject struct {
+	content         []byte
+	contentMd5      string
+	multipartMd5    string
+	contentType     string
+	contentLanguage string
+	contentEncoding string
+	metadata        map[string]string
+	etag            string
+	lastModified    time.Time
+}
+
+type fakeMultipartUpload struct {
+	upload  oci_object_storage.MultipartUpload
+	details oci_object_storage.CreateMultipartUploadDetails
+	parts   map[int]*fakeUploadPart
+}
+
+type fakeUploadPart struct {
+	content []byte
+	md5     string
+	etag    string
+}
+
+var fakeRangePattern = regexp.MustCompile(`^bytes=(\d*)-(\d*)$`)
+
+func newFakeObjectStorage() *fakeObjectStorage {
+	return &fakeObjectStorage{
+		buckets:  map[string]*fakeBucket{},
+		pageSize: defaultFakeObjectStoragePageSize,
+		now:      time.Now,
+	}
+}
+
+// Do dispatches a request of an SDK client to the fake, without going through the network
+func (f *fakeObjectStorage) Do(request *http.Request) (*http.Response, error) {
+	writer := &fakeResponseWriter{header: http.Header{}}
+	f.ServeHTTP(writer, request)
+	return writer.response(request), nil
+}
+
+func (f *fakeObjectStorage) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.requests++
+	writer.Header().Set("opc-request-id", fmt.Sprintf("fake-request-%d", f.requests))
+
+	var status int
+	var result interface{}
+	var err *fakeNetworkError
+	if f.failRequest != nil {
+		err = f.failRequest(request)
+	}
+	if err == nil {
+		status, result, err = f.handle(request, writer.Header())
+	}
+	if err != nil {
+		status = err.status
+		result = map[string]interface{}{"code": err.code, "message": err.message}
+	}
+
+	switch body := result.(type) {
+	case nil:
+		writer.WriteHeader(status)
+	case []byte:
+		writer.Header().Set("Content-Length", strconv.Itoa(len(body)))
+		writer.WriteHeader(status)
+		if request.Method != http.MethodHead {
+			writer.Write(body)
+		}
+	default:
+		data, _ := json.Marshal(body)
+		writer.Header().Set("Content-Type", "application/json")
+		writer.WriteHeader(status)
+		writer.Write(data)
+	}
+}
+
+// handle serves the paths /n/{namespace}/b/, /n/{namespace}/b/{bucket}/ and /n/{namespace}/b/{bucket}/{o|u|p}/{name},
+// where the name of an object may contain slashes
+func (f *fakeObjectStorage) handle(request *http.Request, header http.Header) (int, interface{}, *fakeNetworkError) {
+	segments := strings.SplitN(strings.Trim(request.URL.Path, "/"), "/", 6)
+	if len(segments) < 3 || segments[0] != "n" || segments[2] != "b" {
+		return 0, nil, f.notSupported(request)
+	}
+	namespace := segments[1]
+
+	if len(segments) == 3 {
+		if request.Method == http.MethodPost {
+			return f.createBucket(namespace, request)
+		}
+		return 0, nil, f.notSupported(request)
+	}
+
+	bucket, ok := f.buckets[namespace+"/"+segments[3]]
+	if !ok {
+		return 0, nil, &fakeNetworkError{http.StatusNotFound, "BucketNotFound", fmt.Sprintf("Either the bucket named '%s' does not exist in the namespace '%s' or you are not authorized to access it", segments[3], namespace)}
+	}
+
+	if len(segments) == 4 {
+		switch request.Method {
+		case http.MethodGet, http.MethodHead:
+			header.Set("etag", *bucket.bucket.Etag)
+			return http.StatusOK, f.bucketWithApproximates(bucket), nil
+		case http.MethodDelete:
+			return f.deleteBucket(bucket)
+		}
+		return 0, nil, f.notSupported(request)
+	}
+
+	name := ""
+	if len(segments) == 6 {
+		name = segments[5]
+	}
+
+	switch {
+	case segments[4] == "o" && name == "" && request.Method == http.MethodGet:
+		return f.listObjects(bucket, request)
+	case segments[4] == "o" && name != "":
+		return f.handleObject(bucket, name, request, header)
+	case segments[4] == "u" && name == "" && request.Method == http.MethodGet:
+		return f.listMultipartUploads(bucket, request, header)
+	case segments[4] == "u" && name == "" && request.Method == http.MethodPost:
+		return f.createMultipartUpload(bucket, request)
+	case segments[4] == "u" && name != "":
+		return f.handleMultipartUpload(bucket, name, request, header)
+	case segments[4] == "p" && name == "" && request.Method == http.MethodGet:
+		return f.listPreauthenticatedRequests(bucket, request, header)
+	case segments[4] == "p" && name == "" && request.Method == http.MethodPost:
+		return f.createPreauthenticatedRequest(bucket, request)
+	case segments[4] == "p" && request.Method == http.MethodDelete:
+		if _, ok := bucket.pars[name]; !ok {
+			return 0, nil, &fakeNetworkError{http.StatusNotFound, "NotFound", "the pre-authenticated request " + name + " does not exist"}
+		}
+		delete(bucket.pars, name)
+		return http.StatusNoContent, nil, nil
+	}
+	return 0, nil, f.notSupported(request)
+}
+
+func (f *fakeObjectStorage) notSupported(request *http.Request) *fakeNetworkError {
+	return &fakeNetworkError{http.StatusNotFound, "NotFound", fmt.Sprintf("%s %s is not supported by the fake ObjectStorage service", request.Method, request.URL.Path)}
+}
+
+func (f *fakeObjectStorage) nextId(prefix string) string {
+	f.idIndex++
+	return fmt.Sprintf("%s-%d", prefix, f.idIndex)
+}
+
+func (f *fakeObjectStorage) createBucket(namespace string, request *http.Request) (int, interface{}, *fakeNetworkError) {
+	details := oci_object_storage.CreateBucketDetails{}
+	if err := decodeFakeRequestBody(request, &details); err != nil {
+		return 0, nil, err
+	}
+	if details.Name == nil || details.CompartmentId == nil {
+		return 0, nil, &fakeNetworkError{http.StatusBadRequest, "InvalidParameter", "name and compartmentId are required"}
+	}
+	if _, ok := f.buckets[namespace+"/"+*details.Name]; ok {
+		return 0, nil, &fakeNetworkError{http.StatusConflict, "BucketAlreadyExists", "Either the bucket '" + *details.Name + "' in namespace '" + namespace + "' already exists or you are not authorized to create it"}
+	}
+
+	bucket := oci_object_storage.Bucket{
+		Namespace:     &namespace,
+		Name:          details.Name,
+		CompartmentId: details.CompartmentId,
+		Metadata:      details.Metadata,
+		CreatedBy:     oci_common.String("ocid1.user.oc1..fake"),
+		TimeCreated:   &oci_common.SDKTime{Time: f.now()},
+		Etag:          oci_common.String(f.nextId("etag")),
+		FreeformTags:  details.FreeformTags,
+		DefinedTags:   details.DefinedTags,
+		KmsKeyId:      details.KmsKeyId,
+	}
+	bucket.PublicAccessType = oci_object_storage.BucketPublicAccessTypeEnum(details.PublicAccessType)
+	if bucket.PublicAccessType == "" {
+		bucket.PublicAccessType = oci_object_storage.BucketPublicAccessTypeNopublicaccess
+	}
+	bucket.StorageTier = oci_object_storage.BucketStorageTierEnum(details.StorageTier)
+	if bucket.StorageTier == "" {
+		bucket.StorageTier = oci_object_storage.BucketStorageTierStandard
+	}
+
+	f.buckets[namespace+"/"+*details.Name] = &fakeBucket{
+		bucket:  bucket,
+		objects: map[string]*fakeObject{},
+		uploads: map[string]*fakeMultipartUpload{},
+		pars:    map[string]oci_object_storage.PreauthenticatedRequest{},
+	}
+	return http.StatusOK, bucket, nil
+}
+
+func (f *fakeObjectStorage) bucketWithApproximates(bucket *fakeBucket) oci_object_storage.Bucket {
+	result := bucket.bucket
+	count, size := int64(len(bucket.objects)), int64(0)
+	for _, object := range bucket.objects {
+		size += int64(len(object.content))
+	}
+	result.ApproximateCount = &count
+	result.ApproximateSize = &size
+	return result
+}
+
+func (f *fakeObjectStorage) deleteBucket(bucket *fakeBucket) (int, interface{}, *fakeNetworkError) {
+	if len(bucket.objects) > 0 || len(bucket.uploads) > 0 || len(bucket.pars) > 0 {
+		return 0, nil, &fakeNetworkError{http.StatusConflict, "BucketNotEmpty", fmt.Sprintf("Bucket named '%s' is not empty: it has %d objects, %d multipart uploads and %d pre-authenticated requests", *bucket.bucket.Name, len(bucket.objects), len(bucket.uploads), len(bucket.pars))}
+	}
+	delete(f.buckets, *bucket.bucket.Namespace+"/"+*bucket.bucket.Name)
+	return http.StatusNoContent, nil, nil
+}
+
+func (f *fakeObjectStorage) listObjects(bucket *fakeBucket, request *http.Request) (int, interface{}, *fakeNetworkError) {
+	query := request.URL.Query()
+	limit, err := f.limit(query.Get("limit"))
+	if err != nil {
+		return 0, nil, err
+	}
+	prefix, start, end, delimiter := query.Get("prefix"), query.Get("start"), query.Get("end"), query.Get("delimiter")
+	if delimiter != "" && delimiter != "/" {
+		return 0, nil, &fakeNetworkError{http.StatusBadRequest, "InvalidParameter", "the only supported delimiter is /"}
+	}
+	fields := map[string]bool{"name": true}
+	for _, field := range strings.Split(query.Get("fields"), ",") {
+		fields[field] = true
+	}
+
+	names := []string{}
+	for name := range bucket.objects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := oci_object_storage.ListObjects{Objects: []oci_object_storage.ObjectSummary{}}
+	prefixes := map[string]bool{}
+	for _, name := range names {
+		if !strings.HasPrefix(name, prefix) || name < start || (end != "" && name >= end) {
+			continue
+		}
+		if delimiter != "" {
+			if index := strings.Index(name[len(prefix):], delimiter); index >= 0 {
+				prefixes[name[:len(prefix)+index+1]] = true
+				continue
+			}
+		}
+		if len(result.Objects) == limit {
+			result.NextStartWith = oci_common.String(name)
+			break
+		}
+
+		object := bucket.objects[name]
+		summary := oci_object_storage.ObjectSummary{Name: oci_common.String(name)}
+		if fields["size"] {
+			summary.Size = oci_common.Int64(int64(len(object.content)))
+		}
+		if fields["md5"] {
+			summary.Md5 = oci_common.String(object.md5())
+		}
+		if fields["timeCreated"] {
+			summary.TimeCreated = &oci_common.SDKTime{Time: object.lastModified}
+		}
+		result.Objects = append(result.Objects, summary)
+	}
+	for prefix := range prefixes {
+		result.Prefixes = append(result.Prefixes, prefix)
+	}
+	sort.Strings(result.Prefixes)
+	return http.StatusOK, result, nil
+}
+
+func (f *fakeObjectStorage) handleObject(bucket *fakeBucket, name string, request *http.Request, header http.Header) (int, interface{}, *fakeNetworkError) {
+	object, exists := bucket.objects[name]
+	if err := checkFakeObjectConditions(object, request); err != nil {
+		return 0, nil, err
+	}
+
+	switch request.Method {
+	case http.MethodPut:
+		content, err := ioutil.ReadAll(request.Body)
+		if err != nil {
+			return 0, nil, &fakeNetworkError{http.StatusBadRequest, "InvalidParameter", err.Error()}
+		}
+		sum := md5.Sum(content)
+		contentMd5 := base64.StdEncoding.EncodeToString(sum[:])
+		if expected := request.Header.Get("Content-MD5"); expected != "" && expected != contentMd5 {
+			return 0, nil, &fakeNetworkError{http.StatusBadRequest, "InvalidDigest", "The computed MD5 hash does not match the Content-MD5 of the request"}
+		}
+
+		object = &fakeObject{
+			content:         content,
+			contentMd5:      contentMd5,
+			contentType:     request.Header.Get("Content-Type"),
+			contentLanguage: request.Header.Get("Content-Language"),
+			contentEncoding: request.Header.Get("Content-Encoding"),
+			metadata:        map[string]string{},
+			etag:            f.nextId("etag"),
+			lastModified:    f.now(),
+		}
+		for key, values := range request.Header {
+			if key := strings.ToLower(key); strings.HasPrefix(key, "opc-meta-") {
+				object.metadata[strings.TrimPrefix(key, "opc-meta-")] = values[0]
+			}
+		}
+		bucket.objects[name] = object
+
+		header.Set("etag", object.etag)
+		header.Set("opc-content-md5", contentMd5)
+		header.Set("last-modified", object.lastModified.Format(time.RFC3339Nano))
+		return http.StatusOK, nil, nil
+	}
+
+	if !exists {
+		return 0, nil, &fakeNetworkError{http.StatusNotFound, "ObjectNotFound", fmt.Sprintf("The object '%s' was not found in the bucket '%s'", name, *bucket.bucket.Name)}
+	}
+
+	switch request.Method {
+	case http.MethodGet, http.MethodHead:
+		object.setHeaders(header)
+		content := object.content
+		status := http.StatusOK
+		if value := request.Header.Get("range"); value != "" && request.Method == http.MethodGet {
+			start, end, err := parseFakeRange(value, int64(len(content)))
+			if err != nil {
+				return 0, nil, err
+			}
+			header.Set("content-range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(content)))
+			content = content[start : end+1]
+			status = http.StatusPartialContent
+		}
+		return status, content, nil
+	case http.MethodDelete:
+		delete(bucket.objects, name)
+		return http.StatusNoContent, nil, nil
+	}
+	return 0, nil, f.notSupported(request)
+}
+
+func checkFakeObjectConditions(object *fakeObject, request *http.Request) *fakeNetworkError {
+	if ifMatch := request.Header.Get("if-match"); ifMatch != "" && (object == nil || (ifMatch != "*" && ifMatch != object.etag)) {
+		return &fakeNetworkError{http.StatusPreconditionFailed, "IfMatchFailed", "The if-match header does not match the etag of the object"}
+	}
+	if ifNoneMatch := request.Header.Get("if-none-match"); ifNoneMatch != "" && object != nil && (ifNoneMatch == "*" || ifNoneMatch == object.etag) {
+		if request.Method == http.MethodGet || request.Method == http.MethodHead {
+			return &fakeNetworkError{http.StatusNotModified, "NotModified", "The object has not been modified"}
+		}
+		return &fakeNetworkError{http.StatusPreconditionFailed, "IfNoneMatchFailed", "The if-none-match header matches the etag of the object"}
+	}
+	return nil
+}
+
+// parseFakeRange parses a range header of the form bytes=start-end, bytes=start- or bytes=-suffixLength
+func parseFakeRange(value string, size int64) (int64, int64, *fakeNetworkError) {
+	invalid := &fakeNetworkError{http.StatusRequestedRangeNotSatisfiable, "InvalidRange", "The range " + value + " is not satisfiable"}
+	matches := fakeRangePattern.FindStringSubmatch(value)
+	if matches == nil || (matches[1] == "" && matches[2] == "") {
+		return 0, 0, invalid
+	}
+
+	start, end := int64(0), size-1
+	if matches[1] == "" {
+		suffix, _ := strconv.ParseInt(matches[2], 10, 64)
+		if suffix < size {
+			start = size - suffix
+		}
+	} else {
+		start, _ = strconv.ParseInt(matches[1], 10, 64)
+		if matches[2] != "" {
+			end, _ = strconv.ParseInt(matches[2], 10, 64)
+		}
+	}
+	if end > size-1 {
+		end = size - 1
+	}
+	if start > end {
+		return 0, 0, invalid
+	}
+	return start, end, nil
+}
+
+func (o *fakeObject) md5() string {
+	if o.multipartMd5 != "" {
+		return o.multipartMd5
+	}
+	return o.contentMd5
+}
+
+func (o *fakeObject) setHeaders(header http.Header) {
+	header.Set("etag", o.etag)
+	header.Set("last-modified", o.lastModified.Format(time.RFC3339Nano))
+	if o.multipartMd5 != "" {
+		header.Set("opc-multipart-md5", o.multipartMd5)
+	} else {
+		header.Set("content-md5", o.contentMd5)
+	}
+	if o.contentType != "" {
+		header.Set("content-type", o.contentType)
+	} else {
+		header.Set("content-type", "application/octet-stream")
+	}
+	if o.contentLanguage != "" {
+		header.Set("content-language", o.contentLanguage)
+	}
+	if o.contentEncoding != "" {
+		header.Set("content-encoding", o.contentEncoding)
+	}
+	for key, value := range o.metadata {
+		header.Set("opc-meta-"+key, value)
+	}
+}
+
+func (f *fakeObjectStorage) createMultipartUpload(bucket *fakeBucket, request *http.Request) (int, interface{}, *fakeNetworkError) {
+	details := oci_object_storage.CreateMultipartUploadDetails{}
+	if err := decodeFakeRequestBody(request, &details); err != nil {
+		return 0, nil, err
+	}
+	if details.Object == nil || *details.Object == "" {
+		return 0, nil, &fakeNetworkError{http.StatusBadRequest, "InvalidParameter", "object is required"}
+	}
+
+	upload := &fakeMultipartUpload{
+		upload: oci_object_storage.MultipartUpload{
+			Namespace:   bucket.bucket.Namespace,
+			Bucket:      bucket.bucket.Name,
+			Object:      details.Object,
+			UploadId:    oci_common.String(f.nextId("upload")),
+			TimeCreated: &oci_common.SDKTime{Time: f.now()},
+		},
+		details: details,
+		parts:   map[int]*fakeUploadPart{},
+	}
+	bucket.uploads[*upload.upload.UploadId] = upload
+	return http.StatusOK, upload.upload, nil
+}
+
+func (f *fakeObjectStorage) listMultipartUploads(bucket *fakeBucket, request *http.Request, header http.Header) (int, interface{}, *fakeNetworkError) {
+	uploads := []*fakeMultipartUpload{}
+	for _, upload := range bucket.uploads {
+		uploads = append(uploads, upload)
+	}
+	sort.Slice(uploads, func(i, j int) bool {
+		if *uploads[i].upload.Object != *uploads[j].upload.Object {
+			return *uploads[i].upload.Object < *uploads[j].upload.Object
+		}
+		return uploads[i].upload.TimeCreated.Before(uploads[j].upload.TimeCreated.Time)
+	})
+
+	items := []interface{}{}
+	for _, upload := range uploads {
+		items = append(items, upload.upload)
+	}
+	return f.page(items, request, header)
+}
+
+func (f *fakeObjectStorage) handleMultipartUpload(bucket *fakeBucket, name string, request *http.Request, header http.Header) (int, interface{}, *fakeNetworkError) {
+	uploadId := request.URL.Query().Get("uploadId")
+	upload, ok := bucket.uploads[uploadId]
+	if !ok || *upload.upload.Object != name {
+		return 0, nil, &fakeNetworkError{http.StatusNotFound, "NoSuchUpload", fmt.Sprintf("The upload '%s' of the object '%s' does not exist", uploadId, name)}
+	}
+
+	switch request.Method {
+	case http.MethodPut:
+		partNum, err := strconv.Atoi(request.URL.Query().Get("uploadPartNum"))
+		if err != nil || partNum < 1 || partNum > 10000 {
+			return 0, nil, &fakeNetworkError{http.StatusBadRequest, "InvalidParameter", "uploadPartNum must be between 1 and 10000"}
+		}
+		content, readErr := ioutil.ReadAll(request.Body)
+		if readErr != nil {
+			return 0, nil, &fakeNetworkError{http.StatusBadRequest, "InvalidParameter", readErr.Error()}
+		}
+		sum := md5.Sum(content)
+		partMd5 := base64.StdEncoding.EncodeToString(sum[:])
+		if expected := request.Header.Get("Content-MD5"); expected != "" && expected != partMd5 {
+			return 0, nil, &fakeNetworkError{http.StatusBadRequest, "InvalidDigest", "The computed MD5 hash does not match the Content-MD5 of the request"}
+		}
+
+		part := &fakeUploadPart{content: content, md5: partMd5, etag: f.nextId("etag")}
+		upload.parts[partNum] = part
+		header.Set("etag", part.etag)
+		header.Set("opc-content-md5", partMd5)
+		return http.StatusOK, nil, nil
+
+	case http.MethodGet:
+		partNums := []int{}
+		for partNum := range upload.parts {
+			partNums = append(partNums, partNum)
+		}
+		sort.Ints(partNums)
+
+		items := []interface{}{}
+		for _, partNum := range partNums {
+			part := upload.parts[partNum]
+			items = append(items, oci_object_storage.MultipartUploadPartSummary{
+				Etag:       oci_common.String(part.etag),
+				Md5:        oci_common.String(part.md5),
+				Size:       oci_common.Int64(int64(len(part.content))),
+				PartNumber: oci_common.Int(partNum),
+			})
+		}
+		return f.page(items, request, header)
+
+	case http.MethodPost:
+		return f.commitMultipartUpload(bucket, upload, request, header)
+
+	case http.MethodDelete:
+		delete(bucket.uploads, uploadId)
+		return http.StatusNoContent, nil, nil
+	}
+	return 0, nil, f.notSupported(request)
+}
+
+func (f *fakeObjectStorage) commitMultipartUpload(bucket *fakeBucket, upload *fakeMultipartUpload, request *http.Request, header http.Header) (int, interface{}, *fakeNetworkError) {
+	details := oci_object_storage.CommitMultipartUploadDetails{}
+	if err := decodeFakeRequestBody(request, &details); err != nil {
+		return 0, nil, err
+	}
+	if len(details.PartsToCommit) == 0 {
+		return 0, nil, &fakeNetworkError{http.StatusBadRequest, "InvalidParameter", "partsToCommit is required"}
+	}
+	sort.Slice(details.PartsToCommit, func(i, j int) bool {
+		return *details.PartsToCommit[i].PartNum < *details.PartsToCommit[j].PartNum
+	})
+
+	content := []byte{}
+	digests := []byte{}
+	for _, commit := range details.PartsToCommit {
+		part, ok := upload.parts[*commit.PartNum]
+		if !ok || commit.Etag == nil || *commit.Etag != part.etag {
+			return 0, nil, &fakeNetworkError{http.StatusBadRequest, "InvalidUploadPart", fmt.Sprintf("The part %d was not uploaded with the etag given for it", *commit.PartNum)}
+		}
+		content = append(content, part.content...)
+		digest, _ := base64.StdEncoding.DecodeString(part.md5)
+		digests = append(digests, digest...)
+	}
+	sum := md5.Sum(digests)
+	contentSum := md5.Sum(content)
+
+	object := &fakeObject{
+		content:      content,
+		contentMd5:   base64.StdEncoding.EncodeToString(contentSum[:]),
+		multipartMd5: fmt.Sprintf("%s-%d", base64.StdEncoding.EncodeToString(sum[:]), len(details.PartsToCommit)),
+		metadata:     map[string]string{},
+		etag:         f.nextId("etag"),
+		lastModified: f.now(),
+	}
+	if upload.details.ContentType != nil {
+		object.contentType = *upload.details.ContentType
+	}
+	if upload.details.ContentLanguage != nil {
+		object.contentLanguage = *upload.details.ContentLanguage
+	}
+	if upload.details.ContentEncoding != nil {
+		object.contentEncoding = *upload.details.ContentEncoding
+	}
+	for key, value := range upload.details.Metadata {
+		object.metadata[strings.TrimPrefix(strings.ToLower(key), "opc-meta-")] = value
+	}
+	bucket.objects[*upload.upload.Object] = object
+	delete(bucket.uploads, *upload.upload.UploadId)
+
+	header.Set("etag", object.etag)
+	header.Set("opc-multipart-md5", object.multipartMd5)
+	header.Set("last-modified", object.lastModified.Format(time.RFC3339Nano))
+	return http.StatusOK, nil, nil
+}
+
+func (f *fakeObjectStorage) createPreauthenticatedRequest(bucket *fakeBucket, request *http.Request) (int, interface{}, *fakeNetworkError) {
+	details := oci_object_storage.CreatePreauthenticatedRequestDetails{}
+	if err := decodeFakeRequestBody(request, &details); err != nil {
+		return 0, nil, err
+	}
+	if details.Name == nil || details.AccessType == "" || details.TimeExpires == nil {
+		return 0, nil, &fakeNetworkError{http.StatusBadRequest, "InvalidParameter", "name, accessType and timeExpires are required"}
+	}
+
+	id := f.nextId("par")
+	par := oci_object_storage.PreauthenticatedRequest{
+		Id:          &id,
+		Name:        details.Name,
+		AccessUri:   oci_common.String(fmt.Sprintf("/p/%s/n/%s/b/%s/o/", id, *bucket.bucket.Namespace, *bucket.bucket.Name)),
+		AccessType:  oci_object_storage.PreauthenticatedRequestAccessTypeEnum(details.AccessType),
+		TimeExpires: details.TimeExpires,
+		TimeCreated: &oci_common.SDKTime{Time: f.now()},
+		ObjectName:  details.ObjectName,
+	}
+	bucket.pars[id] = par
+	return http.StatusOK, par, nil
+}
+
+func (f *fakeObjectStorage) listPreauthenticatedRequests(bucket *fakeBucket, request *http.Request, header http.Header) (int, interface{}, *fakeNetworkError) {
+	ids := []string{}
+	for id := range bucket.pars {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	prefix := request.URL.Query().Get("objectNamePrefix")
+	items := []interface{}{}
+	for _, id := range ids {
+		par := bucket.pars[id]
+		if prefix != "" && (par.ObjectName == nil || !strings.HasPrefix(*par.ObjectName, prefix)) {
+			continue
+		}
+		items = append(items, oci_object_storage.PreauthenticatedRequestSummary{
+			Id:          par.Id,
+			Name:        par.Name,
+			AccessType:  oci_object_storage.PreauthenticatedRequestSummaryAccessTypeEnum(par.AccessType),
+			TimeExpires: par.TimeExpires,
+			TimeCreated: par.TimeCreated,
+			ObjectName:  par.ObjectName,
+		})
+	}
+	return f.page(items, request, header)
+}
+
+func (f *fakeObjectStorage) limit(value string) (int, *fakeNetworkError) {
+	if value == "" {
+		return f.pageSize, nil
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 1 {
+		return 0, &fakeNetworkError{http.StatusBadRequest, "InvalidParameter", "limit must be a positive integer"}
+	}
+	return limit, nil
+}
+
+// page returns a page of items, continued with opc-next-page
+func (f *fakeObjectStorage) page(items []interface{}, request *http.Request, header http.Header) (int, interface{}, *fakeNetworkError) {
+	query := request.URL.Query()
+	limit, err := f.limit(query.Get("limit"))
+	if err != nil {
+		return 0, nil, err
+	}
+	start := 0
+	if page := query.Get("page"); page != "" {
+		parsed, err := strconv.Atoi(page)
+		if err != nil || parsed < 0 || parsed > len(items) {
+			return 0, nil, &fakeNetworkError{http.StatusBadRequest, "InvalidParameter", "page " + page + " is not valid"}
+		}
+		start = parsed
+	}
+	end := start + limit
+	if end < len(items) {
+		header.Set("opc-next-page", strconv.Itoa(end))
+	} else {
+		end = len(items)
+	}
+	return http.StatusOK, items[start:end], nil
+}
+
+func decodeFakeRequestBody(request *http.Request, value interface{}) *fakeNetworkError {
+	if request.Body == nil {
+		return &fakeNetworkError{http.StatusBadRequest, "InvalidParameter", "the request has no body"}
+	}
+	if err := json.NewDecoder(request.Body).Decode(value); err != nil {
+		return &fakeNetworkError{http.StatusBadRequest, "InvalidParameter", "the request body is not valid: " + err.Error()}
+	}
+	return nil
+}
@@ -0,0 +1,247 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+	"testing"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_object_storage "github.com/oracle/oci-go-sdk/objectstorage"
+	"github.com/stretchr/testify/assert"
+)
+
+const fakeObjectStorageNamespace = "fakenamespace"
+
+// newFakeObjectStorageClients returns provider clients whose object storage requests are sent to the fake
+func newFakeObjectStorageClients(t *testing.T, dispatcher oci_common.HTTPRequestDispatcher) *OracleClients {
+	password := "password"
+	configProvider := oci_common.NewRawConfigurationProvider(testTenancyOCID, testUserOCID, "us-phoenix-1", testKeyFingerPrint, testPrivateKey, &password)
+	client, err := oci_object_storage.NewObjectStorageClientWithConfigurationProvider(configProvider)
+	assert.NoError(t, err)
+	client.HTTPClient = dispatcher
+	return &OracleClients{objectStorageClient: &client}
+}
+
+// createFakeBucket creates a bucket in the namespace of the fake
+func createFakeBucket(t *testing.T, client *oci_object_storage.ObjectStorageClient, name string) {
+	_, err := client.CreateBucket(context.Background(), oci_object_storage.CreateBucketRequest{
+		NamespaceName: oci_common.String(fakeObjectStorageNamespace),
+		CreateBucketDetails: oci_object_storage.CreateBucketDetails{
+			Name:          oci_common.String(name),
+			CompartmentId: oci_common.String(testTenancyOCID),
+		},
+	})
+	assert.NoError(t, err)
+}
+
+// putFakeObject puts an object with the given content in a bucket of the fake
+func putFakeObject(t *testing.T, client *oci_object_storage.ObjectStorageClient, bucket string, name string, content []byte) {
+	_, err := client.PutObject(context.Background(), oci_object_storage.PutObjectRequest{
+		NamespaceName: oci_common.String(fakeObjectStorageNamespace),
+		BucketName:    oci_common.String(bucket),
+		ObjectName:    oci_common.String(name),
+		ContentLength: oci_common.Int64(int64(len(content))),
+		PutObjectBody: ioutil.NopCloser(bytes.NewReader(content)),
+	})
+	assert.NoError(t, err)
+}
+
+// fakeObjectStorageFixture is a fake with a bucket named "bucket", and the clients and local files of a test using it.
+// The files and directories it creates are removed by cleanup.
+type fakeObjectStorageFixture struct {
+	t       *testing.T
+	fake    *fakeObjectStorage
+	clients *OracleClients
+	client  *oci_object_storage.ObjectStorageClient
+	paths   []string
+
+	// mutex guards the state of the request handler of the test, which is called concurrently by multipart transfers
+	mutex sync.Mutex
+}
+
+func newFakeObjectStorageFixture(t *testing.T) *fakeObjectStorageFixture {
+	fake := newFakeObjectStorage()
+	clients := newFakeObjectStorageClients(t, fake)
+	createFakeBucket(t, clients.objectStorageClient, "bucket")
+	return &fakeObjectStorageFixture{t: t, fake: fake, clients: clients, client: clients.objectStorageClient}
+}
+
+// bucket returns the state of the fake's bucket
+func (f *fakeObjectStorageFixture) bucket() *fakeBucket {
+	return f.fake.buckets[fakeObjectStorageNamespace+"/bucket"]
+}
+
+// tempFile creates a local file with the given content and returns its path
+func (f *fakeObjectStorageFixture) tempFile(content []byte) string {
+	file, err := ioutil.TempFile("", "source-")
+	assert.NoError(f.t, err)
+	file.Close()
+	f.paths = append(f.paths, file.Name())
+	assert.NoError(f.t, ioutil.WriteFile(file.Name(), content, 0644))
+	return file.Name()
+}
+
+// tempDir creates a local directory and returns its path
+func (f *fakeObjectStorageFixture) tempDir() string {
+	dir, err := ioutil.TempDir("", "fake-object-storage-")
+	assert.NoError(f.t, err)
+	f.paths = append(f.paths, dir)
+	return dir
+}
+
+// onRequest calls the handler with every request sent to the fake, holding the fixture's mutex. The request fails with
+// the error the handler returns, if any.
+func (f *fakeObjectStorageFixture) onRequest(handler func(request *http.Request) *fakeNetworkError) {
+	f.fake.failRequest = func(request *http.Request) *fakeNetworkError {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		return handler(request)
+	}
+}
+
+func (f *fakeObjectStorageFixture) cleanup() {
+	for _, path := range f.paths {
+		os.RemoveAll(path)
+	}
+}
+
+func TestFakeObjectStorage_objects(t *testing.T) {
+	fixture := newFakeObjectStorageFixture(t)
+	client := fixture.client
+	ctx := context.Background()
+
+	for _, name := range []string{"a", "dir/b", "dir/c", "dir/sub/d", "e"} {
+		putFakeObject(t, client, "bucket", name, []byte("content of "+name))
+	}
+
+	head, err := client.HeadObject(ctx, oci_object_storage.HeadObjectRequest{
+		NamespaceName: oci_common.String(fakeObjectStorageNamespace),
+		BucketName:    oci_common.String("bucket"),
+		ObjectName:    oci_common.String("dir/sub/d"),
+	})
+	assert.NoError(t, err)
+	sum := md5.Sum([]byte("content of dir/sub/d"))
+	assert.Equal(t, base64.StdEncoding.EncodeToString(sum[:]), *head.ContentMd5)
+	assert.Equal(t, int64(20), *head.ContentLength)
+
+	response, err := client.GetObject(ctx, oci_object_storage.GetObjectRequest{
+		NamespaceName: oci_common.String(fakeObjectStorageNamespace),
+		BucketName:    oci_common.String("bucket"),
+		ObjectName:    oci_common.String("dir/sub/d"),
+		Range:         oci_common.String("bytes=11-"),
+	})
+	if assert.NoError(t, err) {
+		content, _ := ioutil.ReadAll(response.Content)
+		assert.Equal(t, "dir/sub/d", string(content))
+		assert.Equal(t, "bytes 11-19/20", *response.ContentRange)
+	}
+
+	// Objects are listed by name, with the prefixes of the objects below a delimiter
+	request := oci_object_storage.ListObjectsRequest{
+		NamespaceName: oci_common.String(fakeObjectStorageNamespace),
+		BucketName:    oci_common.String("bucket"),
+		Prefix:        oci_common.String("dir/"),
+		Delimiter:     oci_common.String("/"),
+	}
+	list, err := client.ListObjects(ctx, request)
+	if assert.NoError(t, err) && assert.Len(t, list.Objects, 2) {
+		assert.Equal(t, "dir/b", *list.Objects[0].Name)
+		assert.Equal(t, []string{"dir/sub/"}, list.Prefixes)
+	}
+
+	request = oci_object_storage.ListObjectsRequest{
+		NamespaceName: oci_common.String(fakeObjectStorageNamespace),
+		BucketName:    oci_common.String("bucket"),
+		Limit:         oci_common.Int(3),
+	}
+	list, err = client.ListObjects(ctx, request)
+	if assert.NoError(t, err) && assert.Len(t, list.Objects, 3) {
+		assert.Equal(t, "dir/sub/d", *list.NextStartWith)
+	}
+
+	// A bucket that is not empty can not be deleted
+	_, err = client.DeleteBucket(ctx, oci_object_storage.DeleteBucketRequest{
+		NamespaceName: oci_common.String(fakeObjectStorageNamespace),
+		BucketName:    oci_common.String("bucket"),
+	})
+	assertFakeVirtualNetworkError(t, err, http.StatusConflict)
+}
+
+func TestFakeObjectStorage_multipartUpload(t *testing.T) {
+	fixture := newFakeObjectStorageFixture(t)
+	client := fixture.client
+	ctx := context.Background()
+
+	upload, err := client.CreateMultipartUpload(ctx, oci_object_storage.CreateMultipartUploadRequest{
+		NamespaceName:                oci_common.String(fakeObjectStorageNamespace),
+		BucketName:                   oci_common.String("bucket"),
+		CreateMultipartUploadDetails: oci_object_storage.CreateMultipartUploadDetails{Object: oci_common.String("object")},
+	})
+	assert.NoError(t, err)
+
+	parts := []oci_object_storage.CommitMultipartUploadPartDetails{}
+	digests := []byte{}
+	for i, content := range []string{"first part", "second part"} {
+		part, err := client.UploadPart(ctx, oci_object_storage.UploadPartRequest{
+			NamespaceName:  oci_common.String(fakeObjectStorageNamespace),
+			BucketName:     oci_common.String("bucket"),
+			ObjectName:     oci_common.String("object"),
+			UploadId:       upload.UploadId,
+			UploadPartNum:  oci_common.Int(i + 1),
+			ContentLength:  oci_common.Int64(int64(len(content))),
+			UploadPartBody: ioutil.NopCloser(bytes.NewReader([]byte(content))),
+		})
+		assert.NoError(t, err)
+		parts = append(parts, oci_object_storage.CommitMultipartUploadPartDetails{PartNum: oci_common.Int(i + 1), Etag: part.ETag})
+		sum := md5.Sum([]byte(content))
+		digests = append(digests, sum[:]...)
+	}
+
+	uploadedParts, err := client.ListMultipartUploadParts(ctx, oci_object_storage.ListMultipartUploadPartsRequest{
+		NamespaceName: oci_common.String(fakeObjectStorageNamespace),
+		BucketName:    oci_common.String("bucket"),
+		ObjectName:    oci_common.String("object"),
+		UploadId:      upload.UploadId,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, uploadedParts.Items, 2)
+
+	// The MD5 of a multipart object is the MD5 of the MD5s of its parts, followed by the number of parts
+	commit, err := client.CommitMultipartUpload(ctx, oci_object_storage.CommitMultipartUploadRequest{
+		NamespaceName:                oci_common.String(fakeObjectStorageNamespace),
+		BucketName:                   oci_common.String("bucket"),
+		ObjectName:                   oci_common.String("object"),
+		UploadId:                     upload.UploadId,
+		CommitMultipartUploadDetails: oci_object_storage.CommitMultipartUploadDetails{PartsToCommit: parts},
+	})
+	assert.NoError(t, err)
+	sum := md5.Sum(digests)
+	assert.Equal(t, base64.StdEncoding.EncodeToString(sum[:])+"-2", *commit.OpcMultipartMd5)
+
+	object, err := client.GetObject(ctx, oci_object_storage.GetObjectRequest{
+		NamespaceName: oci_common.String(fakeObjectStorageNamespace),
+		BucketName:    oci_common.String("bucket"),
+		ObjectName:    oci_common.String("object"),
+	})
+	if assert.NoError(t, err) {
+		content, _ := ioutil.ReadAll(object.Content)
+		assert.Equal(t, "first partsecond part", string(content))
+		assert.Equal(t, *commit.OpcMultipartMd5, *object.OpcMultipartMd5)
+	}
+
+	// The upload is gone once committed
+	uploads, err := client.ListMultipartUploads(ctx, oci_object_storage.ListMultipartUploadsRequest{
+		NamespaceName: oci_common.String(fakeObjectStorageNamespace),
+		BucketName:    oci_common.String("bucket"),
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, uploads.Items)
+}
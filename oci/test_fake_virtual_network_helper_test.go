@@ -0,0 +1,629 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	fakeVirtualNetworkApiVersion      = "/20160918"
+	defaultFakeVirtualNetworkPageSize = 100
+
+	fakeProvisioningState = "PROVISIONING"
+	fakeAvailableState    = "AVAILABLE"
+	fakeTerminatingState  = "TERMINATING"
+	fakeTerminatedState   = "TERMINATED"
+)
+
+// fakeVirtualNetwork is an in-memory implementation of the VirtualNetwork API for VCNs, subnets, route tables, security
+// lists, DHCP options and gateways, against which the core networking resources can be tested without an OCI account.
+//
+// Resources are PROVISIONING when created and TERMINATING when deleted, and reach the next state when they are read.
+// Lists are paginated with opc-next-page, resources that are not found return 404, and deleting a resource that is
+// still referenced (e.g. a VCN with subnets, or a route table used by a subnet) returns 409.
+//
+// The fake can be used as the HTTPClient of an SDK client, since it implements HTTPRequestDispatcher. It can also be
+// served over TLS, e.g. with httptest.NewTLSServer, and reached through the domain_name_override and
+// custom_cert_location settings when the rewritten host names (e.g. iaas.us-phoenix-1.<domain>) resolve to it.
+type fakeVirtualNetwork struct {
+	mutex     sync.Mutex
+	resources map[string]*fakeNetworkResource
+	// Ids of the resources, in the order in which they were created
+	ids       []string
+	requests  int
+	pageSize  int
+	now       func() time.Time
+	ocidIndex int
+}
+
+type fakeNetworkResource struct {
+	collection *fakeNetworkCollection
+	fields     map[string]interface{}
+	// The state reached once the resource is read
+	nextState string
+	etag      int
+	// Default resources of a VCN are deleted with the VCN
+	isDefault bool
+}
+
+// fakeNetworkCollection describes a type of resource of the VirtualNetwork API
+type fakeNetworkCollection struct {
+	path     string
+	ocidType string
+	required []string
+	// Fields that can not be changed by an update
+	immutable []string
+	// initialize validates the references of a new or updated resource and sets its computed fields
+	initialize func(f *fakeVirtualNetwork, resource *fakeNetworkResource) *fakeNetworkError
+	// references returns the ids of the resources that a resource depends on
+	references func(resource *fakeNetworkResource) []string
+}
+
+type fakeNetworkError struct {
+	status  int
+	code    string
+	message string
+}
+
+func (e *fakeNetworkError) Error() string {
+	return fmt.Sprintf("%d %s: %s", e.status, e.code, e.message)
+}
+
+var fakeNetworkCollections = map[string]*fakeNetworkCollection{}
+
+func init() {
+	for _, collection := range []*fakeNetworkCollection{
+		{
+			path:       "vcns",
+			ocidType:   "vcn",
+			required:   []string{"cidrBlock", "compartmentId"},
+			immutable:  []string{"cidrBlock", "dnsLabel", "defaultDhcpOptionsId", "defaultRouteTableId", "defaultSecurityListId", "vcnDomainName"},
+			initialize: initializeFakeVcn,
+		},
+		{
+			path:       "subnets",
+			ocidType:   "subnet",
+			required:   []string{"cidrBlock", "compartmentId", "vcnId"},
+			immutable:  []string{"cidrBlock", "availabilityDomain", "dnsLabel", "subnetDomainName", "virtualRouterIp", "virtualRouterMac", "prohibitPublicIpOnVnic"},
+			initialize: initializeFakeSubnet,
+			references: func(resource *fakeNetworkResource) []string {
+				references := []string{resource.stringField("routeTableId"), resource.stringField("dhcpOptionsId")}
+				return append(references, resource.stringsField("securityListIds")...)
+			},
+		},
+		{
+			path:       "routeTables",
+			ocidType:   "routetable",
+			required:   []string{"compartmentId", "vcnId"},
+			initialize: initializeFakeRouteTable,
+			references: func(resource *fakeNetworkResource) []string {
+				var references []string
+				for _, rule := range resource.listField("routeRules") {
+					if rule, ok := rule.(map[string]interface{}); ok {
+						if networkEntityId, ok := rule["networkEntityId"].(string); ok {
+							references = append(references, networkEntityId)
+						}
+					}
+				}
+				return references
+			},
+		},
+		{
+			path:       "securityLists",
+			ocidType:   "securitylist",
+			required:   []string{"compartmentId", "vcnId", "egressSecurityRules", "ingressSecurityRules"},
+			initialize: initializeFakeVcnChild,
+		},
+		{
+			path:       "dhcps",
+			ocidType:   "dhcpoptions",
+			required:   []string{"compartmentId", "vcnId", "options"},
+			initialize: initializeFakeVcnChild,
+		},
+		{
+			path:       "internetGateways",
+			ocidType:   "internetgateway",
+			required:   []string{"compartmentId", "vcnId", "isEnabled"},
+			initialize: initializeFakeVcnChild,
+		},
+		{
+			path:      "natGateways",
+			ocidType:  "natgateway",
+			required:  []string{"compartmentId", "vcnId"},
+			immutable: []string{"natIp"},
+			initialize: func(f *fakeVirtualNetwork, resource *fakeNetworkResource) *fakeNetworkError {
+				resource.setDefault("blockTraffic", false)
+				resource.setDefault("natIp", fmt.Sprintf("129.146.%d.%d", f.ocidIndex/250%250, f.ocidIndex%250+1))
+				return initializeFakeVcnChild(f, resource)
+			},
+		},
+		{
+			path:     "serviceGateways",
+			ocidType: "servicegateway",
+			required: []string{"compartmentId", "vcnId", "services"},
+			initialize: func(f *fakeVirtualNetwork, resource *fakeNetworkResource) *fakeNetworkError {
+				resource.setDefault("blockTraffic", false)
+				return initializeFakeVcnChild(f, resource)
+			},
+		},
+	} {
+		collection.immutable = append(collection.immutable, "id", "compartmentId", "vcnId", "lifecycleState", "timeCreated")
+		fakeNetworkCollections[collection.path] = collection
+	}
+}
+
+func newFakeVirtualNetwork() *fakeVirtualNetwork {
+	return &fakeVirtualNetwork{
+		resources: map[string]*fakeNetworkResource{},
+		pageSize:  defaultFakeVirtualNetworkPageSize,
+		now:       time.Now,
+	}
+}
+
+// Do dispatches a request of an SDK client to the fake, without going through the network
+func (f *fakeVirtualNetwork) Do(request *http.Request) (*http.Response, error) {
+	writer := &fakeResponseWriter{header: http.Header{}}
+	f.ServeHTTP(writer, request)
+	return writer.response(request), nil
+}
+
+func (f *fakeVirtualNetwork) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.requests++
+	writer.Header().Set("opc-request-id", fmt.Sprintf("fake-request-%d", f.requests))
+
+	status, result, err := f.handle(request, writer.Header())
+	if err != nil {
+		status = err.status
+		result = map[string]interface{}{"code": err.code, "message": err.message}
+	}
+
+	if result == nil {
+		writer.WriteHeader(status)
+		return
+	}
+	body, _ := json.Marshal(result)
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	writer.Write(body)
+}
+
+func (f *fakeVirtualNetwork) handle(request *http.Request, header http.Header) (int, interface{}, *fakeNetworkError) {
+	path := strings.Trim(strings.TrimPrefix(request.URL.Path, fakeVirtualNetworkApiVersion), "/")
+	segments := strings.Split(path, "/")
+	collection, ok := fakeNetworkCollections[segments[0]]
+	if !ok || len(segments) > 2 {
+		return 0, nil, &fakeNetworkError{http.StatusNotFound, "NotFound", fmt.Sprintf("%s %s is not supported by the fake VirtualNetwork service", request.Method, request.URL.Path)}
+	}
+
+	if len(segments) == 1 {
+		switch request.Method {
+		case http.MethodGet:
+			return f.list(collection, request, header)
+		case http.MethodPost:
+			return f.create(collection, request, header)
+		}
+		return 0, nil, &fakeNetworkError{http.StatusMethodNotAllowed, "MethodNotAllowed", request.Method + " is not allowed on " + request.URL.Path}
+	}
+
+	resource, err := f.get(collection, segments[1])
+	if err != nil {
+		return 0, nil, err
+	}
+	if ifMatch := request.Header.Get("if-match"); ifMatch != "" && request.Method != http.MethodGet && ifMatch != strconv.Itoa(resource.etag) {
+		return 0, nil, &fakeNetworkError{http.StatusPreconditionFailed, "PreconditionFailed", "the etag of " + segments[1] + " does not match"}
+	}
+
+	switch request.Method {
+	case http.MethodGet:
+		if resource.nextState != "" {
+			resource.fields["lifecycleState"] = resource.nextState
+			resource.nextState = ""
+		}
+	case http.MethodPut:
+		if err := f.update(resource, request); err != nil {
+			return 0, nil, err
+		}
+	case http.MethodDelete:
+		if err := f.delete(resource); err != nil {
+			return 0, nil, err
+		}
+		return http.StatusNoContent, nil, nil
+	default:
+		return 0, nil, &fakeNetworkError{http.StatusMethodNotAllowed, "MethodNotAllowed", request.Method + " is not allowed on " + request.URL.Path}
+	}
+
+	header.Set("etag", strconv.Itoa(resource.etag))
+	return http.StatusOK, resource.fields, nil
+}
+
+func (f *fakeVirtualNetwork) create(collection *fakeNetworkCollection, request *http.Request, header http.Header) (int, interface{}, *fakeNetworkError) {
+	fields := map[string]interface{}{}
+	if err := json.NewDecoder(request.Body).Decode(&fields); err != nil {
+		return 0, nil, &fakeNetworkError{http.StatusBadRequest, "InvalidParameter", "the request body is not valid JSON: " + err.Error()}
+	}
+	for _, field := range collection.required {
+		if value, ok := fields[field]; !ok || value == nil {
+			return 0, nil, &fakeNetworkError{http.StatusBadRequest, "InvalidParameter", field + " is required"}
+		}
+	}
+
+	resource, err := f.add(collection, fields, false)
+	if err != nil {
+		return 0, nil, err
+	}
+	header.Set("etag", strconv.Itoa(resource.etag))
+	return http.StatusOK, resource.fields, nil
+}
+
+func (f *fakeVirtualNetwork) add(collection *fakeNetworkCollection, fields map[string]interface{}, isDefault bool) (*fakeNetworkResource, *fakeNetworkError) {
+	f.ocidIndex++
+	resource := &fakeNetworkResource{collection: collection, fields: fields, nextState: fakeAvailableState, etag: 1, isDefault: isDefault}
+	fields["id"] = fmt.Sprintf("ocid1.%s.oc1.phx.fake%06d", collection.ocidType, f.ocidIndex)
+	fields["lifecycleState"] = fakeProvisioningState
+	fields["timeCreated"] = f.now().UTC().Format(time.RFC3339Nano)
+	resource.setDefault("displayName", fmt.Sprintf("%s%d", collection.ocidType, f.ocidIndex))
+	resource.setDefault("freeformTags", map[string]interface{}{})
+	resource.setDefault("definedTags", map[string]interface{}{})
+
+	if err := collection.initialize(f, resource); err != nil {
+		return nil, err
+	}
+	f.resources[resource.id()] = resource
+	f.ids = append(f.ids, resource.id())
+	return resource, nil
+}
+
+func (f *fakeVirtualNetwork) get(collection *fakeNetworkCollection, id string) (*fakeNetworkResource, *fakeNetworkError) {
+	resource, ok := f.resources[id]
+	if !ok || resource.collection != collection {
+		return nil, &fakeNetworkError{http.StatusNotFound, "NotAuthorizedOrNotFound", fmt.Sprintf("%s %s not found", collection.ocidType, id)}
+	}
+	return resource, nil
+}
+
+func (f *fakeVirtualNetwork) update(resource *fakeNetworkResource, request *http.Request) *fakeNetworkError {
+	if resource.isTerminated() {
+		return &fakeNetworkError{http.StatusNotFound, "NotAuthorizedOrNotFound", fmt.Sprintf("%s %s not found", resource.collection.ocidType, resource.id())}
+	}
+
+	changes := map[string]interface{}{}
+	if err := json.NewDecoder(request.Body).Decode(&changes); err != nil {
+		return &fakeNetworkError{http.StatusBadRequest, "InvalidParameter", "the request body is not valid JSON: " + err.Error()}
+	}
+	for _, field := range resource.collection.immutable {
+		delete(changes, field)
+	}
+
+	previous := map[string]interface{}{}
+	for field, value := range resource.fields {
+		previous[field] = value
+	}
+	for field, value := range changes {
+		if value != nil {
+			resource.fields[field] = value
+		}
+	}
+	if err := resource.collection.initialize(f, resource); err != nil {
+		resource.fields = previous
+		return err
+	}
+	resource.etag++
+	return nil
+}
+
+func (f *fakeVirtualNetwork) delete(resource *fakeNetworkResource) *fakeNetworkError {
+	switch resource.state() {
+	case fakeTerminatedState:
+		return &fakeNetworkError{http.StatusNotFound, "NotAuthorizedOrNotFound", fmt.Sprintf("%s %s not found", resource.collection.ocidType, resource.id())}
+	case fakeTerminatingState:
+		return nil
+	}
+	if resource.isDefault {
+		return &fakeNetworkError{http.StatusConflict, "Conflict", fmt.Sprintf("the default %s of a VCN can not be deleted", resource.collection.ocidType)}
+	}
+
+	for _, id := range f.ids {
+		other := f.resources[id]
+		if other.isTerminated() || other == resource {
+			continue
+		}
+		if resource.collection.path == "vcns" && other.stringField("vcnId") == resource.id() && !other.isDefault {
+			return &fakeNetworkError{http.StatusConflict, "Conflict", fmt.Sprintf("%s %s must be deleted before the VCN %s", other.collection.ocidType, id, resource.id())}
+		}
+		if other.collection.references != nil {
+			for _, reference := range other.collection.references(other) {
+				if reference == resource.id() {
+					return &fakeNetworkError{http.StatusConflict, "Conflict", fmt.Sprintf("%s %s is still used by %s %s", resource.collection.ocidType, resource.id(), other.collection.ocidType, id)}
+				}
+			}
+		}
+	}
+
+	resource.terminate()
+	if resource.collection.path == "vcns" {
+		for _, id := range f.ids {
+			if other := f.resources[id]; other.isDefault && other.stringField("vcnId") == resource.id() {
+				other.terminate()
+			}
+		}
+	}
+	return nil
+}
+
+func (f *fakeVirtualNetwork) list(collection *fakeNetworkCollection, request *http.Request, header http.Header) (int, interface{}, *fakeNetworkError) {
+	query := request.URL.Query()
+	if query.Get("compartmentId") == "" {
+		return 0, nil, &fakeNetworkError{http.StatusBadRequest, "InvalidParameter", "compartmentId is required"}
+	}
+
+	items := []map[string]interface{}{}
+	for _, id := range f.ids {
+		resource := f.resources[id]
+		if resource.collection != collection {
+			continue
+		}
+		matches := true
+		for _, field := range []string{"compartmentId", "vcnId", "displayName", "lifecycleState"} {
+			if value := query.Get(field); value != "" && resource.stringField(field) != value {
+				matches = false
+			}
+		}
+		if matches {
+			items = append(items, resource.fields)
+		}
+	}
+
+	if strings.ToUpper(query.Get("sortBy")) == "DISPLAYNAME" {
+		sort.SliceStable(items, func(i, j int) bool {
+			return strings.ToLower(items[i]["displayName"].(string)) < strings.ToLower(items[j]["displayName"].(string))
+		})
+	}
+	if strings.ToUpper(query.Get("sortOrder")) == "DESC" {
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+	}
+
+	limit := f.pageSize
+	if value := query.Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			return 0, nil, &fakeNetworkError{http.StatusBadRequest, "InvalidParameter", "limit must be a positive integer"}
+		}
+		limit = parsed
+	}
+	start := 0
+	if page := query.Get("page"); page != "" {
+		parsed, err := strconv.Atoi(page)
+		if err != nil || parsed < 0 || parsed > len(items) {
+			return 0, nil, &fakeNetworkError{http.StatusBadRequest, "InvalidParameter", "page " + page + " is not valid"}
+		}
+		start = parsed
+	}
+	end := start + limit
+	if end < len(items) {
+		header.Set("opc-next-page", strconv.Itoa(end))
+	} else {
+		end = len(items)
+	}
+	return http.StatusOK, items[start:end], nil
+}
+
+// vcn returns the VCN to which a resource belongs, which must not be terminated
+func (f *fakeVirtualNetwork) vcn(resource *fakeNetworkResource) (*fakeNetworkResource, *fakeNetworkError) {
+	vcn, err := f.get(fakeNetworkCollections["vcns"], resource.stringField("vcnId"))
+	if err != nil {
+		return nil, err
+	}
+	if vcn.isTerminated() || vcn.state() == fakeTerminatingState {
+		return nil, &fakeNetworkError{http.StatusNotFound, "NotAuthorizedOrNotFound", fmt.Sprintf("vcn %s not found", vcn.id())}
+	}
+	return vcn, nil
+}
+
+// checkReference returns an error unless id is a resource of the collection that belongs to the VCN
+func (f *fakeVirtualNetwork) checkReference(collectionPath string, id string, vcn *fakeNetworkResource) *fakeNetworkError {
+	resource, err := f.get(fakeNetworkCollections[collectionPath], id)
+	if err != nil || resource.isTerminated() {
+		return &fakeNetworkError{http.StatusBadRequest, "InvalidParameter", fmt.Sprintf("%s was not found", id)}
+	}
+	if resource.stringField("vcnId") != vcn.id() {
+		return &fakeNetworkError{http.StatusBadRequest, "InvalidParameter", fmt.Sprintf("%s does not belong to VCN %s", id, vcn.id())}
+	}
+	return nil
+}
+
+func initializeFakeVcn(f *fakeVirtualNetwork, vcn *fakeNetworkResource) *fakeNetworkError {
+	if dnsLabel := vcn.stringField("dnsLabel"); dnsLabel != "" {
+		vcn.setDefault("vcnDomainName", dnsLabel+".oraclevcn.com")
+	}
+	if vcn.stringField("defaultRouteTableId") != "" {
+		return nil
+	}
+
+	// The default resources are created with the VCN, and are available as soon as it is
+	defaults := map[string]map[string]interface{}{
+		"defaultRouteTableId": {"routeRules": []interface{}{}},
+		"defaultSecurityListId": {
+			"egressSecurityRules":  []interface{}{map[string]interface{}{"destination": "0.0.0.0/0", "protocol": "all", "isStateless": false}},
+			"ingressSecurityRules": []interface{}{map[string]interface{}{"source": "0.0.0.0/0", "protocol": "6", "isStateless": false, "tcpOptions": map[string]interface{}{"destinationPortRange": map[string]interface{}{"min": 22, "max": 22}}}},
+		},
+		"defaultDhcpOptionsId": {
+			"options": []interface{}{map[string]interface{}{"type": "DomainNameServer", "serverType": "VcnLocalPlusInternet", "customDnsServers": []interface{}{}}},
+		},
+	}
+	for _, field := range []string{"defaultRouteTableId", "defaultSecurityListId", "defaultDhcpOptionsId"} {
+		fields := defaults[field]
+		fields["compartmentId"] = vcn.fields["compartmentId"]
+		fields["vcnId"] = vcn.id()
+
+		collectionPath := map[string]string{"defaultRouteTableId": "routeTables", "defaultSecurityListId": "securityLists", "defaultDhcpOptionsId": "dhcps"}[field]
+		name := map[string]string{"defaultRouteTableId": "Default Route Table", "defaultSecurityListId": "Default Security List", "defaultDhcpOptionsId": "Default DHCP Options"}[field]
+		fields["displayName"] = name + " for " + vcn.stringField("displayName")
+
+		// The VCN is only added to the fake once it is initialized, so its children can not be validated against it
+		f.ocidIndex++
+		child := &fakeNetworkResource{collection: fakeNetworkCollections[collectionPath], fields: fields, etag: 1, isDefault: true}
+		fields["id"] = fmt.Sprintf("ocid1.%s.oc1.phx.fake%06d", child.collection.ocidType, f.ocidIndex)
+		fields["lifecycleState"] = fakeAvailableState
+		fields["timeCreated"] = f.now().UTC().Format(time.RFC3339Nano)
+		child.setDefault("freeformTags", map[string]interface{}{})
+		child.setDefault("definedTags", map[string]interface{}{})
+		f.resources[child.id()] = child
+		f.ids = append(f.ids, child.id())
+		vcn.fields[field] = child.id()
+	}
+	return nil
+}
+
+func initializeFakeVcnChild(f *fakeVirtualNetwork, resource *fakeNetworkResource) *fakeNetworkError {
+	_, err := f.vcn(resource)
+	return err
+}
+
+func initializeFakeRouteTable(f *fakeVirtualNetwork, routeTable *fakeNetworkResource) *fakeNetworkError {
+	vcn, err := f.vcn(routeTable)
+	if err != nil {
+		return err
+	}
+	routeTable.setDefault("routeRules", []interface{}{})
+
+	for _, rule := range routeTable.listField("routeRules") {
+		rule, ok := rule.(map[string]interface{})
+		if !ok {
+			return &fakeNetworkError{http.StatusBadRequest, "InvalidParameter", "routeRules must be objects"}
+		}
+		networkEntityId, _ := rule["networkEntityId"].(string)
+		target, ok := f.resources[networkEntityId]
+		if !ok || target.isTerminated() {
+			return &fakeNetworkError{http.StatusBadRequest, "InvalidParameter", fmt.Sprintf("network entity %s was not found", networkEntityId)}
+		}
+		if target.stringField("vcnId") != vcn.id() {
+			return &fakeNetworkError{http.StatusBadRequest, "InvalidParameter", fmt.Sprintf("network entity %s does not belong to VCN %s", networkEntityId, vcn.id())}
+		}
+	}
+	return nil
+}
+
+func initializeFakeSubnet(f *fakeVirtualNetwork, subnet *fakeNetworkResource) *fakeNetworkError {
+	vcn, err := f.vcn(subnet)
+	if err != nil {
+		return err
+	}
+
+	subnet.setDefault("routeTableId", vcn.fields["defaultRouteTableId"])
+	subnet.setDefault("dhcpOptionsId", vcn.fields["defaultDhcpOptionsId"])
+	subnet.setDefault("securityListIds", []interface{}{vcn.fields["defaultSecurityListId"]})
+	subnet.setDefault("prohibitPublicIpOnVnic", false)
+	subnet.setDefault("virtualRouterMac", "00:00:17:00:00:01")
+	_, network, parseErr := net.ParseCIDR(subnet.stringField("cidrBlock"))
+	if parseErr != nil || network.IP.To4() == nil {
+		return &fakeNetworkError{http.StatusBadRequest, "InvalidParameter", "cidrBlock must be an IPv4 CIDR block"}
+	}
+	// The virtual router uses the first address of the subnet
+	routerIp := network.IP.To4()
+	routerIp[3]++
+	subnet.setDefault("virtualRouterIp", routerIp.String())
+	if dnsLabel, vcnDomainName := subnet.stringField("dnsLabel"), vcn.stringField("vcnDomainName"); dnsLabel != "" && vcnDomainName != "" {
+		subnet.setDefault("subnetDomainName", dnsLabel+"."+vcnDomainName)
+	}
+
+	if err := f.checkReference("routeTables", subnet.stringField("routeTableId"), vcn); err != nil {
+		return err
+	}
+	if err := f.checkReference("dhcps", subnet.stringField("dhcpOptionsId"), vcn); err != nil {
+		return err
+	}
+	for _, securityListId := range subnet.stringsField("securityListIds") {
+		if err := f.checkReference("securityLists", securityListId, vcn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *fakeNetworkResource) id() string {
+	return r.stringField("id")
+}
+
+func (r *fakeNetworkResource) state() string {
+	return r.stringField("lifecycleState")
+}
+
+func (r *fakeNetworkResource) isTerminated() bool {
+	return r.state() == fakeTerminatedState
+}
+
+func (r *fakeNetworkResource) terminate() {
+	r.fields["lifecycleState"] = fakeTerminatingState
+	r.nextState = fakeTerminatedState
+	r.etag++
+}
+
+func (r *fakeNetworkResource) setDefault(field string, value interface{}) {
+	if current, ok := r.fields[field]; !ok || current == nil {
+		r.fields[field] = value
+	}
+}
+
+func (r *fakeNetworkResource) stringField(field string) string {
+	value, _ := r.fields[field].(string)
+	return value
+}
+
+func (r *fakeNetworkResource) listField(field string) []interface{} {
+	value, _ := r.fields[field].([]interface{})
+	return value
+}
+
+func (r *fakeNetworkResource) stringsField(field string) []string {
+	var values []string
+	for _, value := range r.listField(field) {
+		if value, ok := value.(string); ok {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// fakeResponseWriter collects the response of the fake when it is used as a dispatcher
+type fakeResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *fakeResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *fakeResponseWriter) Write(data []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.body.Write(data)
+}
+
+func (w *fakeResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+// response returns the response written by a fake that served a request without going through the network
+func (w *fakeResponseWriter) response(request *http.Request) *http.Response {
+	return newHttpResponse(request, w.status, w.header, w.body.Bytes())
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_core "github.com/oracle/oci-go-sdk/core"
+	"github.com/stretchr/testify/assert"
+)
+
+// newFakeVirtualNetworkClients returns provider clients whose requests are sent to the fake
+func newFakeVirtualNetworkClients(t *testing.T, dispatcher oci_common.HTTPRequestDispatcher, host string) *OracleClients {
+	password := "password"
+	configProvider := oci_common.NewRawConfigurationProvider(testTenancyOCID, testUserOCID, "us-phoenix-1", testKeyFingerPrint, testPrivateKey, &password)
+	client, err := oci_core.NewVirtualNetworkClientWithConfigurationProvider(configProvider)
+	assert.NoError(t, err)
+	client.HTTPClient = dispatcher
+	if host != "" {
+		client.Host = host
+	}
+	return &OracleClients{virtualNetworkClient: &client}
+}
+
+func TestFakeVirtualNetwork_coreResources(t *testing.T) {
+	clients := newFakeVirtualNetworkClients(t, newFakeVirtualNetwork(), "")
+
+	vcn := schema.TestResourceDataRaw(t, CoreVcnResource().Schema, map[string]interface{}{
+		"cidr_block":     "10.0.0.0/16",
+		"compartment_id": testTenancyOCID,
+		"display_name":   "vcn",
+		"dns_label":      "vcn",
+	})
+	assert.NoError(t, CoreVcnResource().Create(vcn, clients))
+	assert.Equal(t, "AVAILABLE", vcn.Get("state"))
+	assert.Equal(t, "vcn.oraclevcn.com", vcn.Get("vcn_domain_name"))
+	assert.NotEmpty(t, vcn.Get("default_route_table_id"))
+
+	internetGateway := schema.TestResourceDataRaw(t, CoreInternetGatewayResource().Schema, map[string]interface{}{
+		"compartment_id": testTenancyOCID,
+		"vcn_id":         vcn.Id(),
+		"enabled":        true,
+	})
+	assert.NoError(t, CoreInternetGatewayResource().Create(internetGateway, clients))
+
+	// The default route table is updated rather than created, and reset rather than deleted
+	defaultRouteTable := schema.TestResourceDataRaw(t, DefaultCoreRouteTableResource().Schema, map[string]interface{}{
+		"manage_default_resource_id": vcn.Get("default_route_table_id"),
+		"route_rules": []interface{}{
+			map[string]interface{}{"destination": "0.0.0.0/0", "network_entity_id": internetGateway.Id()},
+		},
+	})
+	assert.NoError(t, DefaultCoreRouteTableResource().Create(defaultRouteTable, clients))
+	defaultRouteTableId := defaultRouteTable.Id()
+	assert.Equal(t, vcn.Get("default_route_table_id"), defaultRouteTableId)
+	assert.Equal(t, 1, defaultRouteTable.Get("route_rules.#"))
+
+	subnet := schema.TestResourceDataRaw(t, CoreSubnetResource().Schema, map[string]interface{}{
+		"cidr_block":     "10.0.1.0/24",
+		"compartment_id": testTenancyOCID,
+		"vcn_id":         vcn.Id(),
+		"dns_label":      "subnet",
+	})
+	assert.NoError(t, CoreSubnetResource().Create(subnet, clients))
+	assert.Equal(t, vcn.Get("default_route_table_id"), subnet.Get("route_table_id"))
+	assert.Equal(t, "10.0.1.1", subnet.Get("virtual_router_ip"))
+	assert.Equal(t, "subnet.vcn.oraclevcn.com", subnet.Get("subnet_domain_name"))
+
+	// Resources that are still referenced can not be deleted
+	client := clients.virtualNetworkClient
+	_, err := client.DeleteVcn(context.Background(), oci_core.DeleteVcnRequest{VcnId: oci_common.String(vcn.Id())})
+	assertFakeVirtualNetworkError(t, err, http.StatusConflict)
+	_, err = client.DeleteInternetGateway(context.Background(), oci_core.DeleteInternetGatewayRequest{IgId: oci_common.String(internetGateway.Id())})
+	assertFakeVirtualNetworkError(t, err, http.StatusConflict)
+	_, err = client.DeleteRouteTable(context.Background(), oci_core.DeleteRouteTableRequest{RtId: oci_common.String(defaultRouteTableId)})
+	assertFakeVirtualNetworkError(t, err, http.StatusConflict)
+
+	assert.NoError(t, CoreSubnetResource().Delete(subnet, clients))
+	assert.NoError(t, DefaultCoreRouteTableResource().Delete(defaultRouteTable, clients))
+	routeTable, err := client.GetRouteTable(context.Background(), oci_core.GetRouteTableRequest{RtId: oci_common.String(defaultRouteTableId)})
+	assert.NoError(t, err)
+	assert.Equal(t, "AVAILABLE", string(routeTable.LifecycleState))
+	assert.Empty(t, routeTable.RouteRules)
+
+	assert.NoError(t, CoreInternetGatewayResource().Delete(internetGateway, clients))
+	assert.NoError(t, CoreVcnResource().Delete(vcn, clients))
+
+	// The default resources are deleted with the VCN
+	routeTable, err = client.GetRouteTable(context.Background(), oci_core.GetRouteTableRequest{RtId: oci_common.String(defaultRouteTableId)})
+	assert.NoError(t, err)
+	assert.Equal(t, "TERMINATED", string(routeTable.LifecycleState))
+
+	_, err = client.GetVcn(context.Background(), oci_core.GetVcnRequest{VcnId: oci_common.String("ocid1.vcn.oc1.phx.missing")})
+	assertFakeVirtualNetworkError(t, err, http.StatusNotFound)
+}
+
+func TestFakeVirtualNetwork_pagination(t *testing.T) {
+	fake := newFakeVirtualNetwork()
+	clients := newFakeVirtualNetworkClients(t, fake, "")
+	client := clients.virtualNetworkClient
+
+	for _, cidrBlock := range []string{"10.0.0.0/16", "10.1.0.0/16", "10.2.0.0/16"} {
+		_, err := client.CreateVcn(context.Background(), oci_core.CreateVcnRequest{CreateVcnDetails: oci_core.CreateVcnDetails{
+			CidrBlock:     oci_common.String(cidrBlock),
+			CompartmentId: oci_common.String(testTenancyOCID),
+		}})
+		assert.NoError(t, err)
+	}
+
+	request := oci_core.ListVcnsRequest{CompartmentId: oci_common.String(testTenancyOCID), Limit: oci_common.Int(2)}
+	response, err := client.ListVcns(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Len(t, response.Items, 2)
+	assert.NotNil(t, response.OpcNextPage)
+
+	request.Page = response.OpcNextPage
+	response, err = client.ListVcns(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Len(t, response.Items, 1)
+	assert.Nil(t, response.OpcNextPage)
+
+	// The data source reads all the pages
+	vcns := schema.TestResourceDataRaw(t, CoreVcnsDataSource().Schema, map[string]interface{}{
+		"compartment_id": testTenancyOCID,
+	})
+	fake.pageSize = 1
+	assert.NoError(t, CoreVcnsDataSource().Read(vcns, clients))
+	assert.Equal(t, 3, vcns.Get("virtual_networks.#"))
+}
+
+func TestFakeVirtualNetwork_server(t *testing.T) {
+	server := httptest.NewTLSServer(newFakeVirtualNetwork())
+	defer server.Close()
+
+	client := newFakeVirtualNetworkClients(t, server.Client(), server.URL).virtualNetworkClient
+	response, err := client.CreateVcn(context.Background(), oci_core.CreateVcnRequest{CreateVcnDetails: oci_core.CreateVcnDetails{
+		CidrBlock:     oci_common.String("10.0.0.0/16"),
+		CompartmentId: oci_common.String(testTenancyOCID),
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, "PROVISIONING", string(response.LifecycleState))
+
+	vcn, err := client.GetVcn(context.Background(), oci_core.GetVcnRequest{VcnId: response.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, "AVAILABLE", string(vcn.LifecycleState))
+
+	_, err = client.CreateSubnet(context.Background(), oci_core.CreateSubnetRequest{CreateSubnetDetails: oci_core.CreateSubnetDetails{
+		CidrBlock:     oci_common.String("10.0.1.0/24"),
+		CompartmentId: oci_common.String(testTenancyOCID),
+		VcnId:         response.Id,
+		RouteTableId:  oci_common.String("ocid1.routetable.oc1.phx.missing"),
+	}})
+	assertFakeVirtualNetworkError(t, err, http.StatusBadRequest)
+}
+
+func TestFakeVirtualNetwork_domainNameOverride(t *testing.T) {
+	server := httptest.NewTLSServer(newFakeVirtualNetwork())
+	defer server.Close()
+
+	// The clients reach the fake through the host names rewritten with domain_name_override, which are all resolved to
+	// the fake's server
+	os.Setenv(tfEnvPrefix+domainNameOverrideEnv, "fakeoci.example.com")
+	defer os.Unsetenv(tfEnvPrefix + domainNameOverrideEnv)
+	mutex := &sync.Mutex{}
+	dialed := map[string]bool{}
+	httpClient := &http.Client{Transport: &http.Transport{
+		// The certificate of the server is issued to example.com
+		TLSClientConfig: &tls.Config{RootCAs: server.Client().Transport.(*http.Transport).TLSClientConfig.RootCAs, ServerName: "example.com"},
+		DialContext: func(ctx context.Context, network string, address string) (net.Conn, error) {
+			mutex.Lock()
+			dialed[address] = true
+			mutex.Unlock()
+			return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+		},
+	}}
+
+	password := "password"
+	configProvider := oci_common.NewRawConfigurationProvider(testTenancyOCID, testUserOCID, "us-phoenix-1", testKeyFingerPrint, testPrivateKey, &password)
+	clients := &OracleClients{}
+	assert.NoError(t, setGoSDKClients(clients, configProvider, httpClient, "fake", nil))
+	assert.Contains(t, clients.virtualNetworkClient.Host, "iaas.us-phoenix-1.fakeoci.example.com")
+
+	vcn := schema.TestResourceDataRaw(t, CoreVcnResource().Schema, map[string]interface{}{
+		"cidr_block":     "10.0.0.0/16",
+		"compartment_id": testTenancyOCID,
+	})
+	assert.NoError(t, CoreVcnResource().Create(vcn, clients))
+	assert.Equal(t, "AVAILABLE", vcn.Get("state"))
+	assert.Equal(t, map[string]bool{"iaas.us-phoenix-1.fakeoci.example.com:443": true}, dialed)
+}
+
+func assertFakeVirtualNetworkError(t *testing.T, err error, status int) {
+	failure, ok := oci_common.IsServiceError(err)
+	if assert.True(t, ok, "expected a service error, got %v", err) {
+		assert.Equal(t, status, failure.GetHTTPStatusCode())
+	}
+}
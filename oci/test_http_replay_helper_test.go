@@ -0,0 +1,321 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+)
+
+const (
+	httpReplayModeEnv        = "http_replay_mode"
+	httpReplayCassetteDirEnv = "http_replay_cassette_dir"
+	httpReplayRecordMode     = "record"
+	httpReplayReplayMode     = "replay"
+
+	defaultHttpReplayCassetteDir = "testdata/cassettes"
+	httpReplayVariablesFileName  = "variables.json"
+)
+
+// Response headers that are not recorded
+var httpReplayScrubbedHeaders = []string{"Set-Cookie", "Opc-Obo-Token", "Authorization"}
+
+// OCIDs have the form ocid1.<resource type>.<realm>.[region][.future use].<unique id>
+var httpReplayOcidPattern = regexp.MustCompile(`ocid1(\.[a-z0-9_-]*)*\.[a-z0-9]+`)
+
+// httpReplayer records the requests sent by the acceptance tests and their responses to a cassette per test, and
+// replays them so that the tests can run without credentials or network access. Request signatures are never recorded,
+// and the unique part of OCIDs is replaced with a hash of the OCID.
+type httpReplayer struct {
+	mode string
+	dir  string
+
+	mutex        sync.Mutex
+	cassettePath string
+	cassette     *httpReplayCassette
+	replayed     map[int]bool
+}
+
+type httpReplayCassette struct {
+	Interactions []*httpReplayInteraction `json:"interactions"`
+}
+
+type httpReplayInteraction struct {
+	Method                   string      `json:"method"`
+	Url                      string      `json:"url"`
+	RequestBody              string      `json:"request_body,omitempty"`
+	StatusCode               int         `json:"status_code"`
+	ResponseHeader           http.Header `json:"response_header,omitempty"`
+	ResponseBody             string      `json:"response_body,omitempty"`
+	ResponseBodyBase64Binary string      `json:"response_body_base64,omitempty"`
+}
+
+// httpReplayDispatcher sends requests through the replayer
+type httpReplayDispatcher struct {
+	dispatcher oci_common.HTTPRequestDispatcher
+	replayer   *httpReplayer
+}
+
+// newHttpReplayerFromEnv returns the replayer selected by the http_replay_mode setting, or nil if the tests should
+// send requests to the services without recording them
+func newHttpReplayerFromEnv() (*httpReplayer, error) {
+	mode := getEnvSettingWithBlankDefault(httpReplayModeEnv)
+	if mode == "" {
+		return nil, nil
+	}
+	if mode != httpReplayRecordMode && mode != httpReplayReplayMode {
+		return nil, fmt.Errorf("%s must be one of '%s' or '%s'", httpReplayModeEnv, httpReplayRecordMode, httpReplayReplayMode)
+	}
+
+	return newHttpReplayer(mode, getEnvSettingWithDefault(httpReplayCassetteDirEnv, defaultHttpReplayCassetteDir)), nil
+}
+
+func newHttpReplayer(mode string, dir string) *httpReplayer {
+	return &httpReplayer{mode: mode, dir: dir}
+}
+
+func (r *httpReplayer) isReplaying() bool {
+	return r != nil && r.mode == httpReplayReplayMode
+}
+
+// start selects the cassette of a test. Recording starts from an empty cassette, while replaying fails with an error
+// satisfying os.IsNotExist if the test was never recorded.
+func (r *httpReplayer) start(testName string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.cassettePath = filepath.Join(r.dir, strings.Replace(testName, "/", "_", -1)+".json")
+	r.cassette = &httpReplayCassette{}
+	r.replayed = map[int]bool{}
+
+	if r.mode == httpReplayRecordMode {
+		return os.MkdirAll(r.dir, 0755)
+	}
+
+	data, err := ioutil.ReadFile(r.cassettePath)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, r.cassette)
+}
+
+// wrap is the httpDispatcherWrapper of the replayer
+func (r *httpReplayer) wrap(dispatcher oci_common.HTTPRequestDispatcher) oci_common.HTTPRequestDispatcher {
+	return httpReplayDispatcher{dispatcher: dispatcher, replayer: r}
+}
+
+func (d httpReplayDispatcher) Do(request *http.Request) (*http.Response, error) {
+	if d.replayer.isReplaying() {
+		return d.replayer.replay(request)
+	}
+	return d.replayer.record(d.dispatcher, request)
+}
+
+func (r *httpReplayer) record(dispatcher oci_common.HTTPRequestDispatcher, request *http.Request) (*http.Response, error) {
+	requestBody, err := readAndRestoreBody(&request.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := dispatcher.Do(request)
+	if err != nil {
+		return response, err
+	}
+
+	responseBody, err := readAndRestoreBody(&response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	interaction := &httpReplayInteraction{
+		Method:         request.Method,
+		Url:            scrubOcids(request.URL.RequestURI()),
+		StatusCode:     response.StatusCode,
+		ResponseHeader: http.Header{},
+	}
+	// Request bodies are only recorded for reference, they are not matched when replaying
+	if utf8.Valid(requestBody) {
+		interaction.RequestBody = scrubOcids(string(requestBody))
+	}
+	for name, values := range response.Header {
+		for _, value := range values {
+			interaction.ResponseHeader.Add(name, scrubOcids(value))
+		}
+	}
+	for _, name := range httpReplayScrubbedHeaders {
+		interaction.ResponseHeader.Del(name)
+	}
+	if utf8.Valid(responseBody) {
+		interaction.ResponseBody = scrubOcids(string(responseBody))
+	} else {
+		interaction.ResponseBodyBase64Binary = base64.StdEncoding.EncodeToString(responseBody)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	// Requests of tests that do not select a cassette are sent without being recorded
+	if r.cassette == nil {
+		return response, nil
+	}
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+
+	// The cassette is saved after every request, so that it is complete even if the test panics
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return response, ioutil.WriteFile(r.cassettePath, data, 0644)
+}
+
+// replay returns the first recorded response to the same request that was not replayed yet. Requests are matched on
+// their method and URL, or on their path if their query changes between runs (e.g. with time ranges). Once all the
+// responses to a request were replayed, the last one is returned again, e.g. when polling the state of a resource.
+func (r *httpReplayer) replay(request *http.Request) (*http.Response, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.cassette == nil {
+		return nil, fmt.Errorf("no cassette was started to replay %s %s", request.Method, request.URL.Path)
+	}
+
+	index := r.findInteraction(request, func(interaction *httpReplayInteraction) bool {
+		return interaction.Url == request.URL.RequestURI()
+	})
+	if index < 0 {
+		index = r.findInteraction(request, func(interaction *httpReplayInteraction) bool {
+			return strings.SplitN(interaction.Url, "?", 2)[0] == request.URL.EscapedPath()
+		})
+	}
+	if index < 0 {
+		return nil, fmt.Errorf("no response to %s %s was recorded in %s", request.Method, request.URL.RequestURI(), r.cassettePath)
+	}
+	r.replayed[index] = true
+
+	interaction := r.cassette.Interactions[index]
+	body := []byte(interaction.ResponseBody)
+	if interaction.ResponseBodyBase64Binary != "" {
+		var err error
+		if body, err = base64.StdEncoding.DecodeString(interaction.ResponseBodyBase64Binary); err != nil {
+			return nil, err
+		}
+	}
+
+	header := http.Header{}
+	for name, values := range interaction.ResponseHeader {
+		header[name] = append([]string{}, values...)
+	}
+	return newHttpResponse(request, interaction.StatusCode, header, body), nil
+}
+
+// newHttpResponse returns the response to a request that was not sent over the network, as replayed or served by a fake
+func newHttpResponse(request *http.Request, statusCode int, header http.Header, body []byte) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+	}
+}
+
+// findInteraction returns the index of the first matching interaction that was not replayed yet, or else of the last
+// matching one
+func (r *httpReplayer) findInteraction(request *http.Request, matches func(interaction *httpReplayInteraction) bool) int {
+	last := -1
+	for index, interaction := range r.cassette.Interactions {
+		if interaction.Method != request.Method || !matches(interaction) {
+			continue
+		}
+		if !r.replayed[index] {
+			return index
+		}
+		last = index
+	}
+	return last
+}
+
+// saveVariables records the scrubbed values of the test variables, so that the configurations of the replayed tests
+// reference the same OCIDs as the recorded requests
+func (r *httpReplayer) saveVariables(names []string) error {
+	variables := map[string]string{}
+	for _, name := range names {
+		if value := getEnvSettingWithBlankDefault(name); value != "" {
+			variables[name] = scrubOcids(value)
+		}
+	}
+
+	if err := os.MkdirAll(r.dir, 0755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(variables, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(r.dir, httpReplayVariablesFileName), data, 0644)
+}
+
+// loadVariables sets the test variables to the values saved when the cassettes were recorded, if any
+func (r *httpReplayer) loadVariables() error {
+	data, err := ioutil.ReadFile(filepath.Join(r.dir, httpReplayVariablesFileName))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	variables := map[string]string{}
+	if err := json.Unmarshal(data, &variables); err != nil {
+		return err
+	}
+	for name, value := range variables {
+		if err := setEnvSetting(tfEnvPrefix+name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scrubOcids replaces the unique part of the OCIDs in a string with a hash of the OCID, which keeps references between
+// recorded requests consistent
+func scrubOcids(value string) string {
+	return httpReplayOcidPattern.ReplaceAllStringFunc(value, func(ocid string) string {
+		separator := strings.LastIndex(ocid, ".")
+		hash := sha256.Sum256([]byte(ocid))
+		return ocid[:separator+1] + "recorded" + hex.EncodeToString(hash[:])[:32]
+	})
+}
+
+// readAndRestoreBody reads a request or response body and replaces it with a reader of the same content
+func readAndRestoreBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil {
+		return nil, nil
+	}
+
+	content, err := ioutil.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		return nil, err
+	}
+	*body = ioutil.NopCloser(bytes.NewReader(content))
+	return content, nil
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testRecordedVcnId = "ocid1.vcn.oc1.phx.aaaaaaaaxrqeombwty6jyqgk3fraczdd63bv66xgfsqka4ktr7c57awr3p5a"
+
+// testHttpReplayService responds to the VCN requests with a VCN that is provisioned on the first read
+type testHttpReplayService struct {
+	requests []*http.Request
+}
+
+func (s *testHttpReplayService) Do(request *http.Request) (*http.Response, error) {
+	s.requests = append(s.requests, request)
+
+	state := "PROVISIONING"
+	if len(s.requests) > 2 {
+		state = "AVAILABLE"
+	}
+	body := `{"id":"` + testRecordedVcnId + `","lifecycleState":"` + state + `"}`
+	header := http.Header{}
+	header.Set("Opc-Request-Id", "request-id")
+	header.Set("Set-Cookie", "session=secret")
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     header,
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	}, nil
+}
+
+func newTestHttpReplayRequest(t *testing.T, method string, url string, body string) *http.Request {
+	request, err := http.NewRequest(method, url, bytes.NewReader([]byte(body)))
+	assert.NoError(t, err)
+	request.Header.Set("Authorization", `Signature keyId="`+testTenancyOCID+`/`+testUserOCID+`/`+testKeyFingerPrint+`"`)
+	return request
+}
+
+func readTestHttpReplayResponse(t *testing.T, dispatcher httpReplayDispatcher, request *http.Request) (int, string) {
+	response, err := dispatcher.Do(request)
+	assert.NoError(t, err)
+	if response == nil {
+		return 0, ""
+	}
+	body, err := ioutil.ReadAll(response.Body)
+	assert.NoError(t, err)
+	return response.StatusCode, string(body)
+}
+
+func TestHttpReplayer_recordAndReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "http-replay")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	service := &testHttpReplayService{}
+	recorder := newHttpReplayer(httpReplayRecordMode, dir)
+	assert.NoError(t, recorder.start("TestCoreVcnResource_basic"))
+	dispatcher := recorder.wrap(service).(httpReplayDispatcher)
+
+	createRequest := newTestHttpReplayRequest(t, http.MethodPost, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns", `{"compartmentId":"`+testTenancyOCID+`"}`)
+	_, body := readTestHttpReplayResponse(t, dispatcher, createRequest)
+	assert.Contains(t, body, testRecordedVcnId, "the recorded response should be returned unchanged")
+	createRequestBody, err := ioutil.ReadAll(service.requests[0].Body)
+	assert.NoError(t, err)
+	assert.Contains(t, string(createRequestBody), testTenancyOCID, "the request body should still be sent to the service")
+
+	for i := 0; i < 2; i++ {
+		readTestHttpReplayResponse(t, dispatcher, newTestHttpReplayRequest(t, http.MethodGet, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns/"+testRecordedVcnId, ""))
+	}
+
+	cassette, err := ioutil.ReadFile(filepath.Join(dir, "TestCoreVcnResource_basic.json"))
+	assert.NoError(t, err)
+	for _, secret := range []string{testRecordedVcnId, testTenancyOCID, "Signature", "session=secret"} {
+		assert.NotContains(t, string(cassette), secret)
+	}
+
+	// Replayed requests reference the scrubbed OCIDs of the recorded responses
+	scrubbedVcnId := scrubOcids(testRecordedVcnId)
+	assert.True(t, strings.HasPrefix(scrubbedVcnId, "ocid1.vcn.oc1.phx.recorded"))
+	assert.Equal(t, scrubbedVcnId, scrubOcids(testRecordedVcnId), "OCIDs should always be scrubbed the same way")
+
+	replayer := newHttpReplayer(httpReplayReplayMode, dir)
+	assert.NoError(t, replayer.start("TestCoreVcnResource_basic"))
+	dispatcher = replayer.wrap(nil).(httpReplayDispatcher)
+
+	_, body = readTestHttpReplayResponse(t, dispatcher, newTestHttpReplayRequest(t, http.MethodPost, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns", ""))
+	assert.Contains(t, body, scrubbedVcnId)
+
+	getUrl := "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns/" + scrubbedVcnId
+	for _, expectedState := range []string{"PROVISIONING", "AVAILABLE", "AVAILABLE"} {
+		status, body := readTestHttpReplayResponse(t, dispatcher, newTestHttpReplayRequest(t, http.MethodGet, getUrl, ""))
+		assert.Equal(t, http.StatusOK, status)
+		assert.Contains(t, body, `"lifecycleState":"`+expectedState+`"`, "responses should be replayed in order, and the last one repeated")
+	}
+
+	_, err = dispatcher.Do(newTestHttpReplayRequest(t, http.MethodDelete, getUrl, ""))
+	assert.Error(t, err, "requests that were not recorded should fail")
+	assert.Len(t, service.requests, 3, "replayed requests should not be sent to the service")
+
+	err = replayer.start("TestCoreSubnetResource_basic")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestHttpReplayer_variables(t *testing.T) {
+	dir, err := ioutil.TempDir("", "http-replay")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	variable := "http_replay_test_compartment_ocid"
+	assert.NoError(t, setEnvSetting(tfEnvPrefix+variable, testTenancyOCID))
+	defer os.Unsetenv(tfEnvPrefix + variable)
+
+	assert.NoError(t, newHttpReplayer(httpReplayRecordMode, dir).saveVariables([]string{variable}))
+	assert.NoError(t, os.Unsetenv(tfEnvPrefix+variable))
+	assert.NoError(t, newHttpReplayer(httpReplayReplayMode, dir).loadVariables())
+	assert.Equal(t, scrubOcids(testTenancyOCID), getEnvSettingWithBlankDefault(variable))
+}
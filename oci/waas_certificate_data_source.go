@@ -209,7 +209,7 @@ func readSingularWaasCertificate(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).waasClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type WaasCertificateDataSourceCrud struct {
@@ -222,7 +222,7 @@ func (s *WaasCertificateDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *WaasCertificateDataSourceCrud) Get() error {
+func (s *WaasCertificateDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_waas.GetCertificateRequest{}
 
 	if certificateId, ok := s.D.GetOkExists("certificate_id"); ok {
@@ -232,7 +232,7 @@ func (s *WaasCertificateDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "waas")
 
-	response, err := s.Client.GetCertificate(context.Background(), request)
+	response, err := s.Client.GetCertificate(ctx, request)
 	if err != nil {
 		return err
 	}
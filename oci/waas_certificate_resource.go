@@ -11,6 +11,9 @@ import (
 
 func WaasCertificateResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createWaasCertificate,
 		Read:     readWaasCertificate,
@@ -241,7 +244,7 @@ func createWaasCertificate(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).waasClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readWaasCertificate(d *schema.ResourceData, m interface{}) error {
@@ -249,7 +252,7 @@ func readWaasCertificate(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).waasClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateWaasCertificate(d *schema.ResourceData, m interface{}) error {
@@ -257,7 +260,7 @@ func updateWaasCertificate(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).waasClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteWaasCertificate(d *schema.ResourceData, m interface{}) error {
@@ -266,7 +269,7 @@ func deleteWaasCertificate(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).waasClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type WaasCertificateResourceCrud struct {
@@ -304,7 +307,7 @@ func (s *WaasCertificateResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *WaasCertificateResourceCrud) Create() error {
+func (s *WaasCertificateResourceCrud) Create(ctx context.Context) error {
 	request := oci_waas.CreateCertificateRequest{}
 
 	if certificateData, ok := s.D.GetOkExists("certificate_data"); ok {
@@ -346,7 +349,7 @@ func (s *WaasCertificateResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.CreateCertificate(context.Background(), request)
+	response, err := s.Client.CreateCertificate(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -355,7 +358,7 @@ func (s *WaasCertificateResourceCrud) Create() error {
 	return nil
 }
 
-func (s *WaasCertificateResourceCrud) Get() error {
+func (s *WaasCertificateResourceCrud) Get(ctx context.Context) error {
 	request := oci_waas.GetCertificateRequest{}
 
 	tmp := s.D.Id()
@@ -363,7 +366,7 @@ func (s *WaasCertificateResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.GetCertificate(context.Background(), request)
+	response, err := s.Client.GetCertificate(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -372,7 +375,7 @@ func (s *WaasCertificateResourceCrud) Get() error {
 	return nil
 }
 
-func (s *WaasCertificateResourceCrud) Update() error {
+func (s *WaasCertificateResourceCrud) Update(ctx context.Context) error {
 	request := oci_waas.UpdateCertificateRequest{}
 
 	tmp := s.D.Id()
@@ -397,7 +400,7 @@ func (s *WaasCertificateResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.UpdateCertificate(context.Background(), request)
+	response, err := s.Client.UpdateCertificate(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -406,7 +409,7 @@ func (s *WaasCertificateResourceCrud) Update() error {
 	return nil
 }
 
-func (s *WaasCertificateResourceCrud) Delete() error {
+func (s *WaasCertificateResourceCrud) Delete(ctx context.Context) error {
 	request := oci_waas.DeleteCertificateRequest{}
 
 	tmp := s.D.Id()
@@ -414,7 +417,7 @@ func (s *WaasCertificateResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getDeleteConflictRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	_, err := s.Client.DeleteCertificate(context.Background(), request)
+	_, err := s.Client.DeleteCertificate(ctx, request)
 	return err
 }
 
@@ -174,6 +174,16 @@ func TestWaasCertificateResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(singularDatasourceName, "freeform_tags.%", "1"),
 				),
 			},
+			// verify resource import
+			{
+				Config:            config,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"private_key_data",
+				},
+				ResourceName: resourceName,
+			},
 		},
 	})
 }
@@ -224,7 +234,7 @@ func init() {
 	}
 	resource.AddTestSweepers("WaasCertificate", &resource.Sweeper{
 		Name:         "WaasCertificate",
-		Dependencies: DependencyGraph["certificate"],
+		Dependencies: DependencyGraph["waasCertificate"],
 		F:            sweepWaasCertificateResource,
 	})
 }
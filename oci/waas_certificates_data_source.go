@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_common "github.com/oracle/oci-go-sdk/common"
 	oci_waas "github.com/oracle/oci-go-sdk/waas"
 )
@@ -15,7 +16,8 @@ func WaasCertificatesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readWaasCertificates,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -54,6 +56,25 @@ func WaasCertificatesDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(WaasCertificateResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_waas.ListCertificatesSortById),
+					string(oci_waas.ListCertificatesSortByCompartmentid),
+					string(oci_waas.ListCertificatesSortByDisplayname),
+					string(oci_waas.ListCertificatesSortByNotvalidafter),
+					string(oci_waas.ListCertificatesSortByTimecreated),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_waas.ListCertificatesSortOrderAsc),
+					string(oci_waas.ListCertificatesSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -63,7 +84,7 @@ func readWaasCertificates(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).waasClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type WaasCertificatesDataSourceCrud struct {
@@ -76,7 +97,7 @@ func (s *WaasCertificatesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *WaasCertificatesDataSourceCrud) Get() error {
+func (s *WaasCertificatesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_waas.ListCertificatesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -136,9 +157,18 @@ func (s *WaasCertificatesDataSourceCrud) Get() error {
 		request.TimeCreatedLessThan = &oci_common.SDKTime{Time: tmp}
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_waas.ListCertificatesSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_waas.ListCertificatesSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "waas")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListCertificates(context.Background(), request)
+	response, err := s.Client.ListCertificates(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -146,8 +176,8 @@ func (s *WaasCertificatesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListCertificates(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListCertificates(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -203,6 +233,8 @@ func (s *WaasCertificatesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, WaasCertificatesDataSource().Schema["certificates"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("certificates", resources); err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_waas "github.com/oracle/oci-go-sdk/waas"
 )
 
@@ -13,7 +14,8 @@ func WaasEdgeSubnetsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readWaasEdgeSubnets,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"edge_subnets": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -39,6 +41,23 @@ func WaasEdgeSubnetsDataSource() *schema.Resource {
 					},
 				},
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_waas.ListEdgeSubnetsSortByCidr),
+					string(oci_waas.ListEdgeSubnetsSortByRegion),
+					string(oci_waas.ListEdgeSubnetsSortByTimemodified),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_waas.ListEdgeSubnetsSortOrderAsc),
+					string(oci_waas.ListEdgeSubnetsSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -48,7 +67,7 @@ func readWaasEdgeSubnets(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).waasClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type WaasEdgeSubnetsDataSourceCrud struct {
@@ -61,12 +80,21 @@ func (s *WaasEdgeSubnetsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *WaasEdgeSubnetsDataSourceCrud) Get() error {
+func (s *WaasEdgeSubnetsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_waas.ListEdgeSubnetsRequest{}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_waas.ListEdgeSubnetsSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_waas.ListEdgeSubnetsSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "waas")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListEdgeSubnets(context.Background(), request)
+	response, err := s.Client.ListEdgeSubnets(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -74,8 +102,8 @@ func (s *WaasEdgeSubnetsDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListEdgeSubnets(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListEdgeSubnets(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -117,6 +145,8 @@ func (s *WaasEdgeSubnetsDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, WaasEdgeSubnetsDataSource().Schema["edge_subnets"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("edge_subnets", resources); err != nil {
 		return err
 	}
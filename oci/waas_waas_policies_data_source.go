@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_common "github.com/oracle/oci-go-sdk/common"
 	oci_waas "github.com/oracle/oci-go-sdk/waas"
 )
@@ -15,7 +16,8 @@ func WaasWaasPoliciesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readWaasWaasPolicies,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"max_results": dataSourceMaxResultsSchema(),
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -54,6 +56,23 @@ func WaasWaasPoliciesDataSource() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(WaasWaasPolicyResource()),
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_waas.ListWaasPoliciesSortById),
+					string(oci_waas.ListWaasPoliciesSortByDisplayname),
+					string(oci_waas.ListWaasPoliciesSortByTimecreated),
+				}, false),
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_waas.ListWaasPoliciesSortOrderAsc),
+					string(oci_waas.ListWaasPoliciesSortOrderDesc),
+				}, false),
+			},
 		},
 	}
 }
@@ -63,7 +82,7 @@ func readWaasWaasPolicies(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).waasClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type WaasWaasPoliciesDataSourceCrud struct {
@@ -76,7 +95,7 @@ func (s *WaasWaasPoliciesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *WaasWaasPoliciesDataSourceCrud) Get() error {
+func (s *WaasWaasPoliciesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_waas.ListWaasPoliciesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -136,9 +155,18 @@ func (s *WaasWaasPoliciesDataSourceCrud) Get() error {
 		request.TimeCreatedLessThan = &oci_common.SDKTime{Time: tmp}
 	}
 
+	if sortBy, ok := s.D.GetOkExists("sort_by"); ok {
+		request.SortBy = oci_waas.ListWaasPoliciesSortByEnum(sortBy.(string))
+	}
+
+	if sortOrder, ok := s.D.GetOkExists("sort_order"); ok {
+		request.SortOrder = oci_waas.ListWaasPoliciesSortOrderEnum(sortOrder.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "waas")
+	listOptions := pushDownFilters(s.D, &request)
 
-	response, err := s.Client.ListWaasPolicies(context.Background(), request)
+	response, err := s.Client.ListWaasPolicies(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -146,8 +174,8 @@ func (s *WaasWaasPoliciesDataSourceCrud) Get() error {
 	s.Res = &response
 	request.Page = s.Res.OpcNextPage
 
-	for request.Page != nil {
-		listResponse, err := s.Client.ListWaasPolicies(context.Background(), request)
+	for request.Page != nil && !listOptions.hasAllResults(len(s.Res.Items)) {
+		listResponse, err := s.Client.ListWaasPolicies(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -203,6 +231,8 @@ func (s *WaasWaasPoliciesDataSourceCrud) SetData() error {
 		resources = ApplyFilters(f.(*schema.Set), resources, WaasWaasPoliciesDataSource().Schema["waas_policies"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources = applyMaxResults(s.D, resources)
+
 	if err := s.D.Set("waas_policies", resources); err != nil {
 		return err
 	}
@@ -790,7 +790,7 @@ func readSingularWaasWaasPolicy(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).waasClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 type WaasWaasPolicyDataSourceCrud struct {
@@ -803,7 +803,7 @@ func (s *WaasWaasPolicyDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *WaasWaasPolicyDataSourceCrud) Get() error {
+func (s *WaasWaasPolicyDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_waas.GetWaasPolicyRequest{}
 
 	if waasPolicyId, ok := s.D.GetOkExists("waas_policy_id"); ok {
@@ -813,7 +813,7 @@ func (s *WaasWaasPolicyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "waas")
 
-	response, err := s.Client.GetWaasPolicy(context.Background(), request)
+	response, err := s.Client.GetWaasPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
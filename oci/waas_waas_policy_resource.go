@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
 
@@ -818,7 +817,7 @@ func createWaasWaasPolicy(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).waasClient
 
-	return CreateResource(d, sync)
+	return CreateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func readWaasWaasPolicy(d *schema.ResourceData, m interface{}) error {
@@ -826,7 +825,7 @@ func readWaasWaasPolicy(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).waasClient
 
-	return ReadResource(sync)
+	return ReadResource(m.(*OracleClients).StopContext(), sync)
 }
 
 func updateWaasWaasPolicy(d *schema.ResourceData, m interface{}) error {
@@ -834,7 +833,7 @@ func updateWaasWaasPolicy(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*OracleClients).waasClient
 
-	return UpdateResource(d, sync)
+	return UpdateResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 func deleteWaasWaasPolicy(d *schema.ResourceData, m interface{}) error {
@@ -843,7 +842,7 @@ func deleteWaasWaasPolicy(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*OracleClients).waasClient
 	sync.DisableNotFoundRetries = true
 
-	return DeleteResource(d, sync)
+	return DeleteResource(m.(*OracleClients).StopContext(), d, sync)
 }
 
 type WaasWaasPolicyResourceCrud struct {
@@ -851,6 +850,7 @@ type WaasWaasPolicyResourceCrud struct {
 	Client                 *oci_waas.WaasClient
 	Res                    *oci_waas.WaasPolicy
 	DisableNotFoundRetries bool
+	OpcWorkRequestId       *string
 }
 
 func (s *WaasWaasPolicyResourceCrud) ID() string {
@@ -882,7 +882,7 @@ func (s *WaasWaasPolicyResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *WaasWaasPolicyResourceCrud) Create() error {
+func (s *WaasWaasPolicyResourceCrud) Create(ctx context.Context) error {
 	request := oci_waas.CreateWaasPolicyRequest{}
 
 	request.AdditionalDomains = []string{}
@@ -959,17 +959,12 @@ func (s *WaasWaasPolicyResourceCrud) Create() error {
 	retryPolicy := getRetryPolicy(s.DisableNotFoundRetries, "waas")
 	request.RequestMetadata.RetryPolicy = retryPolicy
 
-	response, err := s.Client.CreateWaasPolicy(context.Background(), request)
+	response, err := s.Client.CreateWaasPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
 
-	workId := response.OpcWorkRequestId
-	waasPolicy, err := s.getPolicyFromWorkRequest(workId, retryPolicy, oci_waas.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
-	if err != nil {
-		return err
-	}
-	s.Res = waasPolicy
+	s.OpcWorkRequestId = response.OpcWorkRequestId
 	return nil
 }
 
@@ -1010,45 +1005,63 @@ func (s *WaasWaasPolicyResourceCrud) mapToOrigin(fieldKeyFormat string) (oci_waa
 	return result, nil
 }
 
-func (s *WaasWaasPolicyResourceCrud) getPolicyFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
-	actionTypeEnum oci_waas.WorkRequestResourceActionTypeEnum, timeout time.Duration) (*oci_waas.WaasPolicy, error) {
+func (s *WaasWaasPolicyResourceCrud) WorkRequestTracker() WorkRequestTracker {
+	return newWaasWorkRequestTracker(s.Client, s.DisableNotFoundRetries)
+}
 
-	//Wait until it finishes
-	policyId, err := waasWaitForWorkRequest(workId, "waas",
-		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
+func (s *WaasWaasPolicyResourceCrud) WorkRequestId() *string {
+	return s.OpcWorkRequestId
+}
 
-	if err != nil {
-		// Try to cancel the work request
-		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for policy: %v\n", workId, policyId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
-			oci_waas.CancelWorkRequestRequest{
-				WorkRequestId: workId,
-				RequestMetadata: oci_common.RequestMetadata{
-					RetryPolicy: retryPolicy,
-				},
-			})
-		if cancelErr != nil {
-			log.Printf("[DEBUG] cleanup cancelWorkRequest failed with the error: %v\n", cancelErr)
-		}
-		return nil, err
+func (s *WaasWaasPolicyResourceCrud) WorkRequestSucceeded(ctx context.Context, operation string, workRequest *WorkRequestStatus) error {
+	actionType := oci_waas.WorkRequestResourceActionTypeCreated
+	switch operation {
+	case updateOperation:
+		actionType = oci_waas.WorkRequestResourceActionTypeUpdated
+	case deleteOperation:
+		return nil
+	}
+
+	policyId := workRequest.ResourceIdentifier("waas", string(actionType))
+	if policyId == nil {
+		return fmt.Errorf("work request %s succeeded but did not report the policy as %s", workRequest.Id, actionType)
 	}
 
 	// Fetch the policy object
 	requestGet := oci_waas.GetWaasPolicyRequest{
 		WaasPolicyId: policyId,
 		RequestMetadata: oci_common.RequestMetadata{
-			RetryPolicy: retryPolicy,
+			RetryPolicy: getRetryPolicy(s.DisableNotFoundRetries, "waas"),
 		},
 	}
-	responseGet, err := s.Client.GetWaasPolicy(context.Background(), requestGet)
+	responseGet, err := s.Client.GetWaasPolicy(ctx, requestGet)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	waasPolicy := responseGet.WaasPolicy
-	return &waasPolicy, nil
+	s.Res = &responseGet.WaasPolicy
+	return nil
 }
 
-func (s *WaasWaasPolicyResourceCrud) Get() error {
+func (s *WaasWaasPolicyResourceCrud) WorkRequestFailed(ctx context.Context, operation string, workRequest *WorkRequestStatus) {
+	if operation == deleteOperation {
+		return
+	}
+
+	// Try to cancel the work request
+	log.Printf("[DEBUG] %s failed, attempting to cancel the workrequest: %v\n", operation, *s.OpcWorkRequestId)
+	_, cancelErr := s.Client.CancelWorkRequest(ctx,
+		oci_waas.CancelWorkRequestRequest{
+			WorkRequestId: s.OpcWorkRequestId,
+			RequestMetadata: oci_common.RequestMetadata{
+				RetryPolicy: getRetryPolicy(s.DisableNotFoundRetries, "waas"),
+			},
+		})
+	if cancelErr != nil {
+		log.Printf("[DEBUG] cleanup cancelWorkRequest failed with the error: %v\n", cancelErr)
+	}
+}
+
+func (s *WaasWaasPolicyResourceCrud) Get(ctx context.Context) error {
 	request := oci_waas.GetWaasPolicyRequest{}
 
 	tmp := s.D.Id()
@@ -1056,7 +1069,7 @@ func (s *WaasWaasPolicyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.GetWaasPolicy(context.Background(), request)
+	response, err := s.Client.GetWaasPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -1065,7 +1078,7 @@ func (s *WaasWaasPolicyResourceCrud) Get() error {
 	return nil
 }
 
-func (s *WaasWaasPolicyResourceCrud) Update() error {
+func (s *WaasWaasPolicyResourceCrud) Update(ctx context.Context) error {
 	request := oci_waas.UpdateWaasPolicyRequest{}
 
 	request.AdditionalDomains = []string{}
@@ -1133,17 +1146,12 @@ func (s *WaasWaasPolicyResourceCrud) Update() error {
 	retryPolicy := getRetryPolicy(s.DisableNotFoundRetries, "waas")
 	request.RequestMetadata.RetryPolicy = retryPolicy
 
-	response, err := s.Client.UpdateWaasPolicy(context.Background(), request)
+	response, err := s.Client.UpdateWaasPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
 
-	workId := response.OpcWorkRequestId
-	waasPolicy, err := s.getPolicyFromWorkRequest(workId, retryPolicy, oci_waas.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
-	if err != nil {
-		return err
-	}
-	s.Res = waasPolicy
+	s.OpcWorkRequestId = response.OpcWorkRequestId
 	return nil
 }
 
@@ -1169,7 +1177,7 @@ func (s *WaasWaasPolicyResourceCrud) objectMapToOriginMap(origins interface{}) (
 	return resultMap, nil
 }
 
-func (s *WaasWaasPolicyResourceCrud) Delete() error {
+func (s *WaasWaasPolicyResourceCrud) Delete(ctx context.Context) error {
 	request := oci_waas.DeleteWaasPolicyRequest{}
 
 	tmp := s.D.Id()
@@ -1177,16 +1185,13 @@ func (s *WaasWaasPolicyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.DeleteWaasPolicy(context.Background(), request)
+	response, err := s.Client.DeleteWaasPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
 
-	workId := response.OpcWorkRequestId
-	//Wait until it finishes
-	_, delWorkRequestErr := waasWaitForWorkRequest(workId, "waas",
-		oci_waas.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
-	return delWorkRequestErr
+	s.OpcWorkRequestId = response.OpcWorkRequestId
+	return nil
 }
 
 func (s *WaasWaasPolicyResourceCrud) SetData() error {
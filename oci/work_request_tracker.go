@@ -0,0 +1,180 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+)
+
+// Work request statuses, independent of the service that tracks the work request
+const (
+	workRequestStatusAccepted   = "ACCEPTED"
+	workRequestStatusInProgress = "IN_PROGRESS"
+	workRequestStatusCanceling  = "CANCELING"
+	workRequestStatusSucceeded  = "SUCCEEDED"
+	workRequestStatusFailed     = "FAILED"
+	workRequestStatusCanceled   = "CANCELED"
+)
+
+// WorkRequestTracker adapts the work request API of a service, so that the work requests of all services are
+// waited on and their failures are reported the same way
+type WorkRequestTracker interface {
+	// WorkRequestRetryPolicy returns the retry policy of the GetWorkRequest calls made while waiting for a work
+	// request, which stops retrying once the timeout of the wait has elapsed
+	WorkRequestRetryPolicy(timeout time.Duration) *oci_common.RetryPolicy
+	// GetWorkRequest returns the current status of the work request
+	GetWorkRequest(ctx context.Context, workRequestId string, retryPolicy *oci_common.RetryPolicy) (*WorkRequestStatus, error)
+	// ListWorkRequestErrors returns the messages of the errors that occurred while processing the work request
+	ListWorkRequestErrors(ctx context.Context, workRequest *WorkRequestStatus) ([]string, error)
+	// ListWorkRequestLogEntries returns the messages logged while processing the work request
+	ListWorkRequestLogEntries(ctx context.Context, workRequest *WorkRequestStatus) ([]string, error)
+}
+
+// WorkRequestPartialFailureTracker is implemented by the trackers of services whose work requests can succeed even
+// though some of their work failed
+type WorkRequestPartialFailureTracker interface {
+	// HasErrors returns whether errors occurred while processing the work request, whatever its status
+	HasErrors(workRequest *WorkRequestStatus) bool
+}
+
+// WorkRequestStatus is a work request of any service
+type WorkRequestStatus struct {
+	Id string
+	// Status is one of the workRequestStatus values
+	Status    string
+	Resources []WorkRequestResource
+	// WorkRequest is the service's own representation of the work request
+	WorkRequest interface{}
+}
+
+// WorkRequestResource is a resource affected by a work request
+type WorkRequestResource struct {
+	EntityType string
+	ActionType string
+	Identifier *string
+}
+
+// WorkRequestError reports a work request that did not succeed, along with the errors and log entries the
+// service recorded for it
+type WorkRequestError struct {
+	WorkRequest *WorkRequestStatus
+	Errors      []string
+	LogEntries  []string
+}
+
+func (e *WorkRequestError) Error() string {
+	message := fmt.Sprintf("work request %s did not succeed, status: %s", e.WorkRequest.Id, e.WorkRequest.Status)
+	if e.WorkRequest.Status == workRequestStatusSucceeded {
+		message = fmt.Sprintf("work request %s succeeded with errors", e.WorkRequest.Id)
+	}
+	if len(e.Errors) > 0 {
+		message += "\nErrors:\n  - " + strings.Join(e.Errors, "\n  - ")
+	}
+	if len(e.LogEntries) > 0 {
+		message += "\nLog entries:\n  - " + strings.Join(e.LogEntries, "\n  - ")
+	}
+	return message
+}
+
+// ResourceIdentifier returns the identifier of the first resource of the given entity type affected by the work
+// request with the given action. An empty action type matches any action.
+func (wr *WorkRequestStatus) ResourceIdentifier(entityType string, actionType string) *string {
+	for _, res := range wr.Resources {
+		if !strings.Contains(strings.ToLower(res.EntityType), entityType) {
+			continue
+		}
+		if actionType == "" || res.ActionType == actionType {
+			return res.Identifier
+		}
+	}
+	return nil
+}
+
+// waitForWorkRequest polls the work request until it finishes. If it does not succeed, or succeeds with errors for
+// the services that report them, the returned error is a *WorkRequestError. The last known status of the work
+// request is returned even if waiting failed.
+func waitForWorkRequest(ctx context.Context, tracker WorkRequestTracker, workRequestId *string, timeout time.Duration) (*WorkRequestStatus, error) {
+	if workRequestId == nil {
+		return nil, fmt.Errorf("no work request was returned by the service")
+	}
+
+	retryPolicy := tracker.WorkRequestRetryPolicy(timeout)
+	var workRequest *WorkRequestStatus
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			workRequestStatusAccepted,
+			workRequestStatusInProgress,
+			workRequestStatusCanceling,
+		},
+		Target: []string{
+			workRequestStatusSucceeded,
+			workRequestStatusFailed,
+			workRequestStatusCanceled,
+		},
+		Refresh: func() (interface{}, string, error) {
+			// Stop polling right away once Terraform stops the provider
+			if err := ctx.Err(); err != nil {
+				return nil, "", err
+			}
+			current, err := tracker.GetWorkRequest(ctx, *workRequestId, retryPolicy)
+			if err != nil {
+				return nil, "", err
+			}
+			workRequest = current
+			return current, current.Status, nil
+		},
+		Timeout: timeout,
+	}
+
+	if _, e := stateConf.WaitForState(); e != nil {
+		return workRequest, e
+	}
+
+	if workRequest.Status != workRequestStatusSucceeded {
+		return workRequest, newWorkRequestError(ctx, tracker, workRequest)
+	}
+	if partialFailureTracker, ok := tracker.(WorkRequestPartialFailureTracker); ok && partialFailureTracker.HasErrors(workRequest) {
+		return workRequest, newWorkRequestError(ctx, tracker, workRequest)
+	}
+	return workRequest, nil
+}
+
+func newWorkRequestError(ctx context.Context, tracker WorkRequestTracker, workRequest *WorkRequestStatus) error {
+	result := &WorkRequestError{WorkRequest: workRequest}
+
+	var err error
+	if result.Errors, err = tracker.ListWorkRequestErrors(ctx, workRequest); err != nil {
+		log.Printf("[WARN] unable to list the errors of work request %s: %v", workRequest.Id, err)
+	}
+	if result.LogEntries, err = tracker.ListWorkRequestLogEntries(ctx, workRequest); err != nil {
+		log.Printf("[WARN] unable to list the log entries of work request %s: %v", workRequest.Id, err)
+	}
+
+	return result
+}
+
+// waitForResourceWorkRequest waits for the work request started by the last Create, Update or Delete of a resource,
+// if the resource tracks its operations with work requests
+func waitForResourceWorkRequest(ctx context.Context, sync interface{}, timeout time.Duration, operation string) error {
+	tracked, ok := sync.(WorkRequestTrackedResource)
+	if !ok || tracked.WorkRequestId() == nil {
+		return nil
+	}
+
+	workRequest, err := waitForWorkRequest(ctx, tracked.WorkRequestTracker(), tracked.WorkRequestId(), timeout)
+	if err != nil {
+		if handler, ok := sync.(WorkRequestFailureHandler); ok && ctx.Err() == nil {
+			handler.WorkRequestFailed(ctx, operation, workRequest)
+		}
+		return err
+	}
+
+	return tracked.WorkRequestSucceeded(ctx, operation, workRequest)
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_waas "github.com/oracle/oci-go-sdk/waas"
+)
+
+type testWorkRequestTracker struct {
+	statuses      []string
+	errors        []string
+	logEntries    []string
+	retryPolicy   *oci_common.RetryPolicy
+	retryPolicies map[*oci_common.RetryPolicy]bool
+}
+
+func (t *testWorkRequestTracker) WorkRequestRetryPolicy(timeout time.Duration) *oci_common.RetryPolicy {
+	t.retryPolicy = getRetryPolicy(false, "containerengine")
+	return t.retryPolicy
+}
+
+func (t *testWorkRequestTracker) GetWorkRequest(ctx context.Context, workRequestId string, retryPolicy *oci_common.RetryPolicy) (*WorkRequestStatus, error) {
+	if t.retryPolicies == nil {
+		t.retryPolicies = map[*oci_common.RetryPolicy]bool{}
+	}
+	t.retryPolicies[retryPolicy] = true
+	status := t.statuses[0]
+	if len(t.statuses) > 1 {
+		t.statuses = t.statuses[1:]
+	}
+	clusterId := "ocid1.cluster.oc1..cluster"
+	return &WorkRequestStatus{
+		Id:     workRequestId,
+		Status: status,
+		Resources: []WorkRequestResource{
+			{EntityType: "Cluster", ActionType: "CREATED", Identifier: &clusterId},
+		},
+	}, nil
+}
+
+func (t *testWorkRequestTracker) ListWorkRequestErrors(ctx context.Context, workRequest *WorkRequestStatus) ([]string, error) {
+	return t.errors, nil
+}
+
+func (t *testWorkRequestTracker) ListWorkRequestLogEntries(ctx context.Context, workRequest *WorkRequestStatus) ([]string, error) {
+	return t.logEntries, nil
+}
+
+// testPartialFailureWorkRequestTracker reports the errors of the work requests that succeeded, as WAAS does
+type testPartialFailureWorkRequestTracker struct {
+	testWorkRequestTracker
+}
+
+func (t *testPartialFailureWorkRequestTracker) HasErrors(workRequest *WorkRequestStatus) bool {
+	return len(t.errors) > 0
+}
+
+type testWorkRequestTrackedResource struct {
+	tracker         *testWorkRequestTracker
+	workRequestId   *string
+	succeeded       string
+	failed          string
+	failedWithState bool
+}
+
+func (r *testWorkRequestTrackedResource) WorkRequestTracker() WorkRequestTracker {
+	return r.tracker
+}
+
+func (r *testWorkRequestTrackedResource) WorkRequestId() *string {
+	return r.workRequestId
+}
+
+func (r *testWorkRequestTrackedResource) WorkRequestSucceeded(ctx context.Context, operation string, workRequest *WorkRequestStatus) error {
+	r.succeeded = operation
+	return nil
+}
+
+func (r *testWorkRequestTrackedResource) WorkRequestFailed(ctx context.Context, operation string, workRequest *WorkRequestStatus) {
+	r.failed = operation
+	r.failedWithState = workRequest != nil
+}
+
+func TestWaitForWorkRequest_succeeded(t *testing.T) {
+	workRequestId := "ocid1.workrequest.oc1..succeeded"
+	tracker := &testWorkRequestTracker{statuses: []string{workRequestStatusAccepted, workRequestStatusInProgress, workRequestStatusSucceeded}}
+
+	workRequest, err := waitForWorkRequest(context.Background(), tracker, &workRequestId, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, workRequestStatusSucceeded, workRequest.Status)
+	assert.Equal(t, "ocid1.cluster.oc1..cluster", *workRequest.ResourceIdentifier("cluster", "CREATED"))
+	assert.Equal(t, "ocid1.cluster.oc1..cluster", *workRequest.ResourceIdentifier("cluster", ""))
+	assert.Nil(t, workRequest.ResourceIdentifier("cluster", "DELETED"))
+	assert.Nil(t, workRequest.ResourceIdentifier("nodepool", ""))
+
+	// The work request is polled with the retry policy of the tracker's service, for the whole wait
+	assert.Equal(t, map[*oci_common.RetryPolicy]bool{tracker.retryPolicy: true}, tracker.retryPolicies)
+}
+
+func TestWaitForWorkRequest_succeededWithErrors(t *testing.T) {
+	workRequestId := "ocid1.workrequest.oc1..partial"
+	tracker := &testPartialFailureWorkRequestTracker{testWorkRequestTracker{
+		statuses: []string{workRequestStatusInProgress, workRequestStatusSucceeded},
+		errors:   []string{"Certificate could not be applied"},
+	}}
+
+	workRequest, err := waitForWorkRequest(context.Background(), tracker, &workRequestId, time.Minute)
+	assert.Equal(t, workRequestStatusSucceeded, workRequest.Status)
+	assert.Error(t, err)
+	assert.Equal(t, "work request ocid1.workrequest.oc1..partial succeeded with errors\n"+
+		"Errors:\n  - Certificate could not be applied", err.Error())
+
+	// Work requests without errors succeed
+	tracker = &testPartialFailureWorkRequestTracker{testWorkRequestTracker{statuses: []string{workRequestStatusSucceeded}}}
+	_, err = waitForWorkRequest(context.Background(), tracker, &workRequestId, time.Minute)
+	assert.NoError(t, err)
+
+	// The errors of other services' work requests are only looked at when they did not succeed
+	otherTracker := &testWorkRequestTracker{statuses: []string{workRequestStatusSucceeded}, errors: []string{"Ignored"}}
+	_, err = waitForWorkRequest(context.Background(), otherTracker, &workRequestId, time.Minute)
+	assert.NoError(t, err)
+}
+
+func TestWaasWorkRequestTracker_hasErrors(t *testing.T) {
+	tracker := newWaasWorkRequestTracker(nil, false).(WorkRequestPartialFailureTracker)
+	message := "Policy could not be applied"
+	assert.True(t, tracker.HasErrors(&WorkRequestStatus{WorkRequest: &oci_waas.WorkRequest{Errors: []oci_waas.WorkRequestError{{Message: &message}}}}))
+	assert.False(t, tracker.HasErrors(&WorkRequestStatus{WorkRequest: &oci_waas.WorkRequest{}}))
+}
+
+func TestWaitForWorkRequest_failed(t *testing.T) {
+	workRequestId := "ocid1.workrequest.oc1..failed"
+	tracker := &testWorkRequestTracker{
+		statuses:   []string{workRequestStatusInProgress, workRequestStatusFailed},
+		errors:     []string{"Subnet has no available IP addresses"},
+		logEntries: []string{"Creating cluster", "Provisioning failed"},
+	}
+
+	workRequest, err := waitForWorkRequest(context.Background(), tracker, &workRequestId, time.Minute)
+	assert.Equal(t, workRequestStatusFailed, workRequest.Status)
+	assert.Error(t, err)
+
+	workRequestErr, ok := err.(*WorkRequestError)
+	assert.True(t, ok)
+	assert.Equal(t, tracker.errors, workRequestErr.Errors)
+	assert.Equal(t, tracker.logEntries, workRequestErr.LogEntries)
+	assert.Equal(t, "work request ocid1.workrequest.oc1..failed did not succeed, status: FAILED\n"+
+		"Errors:\n  - Subnet has no available IP addresses\n"+
+		"Log entries:\n  - Creating cluster\n  - Provisioning failed", err.Error())
+}
+
+func TestWaitForWorkRequest_cancelled(t *testing.T) {
+	workRequestId := "ocid1.workrequest.oc1..cancelled"
+	tracker := &testWorkRequestTracker{statuses: []string{workRequestStatusInProgress}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := waitForWorkRequest(ctx, tracker, &workRequestId, time.Minute)
+	assert.Error(t, err)
+}
+
+func TestWaitForResourceWorkRequest_basic(t *testing.T) {
+	workRequestId := "ocid1.workrequest.oc1..resource"
+
+	// Resources that did not start a work request are not waited on
+	notStarted := &testWorkRequestTrackedResource{tracker: &testWorkRequestTracker{}}
+	assert.NoError(t, waitForResourceWorkRequest(context.Background(), notStarted, time.Minute, createOperation))
+	assert.Empty(t, notStarted.succeeded)
+
+	succeeded := &testWorkRequestTrackedResource{
+		tracker:       &testWorkRequestTracker{statuses: []string{workRequestStatusSucceeded}},
+		workRequestId: &workRequestId,
+	}
+	assert.NoError(t, waitForResourceWorkRequest(context.Background(), succeeded, time.Minute, updateOperation))
+	assert.Equal(t, updateOperation, succeeded.succeeded)
+	assert.Empty(t, succeeded.failed)
+
+	failed := &testWorkRequestTrackedResource{
+		tracker:       &testWorkRequestTracker{statuses: []string{workRequestStatusCanceled}},
+		workRequestId: &workRequestId,
+	}
+	assert.Error(t, waitForResourceWorkRequest(context.Background(), failed, time.Minute, createOperation))
+	assert.Empty(t, failed.succeeded)
+	assert.Equal(t, createOperation, failed.failed)
+	assert.True(t, failed.failedWithState)
+}